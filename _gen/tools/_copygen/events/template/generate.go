@@ -25,8 +25,11 @@ func Generate(gen *models.Generator) (string, error) {
 	content.WriteString("import json \"github.com/goccy/go-json\"\n")
 	content.WriteString(generateHandlers(functions) + "\n")
 	content.WriteString(generateHandle(functions) + "\n")
+	content.WriteString(generateIntend(functions) + "\n")
 	content.WriteString(generateRemove(functions) + "\n")
 	content.WriteString(generatehandle(functions) + "\n")
+	content.WriteString(generateIsEvent(functions) + "\n")
+	content.WriteString(generateEventnames(functions) + "\n")
 	return content.String(), nil
 }
 
@@ -46,6 +49,9 @@ func generateHandlers(functions []*models.Function) string {
 	}
 
 	// add manual fields.
+	strct.WriteString("Raw []func(*RawEvent)\n")
+	strct.WriteString("subscriptions map[string][]*Subscription\n")
+	strct.WriteString("listeners map[string][]*Subscription\n")
 	strct.WriteString("mu sync.RWMutex\n")
 
 	strct.WriteString("}\n")
@@ -64,12 +70,32 @@ func generateHandle(functions []*models.Function) string {
 	fn.WriteString("bot.Handlers.mu.Lock()\n")
 	fn.WriteString("defer bot.Handlers.mu.Unlock()\n")
 	fn.WriteString("\n")
+	fn.WriteString("return bot.add(eventname, function, nil)\n")
+	fn.WriteString("}\n")
+	fn.WriteString("\n")
+	fn.WriteString("// add adds an event handler (with an optional subscription) for the given event to the bot.\n")
+	fn.WriteString("//\n")
+	fn.WriteString("// The bot's Handlers must be locked when add is called.\n")
+	fn.WriteString("func (bot *Client) add(eventname string, function interface{}, sub *Subscription) error {\n")
+	fn.WriteString("bot.intend(eventname)\n")
+	fn.WriteString("\n")
 	fn.WriteString("switch eventname {\n")
 
+	// write the raw event case.
+	fn.WriteString("case FlagGatewayEventNameRaw:\n")
+	fn.WriteString("if f, ok := function.(func(*RawEvent)); ok {\n")
+	fn.WriteString("bot.Handlers.Raw = append(bot.Handlers.Raw, f)\n")
+	fn.WriteString("bot.Handlers.track(eventname, len(bot.Handlers.Raw)-1, sub)\n")
+	fn.WriteString("LogEventHandler(Logger.Info(), bot.ApplicationID, eventname)." +
+		"Msg(\"added event handler\")\n")
+	fn.WriteString("return nil\n")
+	fn.WriteString("}\n")
+	fn.WriteString("\n")
+
 	// write cases.
 	cases := len(functions)
 	for i, function := range functions {
-		fn.WriteString(generateHandleCase(function.Name, generateIntentFlags(function.Options)))
+		fn.WriteString(generateHandleCase(function.Name))
 
 		if i+1 != cases {
 			fn.WriteString("\n")
@@ -94,22 +120,14 @@ func generateHandle(functions []*models.Function) string {
 }
 
 // generateHandleCase generates the switch case statement for the Handle function.
-func generateHandleCase(eventname string, flags []string) string {
+func generateHandleCase(eventname string) string {
 	var c strings.Builder
 	c.WriteString("case FlagGatewayEventName" + eventname + ":\n")
 
-	// add automatic intent calculation.
-	for _, flag := range flags {
-		c.WriteString("if !bot.Config.Gateway.IntentSet[" + flag + "] {\n")
-		c.WriteString("bot.Config.Gateway.IntentSet[" + flag + "] = true\n")
-		c.WriteString("bot.Config.Gateway.Intents |= " + flag + "\n")
-		c.WriteString("}\n")
-		c.WriteString("\n")
-	}
-
 	// add the event handler.
 	c.WriteString("if f, ok := function.(func(*" + eventname + ")); ok {\n")
 	c.WriteString("bot.Handlers." + eventname + " = append(bot.Handlers." + eventname + ", f)\n")
+	c.WriteString("bot.Handlers.track(eventname, len(bot.Handlers." + eventname + ")-1, sub)\n")
 	c.WriteString("LogEventHandler(Logger.Info(), bot.ApplicationID, eventname)." +
 		"Msg(\"added event handler\")\n")
 
@@ -118,6 +136,53 @@ func generateHandleCase(eventname string, flags []string) string {
 	return c.String()
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// Intend Func
+////////////////////////////////////////////////////////////////////////////////
+
+// generateIntend provides generated code for the intend function, which calculates intents automatically.
+func generateIntend(functions []*models.Function) string {
+	var fn strings.Builder
+	fn.WriteString("// intend adds the intents that are required to receive the given event to the bot.\n")
+	fn.WriteString("//\n")
+	fn.WriteString("// The bot's Handlers must be locked when intend is called.\n")
+	fn.WriteString("func (bot *Client) intend(eventname string) {\n")
+	fn.WriteString("switch eventname {\n")
+
+	// write cases.
+	var cases []string
+	for _, function := range functions {
+		if flags := generateIntentFlags(function.Options); len(flags) != 0 {
+			cases = append(cases, generateIntendCase(function.Name, flags))
+		}
+	}
+
+	fn.WriteString(strings.Join(cases, "\n"))
+	fn.WriteString("}\n")
+	fn.WriteString("}\n")
+	return fn.String()
+}
+
+// generateIntendCase generates the switch case statement for the intend function.
+func generateIntendCase(eventname string, flags []string) string {
+	var c strings.Builder
+	c.WriteString("case FlagGatewayEventName" + eventname + ":\n")
+
+	// add automatic intent calculation.
+	for i, flag := range flags {
+		c.WriteString("if !bot.Config.Gateway.IntentSet[" + flag + "] {\n")
+		c.WriteString("bot.Config.Gateway.IntentSet[" + flag + "] = true\n")
+		c.WriteString("bot.Config.Gateway.Intents |= " + flag + "\n")
+		c.WriteString("}\n")
+
+		if i+1 != len(flags) {
+			c.WriteString("\n")
+		}
+	}
+
+	return c.String()
+}
+
 // generateIntentFlags generates the Intent Flag strings for automatic intent calculation.
 func generateIntentFlags(options models.FunctionOptions) []string {
 	var intents []string
@@ -148,8 +213,18 @@ func generateRemove(functions []*models.Function) string {
 	fn.WriteString("bot.Handlers.mu.Lock()\n")
 	fn.WriteString("defer bot.Handlers.mu.Unlock()\n")
 	fn.WriteString("\n")
+	fn.WriteString("return bot.remove(eventname, index)\n")
+	fn.WriteString("}\n")
+	fn.WriteString("\n")
+	fn.WriteString("// remove removes the event handler at the given index from the bot.\n")
+	fn.WriteString("//\n")
+	fn.WriteString("// The bot's Handlers must be locked when remove is called.\n")
+	fn.WriteString("func (bot *Client) remove(eventname string, index int) error {\n")
 	fn.WriteString("switch eventname {\n")
 
+	// write the raw event case.
+	fn.WriteString(generateRemoveCase("Raw") + "\n")
+
 	// write cases.
 	cases := len(functions)
 	for i, function := range functions {
@@ -162,6 +237,7 @@ func generateRemove(functions []*models.Function) string {
 	fn.WriteString("}\n")
 	fn.WriteString("\n")
 
+	fn.WriteString("bot.Handlers.untrack(eventname, index)\n")
 	fn.WriteString("LogEventHandler(Logger.Info(), bot.ApplicationID, eventname)." +
 		"Msg(\"removed event handler\")\n")
 	fn.WriteString("\n")
@@ -192,8 +268,11 @@ func generateRemoveCase(eventname string) string {
 	c.WriteString("\n")
 
 	// remove the event handler.
+	//
+	// a new backing array is allocated, such that handle() can read a snapshot of the handlers
+	// without holding the lock while the handlers are called.
 	c.WriteString("bot.Handlers." + eventname + " = " +
-		"append(bot.Handlers." + eventname + "[:index], bot.Handlers." + eventname + "[index+1:]...)\n")
+		"append(bot.Handlers." + eventname + "[:index:index], bot.Handlers." + eventname + "[index+1:]...)\n")
 	return c.String()
 }
 
@@ -205,11 +284,12 @@ func generateRemoveCase(eventname string) string {
 func generatehandle(functions []*models.Function) string {
 	var fn strings.Builder
 	fn.WriteString("// handle handles an event using its name and data.\n")
-	fn.WriteString("func (bot *Client) handle(eventname string, data json.RawMessage) {\n")
-	fn.WriteString("bot.Handlers.mu.RLock()\n")
-	fn.WriteString("defer bot.Handlers.mu.RUnlock()\n")
+	fn.WriteString("//\n")
+	fn.WriteString("// The event handlers are called on their own goroutines when the dispatch is async.\n")
+	fn.WriteString("func (bot *Client) handle(d *dispatch) {\n")
+	fn.WriteString("bot.raw(d)\n")
 	fn.WriteString("\n")
-	fn.WriteString("switch eventname {\n")
+	fn.WriteString("switch d.eventname {\n")
 
 	// write cases.
 	cases := len(functions)
@@ -230,21 +310,72 @@ func generatehandle(functions []*models.Function) string {
 func generatehandleCase(eventname string) string {
 	var c strings.Builder
 	c.WriteString("case FlagGatewayEventName" + eventname + ":\n")
-	c.WriteString("if len(bot.Handlers." + eventname + ") != 0 {")
+
+	// read the handlers, such that a handler can add or remove handlers while it's called.
+	c.WriteString("bot.Handlers.mu.RLock()\n")
+	c.WriteString("handlers := bot.Handlers." + eventname + "\n")
+	c.WriteString("bot.Handlers.mu.RUnlock()\n")
+	c.WriteString("\n")
+	c.WriteString("if len(handlers) != 0 {")
 	c.WriteString("event := new(" + eventname + ")\n")
-	c.WriteString("if err := json.Unmarshal(data, event); err != nil {\n")
-	c.WriteString("LogEventHandler(Logger.Error(), bot.ApplicationID, eventname)." +
-		"Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventName" + eventname + ", Err: err, Action: ErrorEventActionUnmarshal})." +
-		"Msg(\"\")\n")
+	c.WriteString("if err := json.Unmarshal(d.data, event); err != nil {\n")
+	c.WriteString("d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventName" + eventname + ", Err: err, Action: ErrorEventActionUnmarshal})\n")
 	c.WriteString("return\n")
 	c.WriteString("}\n")
 	c.WriteString("\n")
 
-	// call the handlers.
-	c.WriteString("for _, handler := range bot.Handlers." + eventname + " {\n")
-	c.WriteString("go handler(event)\n")
+	// call the handlers (recovering from panics).
+	c.WriteString("for _, handler := range handlers {\n")
+	c.WriteString("call(d, handler, event)\n")
 	c.WriteString("}\n")
 	c.WriteString("}\n")
 
 	return c.String()
 }
+
+////////////////////////////////////////////////////////////////////////////////
+// isEvent Func
+////////////////////////////////////////////////////////////////////////////////
+
+// generateIsEvent provides generated code for the isEvent function.
+func generateIsEvent(functions []*models.Function) string {
+	names := make([]string, len(functions))
+	for i, function := range functions {
+		names[i] = "FlagGatewayEventName" + function.Name
+	}
+
+	var fn strings.Builder
+	fn.WriteString("// isEvent returns whether an event with the given name is handled by Disgo.\n")
+	fn.WriteString("func isEvent(eventname string) bool {\n")
+	fn.WriteString("switch eventname {\n")
+	fn.WriteString("case " + strings.Join(names, ",\n") + ":\n")
+	fn.WriteString("return true\n")
+	fn.WriteString("}\n")
+	fn.WriteString("\n")
+	fn.WriteString("return false\n")
+	fn.WriteString("}\n")
+	return fn.String()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// eventname Funcs
+////////////////////////////////////////////////////////////////////////////////
+
+// generateEventnames provides generated code for the eventname method of each event.
+func generateEventnames(functions []*models.Function) string {
+	var fn strings.Builder
+
+	cases := len(functions)
+	for i, function := range functions {
+		fn.WriteString("// eventname returns the name of the " + function.Name + " event.\n")
+		fn.WriteString("func (*" + function.Name + ") eventname() string {\n")
+		fn.WriteString("return FlagGatewayEventName" + function.Name + "\n")
+		fn.WriteString("}\n")
+
+		if i+1 != cases {
+			fn.WriteString("\n")
+		}
+	}
+
+	return fn.String()
+}
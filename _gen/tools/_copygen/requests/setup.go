@@ -40,8 +40,8 @@ type Copygen interface {
 	BatchEditApplicationCommandPermissions(*disgo.BatchEditApplicationCommandPermissions) (*disgo.GuildApplicationCommandPermissions, error)
 	// http POST
 	CreateInteractionResponse(*disgo.CreateInteractionResponse) error
-	// http PATCH
-	GetOriginalInteractionResponse(*disgo.GetOriginalInteractionResponse) error
+	// http GET
+	GetOriginalInteractionResponse(*disgo.GetOriginalInteractionResponse) (*disgo.Message, error)
 	// http PATCH
 	EditOriginalInteractionResponse(*disgo.EditOriginalInteractionResponse) (*disgo.Message, error)
 	// http DELETE
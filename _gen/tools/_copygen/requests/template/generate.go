@@ -151,6 +151,18 @@ func generateBody(function *models.Function) string {
 	body.WriteString("endpoint := " + endpoint + "\n")
 	body.WriteString("\n")
 
+	// check the permissions of the request (if applicable).
+	body.WriteString("if err = Preflight(bot, r); err != nil {\n")
+	body.WriteString(generatePreflightErrReturn(function, requestName) + "\n")
+	body.WriteString("}\n")
+	body.WriteString("\n")
+
+	// apply the default allowed mentions of the client (if applicable).
+	if allowedMentionsRequests[requestName] {
+		body.WriteString("r = applyAllowedMentions(bot, r)\n")
+		body.WriteString("\n")
+	}
+
 	// Write the function body.
 	//
 	// marshal the request.
@@ -423,6 +435,32 @@ func generateMultipartErrReturn(function *models.Function, request string) strin
 	}
 }
 
+// allowedMentionsRequests represents the requests that create (or edit) a message with allowed mentions.
+var allowedMentionsRequests = map[string]bool{
+	"CreateMessage":                   true,
+	"EditMessage":                     true,
+	"StartThreadinForumChannel":       true,
+	"ExecuteWebhook":                  true,
+	"EditWebhookMessage":              true,
+	"CreateInteractionResponse":       true,
+	"EditOriginalInteractionResponse": true,
+	"CreateFollowupMessage":           true,
+	"EditFollowupMessage":             true,
+}
+
+// generatePreflightErrReturn generates a return statement for the function.
+func generatePreflightErrReturn(function *models.Function, request string) string {
+	err := fmt.Sprintf(requestError, "endpoint", "err")
+	switch len(function.To) {
+	case 1:
+		return "return " + err
+	case 2:
+		return "return nil, " + err
+	default:
+		return "return nil, " + err
+	}
+}
+
 // generateSendRequestErrReturn generates a return statement for the function.
 func generateSendRequestErrReturn(function *models.Function, request string) string {
 	err := fmt.Sprintf(requestError, "endpoint", "err")
@@ -4,16 +4,24 @@ package disgo
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
+	"math/bits"
 	"mime/multipart"
+	"net/http"
 	"net/textproto"
 	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
 	"runtime"
+	"runtime/debug"
 	"strconv"
 	"strings"
 	"sync"
@@ -53,6 +61,17 @@ type Client struct {
 	// Sessions contains sessions a bot uses to interact with the Discord Gateway.
 	Sessions *SessionManager
 
+	// Dispatcher dispatches the events that a bot receives to its event handlers.
+	//
+	// Each event handler is called on its own goroutine when the Dispatcher is nil.
+	Dispatcher *Dispatcher
+
+	// ErrorHandler handles the errors that occur while the bot handles events
+	// or maintains its sessions (i.e., event handler panics and session errors).
+	//
+	// The ErrorHandler is called in addition to the Logger, and must NOT block.
+	ErrorHandler func(err error, ctx *ErrorContext)
+
 	ApplicationID string
 }
 
@@ -132,31 +151,14 @@ func DefaultConfig() *Config {
 
 // Request represents Discord Request parameters used to perform various actions by the client.
 type Request struct {
-	// RateLimiter represents an object that provides rate limit functionality.
-	RateLimiter RateLimiter
-
-	// Client is used to send requests.
-	//
-	// Use Client to set a custom User-Agent in the HTTP Request Header.
-	// https://discord.com/developers/docs/reference#user-agent
-	//
-	// https://pkg.go.dev/github.com/valyala/fasthttp#Client
-	Client *fasthttp.Client
-
-	// Timeout represents the amount of time a request will wait for a response.
-	Timeout time.Duration
-
-	// Retries represents the number of times a request may be retried upon failure.
-	//
-	// A request is ONLY retried when a Bad Gateway or Rate Limit is encountered.
-	Retries int
-
-	// RetryShared determines the behavior of a request when
-	// a (shared) per-resource rate limit is hit.
-	//
-	// set RetryShared to true (default) to retry a request (within the per-route rate limit)
-	// until it's successful or until it experiences a non-shared 429 status code.
-	RetryShared bool
+	RateLimiter     RateLimiter
+	Preflight       PermissionState
+	AllowedMentions *AllowedMentions
+	Client          *fasthttp.Client
+	PreflightUserID string
+	Timeout         time.Duration
+	Retries         int
+	RetryShared     bool
 }
 
 const (
@@ -190,11 +192,12 @@ func DefaultRequest() Request {
 	)
 
 	return Request{
-		RateLimiter: ratelimiter,
-		Client:      client,
-		Timeout:     defaultRequestTimeout,
-		Retries:     1,
-		RetryShared: true,
+		RateLimiter:     ratelimiter,
+		AllowedMentions: DefaultAllowedMentions(),
+		Client:          client,
+		Timeout:         defaultRequestTimeout,
+		Retries:         1,
+		RetryShared:     true,
 	}
 }
 
@@ -206,6 +209,12 @@ type Gateway struct {
 	// RateLimiter represents an object that provides rate limit functionality.
 	RateLimiter RateLimiter
 
+	// SessionStore represents an object that persists the state of a bot's sessions.
+	//
+	// When SessionStore is set, a session that connects without a session ID will attempt
+	// to resume the stored session (rather than identifying a new session).
+	SessionStore SessionStore
+
 	// Intents represents a Discord Gateway Intent.
 	//
 	// You must specify a Gateway Intent in order to receive specific information from an event.
@@ -220,12 +229,25 @@ type Gateway struct {
 	// https://discord.com/developers/docs/topics/gateway#update-presence
 	GatewayPresenceUpdate *GatewayPresenceUpdate
 
+	// SessionStatusHandler handles each transition of a bot's sessions from one status to another
+	// (i.e., SessionStatusConnecting to SessionStatusIdentifying).
+	//
+	// The SessionStatusHandler is called (in order) after the Session is unlocked,
+	// so it can call the Session's methods.
+	SessionStatusHandler func(s *Session, transition *SessionTransition)
+
 	// Intents represents a Discord Gateway Intent.
 	//
 	// You must specify a Gateway Intent in order to receive specific information from an event.
 	//
 	// https://discord.com/developers/docs/topics/gateway#gateway-intents
 	Intents BitFlag
+
+	// HeartbeatLatencyThreshold represents the maximum latency that a session's heartbeat
+	// may average before the session is reconnected (i.e., due to a zombie connection).
+	//
+	// The latency of a session's heartbeat is not checked when HeartbeatLatencyThreshold is 0.
+	HeartbeatLatencyThreshold time.Duration
 }
 
 const (
@@ -273,11 +295,14 @@ func DefaultGateway() Gateway {
 	}
 
 	return Gateway{
-		Intents:               0,
-		IntentSet:             intentSet,
-		GatewayPresenceUpdate: new(GatewayPresenceUpdate),
-		RateLimiter:           ratelimiter,
-		ShardManager:          nil,
+		Intents:                   0,
+		IntentSet:                 intentSet,
+		GatewayPresenceUpdate:     new(GatewayPresenceUpdate),
+		RateLimiter:               ratelimiter,
+		ShardManager:              nil,
+		SessionStore:              nil,
+		SessionStatusHandler:      nil,
+		HeartbeatLatencyThreshold: 0,
 	}
 }
 
@@ -317,6 +342,79 @@ func (g Gateway) DisableIntent(intent BitFlag) {
 	g.IntentSet[intent] = true
 }
 
+// Await waits for the next event that matches the predicate, then returns it.
+//
+// A nil predicate matches every event. The event handler that Await adds to the bot
+// is removed once Await returns (i.e., when an event is received or the context is done).
+//
+//	message, err := disgo.Await(ctx, bot, func(m *disgo.MessageCreate) bool {
+//		return m.ChannelID == channelID
+//	})
+func Await[T any, E event[T]](ctx context.Context, bot *Client, predicate func(*T) bool) (*T, error) {
+	events, err := Collect[T, E](ctx, bot, 1, predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	return events[0], nil
+}
+
+// Collect collects events that match the predicate until n events are collected,
+// then returns the collected events (in the order they are handled).
+//
+// When n <= 0, events are collected until the context is done (i.e., by a timeout or cancellation),
+// then the collected events are returned without an error. Otherwise, the collected events are returned
+// with the context's error when the context is done before n events are collected.
+//
+// A nil predicate matches every event. The predicate may be called concurrently
+// as soon as an event is received, so it must NOT block.
+// The event handler that Collect adds to the bot (see Listen) is removed once Collect returns.
+func Collect[T any, E event[T]](ctx context.Context, bot *Client, n int, predicate func(*T) bool) ([]*T, error) {
+	var mu sync.Mutex
+
+	var events []*T
+	if n > 0 {
+		events = make([]*T, 0, n)
+	}
+
+	collected := make(chan struct{})
+
+	sub := Listen[T, E](bot, func(event *T) {
+		if predicate != nil && !predicate(event) {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if n > 0 && len(events) == n {
+			return
+		}
+
+		events = append(events, event)
+
+		if len(events) == n {
+			close(collected)
+		}
+	})
+
+	select {
+	case <-collected:
+	case <-ctx.Done():
+	}
+
+	sub.Cancel()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if n <= 0 || len(events) == n {
+		return events, nil
+	}
+
+	return events, fmt.Errorf("collect %q: %w", sub.Event(), ctx.Err())
+}
+
 // Gateway Opcodes
 // https://discord.com/developers/docs/topics/opcodes-and-status-codes#gateway-gateway-opcodes
 const (
@@ -4607,8 +4705,8 @@ type ChannelMention struct {
 // https://discord.com/developers/docs/resources/channel#allowed-mentions-object-allowed-mentions-structure
 type AllowedMentions struct {
 	Parse       []*string `json:"parse"`
-	Roles       []*string `json:"roles"`
-	Users       []*string `json:"users"`
+	Roles       []*string `json:"roles,omitempty"`
+	Users       []*string `json:"users,omitempty"`
 	RepliedUser bool      `json:"replied_user"`
 }
 
@@ -5775,6 +5873,462 @@ func (d Modal) InteractionCallbackDataType() Flag {
 	return FlagInteractionCallbackTypeMODAL
 }
 
+// DispatchMode represents the mode that a Dispatcher uses to dispatch events.
+type DispatchMode int
+
+// Dispatch Modes
+const (
+	// DispatchModeConcurrent dispatches each event handler on its own goroutine.
+	//
+	// This is the default mode (when the Client.Dispatcher is nil).
+	DispatchModeConcurrent DispatchMode = 0
+
+	// DispatchModeOrdered dispatches events one at a time (in the order they are received).
+	//
+	// The event handlers of an event are called in the order they were added.
+	DispatchModeOrdered DispatchMode = 1
+
+	// DispatchModeKeyed dispatches events using a pool of workers, such that
+	// events with the same key (i.e., Guild ID) are dispatched in the order they are received.
+	DispatchModeKeyed DispatchMode = 2
+)
+
+const (
+	// defaultDispatchQueueSize represents the default amount of events a dispatch worker can queue.
+	defaultDispatchQueueSize = 1024
+)
+
+// Dispatcher represents an object that dispatches events to a bot's event handlers.
+//
+// A Dispatcher with workers (DispatchModeOrdered or DispatchModeKeyed) calls event handlers
+// on its workers' goroutines. An event is passed to the bot's listeners (i.e., Await, Collect, and Once)
+// before it's queued, such that an event handler can wait for another event without a deadlock.
+type Dispatcher struct {
+	// Key returns the key of an event in DispatchModeKeyed.
+	//
+	// Events with the same key are dispatched in the order they are received.
+	Key func(eventname string, data json.RawMessage) string
+
+	// queues represents the bounded queue of each dispatch worker.
+	queues []chan *dispatch
+
+	// workers is used to wait for the dispatch workers to finish when the Dispatcher is closed.
+	workers sync.WaitGroup
+
+	// metrics represents the metrics of the Dispatcher.
+	metrics DispatcherMetrics
+
+	// Mode represents the Dispatch Mode of the Dispatcher.
+	Mode DispatchMode
+
+	// mu protects the queues from being closed while an event is queued.
+	mu sync.RWMutex
+
+	// closed represents whether the Dispatcher is closed.
+	closed bool
+}
+
+// dispatch represents an event that is dispatched to a bot's event handlers.
+type dispatch struct {
+	// bot represents the bot that received the event.
+	bot *Client
+
+	// shard represents the [shard_id, num_shards] of the Session that received the event.
+	shard *[2]int
+
+	// session represents the ID of the Session that received the event.
+	session string
+
+	// eventname represents the name of the event.
+	eventname string
+
+	// data represents the raw payload data of the event.
+	data json.RawMessage
+
+	// seq represents the sequence number of the event.
+	seq int64
+
+	// async determines whether the event handlers are called on their own goroutines.
+	async bool
+
+	// replayed indicates whether the event is replayed (upon resuming a Session).
+	replayed bool
+}
+
+// newDispatch returns the dispatch of an event that a Session receives.
+func newDispatch(bot *Client, s *Session, eventname string, seq int64, data json.RawMessage) *dispatch {
+	d := &dispatch{
+		bot:       bot,
+		shard:     nil,
+		session:   "",
+		eventname: eventname,
+		data:      data,
+		seq:       seq,
+		async:     true,
+		replayed:  false,
+	}
+
+	if s != nil {
+		d.session = s.ID
+
+		if s.Shard != nil {
+			shard := *s.Shard
+			d.shard = &shard
+		}
+	}
+
+	return d
+}
+
+// DispatcherMetrics represents the metrics of a Dispatcher.
+type DispatcherMetrics struct {
+	// Queued represents the amount of events that are queued (and not dispatched).
+	Queued int64
+
+	// Dispatched represents the amount of events that have been dispatched.
+	Dispatched int64
+
+	// Blocked represents the amount of times an event was queued while its queue was full.
+	//
+	// A full queue blocks the Session that received the event (backpressure)
+	// until the queue has space for the event.
+	Blocked int64
+
+	// BlockedTime represents the total amount of time that Sessions were blocked by full queues.
+	BlockedTime time.Duration
+
+	// Dropped represents the amount of events that were dropped, since they were received
+	// after the Dispatcher was closed.
+	Dropped int64
+}
+
+// NewDispatcher creates a new Dispatcher with the given Dispatch Mode.
+//
+// workers represents the amount of workers used in DispatchModeKeyed,
+// while size represents the maximum amount of events a worker can queue.
+func NewDispatcher(mode DispatchMode, workers, size int) *Dispatcher {
+	d := &Dispatcher{ //nolint:exhaustruct
+		Key:  DispatchKeyGuild,
+		Mode: mode,
+	}
+
+	switch mode {
+	case DispatchModeOrdered:
+		workers = 1
+
+	case DispatchModeKeyed:
+		if workers <= 0 {
+			workers = 1
+		}
+
+	default:
+		return d
+	}
+
+	if size <= 0 {
+		size = defaultDispatchQueueSize
+	}
+
+	d.queues = make([]chan *dispatch, workers)
+	for i := range d.queues {
+		d.queues[i] = make(chan *dispatch, size)
+
+		d.workers.Add(1)
+		go d.work(d.queues[i])
+	}
+
+	return d
+}
+
+// Metrics returns a snapshot of the Dispatcher's metrics.
+func (d *Dispatcher) Metrics() DispatcherMetrics {
+	return DispatcherMetrics{
+		Queued:      atomic.LoadInt64(&d.metrics.Queued),
+		Dispatched:  atomic.LoadInt64(&d.metrics.Dispatched),
+		Blocked:     atomic.LoadInt64(&d.metrics.Blocked),
+		BlockedTime: time.Duration(atomic.LoadInt64((*int64)(&d.metrics.BlockedTime))),
+		Dropped:     atomic.LoadInt64(&d.metrics.Dropped),
+	}
+}
+
+// Close stops the Dispatcher after every queued event is dispatched.
+//
+// Events that are dispatched to the Dispatcher once Close is called are dropped.
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+
+	if d.closed {
+		d.mu.Unlock()
+
+		return
+	}
+
+	d.closed = true
+
+	for _, queue := range d.queues {
+		close(queue)
+	}
+
+	d.mu.Unlock()
+
+	d.workers.Wait()
+}
+
+// dispatch dispatches an event to the bot's event handlers, then returns whether the event is dropped.
+func (d *Dispatcher) dispatch(event *dispatch) bool {
+	// prevent the queues from being closed while the event is queued.
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		atomic.AddInt64(&d.metrics.Dropped, 1)
+
+		LogEventHandler(Logger.Error(), event.bot.ApplicationID, event.eventname).Msg("dropped event after the dispatcher was closed")
+
+		return true
+	}
+
+	// pass the event to the listeners prior to queueing it,
+	// such that the event handlers that wait for the event are NOT deadlocked.
+	event.bot.listen(event)
+
+	if len(d.queues) == 0 {
+		atomic.AddInt64(&d.metrics.Dispatched, 1)
+
+		go event.bot.handle(event)
+
+		return false
+	}
+
+	queue := d.queues[0]
+	if len(d.queues) > 1 {
+		queue = d.queues[d.worker(event.eventname, event.data)]
+	}
+
+	// call the event handlers on the worker's goroutine.
+	event.async = false
+
+	atomic.AddInt64(&d.metrics.Queued, 1)
+
+	select {
+	case queue <- event:
+	default:
+		// apply backpressure until the queue has space for the event.
+		atomic.AddInt64(&d.metrics.Blocked, 1)
+
+		blocked := time.Now()
+		queue <- event
+		atomic.AddInt64((*int64)(&d.metrics.BlockedTime), int64(time.Since(blocked)))
+	}
+
+	return false
+}
+
+// worker returns the index of the worker that dispatches the given event.
+func (d *Dispatcher) worker(eventname string, data json.RawMessage) int {
+	key := DispatchKeyGuild
+	if d.Key != nil {
+		key = d.Key
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(key(eventname, data))) //nolint:errcheck
+
+	return int(hash.Sum32() % uint32(len(d.queues)))
+}
+
+// work dispatches the events from a queue in order.
+func (d *Dispatcher) work(queue chan *dispatch) {
+	defer d.workers.Done()
+
+	for event := range queue {
+		event.bot.handle(event)
+
+		atomic.AddInt64(&d.metrics.Queued, -1)
+		atomic.AddInt64(&d.metrics.Dispatched, 1)
+	}
+}
+
+// Dispatch dispatches an event that is received from a Gateway proxy (instead of a Session)
+// to the bot's event handlers using the bot's Dispatcher.
+//
+// An ErrorEvent is returned when the event is unknown to Disgo or the bot's Dispatcher is closed.
+func (bot *Client) Dispatch(eventname string, data json.RawMessage) error {
+	if !isEvent(eventname) {
+		return ErrorEvent{
+			ClientID: bot.ApplicationID,
+			Event:    eventname,
+			Err:      fmt.Errorf("%s", errDispatchUnknownEvent),
+			Action:   ErrorEventActionDispatch,
+		}
+	}
+
+	if bot.dispatch(newDispatch(bot, nil, eventname, 0, data)) {
+		return ErrorEvent{
+			ClientID: bot.ApplicationID,
+			Event:    eventname,
+			Err:      fmt.Errorf("%s", errDispatchClosed),
+			Action:   ErrorEventActionDispatch,
+		}
+	}
+
+	return nil
+}
+
+// dispatch dispatches an event that a Session receives to the bot's event handlers
+// using the bot's Dispatcher, then returns whether the event is dropped.
+func (bot *Client) dispatch(event *dispatch) bool {
+	if bot.Dispatcher == nil {
+		bot.listen(event)
+
+		go bot.handle(event)
+
+		return false
+	}
+
+	return bot.Dispatcher.dispatch(event)
+}
+
+// replay dispatches the events that a Session replays to the bot's event handlers in order.
+//
+// A Dispatcher with workers dispatches replayed events before the events that the Session receives
+// after resuming. Otherwise, replayed events are NOT ordered with respect to live events.
+func (bot *Client) replay(events []*dispatch) {
+	if len(events) == 0 {
+		return
+	}
+
+	// a Dispatcher with workers dispatches the events in order.
+	if bot.Dispatcher != nil && len(bot.Dispatcher.queues) != 0 {
+		for _, event := range events {
+			bot.Dispatcher.dispatch(event)
+		}
+
+		return
+	}
+
+	for _, event := range events {
+		bot.listen(event)
+	}
+
+	// otherwise, the events are handled one at a time on a separate goroutine,
+	// which runs concurrently with the events that the Session receives after resuming.
+	go func() {
+		for _, event := range events {
+			event.async = false
+			bot.handle(event)
+		}
+	}()
+}
+
+// call calls an event handler with the given event.
+//
+// The event handler is called on its own goroutine when the dispatch is async.
+func call[T any](d *dispatch, handler func(*T), event *T) {
+	if d.async {
+		go invoke(d, handler, event)
+
+		return
+	}
+
+	invoke(d, handler, event)
+}
+
+// invoke calls an event handler with the given event, then recovers from a panic
+// that occurs in the event handler, such that the panic does NOT crash the bot.
+func invoke[T any](d *dispatch, handler func(*T), event *T) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.error(ErrorPanic{
+				Value:    r,
+				ClientID: d.bot.ApplicationID,
+				Event:    d.eventname,
+				Stack:    debug.Stack(),
+			})
+		}
+	}()
+
+	handler(event)
+}
+
+// error logs an error that occurs while an event is handled, then reports it to the bot's ErrorHandler.
+func (d *dispatch) error(err error) {
+	LogEventHandler(Logger.Error(), d.bot.ApplicationID, d.eventname).Err(err).Msg("")
+
+	d.bot.report(err, d.context())
+}
+
+// context returns the ErrorContext of an error that occurs while an event is handled.
+func (d *dispatch) context() *ErrorContext {
+	return &ErrorContext{
+		Shard:     d.shard,
+		SessionID: d.session,
+		Event:     d.eventname,
+		Payload:   d.data,
+	}
+}
+
+// report reports an error to the bot's ErrorHandler (if applicable).
+func (bot *Client) report(err error, ctx *ErrorContext) {
+	if bot.ErrorHandler == nil {
+		return
+	}
+
+	bot.ErrorHandler(err, ctx)
+}
+
+// dispatchKey represents the fields of an event that are used to determine its dispatch key.
+type dispatchKey struct {
+	ID        string `json:"id"`
+	GuildID   string `json:"guild_id"`
+	ChannelID string `json:"channel_id"`
+}
+
+// DispatchKeyGuild returns the Guild ID of an event (or the Channel ID of an event without a Guild ID).
+func DispatchKeyGuild(eventname string, data json.RawMessage) string {
+	var key dispatchKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return ""
+	}
+
+	switch eventname {
+	case FlagGatewayEventNameGuildCreate,
+		FlagGatewayEventNameGuildUpdate,
+		FlagGatewayEventNameGuildDelete:
+		return key.ID
+	}
+
+	if key.GuildID != "" {
+		return key.GuildID
+	}
+
+	return key.ChannelID
+}
+
+// DispatchKeyChannel returns the Channel ID of an event (or the Guild ID of an event without a Channel ID).
+func DispatchKeyChannel(eventname string, data json.RawMessage) string {
+	var key dispatchKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return ""
+	}
+
+	switch eventname {
+	case FlagGatewayEventNameChannelCreate,
+		FlagGatewayEventNameChannelUpdate,
+		FlagGatewayEventNameChannelDelete,
+		FlagGatewayEventNameThreadCreate,
+		FlagGatewayEventNameThreadUpdate,
+		FlagGatewayEventNameThreadDelete:
+		return key.ID
+	}
+
+	if key.ChannelID != "" {
+		return key.ChannelID
+	}
+
+	return key.GuildID
+}
+
 // Discord API Endpoints
 const (
 	EndpointBaseURL    = "https://discord.com/api/v" + VersionDiscordAPI + "/"
@@ -6923,6 +7477,10 @@ func (e ErrorRequest) Error() string {
 		e.ClientID, e.CorrelationID, e.RouteID, e.ResourceID, e.Endpoint, e.Err).Error()
 }
 
+func (e ErrorRequest) Unwrap() error {
+	return e.Err
+}
+
 // Status Code Error Messages.
 const (
 	errStatusCodeKnown   = "status code %d: %v"
@@ -6982,6 +7540,13 @@ const (
 	ErrorEventActionMarshal   = "marshalling"
 	ErrorEventActionRead      = "reading"
 	ErrorEventActionWrite     = "writing"
+	ErrorEventActionDispatch  = "dispatching"
+)
+
+// Event Dispatch Error Messages.
+const (
+	errDispatchUnknownEvent = "event is unknown"
+	errDispatchClosed       = "dispatcher is closed"
 )
 
 // ErrorEvent represents a WebSocket error that occurs when an attempt to {action} an event fails.
@@ -6997,11 +7562,12 @@ type ErrorEvent struct {
 
 	// Action represents the action that prompted the error.
 	//
-	// ErrorEventAction's can be one of four values:
+	// ErrorEventAction's can be one of five values:
 	// ErrorEventActionUnmarshal: an error occurred while unmarshalling the Event from a JSON.
 	// ErrorEventActionMarshal:   an error occurred while marshalling the Event to a JSON.
 	// ErrorEventActionRead:      an error occurred while reading the Event from a Websocket Connection.
 	// ErrorEventActionWrite:     an error occurred while writing the Event to a Websocket Connection.
+	// ErrorEventActionDispatch:  an error occurred while dispatching the Event (see Client.Dispatch).
 	Action string
 }
 
@@ -7010,15 +7576,53 @@ func (e ErrorEvent) Error() string {
 		e.ClientID, e.Event, e.Action, e.Err).Error()
 }
 
-// Discord Gateway Error Messages
-const (
-	errNoSessionManager = `The client must contain a non-nil SessionManager to connect to the Discord Gateway.
+// ErrorPanic represents an Event Handler error that occurs when an event handler panics.
+type ErrorPanic struct {
+	// Value represents the value that the event handler panicked with.
+	Value any
 
-Set the *Client.SessionManager using one of the following methods.
+	// ClientID represents the Application ID of the event handler owner.
+	ClientID string
 
---- 1
+	// Event represents the name of the event involved in this error.
+	Event string
 
-bot := &disgo.Client{
+	// Stack represents the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+func (e ErrorPanic) Error() string {
+	return fmt.Errorf("EVENT HANDLER PANIC: client %q: event %q: panic: %v\n%s",
+		e.ClientID, e.Event, e.Value, e.Stack).Error()
+}
+
+// ErrorContext represents the context of an error that is sent to a Client's ErrorHandler.
+//
+// The Event and Payload of an ErrorContext are empty for the errors that are NOT caused by an event
+// (i.e., an ErrorSession or ErrorDisconnect that disconnects a Session).
+type ErrorContext struct {
+	// Shard represents the [shard_id, num_shards] of the Session involved in the error (if applicable).
+	Shard *[2]int
+
+	// SessionID represents the ID of the Session involved in the error (if applicable).
+	SessionID string
+
+	// Event represents the name of the event involved in the error (if applicable).
+	Event string
+
+	// Payload represents the raw payload data of the event involved in the error (if applicable).
+	Payload json.RawMessage
+}
+
+// Discord Gateway Error Messages
+const (
+	errNoSessionManager = `The client must contain a non-nil SessionManager to connect to the Discord Gateway.
+
+Set the *Client.SessionManager using one of the following methods.
+
+--- 1
+
+bot := &disgo.Client{
 ...
 Sessions: 	disgo.NewSessionManager()
 }
@@ -7043,6 +7647,87 @@ func (e ErrorSession) Error() string {
 	return fmt.Errorf("SESSION ERROR: session %q: error: %w", e.SessionID, e.Err).Error()
 }
 
+// ErrorGatewayClose represents a WebSocket Session error that occurs when the Discord Gateway
+// closes a Session with a Gateway Close Event Code that does NOT allow the Session to reconnect.
+//
+// An ErrorGatewayClose is terminal: The Session must NOT be reconnected
+// until the cause of the error (i.e., an invalid token or intents) is fixed.
+type ErrorGatewayClose struct {
+	// Err represents the error that occurred.
+	Err error
+
+	// Code represents the Gateway Close Event Code of the disconnection.
+	Code *GatewayCloseEventCode
+
+	// SessionID represents the ID of the Session.
+	SessionID string
+}
+
+func (e ErrorGatewayClose) Error() string {
+	return fmt.Errorf("SESSION CLOSED: session %q: Gateway Close Event Code %d %s: %s: %w",
+		e.SessionID, e.Code.Code, e.Code.Description, e.Code.Explanation, e.Err).Error()
+}
+
+func (e ErrorGatewayClose) Unwrap() error {
+	return e.Err
+}
+
+// ErrorSequence represents a WebSocket Session error that occurs when a Session receives
+// an event with an unexpected sequence number (i.e., a gap or duplicate).
+type ErrorSequence struct {
+	// SessionID represents the ID of the Session.
+	SessionID string
+
+	// Event represents the name of the event involved in this error.
+	Event string
+
+	// Expected represents the sequence number that the Session expected.
+	Expected int64
+
+	// Received represents the sequence number that the Session received.
+	Received int64
+}
+
+func (e ErrorSequence) Error() string {
+	kind := "gap"
+	if e.Received < e.Expected {
+		kind = "duplicate"
+	}
+
+	return fmt.Errorf("SEQUENCE ERROR: session %q: event %q: %s: expected sequence %d, received %d",
+		e.SessionID, e.Event, kind, e.Expected, e.Received).Error()
+}
+
+// ErrorShard represents a routing error that occurs when a send event for a guild
+// can NOT be sent because the shard of the guild is NOT connected in this process
+// (i.e., when the shard is managed by another process).
+type ErrorShard struct {
+	// GuildID represents the ID of the guild involved in this error.
+	GuildID string
+
+	// Shard represents the shard_id of the guild.
+	Shard int
+
+	// Shards represents the num_shards used to calculate the shard_id of the guild.
+	Shards int
+}
+
+func (e ErrorShard) Error() string {
+	return fmt.Errorf("SHARD ERROR: guild %q: shard [%d, %d] is NOT connected in this process",
+		e.GuildID, e.Shard, e.Shards).Error()
+}
+
+// ErrorNoSession represents a routing error that occurs when a send event for a guild
+// can NOT be sent because the bot has no connected session.
+type ErrorNoSession struct {
+	// GuildID represents the ID of the guild involved in this error.
+	GuildID string
+}
+
+func (e ErrorNoSession) Error() string {
+	return fmt.Errorf("SHARD ERROR: guild %q: no session is connected", e.GuildID).Error()
+}
+
 const (
 	ErrConnectionSession = "Discord Gateway"
 )
@@ -7068,8 +7753,40 @@ func (e ErrorDisconnect) Error() string {
 	).Error() //lint:ignore ST1005 readability
 }
 
+// ErrorPermissions represents a pre-flight error that occurs when a request is NOT sent
+// because the bot is missing the permissions (or role hierarchy) that the request requires.
+type ErrorPermissions struct {
+	// Route represents the route of the request (see RouteIDs).
+	Route string
+
+	// GuildID represents the ID of the guild involved in this error.
+	GuildID string
+
+	// ChannelID represents the ID of the channel involved in this error (if applicable).
+	ChannelID string
+
+	// Missing represents the permissions that the bot is missing.
+	Missing BitFlag
+
+	// Hierarchy represents whether the request targets a member or role
+	// that is NOT lower than the highest role of the bot.
+	Hierarchy bool
+}
+
+func (e ErrorPermissions) Error() string {
+	if e.Hierarchy {
+		return fmt.Errorf("PERMISSIONS ERROR: route %q: guild %q: the target member (or role) is NOT lower than the bot's highest role",
+			e.Route, e.GuildID).Error()
+	}
+
+	return fmt.Errorf("PERMISSIONS ERROR: route %q: guild %q: channel %q: missing permissions %v",
+		e.Route, e.GuildID, e.ChannelID, PermissionNames(e.Missing)).Error()
+}
+
 // Handlers represents a bot's event handlers.
 type Handlers struct {
+	subscriptions                       map[string][]*Subscription
+	listeners                           map[string][]*Subscription
 	Hello                               []func(*Hello)
 	Ready                               []func(*Ready)
 	Resumed                             []func(*Resumed)
@@ -7134,6 +7851,7 @@ type Handlers struct {
 	TypingStart                         []func(*TypingStart)
 	VoiceStateUpdate                    []func(*VoiceStateUpdate)
 	WebhooksUpdate                      []func(*WebhooksUpdate)
+	Raw                                 []func(*RawEvent)
 	mu                                  sync.RWMutex
 }
 
@@ -7142,10 +7860,28 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	bot.Handlers.mu.Lock()
 	defer bot.Handlers.mu.Unlock()
 
+	return bot.add(eventname, function, nil)
+}
+
+// add adds an event handler (with an optional subscription) for the given event to the bot.
+//
+// The bot's Handlers must be locked when add is called.
+func (bot *Client) add(eventname string, function interface{}, sub *Subscription) error {
+	bot.intend(eventname)
+
 	switch eventname {
+	case FlagGatewayEventNameRaw:
+		if f, ok := function.(func(*RawEvent)); ok {
+			bot.Handlers.Raw = append(bot.Handlers.Raw, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Raw)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
 	case FlagGatewayEventNameHello:
 		if f, ok := function.(func(*Hello)); ok {
 			bot.Handlers.Hello = append(bot.Handlers.Hello, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Hello)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7153,6 +7889,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameReady:
 		if f, ok := function.(func(*Ready)); ok {
 			bot.Handlers.Ready = append(bot.Handlers.Ready, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Ready)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7160,6 +7897,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameResumed:
 		if f, ok := function.(func(*Resumed)); ok {
 			bot.Handlers.Resumed = append(bot.Handlers.Resumed, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Resumed)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7167,6 +7905,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameReconnect:
 		if f, ok := function.(func(*Reconnect)); ok {
 			bot.Handlers.Reconnect = append(bot.Handlers.Reconnect, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Reconnect)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7174,6 +7913,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameInvalidSession:
 		if f, ok := function.(func(*InvalidSession)); ok {
 			bot.Handlers.InvalidSession = append(bot.Handlers.InvalidSession, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InvalidSession)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7181,54 +7921,39 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
 		if f, ok := function.(func(*ApplicationCommandPermissionsUpdate)); ok {
 			bot.Handlers.ApplicationCommandPermissionsUpdate = append(bot.Handlers.ApplicationCommandPermissionsUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ApplicationCommandPermissionsUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
-		}
-
 		if f, ok := function.(func(*AutoModerationRuleCreate)); ok {
 			bot.Handlers.AutoModerationRuleCreate = append(bot.Handlers.AutoModerationRuleCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationRuleCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
-		}
-
 		if f, ok := function.(func(*AutoModerationRuleUpdate)); ok {
 			bot.Handlers.AutoModerationRuleUpdate = append(bot.Handlers.AutoModerationRuleUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationRuleUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
-		}
-
 		if f, ok := function.(func(*AutoModerationRuleDelete)); ok {
 			bot.Handlers.AutoModerationRuleDelete = append(bot.Handlers.AutoModerationRuleDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationRuleDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationActionExecution:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_EXECUTION
-		}
-
 		if f, ok := function.(func(*AutoModerationActionExecution)); ok {
 			bot.Handlers.AutoModerationActionExecution = append(bot.Handlers.AutoModerationActionExecution, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationActionExecution)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7236,6 +7961,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameInteractionCreate:
 		if f, ok := function.(func(*InteractionCreate)); ok {
 			bot.Handlers.InteractionCreate = append(bot.Handlers.InteractionCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InteractionCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7243,23 +7969,15 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameVoiceServerUpdate:
 		if f, ok := function.(func(*VoiceServerUpdate)); ok {
 			bot.Handlers.VoiceServerUpdate = append(bot.Handlers.VoiceServerUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.VoiceServerUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMembersChunk:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
-		}
-
 		if f, ok := function.(func(*GuildMembersChunk)); ok {
 			bot.Handlers.GuildMembersChunk = append(bot.Handlers.GuildMembersChunk, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMembersChunk)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7267,656 +7985,407 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameUserUpdate:
 		if f, ok := function.(func(*UserUpdate)); ok {
 			bot.Handlers.UserUpdate = append(bot.Handlers.UserUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.UserUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelCreate)); ok {
 			bot.Handlers.ChannelCreate = append(bot.Handlers.ChannelCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelUpdate)); ok {
 			bot.Handlers.ChannelUpdate = append(bot.Handlers.ChannelUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelDelete)); ok {
 			bot.Handlers.ChannelDelete = append(bot.Handlers.ChannelDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelPinsUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelPinsUpdate)); ok {
 			bot.Handlers.ChannelPinsUpdate = append(bot.Handlers.ChannelPinsUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelPinsUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadCreate)); ok {
 			bot.Handlers.ThreadCreate = append(bot.Handlers.ThreadCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadUpdate)); ok {
 			bot.Handlers.ThreadUpdate = append(bot.Handlers.ThreadUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadDelete)); ok {
 			bot.Handlers.ThreadDelete = append(bot.Handlers.ThreadDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadListSync:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadListSync)); ok {
 			bot.Handlers.ThreadListSync = append(bot.Handlers.ThreadListSync, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadListSync)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadMemberUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadMemberUpdate)); ok {
 			bot.Handlers.ThreadMemberUpdate = append(bot.Handlers.ThreadMemberUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadMemberUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadMembersUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*ThreadMembersUpdate)); ok {
 			bot.Handlers.ThreadMembersUpdate = append(bot.Handlers.ThreadMembersUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadMembersUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildCreate)); ok {
 			bot.Handlers.GuildCreate = append(bot.Handlers.GuildCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildUpdate)); ok {
 			bot.Handlers.GuildUpdate = append(bot.Handlers.GuildUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildDelete)); ok {
 			bot.Handlers.GuildDelete = append(bot.Handlers.GuildDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildAuditLogEntryCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
-		}
-
 		if f, ok := function.(func(*GuildAuditLogEntryCreate)); ok {
 			bot.Handlers.GuildAuditLogEntryCreate = append(bot.Handlers.GuildAuditLogEntryCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildAuditLogEntryCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildBanAdd:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
-		}
-
 		if f, ok := function.(func(*GuildBanAdd)); ok {
 			bot.Handlers.GuildBanAdd = append(bot.Handlers.GuildBanAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildBanAdd)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildBanRemove:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
-		}
-
 		if f, ok := function.(func(*GuildBanRemove)); ok {
 			bot.Handlers.GuildBanRemove = append(bot.Handlers.GuildBanRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildBanRemove)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildEmojisUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
-		}
-
 		if f, ok := function.(func(*GuildEmojisUpdate)); ok {
 			bot.Handlers.GuildEmojisUpdate = append(bot.Handlers.GuildEmojisUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildEmojisUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildStickersUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
-		}
-
 		if f, ok := function.(func(*GuildStickersUpdate)); ok {
 			bot.Handlers.GuildStickersUpdate = append(bot.Handlers.GuildStickersUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildStickersUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildIntegrationsUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*GuildIntegrationsUpdate)); ok {
 			bot.Handlers.GuildIntegrationsUpdate = append(bot.Handlers.GuildIntegrationsUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildIntegrationsUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMemberAdd:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*GuildMemberAdd)); ok {
 			bot.Handlers.GuildMemberAdd = append(bot.Handlers.GuildMemberAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMemberAdd)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMemberRemove:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*GuildMemberRemove)); ok {
 			bot.Handlers.GuildMemberRemove = append(bot.Handlers.GuildMemberRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMemberRemove)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMemberUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*GuildMemberUpdate)); ok {
 			bot.Handlers.GuildMemberUpdate = append(bot.Handlers.GuildMemberUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMemberUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildRoleCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildRoleCreate)); ok {
 			bot.Handlers.GuildRoleCreate = append(bot.Handlers.GuildRoleCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildRoleCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildRoleUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildRoleUpdate)); ok {
 			bot.Handlers.GuildRoleUpdate = append(bot.Handlers.GuildRoleUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildRoleUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildRoleDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildRoleDelete)); ok {
 			bot.Handlers.GuildRoleDelete = append(bot.Handlers.GuildRoleDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildRoleDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventCreate)); ok {
 			bot.Handlers.GuildScheduledEventCreate = append(bot.Handlers.GuildScheduledEventCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventUpdate)); ok {
 			bot.Handlers.GuildScheduledEventUpdate = append(bot.Handlers.GuildScheduledEventUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventDelete)); ok {
 			bot.Handlers.GuildScheduledEventDelete = append(bot.Handlers.GuildScheduledEventDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUserAdd:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventUserAdd)); ok {
 			bot.Handlers.GuildScheduledEventUserAdd = append(bot.Handlers.GuildScheduledEventUserAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventUserAdd)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUserRemove:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventUserRemove)); ok {
 			bot.Handlers.GuildScheduledEventUserRemove = append(bot.Handlers.GuildScheduledEventUserRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventUserRemove)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameIntegrationCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*IntegrationCreate)); ok {
 			bot.Handlers.IntegrationCreate = append(bot.Handlers.IntegrationCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.IntegrationCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameIntegrationUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*IntegrationUpdate)); ok {
 			bot.Handlers.IntegrationUpdate = append(bot.Handlers.IntegrationUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.IntegrationUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameIntegrationDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*IntegrationDelete)); ok {
 			bot.Handlers.IntegrationDelete = append(bot.Handlers.IntegrationDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.IntegrationDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameInviteCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
-		}
-
 		if f, ok := function.(func(*InviteCreate)); ok {
 			bot.Handlers.InviteCreate = append(bot.Handlers.InviteCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InviteCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameInviteDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
-		}
-
 		if f, ok := function.(func(*InviteDelete)); ok {
 			bot.Handlers.InviteDelete = append(bot.Handlers.InviteDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InviteDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageCreate)); ok {
 			bot.Handlers.MessageCreate = append(bot.Handlers.MessageCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageUpdate)); ok {
 			bot.Handlers.MessageUpdate = append(bot.Handlers.MessageUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageDelete)); ok {
 			bot.Handlers.MessageDelete = append(bot.Handlers.MessageDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageDeleteBulk:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageDeleteBulk)); ok {
 			bot.Handlers.MessageDeleteBulk = append(bot.Handlers.MessageDeleteBulk, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageDeleteBulk)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageReactionAdd:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
-		}
-
 		if f, ok := function.(func(*MessageReactionAdd)); ok {
 			bot.Handlers.MessageReactionAdd = append(bot.Handlers.MessageReactionAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionAdd)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageReactionRemove:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
-		}
-
 		if f, ok := function.(func(*MessageReactionRemove)); ok {
 			bot.Handlers.MessageReactionRemove = append(bot.Handlers.MessageReactionRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionRemove)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageReactionRemoveAll:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
-		}
-
 		if f, ok := function.(func(*MessageReactionRemoveAll)); ok {
 			bot.Handlers.MessageReactionRemoveAll = append(bot.Handlers.MessageReactionRemoveAll, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionRemoveAll)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageReactionRemoveEmoji:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
-		}
-
 		if f, ok := function.(func(*MessageReactionRemoveEmoji)); ok {
 			bot.Handlers.MessageReactionRemoveEmoji = append(bot.Handlers.MessageReactionRemoveEmoji, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionRemoveEmoji)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNamePresenceUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
-		}
-
 		if f, ok := function.(func(*PresenceUpdate)); ok {
 			bot.Handlers.PresenceUpdate = append(bot.Handlers.PresenceUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.PresenceUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameStageInstanceCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*StageInstanceCreate)); ok {
 			bot.Handlers.StageInstanceCreate = append(bot.Handlers.StageInstanceCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.StageInstanceCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameStageInstanceDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*StageInstanceDelete)); ok {
 			bot.Handlers.StageInstanceDelete = append(bot.Handlers.StageInstanceDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.StageInstanceDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameStageInstanceUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*StageInstanceUpdate)); ok {
 			bot.Handlers.StageInstanceUpdate = append(bot.Handlers.StageInstanceUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.StageInstanceUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameTypingStart:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_TYPING] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_TYPING] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_TYPING
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
-		}
-
 		if f, ok := function.(func(*TypingStart)); ok {
 			bot.Handlers.TypingStart = append(bot.Handlers.TypingStart, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.TypingStart)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameVoiceStateUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_VOICE_STATES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_VOICE_STATES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_VOICE_STATES
-		}
-
 		if f, ok := function.(func(*VoiceStateUpdate)); ok {
 			bot.Handlers.VoiceStateUpdate = append(bot.Handlers.VoiceStateUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.VoiceStateUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameWebhooksUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_WEBHOOKS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_WEBHOOKS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_WEBHOOKS
-		}
-
 		if f, ok := function.(func(*WebhooksUpdate)); ok {
 			bot.Handlers.WebhooksUpdate = append(bot.Handlers.WebhooksUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.WebhooksUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -7932,288 +8401,414 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	return err
 }
 
-// Remove removes the event handler at the given index from the bot.
-// This function does NOT remove intents automatically.
-func (bot *Client) Remove(eventname string, index int) error {
-	bot.Handlers.mu.Lock()
-	defer bot.Handlers.mu.Unlock()
-
+// intend adds the intents that are required to receive the given event to the bot.
+//
+// The bot's Handlers must be locked when intend is called.
+func (bot *Client) intend(eventname string) {
 	switch eventname {
-	case FlagGatewayEventNameHello:
-		if len(bot.Handlers.Hello) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameAutoModerationRuleCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
 		}
 
-		bot.Handlers.Hello = append(bot.Handlers.Hello[:index], bot.Handlers.Hello[index+1:]...)
-
-	case FlagGatewayEventNameReady:
-		if len(bot.Handlers.Ready) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameAutoModerationRuleUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
 		}
 
-		bot.Handlers.Ready = append(bot.Handlers.Ready[:index], bot.Handlers.Ready[index+1:]...)
-
-	case FlagGatewayEventNameResumed:
-		if len(bot.Handlers.Resumed) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameAutoModerationRuleDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
 		}
 
-		bot.Handlers.Resumed = append(bot.Handlers.Resumed[:index], bot.Handlers.Resumed[index+1:]...)
-
-	case FlagGatewayEventNameReconnect:
-		if len(bot.Handlers.Reconnect) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameAutoModerationActionExecution:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_EXECUTION
 		}
 
-		bot.Handlers.Reconnect = append(bot.Handlers.Reconnect[:index], bot.Handlers.Reconnect[index+1:]...)
+	case FlagGatewayEventNameGuildMembersChunk:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
 
-	case FlagGatewayEventNameInvalidSession:
-		if len(bot.Handlers.InvalidSession) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
 		}
 
-		bot.Handlers.InvalidSession = append(bot.Handlers.InvalidSession[:index], bot.Handlers.InvalidSession[index+1:]...)
+	case FlagGatewayEventNameChannelCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
 
-	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
-		if len(bot.Handlers.ApplicationCommandPermissionsUpdate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameChannelUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.ApplicationCommandPermissionsUpdate = append(bot.Handlers.ApplicationCommandPermissionsUpdate[:index], bot.Handlers.ApplicationCommandPermissionsUpdate[index+1:]...)
+	case FlagGatewayEventNameChannelDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
 
-	case FlagGatewayEventNameAutoModerationRuleCreate:
-		if len(bot.Handlers.AutoModerationRuleCreate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameChannelPinsUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
 		}
 
-		bot.Handlers.AutoModerationRuleCreate = append(bot.Handlers.AutoModerationRuleCreate[:index], bot.Handlers.AutoModerationRuleCreate[index+1:]...)
-
-	case FlagGatewayEventNameAutoModerationRuleUpdate:
-		if len(bot.Handlers.AutoModerationRuleUpdate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.AutoModerationRuleUpdate = append(bot.Handlers.AutoModerationRuleUpdate[:index], bot.Handlers.AutoModerationRuleUpdate[index+1:]...)
-
-	case FlagGatewayEventNameAutoModerationRuleDelete:
-		if len(bot.Handlers.AutoModerationRuleDelete) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameThreadCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.AutoModerationRuleDelete = append(bot.Handlers.AutoModerationRuleDelete[:index], bot.Handlers.AutoModerationRuleDelete[index+1:]...)
-
-	case FlagGatewayEventNameAutoModerationActionExecution:
-		if len(bot.Handlers.AutoModerationActionExecution) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameThreadUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.AutoModerationActionExecution = append(bot.Handlers.AutoModerationActionExecution[:index], bot.Handlers.AutoModerationActionExecution[index+1:]...)
+	case FlagGatewayEventNameThreadDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
 
-	case FlagGatewayEventNameInteractionCreate:
-		if len(bot.Handlers.InteractionCreate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameThreadListSync:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.InteractionCreate = append(bot.Handlers.InteractionCreate[:index], bot.Handlers.InteractionCreate[index+1:]...)
+	case FlagGatewayEventNameThreadMemberUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
 
-	case FlagGatewayEventNameVoiceServerUpdate:
-		if len(bot.Handlers.VoiceServerUpdate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameThreadMembersUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.VoiceServerUpdate = append(bot.Handlers.VoiceServerUpdate[:index], bot.Handlers.VoiceServerUpdate[index+1:]...)
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
 
-	case FlagGatewayEventNameGuildMembersChunk:
-		if len(bot.Handlers.GuildMembersChunk) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.GuildMembersChunk = append(bot.Handlers.GuildMembersChunk[:index], bot.Handlers.GuildMembersChunk[index+1:]...)
+	case FlagGatewayEventNameGuildUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
 
-	case FlagGatewayEventNameUserUpdate:
-		if len(bot.Handlers.UserUpdate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.UserUpdate = append(bot.Handlers.UserUpdate[:index], bot.Handlers.UserUpdate[index+1:]...)
+	case FlagGatewayEventNameGuildAuditLogEntryCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
+		}
 
-	case FlagGatewayEventNameChannelCreate:
-		if len(bot.Handlers.ChannelCreate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildBanAdd:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
 		}
 
-		bot.Handlers.ChannelCreate = append(bot.Handlers.ChannelCreate[:index], bot.Handlers.ChannelCreate[index+1:]...)
+	case FlagGatewayEventNameGuildBanRemove:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
+		}
 
-	case FlagGatewayEventNameChannelUpdate:
-		if len(bot.Handlers.ChannelUpdate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildEmojisUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
 		}
 
-		bot.Handlers.ChannelUpdate = append(bot.Handlers.ChannelUpdate[:index], bot.Handlers.ChannelUpdate[index+1:]...)
+	case FlagGatewayEventNameGuildStickersUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
+		}
 
-	case FlagGatewayEventNameChannelDelete:
-		if len(bot.Handlers.ChannelDelete) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildIntegrationsUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
 		}
 
-		bot.Handlers.ChannelDelete = append(bot.Handlers.ChannelDelete[:index], bot.Handlers.ChannelDelete[index+1:]...)
+	case FlagGatewayEventNameGuildMemberAdd:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
 
-	case FlagGatewayEventNameChannelPinsUpdate:
-		if len(bot.Handlers.ChannelPinsUpdate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildMemberRemove:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
 		}
 
-		bot.Handlers.ChannelPinsUpdate = append(bot.Handlers.ChannelPinsUpdate[:index], bot.Handlers.ChannelPinsUpdate[index+1:]...)
+	case FlagGatewayEventNameGuildMemberUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
 
-	case FlagGatewayEventNameThreadCreate:
-		if len(bot.Handlers.ThreadCreate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildRoleCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.ThreadCreate = append(bot.Handlers.ThreadCreate[:index], bot.Handlers.ThreadCreate[index+1:]...)
+	case FlagGatewayEventNameGuildRoleUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
 
-	case FlagGatewayEventNameThreadUpdate:
-		if len(bot.Handlers.ThreadUpdate) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildRoleDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		bot.Handlers.ThreadUpdate = append(bot.Handlers.ThreadUpdate[:index], bot.Handlers.ThreadUpdate[index+1:]...)
+	case FlagGatewayEventNameGuildScheduledEventCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
 
-	case FlagGatewayEventNameThreadDelete:
-		if len(bot.Handlers.ThreadDelete) <= index {
-			err := ErrorEventHandler{
-				ClientID: bot.ApplicationID,
-				Event:    eventname,
-				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
-			}
-			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-			return err
+	case FlagGatewayEventNameGuildScheduledEventUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
 		}
 
-		bot.Handlers.ThreadDelete = append(bot.Handlers.ThreadDelete[:index], bot.Handlers.ThreadDelete[index+1:]...)
+	case FlagGatewayEventNameGuildScheduledEventDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
 
-	case FlagGatewayEventNameThreadListSync:
-		if len(bot.Handlers.ThreadListSync) <= index {
+	case FlagGatewayEventNameGuildScheduledEventUserAdd:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventUserRemove:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
+
+	case FlagGatewayEventNameIntegrationCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
+		}
+
+	case FlagGatewayEventNameIntegrationUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
+		}
+
+	case FlagGatewayEventNameIntegrationDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
+		}
+
+	case FlagGatewayEventNameInviteCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
+		}
+
+	case FlagGatewayEventNameInviteDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
+		}
+
+	case FlagGatewayEventNameMessageCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
+	case FlagGatewayEventNameMessageUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
+	case FlagGatewayEventNameMessageDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
+	case FlagGatewayEventNameMessageDeleteBulk:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
+	case FlagGatewayEventNameMessageReactionAdd:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
+		}
+
+	case FlagGatewayEventNameMessageReactionRemove:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
+		}
+
+	case FlagGatewayEventNameMessageReactionRemoveAll:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
+		}
+
+	case FlagGatewayEventNameMessageReactionRemoveEmoji:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_REACTIONS
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
+		}
+
+	case FlagGatewayEventNamePresenceUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
+		}
+
+	case FlagGatewayEventNameStageInstanceCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameStageInstanceDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameStageInstanceUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameTypingStart:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_TYPING] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_TYPING] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGE_TYPING
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGE_REACTIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
+		}
+
+	case FlagGatewayEventNameVoiceStateUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_VOICE_STATES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_VOICE_STATES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_VOICE_STATES
+		}
+
+	case FlagGatewayEventNameWebhooksUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_WEBHOOKS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_WEBHOOKS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_WEBHOOKS
+		}
+	}
+}
+
+// Remove removes the event handler at the given index from the bot.
+// This function does NOT remove intents automatically.
+func (bot *Client) Remove(eventname string, index int) error {
+	bot.Handlers.mu.Lock()
+	defer bot.Handlers.mu.Unlock()
+
+	return bot.remove(eventname, index)
+}
+
+// remove removes the event handler at the given index from the bot.
+//
+// The bot's Handlers must be locked when remove is called.
+func (bot *Client) remove(eventname string, index int) error {
+	switch eventname {
+	case FlagGatewayEventNameRaw:
+		if len(bot.Handlers.Raw) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8223,10 +8818,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadListSync = append(bot.Handlers.ThreadListSync[:index], bot.Handlers.ThreadListSync[index+1:]...)
+		bot.Handlers.Raw = append(bot.Handlers.Raw[:index:index], bot.Handlers.Raw[index+1:]...)
 
-	case FlagGatewayEventNameThreadMemberUpdate:
-		if len(bot.Handlers.ThreadMemberUpdate) <= index {
+	case FlagGatewayEventNameHello:
+		if len(bot.Handlers.Hello) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8236,10 +8831,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadMemberUpdate = append(bot.Handlers.ThreadMemberUpdate[:index], bot.Handlers.ThreadMemberUpdate[index+1:]...)
+		bot.Handlers.Hello = append(bot.Handlers.Hello[:index:index], bot.Handlers.Hello[index+1:]...)
 
-	case FlagGatewayEventNameThreadMembersUpdate:
-		if len(bot.Handlers.ThreadMembersUpdate) <= index {
+	case FlagGatewayEventNameReady:
+		if len(bot.Handlers.Ready) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8249,10 +8844,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadMembersUpdate = append(bot.Handlers.ThreadMembersUpdate[:index], bot.Handlers.ThreadMembersUpdate[index+1:]...)
+		bot.Handlers.Ready = append(bot.Handlers.Ready[:index:index], bot.Handlers.Ready[index+1:]...)
 
-	case FlagGatewayEventNameGuildCreate:
-		if len(bot.Handlers.GuildCreate) <= index {
+	case FlagGatewayEventNameResumed:
+		if len(bot.Handlers.Resumed) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8262,10 +8857,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildCreate = append(bot.Handlers.GuildCreate[:index], bot.Handlers.GuildCreate[index+1:]...)
+		bot.Handlers.Resumed = append(bot.Handlers.Resumed[:index:index], bot.Handlers.Resumed[index+1:]...)
 
-	case FlagGatewayEventNameGuildUpdate:
-		if len(bot.Handlers.GuildUpdate) <= index {
+	case FlagGatewayEventNameReconnect:
+		if len(bot.Handlers.Reconnect) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8275,10 +8870,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildUpdate = append(bot.Handlers.GuildUpdate[:index], bot.Handlers.GuildUpdate[index+1:]...)
+		bot.Handlers.Reconnect = append(bot.Handlers.Reconnect[:index:index], bot.Handlers.Reconnect[index+1:]...)
 
-	case FlagGatewayEventNameGuildDelete:
-		if len(bot.Handlers.GuildDelete) <= index {
+	case FlagGatewayEventNameInvalidSession:
+		if len(bot.Handlers.InvalidSession) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8288,10 +8883,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildDelete = append(bot.Handlers.GuildDelete[:index], bot.Handlers.GuildDelete[index+1:]...)
+		bot.Handlers.InvalidSession = append(bot.Handlers.InvalidSession[:index:index], bot.Handlers.InvalidSession[index+1:]...)
 
-	case FlagGatewayEventNameGuildAuditLogEntryCreate:
-		if len(bot.Handlers.GuildAuditLogEntryCreate) <= index {
+	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
+		if len(bot.Handlers.ApplicationCommandPermissionsUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8301,10 +8896,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildAuditLogEntryCreate = append(bot.Handlers.GuildAuditLogEntryCreate[:index], bot.Handlers.GuildAuditLogEntryCreate[index+1:]...)
+		bot.Handlers.ApplicationCommandPermissionsUpdate = append(bot.Handlers.ApplicationCommandPermissionsUpdate[:index:index], bot.Handlers.ApplicationCommandPermissionsUpdate[index+1:]...)
 
-	case FlagGatewayEventNameGuildBanAdd:
-		if len(bot.Handlers.GuildBanAdd) <= index {
+	case FlagGatewayEventNameAutoModerationRuleCreate:
+		if len(bot.Handlers.AutoModerationRuleCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8314,10 +8909,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildBanAdd = append(bot.Handlers.GuildBanAdd[:index], bot.Handlers.GuildBanAdd[index+1:]...)
+		bot.Handlers.AutoModerationRuleCreate = append(bot.Handlers.AutoModerationRuleCreate[:index:index], bot.Handlers.AutoModerationRuleCreate[index+1:]...)
 
-	case FlagGatewayEventNameGuildBanRemove:
-		if len(bot.Handlers.GuildBanRemove) <= index {
+	case FlagGatewayEventNameAutoModerationRuleUpdate:
+		if len(bot.Handlers.AutoModerationRuleUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8327,10 +8922,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildBanRemove = append(bot.Handlers.GuildBanRemove[:index], bot.Handlers.GuildBanRemove[index+1:]...)
+		bot.Handlers.AutoModerationRuleUpdate = append(bot.Handlers.AutoModerationRuleUpdate[:index:index], bot.Handlers.AutoModerationRuleUpdate[index+1:]...)
 
-	case FlagGatewayEventNameGuildEmojisUpdate:
-		if len(bot.Handlers.GuildEmojisUpdate) <= index {
+	case FlagGatewayEventNameAutoModerationRuleDelete:
+		if len(bot.Handlers.AutoModerationRuleDelete) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8340,10 +8935,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildEmojisUpdate = append(bot.Handlers.GuildEmojisUpdate[:index], bot.Handlers.GuildEmojisUpdate[index+1:]...)
+		bot.Handlers.AutoModerationRuleDelete = append(bot.Handlers.AutoModerationRuleDelete[:index:index], bot.Handlers.AutoModerationRuleDelete[index+1:]...)
 
-	case FlagGatewayEventNameGuildStickersUpdate:
-		if len(bot.Handlers.GuildStickersUpdate) <= index {
+	case FlagGatewayEventNameAutoModerationActionExecution:
+		if len(bot.Handlers.AutoModerationActionExecution) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8353,10 +8948,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildStickersUpdate = append(bot.Handlers.GuildStickersUpdate[:index], bot.Handlers.GuildStickersUpdate[index+1:]...)
+		bot.Handlers.AutoModerationActionExecution = append(bot.Handlers.AutoModerationActionExecution[:index:index], bot.Handlers.AutoModerationActionExecution[index+1:]...)
 
-	case FlagGatewayEventNameGuildIntegrationsUpdate:
-		if len(bot.Handlers.GuildIntegrationsUpdate) <= index {
+	case FlagGatewayEventNameInteractionCreate:
+		if len(bot.Handlers.InteractionCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8366,10 +8961,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildIntegrationsUpdate = append(bot.Handlers.GuildIntegrationsUpdate[:index], bot.Handlers.GuildIntegrationsUpdate[index+1:]...)
+		bot.Handlers.InteractionCreate = append(bot.Handlers.InteractionCreate[:index:index], bot.Handlers.InteractionCreate[index+1:]...)
 
-	case FlagGatewayEventNameGuildMemberAdd:
-		if len(bot.Handlers.GuildMemberAdd) <= index {
+	case FlagGatewayEventNameVoiceServerUpdate:
+		if len(bot.Handlers.VoiceServerUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8379,10 +8974,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildMemberAdd = append(bot.Handlers.GuildMemberAdd[:index], bot.Handlers.GuildMemberAdd[index+1:]...)
+		bot.Handlers.VoiceServerUpdate = append(bot.Handlers.VoiceServerUpdate[:index:index], bot.Handlers.VoiceServerUpdate[index+1:]...)
 
-	case FlagGatewayEventNameGuildMemberRemove:
-		if len(bot.Handlers.GuildMemberRemove) <= index {
+	case FlagGatewayEventNameGuildMembersChunk:
+		if len(bot.Handlers.GuildMembersChunk) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8392,10 +8987,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildMemberRemove = append(bot.Handlers.GuildMemberRemove[:index], bot.Handlers.GuildMemberRemove[index+1:]...)
+		bot.Handlers.GuildMembersChunk = append(bot.Handlers.GuildMembersChunk[:index:index], bot.Handlers.GuildMembersChunk[index+1:]...)
 
-	case FlagGatewayEventNameGuildMemberUpdate:
-		if len(bot.Handlers.GuildMemberUpdate) <= index {
+	case FlagGatewayEventNameUserUpdate:
+		if len(bot.Handlers.UserUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8405,10 +9000,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildMemberUpdate = append(bot.Handlers.GuildMemberUpdate[:index], bot.Handlers.GuildMemberUpdate[index+1:]...)
+		bot.Handlers.UserUpdate = append(bot.Handlers.UserUpdate[:index:index], bot.Handlers.UserUpdate[index+1:]...)
 
-	case FlagGatewayEventNameGuildRoleCreate:
-		if len(bot.Handlers.GuildRoleCreate) <= index {
+	case FlagGatewayEventNameChannelCreate:
+		if len(bot.Handlers.ChannelCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8418,10 +9013,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildRoleCreate = append(bot.Handlers.GuildRoleCreate[:index], bot.Handlers.GuildRoleCreate[index+1:]...)
+		bot.Handlers.ChannelCreate = append(bot.Handlers.ChannelCreate[:index:index], bot.Handlers.ChannelCreate[index+1:]...)
 
-	case FlagGatewayEventNameGuildRoleUpdate:
-		if len(bot.Handlers.GuildRoleUpdate) <= index {
+	case FlagGatewayEventNameChannelUpdate:
+		if len(bot.Handlers.ChannelUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8431,10 +9026,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildRoleUpdate = append(bot.Handlers.GuildRoleUpdate[:index], bot.Handlers.GuildRoleUpdate[index+1:]...)
+		bot.Handlers.ChannelUpdate = append(bot.Handlers.ChannelUpdate[:index:index], bot.Handlers.ChannelUpdate[index+1:]...)
 
-	case FlagGatewayEventNameGuildRoleDelete:
-		if len(bot.Handlers.GuildRoleDelete) <= index {
+	case FlagGatewayEventNameChannelDelete:
+		if len(bot.Handlers.ChannelDelete) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8444,10 +9039,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildRoleDelete = append(bot.Handlers.GuildRoleDelete[:index], bot.Handlers.GuildRoleDelete[index+1:]...)
+		bot.Handlers.ChannelDelete = append(bot.Handlers.ChannelDelete[:index:index], bot.Handlers.ChannelDelete[index+1:]...)
 
-	case FlagGatewayEventNameGuildScheduledEventCreate:
-		if len(bot.Handlers.GuildScheduledEventCreate) <= index {
+	case FlagGatewayEventNameChannelPinsUpdate:
+		if len(bot.Handlers.ChannelPinsUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8457,10 +9052,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventCreate = append(bot.Handlers.GuildScheduledEventCreate[:index], bot.Handlers.GuildScheduledEventCreate[index+1:]...)
+		bot.Handlers.ChannelPinsUpdate = append(bot.Handlers.ChannelPinsUpdate[:index:index], bot.Handlers.ChannelPinsUpdate[index+1:]...)
 
-	case FlagGatewayEventNameGuildScheduledEventUpdate:
-		if len(bot.Handlers.GuildScheduledEventUpdate) <= index {
+	case FlagGatewayEventNameThreadCreate:
+		if len(bot.Handlers.ThreadCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8470,10 +9065,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventUpdate = append(bot.Handlers.GuildScheduledEventUpdate[:index], bot.Handlers.GuildScheduledEventUpdate[index+1:]...)
+		bot.Handlers.ThreadCreate = append(bot.Handlers.ThreadCreate[:index:index], bot.Handlers.ThreadCreate[index+1:]...)
 
-	case FlagGatewayEventNameGuildScheduledEventDelete:
-		if len(bot.Handlers.GuildScheduledEventDelete) <= index {
+	case FlagGatewayEventNameThreadUpdate:
+		if len(bot.Handlers.ThreadUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8483,10 +9078,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventDelete = append(bot.Handlers.GuildScheduledEventDelete[:index], bot.Handlers.GuildScheduledEventDelete[index+1:]...)
+		bot.Handlers.ThreadUpdate = append(bot.Handlers.ThreadUpdate[:index:index], bot.Handlers.ThreadUpdate[index+1:]...)
 
-	case FlagGatewayEventNameGuildScheduledEventUserAdd:
-		if len(bot.Handlers.GuildScheduledEventUserAdd) <= index {
+	case FlagGatewayEventNameThreadDelete:
+		if len(bot.Handlers.ThreadDelete) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8496,10 +9091,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventUserAdd = append(bot.Handlers.GuildScheduledEventUserAdd[:index], bot.Handlers.GuildScheduledEventUserAdd[index+1:]...)
+		bot.Handlers.ThreadDelete = append(bot.Handlers.ThreadDelete[:index:index], bot.Handlers.ThreadDelete[index+1:]...)
 
-	case FlagGatewayEventNameGuildScheduledEventUserRemove:
-		if len(bot.Handlers.GuildScheduledEventUserRemove) <= index {
+	case FlagGatewayEventNameThreadListSync:
+		if len(bot.Handlers.ThreadListSync) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8509,10 +9104,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventUserRemove = append(bot.Handlers.GuildScheduledEventUserRemove[:index], bot.Handlers.GuildScheduledEventUserRemove[index+1:]...)
+		bot.Handlers.ThreadListSync = append(bot.Handlers.ThreadListSync[:index:index], bot.Handlers.ThreadListSync[index+1:]...)
 
-	case FlagGatewayEventNameIntegrationCreate:
-		if len(bot.Handlers.IntegrationCreate) <= index {
+	case FlagGatewayEventNameThreadMemberUpdate:
+		if len(bot.Handlers.ThreadMemberUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8522,10 +9117,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.IntegrationCreate = append(bot.Handlers.IntegrationCreate[:index], bot.Handlers.IntegrationCreate[index+1:]...)
+		bot.Handlers.ThreadMemberUpdate = append(bot.Handlers.ThreadMemberUpdate[:index:index], bot.Handlers.ThreadMemberUpdate[index+1:]...)
 
-	case FlagGatewayEventNameIntegrationUpdate:
-		if len(bot.Handlers.IntegrationUpdate) <= index {
+	case FlagGatewayEventNameThreadMembersUpdate:
+		if len(bot.Handlers.ThreadMembersUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8535,10 +9130,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.IntegrationUpdate = append(bot.Handlers.IntegrationUpdate[:index], bot.Handlers.IntegrationUpdate[index+1:]...)
+		bot.Handlers.ThreadMembersUpdate = append(bot.Handlers.ThreadMembersUpdate[:index:index], bot.Handlers.ThreadMembersUpdate[index+1:]...)
 
-	case FlagGatewayEventNameIntegrationDelete:
-		if len(bot.Handlers.IntegrationDelete) <= index {
+	case FlagGatewayEventNameGuildCreate:
+		if len(bot.Handlers.GuildCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8548,10 +9143,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.IntegrationDelete = append(bot.Handlers.IntegrationDelete[:index], bot.Handlers.IntegrationDelete[index+1:]...)
+		bot.Handlers.GuildCreate = append(bot.Handlers.GuildCreate[:index:index], bot.Handlers.GuildCreate[index+1:]...)
 
-	case FlagGatewayEventNameInviteCreate:
-		if len(bot.Handlers.InviteCreate) <= index {
+	case FlagGatewayEventNameGuildUpdate:
+		if len(bot.Handlers.GuildUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8561,10 +9156,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.InviteCreate = append(bot.Handlers.InviteCreate[:index], bot.Handlers.InviteCreate[index+1:]...)
+		bot.Handlers.GuildUpdate = append(bot.Handlers.GuildUpdate[:index:index], bot.Handlers.GuildUpdate[index+1:]...)
 
-	case FlagGatewayEventNameInviteDelete:
-		if len(bot.Handlers.InviteDelete) <= index {
+	case FlagGatewayEventNameGuildDelete:
+		if len(bot.Handlers.GuildDelete) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8574,10 +9169,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.InviteDelete = append(bot.Handlers.InviteDelete[:index], bot.Handlers.InviteDelete[index+1:]...)
+		bot.Handlers.GuildDelete = append(bot.Handlers.GuildDelete[:index:index], bot.Handlers.GuildDelete[index+1:]...)
 
-	case FlagGatewayEventNameMessageCreate:
-		if len(bot.Handlers.MessageCreate) <= index {
+	case FlagGatewayEventNameGuildAuditLogEntryCreate:
+		if len(bot.Handlers.GuildAuditLogEntryCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8587,10 +9182,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageCreate = append(bot.Handlers.MessageCreate[:index], bot.Handlers.MessageCreate[index+1:]...)
+		bot.Handlers.GuildAuditLogEntryCreate = append(bot.Handlers.GuildAuditLogEntryCreate[:index:index], bot.Handlers.GuildAuditLogEntryCreate[index+1:]...)
 
-	case FlagGatewayEventNameMessageUpdate:
-		if len(bot.Handlers.MessageUpdate) <= index {
+	case FlagGatewayEventNameGuildBanAdd:
+		if len(bot.Handlers.GuildBanAdd) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8600,10 +9195,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageUpdate = append(bot.Handlers.MessageUpdate[:index], bot.Handlers.MessageUpdate[index+1:]...)
+		bot.Handlers.GuildBanAdd = append(bot.Handlers.GuildBanAdd[:index:index], bot.Handlers.GuildBanAdd[index+1:]...)
 
-	case FlagGatewayEventNameMessageDelete:
-		if len(bot.Handlers.MessageDelete) <= index {
+	case FlagGatewayEventNameGuildBanRemove:
+		if len(bot.Handlers.GuildBanRemove) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8613,10 +9208,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageDelete = append(bot.Handlers.MessageDelete[:index], bot.Handlers.MessageDelete[index+1:]...)
+		bot.Handlers.GuildBanRemove = append(bot.Handlers.GuildBanRemove[:index:index], bot.Handlers.GuildBanRemove[index+1:]...)
 
-	case FlagGatewayEventNameMessageDeleteBulk:
-		if len(bot.Handlers.MessageDeleteBulk) <= index {
+	case FlagGatewayEventNameGuildEmojisUpdate:
+		if len(bot.Handlers.GuildEmojisUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8626,10 +9221,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageDeleteBulk = append(bot.Handlers.MessageDeleteBulk[:index], bot.Handlers.MessageDeleteBulk[index+1:]...)
+		bot.Handlers.GuildEmojisUpdate = append(bot.Handlers.GuildEmojisUpdate[:index:index], bot.Handlers.GuildEmojisUpdate[index+1:]...)
 
-	case FlagGatewayEventNameMessageReactionAdd:
-		if len(bot.Handlers.MessageReactionAdd) <= index {
+	case FlagGatewayEventNameGuildStickersUpdate:
+		if len(bot.Handlers.GuildStickersUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8639,10 +9234,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionAdd = append(bot.Handlers.MessageReactionAdd[:index], bot.Handlers.MessageReactionAdd[index+1:]...)
+		bot.Handlers.GuildStickersUpdate = append(bot.Handlers.GuildStickersUpdate[:index:index], bot.Handlers.GuildStickersUpdate[index+1:]...)
 
-	case FlagGatewayEventNameMessageReactionRemove:
-		if len(bot.Handlers.MessageReactionRemove) <= index {
+	case FlagGatewayEventNameGuildIntegrationsUpdate:
+		if len(bot.Handlers.GuildIntegrationsUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8652,10 +9247,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionRemove = append(bot.Handlers.MessageReactionRemove[:index], bot.Handlers.MessageReactionRemove[index+1:]...)
+		bot.Handlers.GuildIntegrationsUpdate = append(bot.Handlers.GuildIntegrationsUpdate[:index:index], bot.Handlers.GuildIntegrationsUpdate[index+1:]...)
 
-	case FlagGatewayEventNameMessageReactionRemoveAll:
-		if len(bot.Handlers.MessageReactionRemoveAll) <= index {
+	case FlagGatewayEventNameGuildMemberAdd:
+		if len(bot.Handlers.GuildMemberAdd) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8665,10 +9260,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionRemoveAll = append(bot.Handlers.MessageReactionRemoveAll[:index], bot.Handlers.MessageReactionRemoveAll[index+1:]...)
+		bot.Handlers.GuildMemberAdd = append(bot.Handlers.GuildMemberAdd[:index:index], bot.Handlers.GuildMemberAdd[index+1:]...)
 
-	case FlagGatewayEventNameMessageReactionRemoveEmoji:
-		if len(bot.Handlers.MessageReactionRemoveEmoji) <= index {
+	case FlagGatewayEventNameGuildMemberRemove:
+		if len(bot.Handlers.GuildMemberRemove) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8678,10 +9273,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionRemoveEmoji = append(bot.Handlers.MessageReactionRemoveEmoji[:index], bot.Handlers.MessageReactionRemoveEmoji[index+1:]...)
+		bot.Handlers.GuildMemberRemove = append(bot.Handlers.GuildMemberRemove[:index:index], bot.Handlers.GuildMemberRemove[index+1:]...)
 
-	case FlagGatewayEventNamePresenceUpdate:
-		if len(bot.Handlers.PresenceUpdate) <= index {
+	case FlagGatewayEventNameGuildMemberUpdate:
+		if len(bot.Handlers.GuildMemberUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8691,10 +9286,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.PresenceUpdate = append(bot.Handlers.PresenceUpdate[:index], bot.Handlers.PresenceUpdate[index+1:]...)
+		bot.Handlers.GuildMemberUpdate = append(bot.Handlers.GuildMemberUpdate[:index:index], bot.Handlers.GuildMemberUpdate[index+1:]...)
 
-	case FlagGatewayEventNameStageInstanceCreate:
-		if len(bot.Handlers.StageInstanceCreate) <= index {
+	case FlagGatewayEventNameGuildRoleCreate:
+		if len(bot.Handlers.GuildRoleCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8704,10 +9299,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.StageInstanceCreate = append(bot.Handlers.StageInstanceCreate[:index], bot.Handlers.StageInstanceCreate[index+1:]...)
+		bot.Handlers.GuildRoleCreate = append(bot.Handlers.GuildRoleCreate[:index:index], bot.Handlers.GuildRoleCreate[index+1:]...)
 
-	case FlagGatewayEventNameStageInstanceDelete:
-		if len(bot.Handlers.StageInstanceDelete) <= index {
+	case FlagGatewayEventNameGuildRoleUpdate:
+		if len(bot.Handlers.GuildRoleUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8717,10 +9312,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.StageInstanceDelete = append(bot.Handlers.StageInstanceDelete[:index], bot.Handlers.StageInstanceDelete[index+1:]...)
+		bot.Handlers.GuildRoleUpdate = append(bot.Handlers.GuildRoleUpdate[:index:index], bot.Handlers.GuildRoleUpdate[index+1:]...)
 
-	case FlagGatewayEventNameStageInstanceUpdate:
-		if len(bot.Handlers.StageInstanceUpdate) <= index {
+	case FlagGatewayEventNameGuildRoleDelete:
+		if len(bot.Handlers.GuildRoleDelete) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8730,10 +9325,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.StageInstanceUpdate = append(bot.Handlers.StageInstanceUpdate[:index], bot.Handlers.StageInstanceUpdate[index+1:]...)
+		bot.Handlers.GuildRoleDelete = append(bot.Handlers.GuildRoleDelete[:index:index], bot.Handlers.GuildRoleDelete[index+1:]...)
 
-	case FlagGatewayEventNameTypingStart:
-		if len(bot.Handlers.TypingStart) <= index {
+	case FlagGatewayEventNameGuildScheduledEventCreate:
+		if len(bot.Handlers.GuildScheduledEventCreate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8743,10 +9338,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.TypingStart = append(bot.Handlers.TypingStart[:index], bot.Handlers.TypingStart[index+1:]...)
+		bot.Handlers.GuildScheduledEventCreate = append(bot.Handlers.GuildScheduledEventCreate[:index:index], bot.Handlers.GuildScheduledEventCreate[index+1:]...)
 
-	case FlagGatewayEventNameVoiceStateUpdate:
-		if len(bot.Handlers.VoiceStateUpdate) <= index {
+	case FlagGatewayEventNameGuildScheduledEventUpdate:
+		if len(bot.Handlers.GuildScheduledEventUpdate) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8756,10 +9351,10 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.VoiceStateUpdate = append(bot.Handlers.VoiceStateUpdate[:index], bot.Handlers.VoiceStateUpdate[index+1:]...)
+		bot.Handlers.GuildScheduledEventUpdate = append(bot.Handlers.GuildScheduledEventUpdate[:index:index], bot.Handlers.GuildScheduledEventUpdate[index+1:]...)
 
-	case FlagGatewayEventNameWebhooksUpdate:
-		if len(bot.Handlers.WebhooksUpdate) <= index {
+	case FlagGatewayEventNameGuildScheduledEventDelete:
+		if len(bot.Handlers.GuildScheduledEventDelete) <= index {
 			err := ErrorEventHandler{
 				ClientID: bot.ApplicationID,
 				Event:    eventname,
@@ -8769,2513 +9364,4138 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.WebhooksUpdate = append(bot.Handlers.WebhooksUpdate[:index], bot.Handlers.WebhooksUpdate[index+1:]...)
-	}
-
-	LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("removed event handler")
-
-	return nil
-}
-
-// handle handles an event using its name and data.
-func (bot *Client) handle(eventname string, data json.RawMessage) {
-	bot.Handlers.mu.RLock()
-	defer bot.Handlers.mu.RUnlock()
-
-	switch eventname {
-	case FlagGatewayEventNameHello:
-		if len(bot.Handlers.Hello) != 0 {
-			event := new(Hello)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameHello, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.GuildScheduledEventDelete = append(bot.Handlers.GuildScheduledEventDelete[:index:index], bot.Handlers.GuildScheduledEventDelete[index+1:]...)
 
-			for _, handler := range bot.Handlers.Hello {
-				go handler(event)
+	case FlagGatewayEventNameGuildScheduledEventUserAdd:
+		if len(bot.Handlers.GuildScheduledEventUserAdd) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameReady:
-		if len(bot.Handlers.Ready) != 0 {
-			event := new(Ready)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReady, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.GuildScheduledEventUserAdd = append(bot.Handlers.GuildScheduledEventUserAdd[:index:index], bot.Handlers.GuildScheduledEventUserAdd[index+1:]...)
 
-			for _, handler := range bot.Handlers.Ready {
-				go handler(event)
+	case FlagGatewayEventNameGuildScheduledEventUserRemove:
+		if len(bot.Handlers.GuildScheduledEventUserRemove) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameResumed:
-		if len(bot.Handlers.Resumed) != 0 {
-			event := new(Resumed)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameResumed, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.GuildScheduledEventUserRemove = append(bot.Handlers.GuildScheduledEventUserRemove[:index:index], bot.Handlers.GuildScheduledEventUserRemove[index+1:]...)
 
-			for _, handler := range bot.Handlers.Resumed {
-				go handler(event)
+	case FlagGatewayEventNameIntegrationCreate:
+		if len(bot.Handlers.IntegrationCreate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameReconnect:
-		if len(bot.Handlers.Reconnect) != 0 {
-			event := new(Reconnect)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReconnect, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.IntegrationCreate = append(bot.Handlers.IntegrationCreate[:index:index], bot.Handlers.IntegrationCreate[index+1:]...)
 
-			for _, handler := range bot.Handlers.Reconnect {
-				go handler(event)
+	case FlagGatewayEventNameIntegrationUpdate:
+		if len(bot.Handlers.IntegrationUpdate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameInvalidSession:
-		if len(bot.Handlers.InvalidSession) != 0 {
-			event := new(InvalidSession)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInvalidSession, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.IntegrationUpdate = append(bot.Handlers.IntegrationUpdate[:index:index], bot.Handlers.IntegrationUpdate[index+1:]...)
 
-			for _, handler := range bot.Handlers.InvalidSession {
-				go handler(event)
+	case FlagGatewayEventNameIntegrationDelete:
+		if len(bot.Handlers.IntegrationDelete) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
-		if len(bot.Handlers.ApplicationCommandPermissionsUpdate) != 0 {
-			event := new(ApplicationCommandPermissionsUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameApplicationCommandPermissionsUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.IntegrationDelete = append(bot.Handlers.IntegrationDelete[:index:index], bot.Handlers.IntegrationDelete[index+1:]...)
 
-			for _, handler := range bot.Handlers.ApplicationCommandPermissionsUpdate {
-				go handler(event)
+	case FlagGatewayEventNameInviteCreate:
+		if len(bot.Handlers.InviteCreate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameAutoModerationRuleCreate:
-		if len(bot.Handlers.AutoModerationRuleCreate) != 0 {
-			event := new(AutoModerationRuleCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.InviteCreate = append(bot.Handlers.InviteCreate[:index:index], bot.Handlers.InviteCreate[index+1:]...)
 
-			for _, handler := range bot.Handlers.AutoModerationRuleCreate {
-				go handler(event)
+	case FlagGatewayEventNameInviteDelete:
+		if len(bot.Handlers.InviteDelete) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameAutoModerationRuleUpdate:
-		if len(bot.Handlers.AutoModerationRuleUpdate) != 0 {
-			event := new(AutoModerationRuleUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.InviteDelete = append(bot.Handlers.InviteDelete[:index:index], bot.Handlers.InviteDelete[index+1:]...)
 
-			for _, handler := range bot.Handlers.AutoModerationRuleUpdate {
-				go handler(event)
+	case FlagGatewayEventNameMessageCreate:
+		if len(bot.Handlers.MessageCreate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameAutoModerationRuleDelete:
-		if len(bot.Handlers.AutoModerationRuleDelete) != 0 {
-			event := new(AutoModerationRuleDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageCreate = append(bot.Handlers.MessageCreate[:index:index], bot.Handlers.MessageCreate[index+1:]...)
 
-			for _, handler := range bot.Handlers.AutoModerationRuleDelete {
-				go handler(event)
+	case FlagGatewayEventNameMessageUpdate:
+		if len(bot.Handlers.MessageUpdate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameAutoModerationActionExecution:
-		if len(bot.Handlers.AutoModerationActionExecution) != 0 {
-			event := new(AutoModerationActionExecution)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationActionExecution, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageUpdate = append(bot.Handlers.MessageUpdate[:index:index], bot.Handlers.MessageUpdate[index+1:]...)
 
-			for _, handler := range bot.Handlers.AutoModerationActionExecution {
-				go handler(event)
+	case FlagGatewayEventNameMessageDelete:
+		if len(bot.Handlers.MessageDelete) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameInteractionCreate:
-		if len(bot.Handlers.InteractionCreate) != 0 {
-			event := new(InteractionCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInteractionCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageDelete = append(bot.Handlers.MessageDelete[:index:index], bot.Handlers.MessageDelete[index+1:]...)
 
-			for _, handler := range bot.Handlers.InteractionCreate {
-				go handler(event)
+	case FlagGatewayEventNameMessageDeleteBulk:
+		if len(bot.Handlers.MessageDeleteBulk) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameVoiceServerUpdate:
-		if len(bot.Handlers.VoiceServerUpdate) != 0 {
-			event := new(VoiceServerUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceServerUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageDeleteBulk = append(bot.Handlers.MessageDeleteBulk[:index:index], bot.Handlers.MessageDeleteBulk[index+1:]...)
 
-			for _, handler := range bot.Handlers.VoiceServerUpdate {
-				go handler(event)
+	case FlagGatewayEventNameMessageReactionAdd:
+		if len(bot.Handlers.MessageReactionAdd) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameGuildMembersChunk:
-		if len(bot.Handlers.GuildMembersChunk) != 0 {
-			event := new(GuildMembersChunk)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMembersChunk, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageReactionAdd = append(bot.Handlers.MessageReactionAdd[:index:index], bot.Handlers.MessageReactionAdd[index+1:]...)
 
-			for _, handler := range bot.Handlers.GuildMembersChunk {
-				go handler(event)
+	case FlagGatewayEventNameMessageReactionRemove:
+		if len(bot.Handlers.MessageReactionRemove) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameUserUpdate:
-		if len(bot.Handlers.UserUpdate) != 0 {
-			event := new(UserUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameUserUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageReactionRemove = append(bot.Handlers.MessageReactionRemove[:index:index], bot.Handlers.MessageReactionRemove[index+1:]...)
 
-			for _, handler := range bot.Handlers.UserUpdate {
-				go handler(event)
+	case FlagGatewayEventNameMessageReactionRemoveAll:
+		if len(bot.Handlers.MessageReactionRemoveAll) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameChannelCreate:
-		if len(bot.Handlers.ChannelCreate) != 0 {
-			event := new(ChannelCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageReactionRemoveAll = append(bot.Handlers.MessageReactionRemoveAll[:index:index], bot.Handlers.MessageReactionRemoveAll[index+1:]...)
 
-			for _, handler := range bot.Handlers.ChannelCreate {
-				go handler(event)
+	case FlagGatewayEventNameMessageReactionRemoveEmoji:
+		if len(bot.Handlers.MessageReactionRemoveEmoji) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameChannelUpdate:
-		if len(bot.Handlers.ChannelUpdate) != 0 {
-			event := new(ChannelUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.MessageReactionRemoveEmoji = append(bot.Handlers.MessageReactionRemoveEmoji[:index:index], bot.Handlers.MessageReactionRemoveEmoji[index+1:]...)
 
-			for _, handler := range bot.Handlers.ChannelUpdate {
-				go handler(event)
+	case FlagGatewayEventNamePresenceUpdate:
+		if len(bot.Handlers.PresenceUpdate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameChannelDelete:
-		if len(bot.Handlers.ChannelDelete) != 0 {
-			event := new(ChannelDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.PresenceUpdate = append(bot.Handlers.PresenceUpdate[:index:index], bot.Handlers.PresenceUpdate[index+1:]...)
 
-			for _, handler := range bot.Handlers.ChannelDelete {
-				go handler(event)
+	case FlagGatewayEventNameStageInstanceCreate:
+		if len(bot.Handlers.StageInstanceCreate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameChannelPinsUpdate:
-		if len(bot.Handlers.ChannelPinsUpdate) != 0 {
-			event := new(ChannelPinsUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelPinsUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.StageInstanceCreate = append(bot.Handlers.StageInstanceCreate[:index:index], bot.Handlers.StageInstanceCreate[index+1:]...)
 
-			for _, handler := range bot.Handlers.ChannelPinsUpdate {
-				go handler(event)
+	case FlagGatewayEventNameStageInstanceDelete:
+		if len(bot.Handlers.StageInstanceDelete) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameThreadCreate:
-		if len(bot.Handlers.ThreadCreate) != 0 {
-			event := new(ThreadCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.StageInstanceDelete = append(bot.Handlers.StageInstanceDelete[:index:index], bot.Handlers.StageInstanceDelete[index+1:]...)
 
-			for _, handler := range bot.Handlers.ThreadCreate {
-				go handler(event)
+	case FlagGatewayEventNameStageInstanceUpdate:
+		if len(bot.Handlers.StageInstanceUpdate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameThreadUpdate:
-		if len(bot.Handlers.ThreadUpdate) != 0 {
-			event := new(ThreadUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
-			}
+		bot.Handlers.StageInstanceUpdate = append(bot.Handlers.StageInstanceUpdate[:index:index], bot.Handlers.StageInstanceUpdate[index+1:]...)
 
-			for _, handler := range bot.Handlers.ThreadUpdate {
-				go handler(event)
+	case FlagGatewayEventNameTypingStart:
+		if len(bot.Handlers.TypingStart) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameThreadDelete:
-		if len(bot.Handlers.ThreadDelete) != 0 {
-			event := new(ThreadDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
-				return
+		bot.Handlers.TypingStart = append(bot.Handlers.TypingStart[:index:index], bot.Handlers.TypingStart[index+1:]...)
+
+	case FlagGatewayEventNameVoiceStateUpdate:
+		if len(bot.Handlers.VoiceStateUpdate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
+		}
+
+		bot.Handlers.VoiceStateUpdate = append(bot.Handlers.VoiceStateUpdate[:index:index], bot.Handlers.VoiceStateUpdate[index+1:]...)
 
-			for _, handler := range bot.Handlers.ThreadDelete {
-				go handler(event)
+	case FlagGatewayEventNameWebhooksUpdate:
+		if len(bot.Handlers.WebhooksUpdate) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
 			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
 		}
 
-	case FlagGatewayEventNameThreadListSync:
-		if len(bot.Handlers.ThreadListSync) != 0 {
-			event := new(ThreadListSync)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadListSync, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+		bot.Handlers.WebhooksUpdate = append(bot.Handlers.WebhooksUpdate[:index:index], bot.Handlers.WebhooksUpdate[index+1:]...)
+	}
+
+	bot.Handlers.untrack(eventname, index)
+	LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("removed event handler")
+
+	return nil
+}
+
+// handle handles an event using its name and data.
+//
+// The event handlers are called on their own goroutines when the dispatch is async.
+func (bot *Client) handle(d *dispatch) {
+	bot.raw(d)
+
+	switch d.eventname {
+	case FlagGatewayEventNameHello:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Hello
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(Hello)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameHello, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadListSync {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameThreadMemberUpdate:
-		if len(bot.Handlers.ThreadMemberUpdate) != 0 {
-			event := new(ThreadMemberUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameReady:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Ready
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(Ready)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReady, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadMemberUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameThreadMembersUpdate:
-		if len(bot.Handlers.ThreadMembersUpdate) != 0 {
-			event := new(ThreadMembersUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMembersUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameResumed:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Resumed
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(Resumed)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameResumed, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadMembersUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildCreate:
-		if len(bot.Handlers.GuildCreate) != 0 {
-			event := new(GuildCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameReconnect:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Reconnect
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(Reconnect)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReconnect, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildUpdate:
-		if len(bot.Handlers.GuildUpdate) != 0 {
-			event := new(GuildUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameInvalidSession:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InvalidSession
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(InvalidSession)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInvalidSession, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildDelete:
-		if len(bot.Handlers.GuildDelete) != 0 {
-			event := new(GuildDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ApplicationCommandPermissionsUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ApplicationCommandPermissionsUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameApplicationCommandPermissionsUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildAuditLogEntryCreate:
-		if len(bot.Handlers.GuildAuditLogEntryCreate) != 0 {
-			event := new(GuildAuditLogEntryCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildAuditLogEntryCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameAutoModerationRuleCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationRuleCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(AutoModerationRuleCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildAuditLogEntryCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildBanAdd:
-		if len(bot.Handlers.GuildBanAdd) != 0 {
-			event := new(GuildBanAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameAutoModerationRuleUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationRuleUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(AutoModerationRuleUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildBanAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildBanRemove:
-		if len(bot.Handlers.GuildBanRemove) != 0 {
-			event := new(GuildBanRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameAutoModerationRuleDelete:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationRuleDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(AutoModerationRuleDelete)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildBanRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildEmojisUpdate:
-		if len(bot.Handlers.GuildEmojisUpdate) != 0 {
-			event := new(GuildEmojisUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildEmojisUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameAutoModerationActionExecution:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationActionExecution
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(AutoModerationActionExecution)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationActionExecution, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildEmojisUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildStickersUpdate:
-		if len(bot.Handlers.GuildStickersUpdate) != 0 {
-			event := new(GuildStickersUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildStickersUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameInteractionCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InteractionCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(InteractionCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInteractionCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildStickersUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildIntegrationsUpdate:
-		if len(bot.Handlers.GuildIntegrationsUpdate) != 0 {
-			event := new(GuildIntegrationsUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildIntegrationsUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameVoiceServerUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.VoiceServerUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(VoiceServerUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceServerUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildIntegrationsUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildMemberAdd:
-		if len(bot.Handlers.GuildMemberAdd) != 0 {
-			event := new(GuildMemberAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameGuildMembersChunk:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMembersChunk
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildMembersChunk)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMembersChunk, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildMemberAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildMemberRemove:
-		if len(bot.Handlers.GuildMemberRemove) != 0 {
-			event := new(GuildMemberRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameUserUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.UserUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(UserUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameUserUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildMemberRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildMemberUpdate:
-		if len(bot.Handlers.GuildMemberUpdate) != 0 {
-			event := new(GuildMemberUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameChannelCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ChannelCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildMemberUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildRoleCreate:
-		if len(bot.Handlers.GuildRoleCreate) != 0 {
-			event := new(GuildRoleCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameChannelUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ChannelUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildRoleCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildRoleUpdate:
-		if len(bot.Handlers.GuildRoleUpdate) != 0 {
-			event := new(GuildRoleUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameChannelDelete:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ChannelDelete)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildRoleUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildRoleDelete:
-		if len(bot.Handlers.GuildRoleDelete) != 0 {
-			event := new(GuildRoleDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameChannelPinsUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelPinsUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ChannelPinsUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelPinsUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildRoleDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildScheduledEventCreate:
-		if len(bot.Handlers.GuildScheduledEventCreate) != 0 {
-			event := new(GuildScheduledEventCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameThreadCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ThreadCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildScheduledEventUpdate:
-		if len(bot.Handlers.GuildScheduledEventUpdate) != 0 {
-			event := new(GuildScheduledEventUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameThreadUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ThreadUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildScheduledEventDelete:
-		if len(bot.Handlers.GuildScheduledEventDelete) != 0 {
-			event := new(GuildScheduledEventDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameThreadDelete:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ThreadDelete)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildScheduledEventUserAdd:
-		if len(bot.Handlers.GuildScheduledEventUserAdd) != 0 {
-			event := new(GuildScheduledEventUserAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameThreadListSync:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadListSync
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ThreadListSync)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadListSync, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventUserAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameGuildScheduledEventUserRemove:
-		if len(bot.Handlers.GuildScheduledEventUserRemove) != 0 {
-			event := new(GuildScheduledEventUserRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameThreadMemberUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadMemberUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ThreadMemberUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventUserRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameIntegrationCreate:
-		if len(bot.Handlers.IntegrationCreate) != 0 {
-			event := new(IntegrationCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameThreadMembersUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadMembersUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(ThreadMembersUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMembersUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.IntegrationCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameIntegrationUpdate:
-		if len(bot.Handlers.IntegrationUpdate) != 0 {
-			event := new(IntegrationUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameGuildCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.IntegrationUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
-	case FlagGatewayEventNameIntegrationDelete:
-		if len(bot.Handlers.IntegrationDelete) != 0 {
-			event := new(IntegrationDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+	case FlagGatewayEventNameGuildUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.IntegrationDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildDelete:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildDelete)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildDelete, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildAuditLogEntryCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildAuditLogEntryCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildAuditLogEntryCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildAuditLogEntryCreate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildBanAdd:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildBanAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildBanAdd)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanAdd, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildBanRemove:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildBanRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildBanRemove)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanRemove, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildEmojisUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildEmojisUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildEmojisUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildEmojisUpdate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildStickersUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildStickersUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildStickersUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildStickersUpdate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildIntegrationsUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildIntegrationsUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildIntegrationsUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildIntegrationsUpdate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildMemberAdd:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMemberAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildMemberAdd)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberAdd, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildMemberRemove:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMemberRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildMemberRemove)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberRemove, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildMemberUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMemberUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildMemberUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildRoleCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildRoleCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildRoleCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleCreate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildRoleUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildRoleUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildRoleUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleUpdate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildRoleDelete:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildRoleDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildRoleDelete)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleDelete, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildScheduledEventCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventCreate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildScheduledEventUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUpdate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventDelete:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildScheduledEventDelete)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventDelete, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventUserAdd:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventUserAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildScheduledEventUserAdd)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserAdd, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventUserRemove:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventUserRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(GuildScheduledEventUserRemove)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserRemove, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameIntegrationCreate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.IntegrationCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(IntegrationCreate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationCreate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameIntegrationUpdate:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.IntegrationUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(IntegrationUpdate)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationUpdate, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
+			}
+		}
+
+	case FlagGatewayEventNameIntegrationDelete:
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.IntegrationDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
+			event := new(IntegrationDelete)
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationDelete, Err: err, Action: ErrorEventActionUnmarshal})
+				return
+			}
+
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameInviteCreate:
-		if len(bot.Handlers.InviteCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InviteCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(InviteCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.InviteCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameInviteDelete:
-		if len(bot.Handlers.InviteDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InviteDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(InviteDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.InviteDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageCreate:
-		if len(bot.Handlers.MessageCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageUpdate:
-		if len(bot.Handlers.MessageUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageDelete:
-		if len(bot.Handlers.MessageDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageDeleteBulk:
-		if len(bot.Handlers.MessageDeleteBulk) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageDeleteBulk
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageDeleteBulk)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDeleteBulk, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDeleteBulk, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageDeleteBulk {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionAdd:
-		if len(bot.Handlers.MessageReactionAdd) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionAdd, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionRemove:
-		if len(bot.Handlers.MessageReactionRemove) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemove, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionRemoveAll:
-		if len(bot.Handlers.MessageReactionRemoveAll) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionRemoveAll
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionRemoveAll)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveAll, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveAll, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionRemoveAll {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionRemoveEmoji:
-		if len(bot.Handlers.MessageReactionRemoveEmoji) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionRemoveEmoji
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionRemoveEmoji)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveEmoji, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveEmoji, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionRemoveEmoji {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNamePresenceUpdate:
-		if len(bot.Handlers.PresenceUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.PresenceUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(PresenceUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNamePresenceUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNamePresenceUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.PresenceUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameStageInstanceCreate:
-		if len(bot.Handlers.StageInstanceCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.StageInstanceCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(StageInstanceCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.StageInstanceCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameStageInstanceDelete:
-		if len(bot.Handlers.StageInstanceDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.StageInstanceDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(StageInstanceDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.StageInstanceDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameStageInstanceUpdate:
-		if len(bot.Handlers.StageInstanceUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.StageInstanceUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(StageInstanceUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.StageInstanceUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameTypingStart:
-		if len(bot.Handlers.TypingStart) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.TypingStart
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(TypingStart)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameTypingStart, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameTypingStart, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.TypingStart {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameVoiceStateUpdate:
-		if len(bot.Handlers.VoiceStateUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.VoiceStateUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(VoiceStateUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceStateUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceStateUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.VoiceStateUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameWebhooksUpdate:
-		if len(bot.Handlers.WebhooksUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.WebhooksUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(WebhooksUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameWebhooksUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameWebhooksUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.WebhooksUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 	}
 }
 
-/**json_convert.go contains type conversion functions for JSON data functionality.
+// isEvent returns whether an event with the given name is handled by Disgo.
+func isEvent(eventname string) bool {
+	switch eventname {
+	case FlagGatewayEventNameHello,
+		FlagGatewayEventNameReady,
+		FlagGatewayEventNameResumed,
+		FlagGatewayEventNameReconnect,
+		FlagGatewayEventNameInvalidSession,
+		FlagGatewayEventNameApplicationCommandPermissionsUpdate,
+		FlagGatewayEventNameAutoModerationRuleCreate,
+		FlagGatewayEventNameAutoModerationRuleUpdate,
+		FlagGatewayEventNameAutoModerationRuleDelete,
+		FlagGatewayEventNameAutoModerationActionExecution,
+		FlagGatewayEventNameInteractionCreate,
+		FlagGatewayEventNameVoiceServerUpdate,
+		FlagGatewayEventNameGuildMembersChunk,
+		FlagGatewayEventNameUserUpdate,
+		FlagGatewayEventNameChannelCreate,
+		FlagGatewayEventNameChannelUpdate,
+		FlagGatewayEventNameChannelDelete,
+		FlagGatewayEventNameChannelPinsUpdate,
+		FlagGatewayEventNameThreadCreate,
+		FlagGatewayEventNameThreadUpdate,
+		FlagGatewayEventNameThreadDelete,
+		FlagGatewayEventNameThreadListSync,
+		FlagGatewayEventNameThreadMemberUpdate,
+		FlagGatewayEventNameThreadMembersUpdate,
+		FlagGatewayEventNameGuildCreate,
+		FlagGatewayEventNameGuildUpdate,
+		FlagGatewayEventNameGuildDelete,
+		FlagGatewayEventNameGuildAuditLogEntryCreate,
+		FlagGatewayEventNameGuildBanAdd,
+		FlagGatewayEventNameGuildBanRemove,
+		FlagGatewayEventNameGuildEmojisUpdate,
+		FlagGatewayEventNameGuildStickersUpdate,
+		FlagGatewayEventNameGuildIntegrationsUpdate,
+		FlagGatewayEventNameGuildMemberAdd,
+		FlagGatewayEventNameGuildMemberRemove,
+		FlagGatewayEventNameGuildMemberUpdate,
+		FlagGatewayEventNameGuildRoleCreate,
+		FlagGatewayEventNameGuildRoleUpdate,
+		FlagGatewayEventNameGuildRoleDelete,
+		FlagGatewayEventNameGuildScheduledEventCreate,
+		FlagGatewayEventNameGuildScheduledEventUpdate,
+		FlagGatewayEventNameGuildScheduledEventDelete,
+		FlagGatewayEventNameGuildScheduledEventUserAdd,
+		FlagGatewayEventNameGuildScheduledEventUserRemove,
+		FlagGatewayEventNameIntegrationCreate,
+		FlagGatewayEventNameIntegrationUpdate,
+		FlagGatewayEventNameIntegrationDelete,
+		FlagGatewayEventNameInviteCreate,
+		FlagGatewayEventNameInviteDelete,
+		FlagGatewayEventNameMessageCreate,
+		FlagGatewayEventNameMessageUpdate,
+		FlagGatewayEventNameMessageDelete,
+		FlagGatewayEventNameMessageDeleteBulk,
+		FlagGatewayEventNameMessageReactionAdd,
+		FlagGatewayEventNameMessageReactionRemove,
+		FlagGatewayEventNameMessageReactionRemoveAll,
+		FlagGatewayEventNameMessageReactionRemoveEmoji,
+		FlagGatewayEventNamePresenceUpdate,
+		FlagGatewayEventNameStageInstanceCreate,
+		FlagGatewayEventNameStageInstanceDelete,
+		FlagGatewayEventNameStageInstanceUpdate,
+		FlagGatewayEventNameTypingStart,
+		FlagGatewayEventNameVoiceStateUpdate,
+		FlagGatewayEventNameWebhooksUpdate:
+		return true
+	}
 
-This lets users (developers) easily type convert JSON data between structs.
+	return false
+}
 
-*/
+// eventname returns the name of the Hello event.
+func (*Hello) eventname() string {
+	return FlagGatewayEventNameHello
+}
 
-func (b BitFlag) String() string {
-	return strconv.FormatUint(uint64(b), base10)
+// eventname returns the name of the Ready event.
+func (*Ready) eventname() string {
+	return FlagGatewayEventNameReady
 }
 
-var (
-	byteEmptySlice = []byte("[]")
-)
+// eventname returns the name of the Resumed event.
+func (*Resumed) eventname() string {
+	return FlagGatewayEventNameResumed
+}
 
-func (t Flags) MarshalJSON() ([]byte, error) {
-	if len(t) == 0 {
-		return byteEmptySlice, nil
-	}
+// eventname returns the name of the Reconnect event.
+func (*Reconnect) eventname() string {
+	return FlagGatewayEventNameReconnect
+}
 
-	var output bytes.Buffer
-	output.WriteByte('[')
+// eventname returns the name of the InvalidSession event.
+func (*InvalidSession) eventname() string {
+	return FlagGatewayEventNameInvalidSession
+}
 
-	stop := len(t) - 1
-	for i, f := range t {
-		output.WriteString(strconv.Itoa(int(f)))
+// eventname returns the name of the ApplicationCommandPermissionsUpdate event.
+func (*ApplicationCommandPermissionsUpdate) eventname() string {
+	return FlagGatewayEventNameApplicationCommandPermissionsUpdate
+}
 
-		if i == stop {
-			break
-		}
+// eventname returns the name of the AutoModerationRuleCreate event.
+func (*AutoModerationRuleCreate) eventname() string {
+	return FlagGatewayEventNameAutoModerationRuleCreate
+}
 
-		output.WriteByte(',')
-	}
+// eventname returns the name of the AutoModerationRuleUpdate event.
+func (*AutoModerationRuleUpdate) eventname() string {
+	return FlagGatewayEventNameAutoModerationRuleUpdate
+}
 
-	output.WriteByte(']')
+// eventname returns the name of the AutoModerationRuleDelete event.
+func (*AutoModerationRuleDelete) eventname() string {
+	return FlagGatewayEventNameAutoModerationRuleDelete
+}
 
-	return output.Bytes(), nil
+// eventname returns the name of the AutoModerationActionExecution event.
+func (*AutoModerationActionExecution) eventname() string {
+	return FlagGatewayEventNameAutoModerationActionExecution
 }
 
-func (r *BulkOverwriteGlobalApplicationCommands) MarshalJSON() ([]byte, error) {
-	return json.Marshal(r.ApplicationCommands) //nolint:wrapcheck
+// eventname returns the name of the InteractionCreate event.
+func (*InteractionCreate) eventname() string {
+	return FlagGatewayEventNameInteractionCreate
 }
 
-func (r *BulkOverwriteGuildApplicationCommands) MarshalJSON() ([]byte, error) {
-	return json.Marshal(r.ApplicationCommands) //nolint:wrapcheck
+// eventname returns the name of the VoiceServerUpdate event.
+func (*VoiceServerUpdate) eventname() string {
+	return FlagGatewayEventNameVoiceServerUpdate
 }
 
-func (r *ModifyGuildChannelPositions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(r.Parameters) //nolint:wrapcheck
+// eventname returns the name of the GuildMembersChunk event.
+func (*GuildMembersChunk) eventname() string {
+	return FlagGatewayEventNameGuildMembersChunk
 }
 
-func (r *ModifyGuildRolePositions) MarshalJSON() ([]byte, error) {
-	return json.Marshal(r.Parameters) //nolint:wrapcheck
+// eventname returns the name of the UserUpdate event.
+func (*UserUpdate) eventname() string {
+	return FlagGatewayEventNameUserUpdate
 }
 
-/**unmarshal.go contains custom UnmarshalJSON() functions.
+// eventname returns the name of the ChannelCreate event.
+func (*ChannelCreate) eventname() string {
+	return FlagGatewayEventNameChannelCreate
+}
 
-This lets json.Unmarshal() unmarshal JSON data into types that contain interface fields.
+// eventname returns the name of the ChannelUpdate event.
+func (*ChannelUpdate) eventname() string {
+	return FlagGatewayEventNameChannelUpdate
+}
 
-In addition, structs that contain an embedded field - that implements UnmarshalJSON() - will
-use the embedded field's implementation of UnmarshalJSON(). As a result, these structs must
-also implement UnmarshalJSON() to prevent null pointer dereferences.
+// eventname returns the name of the ChannelDelete event.
+func (*ChannelDelete) eventname() string {
+	return FlagGatewayEventNameChannelDelete
+}
 
-*/
+// eventname returns the name of the ChannelPinsUpdate event.
+func (*ChannelPinsUpdate) eventname() string {
+	return FlagGatewayEventNameChannelPinsUpdate
+}
 
-/** Unused: Command, Event */
+// eventname returns the name of the ThreadCreate event.
+func (*ThreadCreate) eventname() string {
+	return FlagGatewayEventNameThreadCreate
+}
 
-/** Nonce
+// eventname returns the name of the ThreadUpdate event.
+func (*ThreadUpdate) eventname() string {
+	return FlagGatewayEventNameThreadUpdate
+}
 
-Includes: CreateMessage, Message
+// eventname returns the name of the ThreadDelete event.
+func (*ThreadDelete) eventname() string {
+	return FlagGatewayEventNameThreadDelete
+}
 
-**/
+// eventname returns the name of the ThreadListSync event.
+func (*ThreadListSync) eventname() string {
+	return FlagGatewayEventNameThreadListSync
+}
 
-func (v *Nonce) UnmarshalJSON(b []byte) error {
-	var x interface{}
+// eventname returns the name of the ThreadMemberUpdate event.
+func (*ThreadMemberUpdate) eventname() string {
+	return FlagGatewayEventNameThreadMemberUpdate
+}
 
-	if err := json.Unmarshal(b, &x); err != nil {
-		return fmt.Errorf(errUnmarshal, x, err)
-	}
+// eventname returns the name of the ThreadMembersUpdate event.
+func (*ThreadMembersUpdate) eventname() string {
+	return FlagGatewayEventNameThreadMembersUpdate
+}
 
-	switch xValue := x.(type) {
-	case string:
-		*v = Nonce(xValue)
+// eventname returns the name of the GuildCreate event.
+func (*GuildCreate) eventname() string {
+	return FlagGatewayEventNameGuildCreate
+}
 
-	case int:
-		*v = Nonce(strconv.Itoa(xValue))
+// eventname returns the name of the GuildUpdate event.
+func (*GuildUpdate) eventname() string {
+	return FlagGatewayEventNameGuildUpdate
+}
 
-	default:
-		return fmt.Errorf(errUnmarshal, v, fmt.Errorf("value is type %T", x))
-	}
+// eventname returns the name of the GuildDelete event.
+func (*GuildDelete) eventname() string {
+	return FlagGatewayEventNameGuildDelete
+}
 
-	return nil
+// eventname returns the name of the GuildAuditLogEntryCreate event.
+func (*GuildAuditLogEntryCreate) eventname() string {
+	return FlagGatewayEventNameGuildAuditLogEntryCreate
 }
 
-/** Value
+// eventname returns the name of the GuildBanAdd event.
+func (*GuildBanAdd) eventname() string {
+	return FlagGatewayEventNameGuildBanAdd
+}
 
-Includes: ApplicationCommandOptionChoice, ApplicationCommandInteractionDataOption
+// eventname returns the name of the GuildBanRemove event.
+func (*GuildBanRemove) eventname() string {
+	return FlagGatewayEventNameGuildBanRemove
+}
 
-**/
+// eventname returns the name of the GuildEmojisUpdate event.
+func (*GuildEmojisUpdate) eventname() string {
+	return FlagGatewayEventNameGuildEmojisUpdate
+}
 
-func (v *Value) UnmarshalJSON(b []byte) error {
-	var x interface{}
+// eventname returns the name of the GuildStickersUpdate event.
+func (*GuildStickersUpdate) eventname() string {
+	return FlagGatewayEventNameGuildStickersUpdate
+}
 
-	if err := json.Unmarshal(b, &x); err != nil {
-		return fmt.Errorf(errUnmarshal, x, err)
-	}
+// eventname returns the name of the GuildIntegrationsUpdate event.
+func (*GuildIntegrationsUpdate) eventname() string {
+	return FlagGatewayEventNameGuildIntegrationsUpdate
+}
 
-	switch xValue := x.(type) {
-	case string:
-		*v = Value(xValue)
+// eventname returns the name of the GuildMemberAdd event.
+func (*GuildMemberAdd) eventname() string {
+	return FlagGatewayEventNameGuildMemberAdd
+}
 
-	case int:
-		*v = Value(strconv.Itoa(xValue))
+// eventname returns the name of the GuildMemberRemove event.
+func (*GuildMemberRemove) eventname() string {
+	return FlagGatewayEventNameGuildMemberRemove
+}
 
-	case float64:
-		*v = Value(strconv.FormatFloat(xValue, 'f', -1, bit64))
+// eventname returns the name of the GuildMemberUpdate event.
+func (*GuildMemberUpdate) eventname() string {
+	return FlagGatewayEventNameGuildMemberUpdate
+}
 
-	case bool:
-		*v = Value(strconv.FormatBool(xValue))
+// eventname returns the name of the GuildRoleCreate event.
+func (*GuildRoleCreate) eventname() string {
+	return FlagGatewayEventNameGuildRoleCreate
+}
 
-	default:
-		return fmt.Errorf(errUnmarshal, v, fmt.Errorf("value is type %T", x))
-	}
+// eventname returns the name of the GuildRoleUpdate event.
+func (*GuildRoleUpdate) eventname() string {
+	return FlagGatewayEventNameGuildRoleUpdate
+}
 
-	return nil
+// eventname returns the name of the GuildRoleDelete event.
+func (*GuildRoleDelete) eventname() string {
+	return FlagGatewayEventNameGuildRoleDelete
 }
 
-/** Component **/
+// eventname returns the name of the GuildScheduledEventCreate event.
+func (*GuildScheduledEventCreate) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventCreate
+}
 
-// unmarshalComponents unmarshals a JSON component array into a slice of Go Interface Components (with underlying structs).
-func unmarshalComponents(b []byte) ([]Component, error) {
-	if len(b) == 0 {
-		return nil, nil
-	}
+// eventname returns the name of the GuildScheduledEventUpdate event.
+func (*GuildScheduledEventUpdate) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventUpdate
+}
 
-	type unmarshalComponent struct {
+// eventname returns the name of the GuildScheduledEventDelete event.
+func (*GuildScheduledEventDelete) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventDelete
+}
 
-		// https://discord.com/developers/docs/interactions/message-components#component-object-example-component
-		Type Flag `json:"type"`
-	}
+// eventname returns the name of the GuildScheduledEventUserAdd event.
+func (*GuildScheduledEventUserAdd) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventUserAdd
+}
 
-	// Components are always provided in a JSON array.
-	// Create a variable (of type []unmarshalComponent) that can read all of the Component Types.
-	var unmarshalledComponents []unmarshalComponent
+// eventname returns the name of the GuildScheduledEventUserRemove event.
+func (*GuildScheduledEventUserRemove) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventUserRemove
+}
 
-	// unmarshal the JSON (components.{component.Type}) into unmarshalledComponents.
-	if err := json.Unmarshal(b, &unmarshalledComponents); err != nil {
-		return nil, fmt.Errorf(errUnmarshal, unmarshalledComponents, err)
-	}
+// eventname returns the name of the IntegrationCreate event.
+func (*IntegrationCreate) eventname() string {
+	return FlagGatewayEventNameIntegrationCreate
+}
 
-	// use the known component types to return a slice of Go Interface Components with underlying structs.
-	components := make([]Component, len(unmarshalledComponents))
-	for i, unmarshalledComponent := range unmarshalledComponents {
-		// set the component (interface) to an underlying type.
-		switch unmarshalledComponent.Type {
-		case FlagComponentTypeActionRow:
-			components[i] = &ActionRow{} //nolint:exhaustruct
+// eventname returns the name of the IntegrationUpdate event.
+func (*IntegrationUpdate) eventname() string {
+	return FlagGatewayEventNameIntegrationUpdate
+}
 
-		case FlagComponentTypeButton:
-			components[i] = &Button{} //nolint:exhaustruct
+// eventname returns the name of the IntegrationDelete event.
+func (*IntegrationDelete) eventname() string {
+	return FlagGatewayEventNameIntegrationDelete
+}
 
-		case FlagComponentTypeStringSelect,
-			FlagComponentTypeUserSelect,
-			FlagComponentTypeRoleSelect,
-			FlagComponentTypeMentionableSelect,
-			FlagComponentTypeChannelSelect:
-			components[i] = &SelectMenu{} //nolint:exhaustruct
+// eventname returns the name of the InviteCreate event.
+func (*InviteCreate) eventname() string {
+	return FlagGatewayEventNameInviteCreate
+}
 
-		case FlagComponentTypeTextInput:
-			components[i] = &TextInput{} //nolint:exhaustruct
+// eventname returns the name of the InviteDelete event.
+func (*InviteDelete) eventname() string {
+	return FlagGatewayEventNameInviteDelete
+}
 
-		default:
-			return nil, fmt.Errorf(
-				"attempt to unmarshal into unknown component type (%d)",
-				unmarshalledComponent.Type,
-			)
-		}
-	}
+// eventname returns the name of the MessageCreate event.
+func (*MessageCreate) eventname() string {
+	return FlagGatewayEventNameMessageCreate
+}
 
-	return components, nil
+// eventname returns the name of the MessageUpdate event.
+func (*MessageUpdate) eventname() string {
+	return FlagGatewayEventNameMessageUpdate
 }
 
-func (r *EditOriginalInteractionResponse) UnmarshalJSON(b []byte) error {
-	// The following pattern is present throughout this file
-	// in order to prevent a stack overflow (of r.UnmarshalJSON()).
-	type alias EditOriginalInteractionResponse
+// eventname returns the name of the MessageDelete event.
+func (*MessageDelete) eventname() string {
+	return FlagGatewayEventNameMessageDelete
+}
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
-	}
+// eventname returns the name of the MessageDeleteBulk event.
+func (*MessageDeleteBulk) eventname() string {
+	return FlagGatewayEventNameMessageDeleteBulk
+}
 
-	if err := json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+// eventname returns the name of the MessageReactionAdd event.
+func (*MessageReactionAdd) eventname() string {
+	return FlagGatewayEventNameMessageReactionAdd
+}
 
-	components, err := unmarshalComponents(unmarshalled.Components)
-	if err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+// eventname returns the name of the MessageReactionRemove event.
+func (*MessageReactionRemove) eventname() string {
+	return FlagGatewayEventNameMessageReactionRemove
+}
 
-	unmarshalled.alias.Components = &components
+// eventname returns the name of the MessageReactionRemoveAll event.
+func (*MessageReactionRemoveAll) eventname() string {
+	return FlagGatewayEventNameMessageReactionRemoveAll
+}
 
-	if r == nil {
-		r = new(EditOriginalInteractionResponse)
-	}
+// eventname returns the name of the MessageReactionRemoveEmoji event.
+func (*MessageReactionRemoveEmoji) eventname() string {
+	return FlagGatewayEventNameMessageReactionRemoveEmoji
+}
 
-	*r = EditOriginalInteractionResponse(unmarshalled.alias)
+// eventname returns the name of the PresenceUpdate event.
+func (*PresenceUpdate) eventname() string {
+	return FlagGatewayEventNamePresenceUpdate
+}
 
-	return nil
+// eventname returns the name of the StageInstanceCreate event.
+func (*StageInstanceCreate) eventname() string {
+	return FlagGatewayEventNameStageInstanceCreate
 }
 
-func (r *CreateFollowupMessage) UnmarshalJSON(b []byte) error {
-	type alias CreateFollowupMessage
+// eventname returns the name of the StageInstanceDelete event.
+func (*StageInstanceDelete) eventname() string {
+	return FlagGatewayEventNameStageInstanceDelete
+}
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
-	}
+// eventname returns the name of the StageInstanceUpdate event.
+func (*StageInstanceUpdate) eventname() string {
+	return FlagGatewayEventNameStageInstanceUpdate
+}
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+// eventname returns the name of the TypingStart event.
+func (*TypingStart) eventname() string {
+	return FlagGatewayEventNameTypingStart
+}
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+// eventname returns the name of the VoiceStateUpdate event.
+func (*VoiceStateUpdate) eventname() string {
+	return FlagGatewayEventNameVoiceStateUpdate
+}
 
-	if r == nil {
-		r = new(CreateFollowupMessage)
-	}
+// eventname returns the name of the WebhooksUpdate event.
+func (*WebhooksUpdate) eventname() string {
+	return FlagGatewayEventNameWebhooksUpdate
+}
 
-	*r = CreateFollowupMessage(unmarshalled.alias)
+// FlagGatewayEventNameRaw represents the name used to handle every event as a RawEvent.
+//
+// A RawEvent is NOT sent by Discord, so an event with this name is never dispatched.
+const FlagGatewayEventNameRaw = "*"
 
-	return nil
-}
+// RawEvent represents an event that is dispatched to a bot's raw event handlers
+// (including events that are unknown to Disgo).
+type RawEvent struct {
+	// Shard represents the [shard_id, num_shards] of the Session that received the event.
+	Shard *[2]int
 
-func (r *EditFollowupMessage) UnmarshalJSON(b []byte) error {
-	type alias EditFollowupMessage
+	// Name represents the name of the event (i.e., MESSAGE_CREATE).
+	Name string
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
-	}
+	// SessionID represents the ID of the Session that received the event.
+	SessionID string
 
-	if err := json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+	// Data represents the raw payload data of the event.
+	Data json.RawMessage
 
-	components, err := unmarshalComponents(unmarshalled.Components)
-	if err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+	// Seq represents the sequence number of the event.
+	Seq int64
 
-	unmarshalled.alias.Components = &components
+	// Replayed indicates whether the event is replayed, which occurs when Discord
+	// resends the events that a Session missed while it was resuming.
+	Replayed bool
+}
 
-	if r == nil {
-		r = new(EditFollowupMessage)
-	}
+// DispatchInfo represents information about the dispatch of an event to an event handler (see OnDispatch).
+type DispatchInfo struct {
+	// Seq represents the sequence number of the event.
+	Seq int64
 
-	*r = EditFollowupMessage(unmarshalled.alias)
+	// Replayed indicates whether the event is replayed, which occurs when Discord
+	// resends the events that a Session missed while it was resuming.
+	Replayed bool
+}
 
-	return nil
+// eventname returns the name used to handle raw events.
+func (*RawEvent) eventname() string {
+	return FlagGatewayEventNameRaw
 }
 
-func (r *EditMessage) UnmarshalJSON(b []byte) error {
-	type alias EditMessage
+// raw dispatches an event to the bot's raw event handlers.
+func (bot *Client) raw(d *dispatch) {
+	bot.Handlers.mu.RLock()
+	handlers := bot.Handlers.Raw
+	bot.Handlers.mu.RUnlock()
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
+	if len(handlers) == 0 {
+		return
 	}
 
-	if err := json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+	event := d.raw()
+	for _, handler := range handlers {
+		call(d, handler, event)
 	}
+}
 
-	components, err := unmarshalComponents(unmarshalled.Components)
-	if err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+// raw returns the RawEvent of a dispatch.
+func (d *dispatch) raw() *RawEvent {
+	return &RawEvent{
+		Shard:     d.shard,
+		Name:      d.eventname,
+		SessionID: d.session,
+		Data:      d.data,
+		Seq:       d.seq,
+		Replayed:  d.replayed,
 	}
+}
 
-	unmarshalled.alias.Components = &components
+// OnCustom adds an event handler for a custom event (i.e., an event that is unknown to Disgo) to the bot,
+// then returns the Subscription that is used to remove the event handler.
+//
+// The data of each event with the given name is decoded into a new T using the given decoder
+// (or json.Unmarshal when the decoder is nil), then passed to the event handler.
+//
+//	sub := disgo.OnCustom(bot, "NEW_EVENT", nil, func(e *NewEvent) { ... })
+func OnCustom[T any](bot *Client, eventname string, decoder func(data json.RawMessage, event *T) error, handler func(*T)) *Subscription {
+	return onRaw(bot, eventname, decoder, func(event *T, _ *RawEvent) {
+		handler(event)
+	})
+}
 
-	if r == nil {
-		r = new(EditMessage)
-	}
+// OnDispatch adds an event handler for an event to the bot, which receives the DispatchInfo
+// of each event (i.e., whether the event is replayed), then returns the Subscription
+// that is used to remove the event handler.
+//
+//	sub := disgo.OnDispatch(bot, func(m *disgo.MessageCreate, info *disgo.DispatchInfo) {
+//		if info.Replayed { ... }
+//	})
+func OnDispatch[T any, E event[T]](bot *Client, handler func(*T, *DispatchInfo)) *Subscription {
+	var e E
 
-	*r = EditMessage(unmarshalled.alias)
+	eventname := e.eventname()
 
-	return nil
-}
+	// the event handler is a raw event handler, so the intents of its event are added explicitly.
+	bot.Handlers.mu.Lock()
+	bot.intend(eventname)
+	bot.Handlers.mu.Unlock()
 
-func (r *ForumThreadMessageParams) UnmarshalJSON(b []byte) error {
-	type alias ForumThreadMessageParams
+	return onRaw(bot, eventname, nil, func(event *T, raw *RawEvent) {
+		handler(event, &DispatchInfo{Seq: raw.Seq, Replayed: raw.Replayed})
+	})
+}
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
+// onRaw adds a raw event handler to the bot, which decodes the data of each event with the given name
+// into a new T using the given decoder (or json.Unmarshal when the decoder is nil), then passes it
+// to the event handler (with the RawEvent).
+func onRaw[T any](bot *Client, eventname string, decoder func(data json.RawMessage, event *T) error, handler func(*T, *RawEvent)) *Subscription {
+	if decoder == nil {
+		decoder = func(data json.RawMessage, event *T) error {
+			return json.Unmarshal(data, event) //nolint:wrapcheck
+		}
 	}
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+	return On(bot, func(raw *RawEvent) {
+		if raw.Name != eventname {
+			return
+		}
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+		event := new(T)
+		if err := decoder(raw.Data, event); err != nil {
+			err = ErrorEvent{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      err,
+				Action:   ErrorEventActionUnmarshal,
+			}
 
-	if r == nil {
-		r = new(ForumThreadMessageParams)
-	}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
 
-	*r = ForumThreadMessageParams(unmarshalled.alias)
+			bot.report(err, &ErrorContext{
+				Shard:     raw.Shard,
+				SessionID: raw.SessionID,
+				Event:     eventname,
+				Payload:   raw.Data,
+			})
 
-	return nil
+			return
+		}
+
+		handler(event, raw)
+	})
 }
 
-func (r *ExecuteWebhook) UnmarshalJSON(b []byte) error {
-	type alias ExecuteWebhook
+// Interaction Endpoint Headers
+// https://discord.com/developers/docs/interactions/receiving-and-responding#security-and-authorization
+const (
+	headerSignature = "X-Signature-Ed25519"
+	headerTimestamp = "X-Signature-Timestamp"
+)
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
-	}
+const (
+	// defaultInteractionReplyTimeout represents the default amount of time an InteractionServer waits
+	// for an inline reply, which is less than the 3 seconds Discord waits for an initial response.
+	defaultInteractionReplyTimeout = time.Millisecond * 2500
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+	// defaultInteractionTimestampTolerance represents the default maximum difference between
+	// the time an InteractionServer receives an interaction and its signature timestamp.
+	defaultInteractionTimestampTolerance = time.Second * 30
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+	// maxInteractionBodySize represents the maximum size of an interaction's HTTP request body.
+	maxInteractionBodySize = 1 << 20
+)
 
-	if r == nil {
-		r = new(ExecuteWebhook)
-	}
+// InteractionServer represents an HTTP handler that receives interactions from Discord
+// using an Interactions Endpoint URL (instead of the Discord Gateway).
+//
+// Each interaction (that is NOT a PING) is dispatched to the bot's InteractionCreate event handlers.
+//
+// https://discord.com/developers/docs/interactions/receiving-and-responding#receiving-an-interaction
+type InteractionServer struct {
+	// pending represents a map of interaction IDs to channels that receive inline replies.
+	pending sync.Map
 
-	*r = ExecuteWebhook(unmarshalled.alias)
+	// Bot represents the bot that handles the interactions.
+	Bot *Client
 
-	return nil
-}
+	// PublicKey represents the public key of the application, which verifies
+	// that each interaction is sent by Discord.
+	PublicKey ed25519.PublicKey
 
-func (r *EditWebhookMessage) UnmarshalJSON(b []byte) error {
-	type alias EditWebhookMessage
+	// Timeout represents the amount of time the server waits for an inline reply to an interaction,
+	// before the interaction is deferred (in the HTTP response).
+	Timeout time.Duration
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
-	}
+	// Tolerance represents the maximum difference between the time the server receives an interaction
+	// and its signature timestamp, such that a request which is replayed later is rejected.
+	Tolerance time.Duration
+}
 
-	if err := json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+// NewInteractionServer returns a new InteractionServer using the given (hex encoded) public key
+// of the bot's application.
+func NewInteractionServer(bot *Client, publicKey string) (*InteractionServer, error) {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("interaction server: public key: %w", err)
 	}
 
-	components, err := unmarshalComponents(unmarshalled.Components)
-	if err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("interaction server: public key: invalid length %d", len(key))
 	}
 
-	unmarshalled.alias.Components = &components
+	return &InteractionServer{ //nolint:exhaustruct
+		Bot:       bot,
+		PublicKey: key,
+		Timeout:   defaultInteractionReplyTimeout,
+		Tolerance: defaultInteractionTimestampTolerance,
+	}, nil
+}
 
-	if r == nil {
-		r = new(EditWebhookMessage)
+// ErrorInteractionReply represents an error that occurs when an interaction can NOT be replied to
+// in the HTTP response of an InteractionServer.
+type ErrorInteractionReply struct {
+	// InteractionID represents the ID of the interaction.
+	InteractionID string
+}
+
+func (e ErrorInteractionReply) Error() string {
+	return fmt.Sprintf("interaction %q can NOT be replied to inline: it was already replied to or deferred", e.InteractionID)
+}
+
+// Reply replies to an interaction (received by the server) in the HTTP response of the interaction.
+//
+// An ErrorInteractionReply is returned when the interaction was already replied to or deferred,
+// such that the reply must be sent using CreateFollowupMessage or EditOriginalInteractionResponse.
+func (s *InteractionServer) Reply(interaction *Interaction, response *InteractionResponse) error {
+	value, ok := s.pending.LoadAndDelete(interaction.ID)
+	if !ok {
+		return ErrorInteractionReply{InteractionID: interaction.ID}
 	}
 
-	*r = EditWebhookMessage(unmarshalled.alias)
+	reply, _ := value.(chan *InteractionResponse)
+	reply <- response
 
 	return nil
 }
 
-func (r *ActionRow) UnmarshalJSON(b []byte) error {
-	type alias ActionRow
+// ServeHTTP verifies and responds to an interaction that Discord sends to the Interactions Endpoint URL.
+func (s *InteractionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
 
-	var unmarshalled struct {
-		Components json.RawMessage `json:"components"`
-		alias
+		return
 	}
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxInteractionBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+		return
 	}
 
-	if r == nil {
-		r = new(ActionRow)
+	timestamp := r.Header.Get(headerTimestamp)
+	if !s.Verify(r.Header.Get(headerSignature), timestamp, body) {
+		http.Error(w, "invalid request signature", http.StatusUnauthorized)
+
+		return
 	}
 
-	*r = ActionRow(unmarshalled.alias)
+	if !s.fresh(timestamp, time.Now()) {
+		http.Error(w, "invalid request timestamp", http.StatusUnauthorized)
 
-	return nil
-}
+		return
+	}
 
-func (r *ModalSubmitData) UnmarshalJSON(b []byte) error {
-	type alias ModalSubmitData
+	interaction := new(Interaction)
+	if err := json.Unmarshal(body, interaction); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
+		return
 	}
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+	// respond to a PING (which Discord uses to validate the Interactions Endpoint URL).
+	if interaction.Type == FlagInteractionTypePING {
+		s.respond(w, &InteractionResponse{Type: FlagInteractionCallbackTypePONG}) //nolint:exhaustruct
+
+		return
 	}
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+	// an interaction is deferred immediately when the bot has no event handler to reply to it.
+	if !s.handled() {
+		s.respond(w, deferral(interaction))
+
+		return
 	}
 
-	if r == nil {
-		r = new(ModalSubmitData)
+	reply := make(chan *InteractionResponse, 1)
+	s.pending.Store(interaction.ID, reply)
+
+	s.Bot.dispatch(newDispatch(s.Bot, nil, FlagGatewayEventNameInteractionCreate, 0, body))
+
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = defaultInteractionReplyTimeout
 	}
 
-	*r = ModalSubmitData(unmarshalled.alias)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
 
-	return nil
-}
+	select {
+	case response := <-reply:
+		s.respond(w, response)
 
-func (r *Messages) UnmarshalJSON(b []byte) error {
-	type alias Messages
+	case <-timer.C:
+		s.deadline(w, interaction, reply)
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
+	case <-r.Context().Done():
+		s.pending.Delete(interaction.ID)
 	}
+}
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+// deadline defers an interaction that is NOT replied to before the server's timeout.
+func (s *InteractionServer) deadline(w http.ResponseWriter, interaction *Interaction, reply chan *InteractionResponse) {
+	// a reply may be sent while the interaction is deferred.
+	if _, ok := s.pending.LoadAndDelete(interaction.ID); !ok {
+		s.respond(w, <-reply)
+
+		return
 	}
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+	LogEventHandler(Logger.Info(), s.Bot.ApplicationID, FlagGatewayEventNameInteractionCreate).
+		Str("interaction", interaction.ID).Msg("deferred interaction")
+
+	s.respond(w, deferral(interaction))
+}
+
+// deferral returns the response that defers an interaction.
+func deferral(interaction *Interaction) *InteractionResponse {
+	response := &InteractionResponse{ //nolint:exhaustruct
+		Type: FlagInteractionCallbackTypeDEFERRED_CHANNEL_MESSAGE_WITH_SOURCE,
 	}
 
-	if r == nil {
-		r = new(Messages)
+	switch interaction.Type {
+	case FlagInteractionTypeMESSAGE_COMPONENT:
+		response.Type = FlagInteractionCallbackTypeDEFERRED_UPDATE_MESSAGE
+
+	// an autocomplete interaction can NOT be deferred.
+	case FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
+		response.Type = FlagInteractionCallbackTypeAPPLICATION_COMMAND_AUTOCOMPLETE_RESULT
+		response.Data = &Autocomplete{Choices: []*ApplicationCommandOptionChoice{}}
 	}
 
-	*r = Messages(unmarshalled.alias)
+	return response
+}
 
-	return nil
+// handled returns whether the bot has an event handler that receives interactions.
+func (s *InteractionServer) handled() bool {
+	s.Bot.Handlers.mu.RLock()
+	defer s.Bot.Handlers.mu.RUnlock()
+
+	return len(s.Bot.Handlers.InteractionCreate) != 0 || len(s.Bot.Handlers.Raw) != 0
 }
 
-func (r *Modal) UnmarshalJSON(b []byte) error {
-	type alias Modal
+// fresh returns whether the signature timestamp (in Unix seconds) of an interaction's HTTP request
+// is within the server's tolerance of the given time.
+func (s *InteractionServer) fresh(timestamp string, now time.Time) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
 
-	var unmarshalled struct {
-		alias
-		Components json.RawMessage `json:"components"`
+	tolerance := s.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultInteractionTimestampTolerance
 	}
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+	age := now.Sub(time.Unix(seconds, 0))
+
+	return age <= tolerance && age >= -tolerance
+}
+
+// respond writes an interaction response to the HTTP response.
+func (s *InteractionServer) respond(w http.ResponseWriter, response *InteractionResponse) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		LogEventHandler(Logger.Error(), s.Bot.ApplicationID, FlagGatewayEventNameInteractionCreate).
+			Err(fmt.Errorf(errSendMarshal, err)).Msg("")
+
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
 	}
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(body); err != nil {
+		LogEventHandler(Logger.Error(), s.Bot.ApplicationID, FlagGatewayEventNameInteractionCreate).Err(err).Msg("")
 	}
+}
 
-	if r == nil {
-		r = new(Modal)
+// Verify verifies the Ed25519 signature of an interaction's HTTP request
+// using the server's public key.
+//
+// https://discord.com/developers/docs/interactions/receiving-and-responding#security-and-authorization
+func (s *InteractionServer) Verify(signature, timestamp string, body []byte) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil || len(sig) != ed25519.SignatureSize || timestamp == "" {
+		return false
 	}
 
-	*r = Modal(unmarshalled.alias)
+	message := make([]byte, 0, len(timestamp)+len(body))
+	message = append(message, timestamp...)
+	message = append(message, body...)
 
-	return nil
+	return ed25519.Verify(s.PublicKey, message, sig)
 }
 
-func (r *Message) UnmarshalJSON(b []byte) error {
-	type alias Message
+/**json_convert.go contains type conversion functions for JSON data functionality.
 
-	var unmarshalled struct {
-		Components json.RawMessage `json:"components"`
-		alias
-	}
+This lets users (developers) easily type convert JSON data between structs.
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalled); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+*/
 
-	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+func (b BitFlag) String() string {
+	return strconv.FormatUint(uint64(b), base10)
+}
+
+var (
+	byteEmptySlice = []byte("[]")
+)
+
+func (t Flags) MarshalJSON() ([]byte, error) {
+	if len(t) == 0 {
+		return byteEmptySlice, nil
 	}
 
-	if r == nil {
-		r = new(Message)
+	var output bytes.Buffer
+	output.WriteByte('[')
+
+	stop := len(t) - 1
+	for i, f := range t {
+		output.WriteString(strconv.Itoa(int(f)))
+
+		if i == stop {
+			break
+		}
+
+		output.WriteByte(',')
 	}
 
-	*r = Message(unmarshalled.alias)
+	output.WriteByte(']')
 
-	return nil
+	return output.Bytes(), nil
 }
 
-/** InteractionData **/
+func (r *BulkOverwriteGlobalApplicationCommands) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.ApplicationCommands) //nolint:wrapcheck
+}
 
-// unmarshalInteractionData unmarshals a JSON InteractionData object into
-// a Go Interface InteractionData (with an underlying struct).
-func unmarshalInteractionData(b json.RawMessage, x Flag) (InteractionData, error) {
-	if len(b) == 0 {
-		return nil, nil
-	}
+func (r *BulkOverwriteGuildApplicationCommands) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.ApplicationCommands) //nolint:wrapcheck
+}
 
-	var interactionData InteractionData
+func (r *ModifyGuildChannelPositions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Parameters) //nolint:wrapcheck
+}
 
-	// use the known Interaction Data type to return
-	// a Go Interface InteractionData with an underlying struct.
-	switch x {
-	case FlagInteractionTypePING:
-		return nil, nil
+func (r *ModifyGuildRolePositions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Parameters) //nolint:wrapcheck
+}
 
-	case FlagInteractionTypeAPPLICATION_COMMAND,
-		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
-		interactionData = &ApplicationCommandData{} //nolint:exhaustruct
+/**unmarshal.go contains custom UnmarshalJSON() functions.
 
-	case FlagInteractionTypeMESSAGE_COMPONENT:
-		interactionData = &MessageComponentData{} //nolint:exhaustruct
+This lets json.Unmarshal() unmarshal JSON data into types that contain interface fields.
 
-	case FlagInteractionTypeMODAL_SUBMIT:
-		interactionData = &ModalSubmitData{} //nolint:exhaustruct
-	}
+In addition, structs that contain an embedded field - that implements UnmarshalJSON() - will
+use the embedded field's implementation of UnmarshalJSON(). As a result, these structs must
+also implement UnmarshalJSON() to prevent null pointer dereferences.
 
-	if interactionData == nil {
-		return nil, fmt.Errorf("attempt to unmarshal into unknown interaction data type (%d)", x)
-	}
+*/
 
-	// unmarshal into the underlying struct.
-	if err := json.Unmarshal(b, interactionData); err != nil {
-		return nil, fmt.Errorf(errUnmarshal, interactionData, err)
-	}
+/** Unused: Command, Event */
 
-	return interactionData, nil
-}
+/** Nonce
 
-func (r *Interaction) UnmarshalJSON(b []byte) error {
-	type alias Interaction
+Includes: CreateMessage, Message
 
-	var unmarshalledInteraction struct {
-		Data json.RawMessage `json:"data,omitempty"`
-		alias
-	}
+**/
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalledInteraction); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
-	}
+func (v *Nonce) UnmarshalJSON(b []byte) error {
+	// a nonce is a string or an integer (which is kept as is to prevent a loss of precision).
+	if len(b) != 0 && b[0] == '"' {
+		var x string
+		if err := json.Unmarshal(b, &x); err != nil {
+			return fmt.Errorf(errUnmarshal, v, err)
+		}
 
-	if unmarshalledInteraction.alias.Data, err =
-		unmarshalInteractionData(unmarshalledInteraction.Data, unmarshalledInteraction.Type); err != nil {
-		return fmt.Errorf(errUnmarshal, r, err)
+		*v = Nonce(x)
+
+		return nil
 	}
 
-	if r == nil {
-		r = new(Interaction)
+	if _, err := strconv.ParseInt(string(b), base10, bit64); err != nil {
+		return fmt.Errorf(errUnmarshal, v, fmt.Errorf("value is NOT an integer: %w", err))
 	}
 
-	*r = Interaction(unmarshalledInteraction.alias)
+	*v = Nonce(b)
 
 	return nil
 }
 
-/** InteractionCallbackData **/
+/** Value
 
-// unmarshalInteractionCallbackData unmarshals a JSON InteractionCallbackData object into
-// a Go Interface InteractionCallbackData (with an underlying struct).
-func unmarshalInteractionCallbackData(b []byte, x Flag) (InteractionCallbackData, error) {
+Includes: ApplicationCommandOptionChoice, ApplicationCommandInteractionDataOption
+
+**/
+
+func (v *Value) UnmarshalJSON(b []byte) error {
+	var x interface{}
+
+	if err := json.Unmarshal(b, &x); err != nil {
+		return fmt.Errorf(errUnmarshal, x, err)
+	}
+
+	switch xValue := x.(type) {
+	case string:
+		*v = Value(xValue)
+
+	case int:
+		*v = Value(strconv.Itoa(xValue))
+
+	case float64:
+		*v = Value(strconv.FormatFloat(xValue, 'f', -1, bit64))
+
+	case bool:
+		*v = Value(strconv.FormatBool(xValue))
+
+	default:
+		return fmt.Errorf(errUnmarshal, v, fmt.Errorf("value is type %T", x))
+	}
+
+	return nil
+}
+
+/** Component **/
+
+// unmarshalComponents unmarshals a JSON component array into a slice of Go Interface Components (with underlying structs).
+func unmarshalComponents(b []byte) ([]Component, error) {
 	if len(b) == 0 {
 		return nil, nil
 	}
 
-	var interactionCallbackData InteractionCallbackData
+	type unmarshalComponent struct {
 
-	// use the known Interaction Callback Data type to return
-	// a Go Interface InteractionCallbackData with an underlying struct.
-	switch x {
-	case FlagInteractionCallbackTypePONG:
-		return nil, nil // Ping
+		// https://discord.com/developers/docs/interactions/message-components#component-object-example-component
+		Type Flag `json:"type"`
+	}
 
-	case FlagInteractionCallbackTypeCHANNEL_MESSAGE_WITH_SOURCE,
-		FlagInteractionCallbackTypeUPDATE_MESSAGE:
-		interactionCallbackData = &Messages{} //nolint:exhaustruct
+	// Components are always provided in a JSON array.
+	// Create a variable (of type []unmarshalComponent) that can read all of the Component Types.
+	var unmarshalledComponents []unmarshalComponent
 
-	case FlagInteractionCallbackTypeDEFERRED_CHANNEL_MESSAGE_WITH_SOURCE:
-		return nil, nil // Edit a followup response later.
+	// unmarshal the JSON (components.{component.Type}) into unmarshalledComponents.
+	if err := json.Unmarshal(b, &unmarshalledComponents); err != nil {
+		return nil, fmt.Errorf(errUnmarshal, unmarshalledComponents, err)
+	}
 
-	case FlagInteractionCallbackTypeDEFERRED_UPDATE_MESSAGE:
-		return nil, nil // Edit the original response later.
+	// use the known component types to return a slice of Go Interface Components with underlying structs.
+	components := make([]Component, len(unmarshalledComponents))
+	for i, unmarshalledComponent := range unmarshalledComponents {
+		// set the component (interface) to an underlying type.
+		switch unmarshalledComponent.Type {
+		case FlagComponentTypeActionRow:
+			components[i] = &ActionRow{} //nolint:exhaustruct
 
-	case FlagInteractionCallbackTypeAPPLICATION_COMMAND_AUTOCOMPLETE_RESULT:
-		interactionCallbackData = &Autocomplete{} //nolint:exhaustruct
+		case FlagComponentTypeButton:
+			components[i] = &Button{} //nolint:exhaustruct
 
-	case FlagInteractionCallbackTypeMODAL:
-		interactionCallbackData = &Modal{} //nolint:exhaustruct
+		case FlagComponentTypeStringSelect,
+			FlagComponentTypeUserSelect,
+			FlagComponentTypeRoleSelect,
+			FlagComponentTypeMentionableSelect,
+			FlagComponentTypeChannelSelect:
+			components[i] = &SelectMenu{} //nolint:exhaustruct
+
+		case FlagComponentTypeTextInput:
+			components[i] = &TextInput{} //nolint:exhaustruct
+
+		default:
+			return nil, fmt.Errorf(
+				"attempt to unmarshal into unknown component type (%d)",
+				unmarshalledComponent.Type,
+			)
+		}
 	}
 
-	if interactionCallbackData == nil {
-		return nil, fmt.Errorf(
-			"attempt to unmarshal into unknown interaction callback data type (%d)",
-			x)
+	// unmarshal the JSON (components) into the underlying structs of the components.
+	raw := make([]json.RawMessage, len(components))
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf(errUnmarshal, raw, err)
 	}
 
-	// unmarshal into the underlying struct.
-	if err := json.Unmarshal(b, interactionCallbackData); err != nil {
-		return nil, fmt.Errorf(errUnmarshal, interactionCallbackData, err)
+	for i := range components {
+		if err := json.Unmarshal(raw[i], components[i]); err != nil {
+			return nil, fmt.Errorf(errUnmarshal, components[i], err)
+		}
 	}
 
-	return interactionCallbackData, nil
+	return components, nil
 }
 
-func (r *InteractionResponse) UnmarshalJSON(b []byte) error {
-	type alias InteractionResponse
+func (r *EditOriginalInteractionResponse) UnmarshalJSON(b []byte) error {
+	// The following pattern is present throughout this file
+	// in order to prevent a stack overflow (of r.UnmarshalJSON()).
+	type alias EditOriginalInteractionResponse
 
-	var unmarshalledInteractionResponse struct {
+	var unmarshalled struct {
 		alias
-		Data json.RawMessage `json:"data,omitempty"`
+		Components json.RawMessage `json:"components"`
 	}
 
-	var err error
-	if err = json.Unmarshal(b, &unmarshalledInteractionResponse); err != nil {
+	if err := json.Unmarshal(b, &unmarshalled); err != nil {
 		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	if unmarshalledInteractionResponse.alias.Data, err =
-		unmarshalInteractionCallbackData(
-			unmarshalledInteractionResponse.Data, unmarshalledInteractionResponse.Type); err != nil {
+	components, err := unmarshalComponents(unmarshalled.Components)
+	if err != nil {
 		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
+	unmarshalled.alias.Components = &components
+
 	if r == nil {
-		r = new(InteractionResponse)
+		r = new(EditOriginalInteractionResponse)
 	}
 
-	*r = InteractionResponse(unmarshalledInteractionResponse.alias)
+	*r = EditOriginalInteractionResponse(unmarshalled.alias)
 
 	return nil
 }
 
-/** Structs that contain embedded fields that implement UnmarshalJSON() **/
+func (r *CreateFollowupMessage) UnmarshalJSON(b []byte) error {
+	type alias CreateFollowupMessage
 
-func (e *MessageCreate) UnmarshalJSON(b []byte) error {
-	if err := json.Unmarshal(b, &e.Message); err != nil {
-		return fmt.Errorf(errUnmarshal, e, err)
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
 	}
 
-	return nil
-}
-
-func (e *MessageUpdate) UnmarshalJSON(b []byte) error {
-	if err := json.Unmarshal(b, &e.Message); err != nil {
-		return fmt.Errorf(errUnmarshal, e, err)
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	return nil
-}
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-func (e *InteractionCreate) UnmarshalJSON(b []byte) error {
-	if err := json.Unmarshal(b, &e.Interaction); err != nil {
-		return fmt.Errorf(errUnmarshal, e, err)
+	if r == nil {
+		r = new(CreateFollowupMessage)
 	}
 
+	*r = CreateFollowupMessage(unmarshalled.alias)
+
 	return nil
 }
 
-func (e *CreateInteractionResponse) UnmarshalJSON(b []byte) error {
-	if err := json.Unmarshal(b, &e.InteractionResponse); err != nil {
-		return fmt.Errorf(errUnmarshal, e, err)
+func (r *EditFollowupMessage) UnmarshalJSON(b []byte) error {
+	type alias EditFollowupMessage
+
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
 	}
 
-	return nil
-}
+	if err := json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-/**json_unmarshal_convert.go contains type conversion functions for interfaces that JSON data is unmarshalled into.
+	components, err := unmarshalComponents(unmarshalled.Components)
+	if err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-This lets users (developers) easily type convert interfaces.
+	unmarshalled.alias.Components = &components
 
-*/
+	if r == nil {
+		r = new(EditFollowupMessage)
+	}
 
-/* Nonce */
+	*r = EditFollowupMessage(unmarshalled.alias)
 
-func (n Nonce) String() string {
-	return string(n)
+	return nil
 }
 
-func (n Nonce) Int64() (int64, error) {
-	return strconv.ParseInt(string(n), base10, bit64) //nolint:wrapcheck
-}
+func (r *EditMessage) UnmarshalJSON(b []byte) error {
+	type alias EditMessage
 
-/* Value */
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
+	}
 
-func (n Value) String() string {
-	return string(n)
-}
+	if err := json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-func (n Value) Float64() (float64, error) {
-	return strconv.ParseFloat(string(n), bit64) //nolint:wrapcheck
-}
+	components, err := unmarshalComponents(unmarshalled.Components)
+	if err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-func (n Value) Int64() (int64, error) {
-	return strconv.ParseInt(string(n), base10, bit64) //nolint:wrapcheck
-}
+	unmarshalled.alias.Components = &components
 
-func (n Value) Bool() (bool, error) {
-	return strconv.ParseBool(string(n)) //nolint:wrapcheck
-}
+	if r == nil {
+		r = new(EditMessage)
+	}
 
-/* InteractionData */
-const (
-	errTypeConvert = "attempted to type convert InteractionData of type %v to type %s"
-)
+	*r = EditMessage(unmarshalled.alias)
 
-// ApplicationCommand type converts an InteractionData field into an ApplicationCommandData struct.
-func (i *Interaction) ApplicationCommand() *ApplicationCommandData {
-	switch i.Data.InteractionDataType() {
-	case FlagInteractionTypeAPPLICATION_COMMAND,
-		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
-		return i.Data.(*ApplicationCommandData) //nolint:forcetypeassert
+	return nil
+}
 
-	case FlagInteractionTypePING:
-		panic(fmt.Sprintf(errTypeConvert, "Ping", "ApplicationCommandData"))
+func (r *ForumThreadMessageParams) UnmarshalJSON(b []byte) error {
+	type alias ForumThreadMessageParams
 
-	case FlagInteractionTypeMESSAGE_COMPONENT:
-		panic(fmt.Sprintf(errTypeConvert, "MessageComponentData", "ApplicationCommandData"))
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
+	}
 
-	case FlagInteractionTypeMODAL_SUBMIT:
-		panic(fmt.Sprintf(errTypeConvert, "ModalSubmitData", "ApplicationCommandData"))
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	panic(fmt.Sprintf(errTypeConvert, i.Data.InteractionDataType(), "ApplicationCommandData"))
-}
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-// MessageComponent type converts an InteractionData field into a MessageComponentData struct.
-func (i *Interaction) MessageComponent() *MessageComponentData {
-	switch i.Data.InteractionDataType() {
-	case FlagInteractionTypeMESSAGE_COMPONENT:
-		return i.Data.(*MessageComponentData) //nolint:forcetypeassert
-
-	case FlagInteractionTypePING:
-		panic(fmt.Sprintf(errTypeConvert, "Ping", "MessageComponentData"))
-
-	case FlagInteractionTypeAPPLICATION_COMMAND,
-		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
-		panic(fmt.Sprintf(errTypeConvert, "ApplicationCommandData", "MessageComponentData"))
-
-	case FlagInteractionTypeMODAL_SUBMIT:
-		panic(fmt.Sprintf(errTypeConvert, "ModalSubmitData", "MessageComponentData"))
+	if r == nil {
+		r = new(ForumThreadMessageParams)
 	}
 
-	panic(fmt.Sprintf(errTypeConvert, i.Data.InteractionDataType(), "MessageComponentData"))
+	*r = ForumThreadMessageParams(unmarshalled.alias)
+
+	return nil
 }
 
-// ModalSubmit type converts an InteractionData field into a ModalSubmitData struct.
-func (i *Interaction) ModalSubmit() *ModalSubmitData {
-	switch i.Data.InteractionDataType() {
-	case FlagInteractionTypeMODAL_SUBMIT:
-		return i.Data.(*ModalSubmitData) //nolint:forcetypeassert
+func (r *ExecuteWebhook) UnmarshalJSON(b []byte) error {
+	type alias ExecuteWebhook
 
-	case FlagInteractionTypePING:
-		panic(fmt.Sprintf(errTypeConvert, "Ping", "ModalSubmitData"))
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
+	}
 
-	case FlagInteractionTypeAPPLICATION_COMMAND,
-		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
-		panic(fmt.Sprintf(errTypeConvert, "ApplicationCommandData", "ModalSubmitData"))
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	case FlagInteractionTypeMESSAGE_COMPONENT:
-		panic(fmt.Sprintf(errTypeConvert, "MessageComponentData", "ModalSubmitData"))
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	panic(fmt.Sprintf(errTypeConvert, i.Data.InteractionDataType(), "ModalSubmitData"))
-}
+	if r == nil {
+		r = new(ExecuteWebhook)
+	}
 
-// init is called at the start of the application.
-func init() {
-	zerolog.TimeFieldFormat = time.RFC3339Nano
-	zerolog.SetGlobalLevel(zerolog.Disabled)
-}
+	*r = ExecuteWebhook(unmarshalled.alias)
 
-var (
-	// Logger represents the Disgo Logger used to log information.
-	Logger = zerolog.New(os.Stdout)
-)
+	return nil
+}
 
-// Logger Contexts
-const (
-	// LogCtxClient represents the log key for a Client (Bot) Application ID.
-	LogCtxClient = "client"
+func (r *EditWebhookMessage) UnmarshalJSON(b []byte) error {
+	type alias EditWebhookMessage
 
-	// LogCtxCorrelation represents the log key for a Correlation ID.
-	LogCtxCorrelation = "xid"
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
+	}
 
-	// LogCtxRequest represents the log key for a Request ID.
-	LogCtxRequest = "request"
+	if err := json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	// LogCtxRoute represents the log key for a Route ID.
-	LogCtxRoute = "route"
+	components, err := unmarshalComponents(unmarshalled.Components)
+	if err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	// LogCtxResource represents the log key for a Resource ID.
-	LogCtxResource = "resource"
+	unmarshalled.alias.Components = &components
 
-	// LogCtxEndpoint represents the log key for an Endpoint.
-	LogCtxEndpoint = "endpoint"
+	if r == nil {
+		r = new(EditWebhookMessage)
+	}
 
-	// LogCtxRequestBody represents the log key for an HTTP Request Body.
-	LogCtxRequestBody = "body"
+	*r = EditWebhookMessage(unmarshalled.alias)
 
-	// LogCtxBucket represents the log key for a Rate Limit Bucket ID.
-	LogCtxBucket = "bucket"
+	return nil
+}
 
-	// LogCtxReset represents the log key for a Discord Bucket reset time.
-	LogCtxReset = "reset"
+func (r *ActionRow) UnmarshalJSON(b []byte) error {
+	type alias ActionRow
 
-	// LogCtxResponse represents the log key for an HTTP Request Response.
-	LogCtxResponse = "response"
+	var unmarshalled struct {
+		Components json.RawMessage `json:"components"`
+		alias
+	}
 
-	// LogCtxResponseHeader represents the log key for an HTTP Request Response header.
-	LogCtxResponseHeader = "header"
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	// LogCtxResponseBody represents the log key for an HTTP Request Response body.
-	LogCtxResponseBody = "body"
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	// LogCtxRequestRateLimitCode represents the log key for an HTTP Rate Limit Response code.
-	LogCtxRequestRateLimitCode = "code"
+	if r == nil {
+		r = new(ActionRow)
+	}
 
-	// LogCtxSession represents the log key for a Discord Session ID.
-	LogCtxSession = "session"
+	*r = ActionRow(unmarshalled.alias)
 
-	// LogCtxPayload represents the log key for a Discord Gateway Payload.
-	LogCtxPayload = "payload"
+	return nil
+}
 
-	// LogCtxPayloadOpcode represents the log key for a Discord Gateway Payload opcode.
-	LogCtxPayloadOpcode = "opcode"
+func (r *ModalSubmitData) UnmarshalJSON(b []byte) error {
+	type alias ModalSubmitData
 
-	// LogCtxPayloadData represents the log key for Discord Gateway Payload data.
-	LogCtxPayloadData = "data"
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
+	}
 
-	// LogCtxEvent represents the log key for a Discord Gateway Event.
-	LogCtxEvent = "event"
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	// LogCtxCommand represents the log key for a Discord Gateway command.
-	LogCtxCommand = "command"
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	// LogCtxCommandOpcode represents the log key for a Discord Gateway command opcode.
-	LogCtxCommandOpcode = "opcode"
+	if r == nil {
+		r = new(ModalSubmitData)
+	}
 
-	// LogCtxCommandName represents the log key for a Discord Gateway command name.
-	LogCtxCommandName = "name"
-)
+	*r = ModalSubmitData(unmarshalled.alias)
 
-// LogRequest logs a request.
-func LogRequest(log *zerolog.Event, clientid, xid, routeid, resourceid, endpoint string) *zerolog.Event {
-	return log.Timestamp().
-		Str(LogCtxClient, clientid).
-		Dict(LogCtxRequest, zerolog.Dict().
-			Str(LogCtxCorrelation, xid).
-			Str(LogCtxRoute, routeid).
-			Str(LogCtxResource, resourceid).
-			Str(LogCtxEndpoint, endpoint),
-		)
+	return nil
 }
 
-// LogRequestBody logs a request with its body.
-func LogRequestBody(log *zerolog.Event, clientid, xid, routeid, resourceid, endpoint, body string) *zerolog.Event {
-	return log.Timestamp().
-		Str(LogCtxClient, clientid).
-		Dict(LogCtxRequest, zerolog.Dict().
-			Str(LogCtxCorrelation, xid).
-			Str(LogCtxRoute, routeid).
-			Str(LogCtxResource, resourceid).
-			Str(LogCtxEndpoint, endpoint).
-			Str(LogCtxRequestBody, body),
-		)
-}
+func (r *Messages) UnmarshalJSON(b []byte) error {
+	type alias Messages
 
-// LogResponse logs a response (typically using LogRequest).
-func LogResponse(log *zerolog.Event, header, body string) *zerolog.Event {
-	return log.Dict(LogCtxResponse, zerolog.Dict().
-		Str(LogCtxResponseHeader, header).
-		Str(LogCtxResponseBody, body),
-	)
-}
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
+	}
 
-// LogEventHandler logs an event handler action.
-func LogEventHandler(log *zerolog.Event, clientid, event string) *zerolog.Event {
-	return log.Timestamp().
-		Str(LogCtxClient, clientid).
-		Str(LogCtxEvent, event)
-}
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-// LogSession logs a session.
-func LogSession(log *zerolog.Event, sessionid string) *zerolog.Event {
-	return log.Timestamp().
-		Str(LogCtxSession, sessionid)
-}
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-// LogPayload logs a Discord Gateway Payload (typically using LogSession).
-func LogPayload(log *zerolog.Event, op int, data json.RawMessage) *zerolog.Event {
-	return log.Dict(LogCtxPayload, zerolog.Dict().
-		Int(LogCtxPayloadOpcode, op).
-		Bytes(LogCtxPayloadData, data),
-	)
-}
+	if r == nil {
+		r = new(Messages)
+	}
 
-// LogCommand logs a Gateway Command (typically using a LogSession).
-func LogCommand(log *zerolog.Event, clientid string, op int, command string) *zerolog.Event {
-	return log.Str(LogCtxClient, clientid).
-		Dict(LogCtxCommand, zerolog.Dict().
-			Int(LogCtxCommandOpcode, op).
-			Str(LogCtxCommandName, command),
-		)
+	*r = Messages(unmarshalled.alias)
+
+	return nil
 }
 
-const (
-	grantTypeAuthorizationCodeGrant = "authorization_code"
-	grantTypeRefreshToken           = "refresh_token"
-	grantTypeClientCredentials      = "client_credentials"
-	amountAuthURLParams             = 6
-	amountAuthURLParamsBot          = 3
-)
+func (r *Modal) UnmarshalJSON(b []byte) error {
+	type alias Modal
 
-// GenerateAuthorizationURL generates an authorization URL from a given client and response type.
-func GenerateAuthorizationURL(bot *Client, response string) string {
-	params := make([]string, 0, amountAuthURLParams)
+	var unmarshalled struct {
+		alias
+		Components json.RawMessage `json:"components"`
+	}
 
-	// response_type is the type of response the redirect will return.
-	if response != "" {
-		params = append(params, "responsetype="+response)
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	// client_id is the application client id.
-	params = append(params, "client_id="+bot.Authorization.ClientID)
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-	// scope is a list of OAuth2 scopes separated by url encoded spaces (%20).
-	scope := urlQueryStringScope(bot.Authorization.Scopes)
-	if scope != "" {
-		params = append(params, scope)
+	if r == nil {
+		r = new(Modal)
 	}
 
-	// redirect_uri is the URL registered while creating the application.
-	if bot.Authorization.RedirectURI != "" {
-		params = append(params, "redirect_uri="+url.QueryEscape(bot.Authorization.RedirectURI))
+	*r = Modal(unmarshalled.alias)
+
+	return nil
+}
+
+func (r *Message) UnmarshalJSON(b []byte) error {
+	type alias Message
+
+	var unmarshalled struct {
+		Components json.RawMessage `json:"components"`
+		alias
 	}
 
-	// state is the unique string mentioned in State and Security.
-	if bot.Authorization.State != "" {
-		params = append(params, "state="+bot.Authorization.State)
+	var err error
+	if err = json.Unmarshal(b, &unmarshalled); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	// prompt controls how the authorization flow handles existing authorizations.
-	if bot.Authorization.Prompt != "" {
-		params = append(params, "prompt="+bot.Authorization.Prompt)
+	if unmarshalled.alias.Components, err = unmarshalComponents(unmarshalled.Components); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	return EndpointAuthorizationURL() + "?" + strings.Join(params, "&")
+	if r == nil {
+		r = new(Message)
+	}
+
+	*r = Message(unmarshalled.alias)
+
+	return nil
 }
 
-// BotAuthParams represents parameters used to generate a bot authorization URL.
-type BotAuthParams struct {
-	// Bot provides the client_id and scopes parameters.
-	Bot *Client
+/** InteractionData **/
 
-	// GuildID pre-selects a guild in the authorization prompt.
-	GuildID string
+// unmarshalInteractionData unmarshals a JSON InteractionData object into
+// a Go Interface InteractionData (with an underlying struct).
+func unmarshalInteractionData(b json.RawMessage, x Flag) (InteractionData, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
 
-	// ResponseType provides the type of response the OAuth2 flow will return.
-	//
-	// In the context of bot authorization, response_type is only provided when
-	// a scope outside of `bot` and `applications.commands` is requested.
-	ResponseType string
+	var interactionData InteractionData
 
-	// Permissions represents the permissions the bot is requesting.
-	Permissions BitFlag
+	// use the known Interaction Data type to return
+	// a Go Interface InteractionData with an underlying struct.
+	switch x {
+	case FlagInteractionTypePING:
+		return nil, nil
 
-	// DisableGuildSelect disables the ability to select other guilds
-	// in the authorization prompt (when GuildID is provided).
-	DisableGuildSelect bool
-}
+	case FlagInteractionTypeAPPLICATION_COMMAND,
+		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
+		interactionData = &ApplicationCommandData{} //nolint:exhaustruct
 
-// GenerateBotAuthorizationURL generates a bot authorization URL using the given BotAuthParams.
-//
-// Bot.Scopes must include "bot" to enable the OAuth2 Bot Flow.
-func GenerateBotAuthorizationURL(p BotAuthParams) string {
-	params := make([]string, 0, amountAuthURLParamsBot)
+	case FlagInteractionTypeMESSAGE_COMPONENT:
+		interactionData = &MessageComponentData{} //nolint:exhaustruct
 
-	// permissions is permissions the bot is requesting.
-	params = append(params, "permissions="+strconv.FormatUint(uint64(p.Permissions), base10))
+	case FlagInteractionTypeMODAL_SUBMIT:
+		interactionData = &ModalSubmitData{} //nolint:exhaustruct
+	}
 
-	// guild_id is the Guild ID of the guild that is pre-selected in the authorization prompt.
-	if p.GuildID != "" {
-		params = append(params, "guild_id="+p.GuildID)
+	if interactionData == nil {
+		return nil, fmt.Errorf("attempt to unmarshal into unknown interaction data type (%d)", x)
 	}
 
-	// disable_guild_select determines whether the user will be allowed to select a guild
-	// other than the guild_id.
-	params = append(params, "disable_guild_select="+strconv.FormatBool(p.DisableGuildSelect))
+	// unmarshal into the underlying struct.
+	if err := json.Unmarshal(b, interactionData); err != nil {
+		return nil, fmt.Errorf(errUnmarshal, interactionData, err)
+	}
 
-	return GenerateAuthorizationURL(p.Bot, p.ResponseType) + "&" + strings.Join(params, "&")
+	return interactionData, nil
 }
 
-// AuthorizationCodeGrant performs an OAuth2 authorization code grant.
-//
-// Send the user a valid Authorization URL, which can be generated using
-// GenerateAuthorizationURL(bot, "code").
-//
-// When the user visits the Authorization URL, they will be prompted for authorization.
-// If the user accepts the prompt, they will be redirected to the `redirect_uri`.
-// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
-// by parsing the request's URL Query String into a disgo.RedirectURL object.
-//
-// Retrieve the user's access token by calling THIS FUNCTION (with the disgo.RedirectURL parameter),
-// which performs an Access Token Exchange.
-//
-// Refresh the token by using RefreshAuthorizationCodeGrant(bot, token).
-//
-// For more information, read https://discord.com/developers/docs/topics/oauth2#authorization-code-grant
-func AuthorizationCodeGrant(bot *Client, ru *RedirectURL) (*AccessTokenResponse, error) {
-	exchange := &AccessTokenExchange{
-		ClientID:     bot.Authorization.ClientID,
-		ClientSecret: bot.Authorization.ClientSecret,
-		GrantType:    grantTypeAuthorizationCodeGrant,
-		Code:         ru.Code,
-		RedirectURI:  bot.Authorization.RedirectURI,
+func (r *Interaction) UnmarshalJSON(b []byte) error {
+	type alias Interaction
+
+	var unmarshalledInteraction struct {
+		Data json.RawMessage `json:"data,omitempty"`
+		alias
 	}
 
-	return exchange.Send(bot)
-}
+	var err error
+	if err = json.Unmarshal(b, &unmarshalledInteraction); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
+	}
 
-// RefreshAuthorizationCodeGrant refreshes an Access Token from an OAuth2 authorization code grant.
-func RefreshAuthorizationCodeGrant(bot *Client, token *AccessTokenResponse) (*AccessTokenResponse, error) {
-	exchange := &RefreshTokenExchange{
-		ClientID:     bot.Authorization.ClientID,
-		ClientSecret: bot.Authorization.ClientSecret,
-		GrantType:    grantTypeRefreshToken,
-		RefreshToken: token.RefreshToken,
+	if unmarshalledInteraction.alias.Data, err =
+		unmarshalInteractionData(unmarshalledInteraction.Data, unmarshalledInteraction.Type); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	return exchange.Send(bot)
+	if r == nil {
+		r = new(Interaction)
+	}
+
+	*r = Interaction(unmarshalledInteraction.alias)
+
+	return nil
 }
 
-// ImplicitGrant converts a RedirectURI (from a simplified OAuth2 grant) to an AccessTokenResponse.
-//
-// Send the user a valid Authorization URL, which can be generated using
-// GenerateAuthorizationURL(bot, "token").
-//
-// When the user visits the Authorization URL, they will be prompted for authorization.
-// If the user accepts the prompt, they will be redirected to the `redirect_uri`.
-// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
-// by parsing the request's URI Fragments into a disgo.RedirectURI object.
-//
-// A disgo.RedirectURI object is equivalent to a disgo.AccessTokenResponse,
-// but it does NOT contain a refresh token.
-//
-// For more information, read https://discord.com/developers/docs/topics/oauth2#implicit-grant
-func ImplicitGrant(ru *RedirectURI) *AccessTokenResponse {
-	return &AccessTokenResponse{
-		AccessToken:  ru.AccessToken,
-		TokenType:    ru.TokenType,
-		ExpiresIn:    ru.ExpiresIn,
-		RefreshToken: "",
-		Scope:        ru.Scope,
+/** InteractionCallbackData **/
+
+// unmarshalInteractionCallbackData unmarshals a JSON InteractionCallbackData object into
+// a Go Interface InteractionCallbackData (with an underlying struct).
+func unmarshalInteractionCallbackData(b []byte, x Flag) (InteractionCallbackData, error) {
+	if len(b) == 0 {
+		return nil, nil
 	}
-}
 
-// ClientCredentialsGrant performs a client credential OAuth2 grant for TESTING PURPOSES.
-//
-// The bot client's Authentication Header will be set to a Basic Authentication Header that
-// uses the bot's ClientID as a username and ClientSecret as a password.
-//
-// A request will be made for a Client Credential grant which returns a disgo.AccessTokenResponse
-// that does NOT contain a refresh token.
-//
-// For more information, read https://discord.com/developers/docs/topics/oauth2#client-credentials-grant
-func ClientCredentialsGrant(bot *Client) (*AccessTokenResponse, error) {
-	bot.Authentication.Header = "Basic " +
-		base64.StdEncoding.EncodeToString([]byte(bot.Authorization.ClientID+":"+bot.Authorization.ClientSecret))
+	var interactionCallbackData InteractionCallbackData
 
-	grant := &ClientCredentialsTokenRequest{
-		GrantType: grantTypeClientCredentials,
-		Scope:     urlQueryStringScope(bot.Authorization.Scopes),
+	// use the known Interaction Callback Data type to return
+	// a Go Interface InteractionCallbackData with an underlying struct.
+	switch x {
+	case FlagInteractionCallbackTypePONG:
+		return nil, nil // Ping
+
+	case FlagInteractionCallbackTypeCHANNEL_MESSAGE_WITH_SOURCE,
+		FlagInteractionCallbackTypeUPDATE_MESSAGE:
+		interactionCallbackData = &Messages{} //nolint:exhaustruct
+
+	case FlagInteractionCallbackTypeDEFERRED_CHANNEL_MESSAGE_WITH_SOURCE:
+		return nil, nil // Edit a followup response later.
+
+	case FlagInteractionCallbackTypeDEFERRED_UPDATE_MESSAGE:
+		return nil, nil // Edit the original response later.
+
+	case FlagInteractionCallbackTypeAPPLICATION_COMMAND_AUTOCOMPLETE_RESULT:
+		interactionCallbackData = &Autocomplete{} //nolint:exhaustruct
+
+	case FlagInteractionCallbackTypeMODAL:
+		interactionCallbackData = &Modal{} //nolint:exhaustruct
 	}
 
-	return grant.Send(bot)
+	if interactionCallbackData == nil {
+		return nil, fmt.Errorf(
+			"attempt to unmarshal into unknown interaction callback data type (%d)",
+			x)
+	}
+
+	// unmarshal into the underlying struct.
+	if err := json.Unmarshal(b, interactionCallbackData); err != nil {
+		return nil, fmt.Errorf(errUnmarshal, interactionCallbackData, err)
+	}
+
+	return interactionCallbackData, nil
 }
 
-// BotAuthorization performs a specialized OAuth2 flow for users to add bots to guilds.
-//
-// Send the user a valid Bot Authorization URL, which can be generated using
-// GenerateBotAuthorizationURL(disgo.BotAuthParams{...}).
-//
-// When the user visits the Bot Authorization URL, they will be prompted for authorization.
-// If the user accepts the prompt (with a guild), the bot will be added to the selected guild.
-//
-// For more information read, https://discord.com/developers/docs/topics/oauth2#bot-authorization-flow
-func BotAuthorization() {}
+func (r *InteractionResponse) UnmarshalJSON(b []byte) error {
+	type alias InteractionResponse
 
-// AdvancedBotAuthorization performs a specialized OAuth2 flow for users to add bots to guilds.
-//
-// Send the user a valid Bot Authorization URL, which can be generated using
-// GenerateBotAuthorizationURL(disgo.BotAuthParams{...}).
-//
-// If the user accepts the prompt (with a guild), they will be redirected to the `redirect_uri`.
-// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
-// by parsing the request's URL Query String into a disgo.RedirectURL object.
-//
-// Retrieve the user's access token by calling THIS FUNCTION (with the disgo.RedirectURL parameter),
-// which performs an Access Token Exchange.
-//
-// Refresh the token by using RefreshAuthorizationCodeGrant(bot, token).
-//
-// For more information read, https://discord.com/developers/docs/topics/oauth2#advanced-bot-authorization
-func AdvancedBotAuthorization(bot *Client, ru *RedirectURL) (*AccessTokenResponse, error) {
-	return AuthorizationCodeGrant(bot, ru)
-}
-
-// WebhookAuthorization performs a specialized OAuth2 authorization code grant.
-//
-// Send the user a valid Authorization URL, which can be generated using
-// GenerateAuthorizationURL(bot, "code") when bot.Scopes is set to `webhook.incoming`.
-//
-// When the user visits the Authorization URL, they will be prompted for authorization.
-// If the user accepts the prompt (with a channel), they will be redirected to the `redirect_uri`.
-// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
-// by parsing the request's URL Query String into a disgo.RedirectURL object.
-//
-// Retrieve the user's access token by calling THIS FUNCTION (with the disgo.RedirectURL parameter),
-// which performs an Access Token Exchange.
-//
-// Refresh the token by using RefreshAuthorizationCodeGrant(bot, token).
-//
-// For more information read, https://discord.com/developers/docs/topics/oauth2#webhooks
-func WebhookAuthorization(bot *Client, ru *RedirectURL) (*AccessTokenResponse, *Webhook, error) {
-	exchange := &AccessTokenExchange{
-		ClientID:     bot.Authorization.ClientID,
-		ClientSecret: bot.Authorization.ClientSecret,
-		GrantType:    grantTypeAuthorizationCodeGrant,
-		Code:         ru.Code,
-		RedirectURI:  bot.Authorization.RedirectURI,
+	var unmarshalledInteractionResponse struct {
+		alias
+		Data json.RawMessage `json:"data,omitempty"`
 	}
 
 	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[1]("1")
-	query, err := EndpointQueryString(exchange)
-	if err != nil {
-		return nil, nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
+	if err = json.Unmarshal(b, &unmarshalledInteractionResponse); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
-	endpoint := EndpointTokenURL() + "?" + query
 
-	result := new(WebhookTokenResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
-	if err != nil {
-		return nil, nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+	if unmarshalledInteractionResponse.alias.Data, err =
+		unmarshalInteractionCallbackData(
+			unmarshalledInteractionResponse.Data, unmarshalledInteractionResponse.Type); err != nil {
+		return fmt.Errorf(errUnmarshal, r, err)
 	}
 
-	// convert the webhook token response to an access token response (and webhook).
-	token := &AccessTokenResponse{
-		AccessToken:  result.AccessToken,
-		TokenType:    result.TokenType,
-		ExpiresIn:    result.ExpiresIn,
-		RefreshToken: result.RefreshToken,
-		Scope:        result.Scope,
+	if r == nil {
+		r = new(InteractionResponse)
 	}
 
-	return token, result.Webhook, nil
+	*r = InteractionResponse(unmarshalledInteractionResponse.alias)
+
+	return nil
 }
 
-// Send sends an AccessTokenExchange request to Discord and returns an AccessTokenResponse.
-func (r *AccessTokenExchange) Send(bot *Client) (*AccessTokenResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[1]("1")
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
-	}
-	endpoint := EndpointTokenURL() + "?" + query
+/** Structs that contain embedded fields that implement UnmarshalJSON() **/
 
-	result := new(AccessTokenResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+func (e *MessageCreate) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &e.Message); err != nil {
+		return fmt.Errorf(errUnmarshal, e, err)
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a RefreshTokenExchange request to Discord and returns an AccessTokenResponse.
-//
-// Uses the RefreshTokenExchange ClientID and ClientSecret.
-func (r *RefreshTokenExchange) Send(bot *Client) (*AccessTokenResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[1]("1")
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
-	}
-	endpoint := EndpointTokenURL() + "?" + query
-
-	result := new(AccessTokenResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+func (e *MessageUpdate) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &e.Message); err != nil {
+		return fmt.Errorf(errUnmarshal, e, err)
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ClientCredentialsTokenRequest to Discord and returns a ClientCredentialsTokenRequest.
-func (r *ClientCredentialsTokenRequest) Send(bot *Client) (*AccessTokenResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[1]("1")
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
+func (e *InteractionCreate) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &e.Interaction); err != nil {
+		return fmt.Errorf(errUnmarshal, e, err)
 	}
-	endpoint := EndpointTokenURL() + "?" + query
 
-	result := new(AccessTokenResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+	return nil
+}
+
+func (e *CreateInteractionResponse) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &e.InteractionResponse); err != nil {
+		return fmt.Errorf(errUnmarshal, e, err)
 	}
 
-	return result, nil
+	return nil
 }
 
-// urlQueryStringScope parses a given slice of scopes to generate a valid URL Query String.
-func urlQueryStringScope(scopes []string) string {
-	if len(scopes) > 0 {
-		var scope strings.Builder
-		scope.WriteString("scope=")
+/**json_unmarshal_convert.go contains type conversion functions for interfaces that JSON data is unmarshalled into.
 
-		for i, s := range scopes {
-			if i > 0 {
-				scope.WriteString("%20")
-			}
+This lets users (developers) easily type convert interfaces.
 
-			scope.WriteString(s)
-		}
+*/
 
-		return scope.String()
-	}
+/* Nonce */
 
-	return ""
+func (n Nonce) String() string {
+	return string(n)
 }
 
-// rlbpool represents a synchronized Rate Limit Bucket pool.
-var rlbpool sync.Pool
-
-// getBucket gets a Bucket from a pool.
-func getBucket() *Bucket {
-	if b := rlbpool.Get(); b != nil {
-		return b.(*Bucket) //nolint:forcetypeassert
-	}
-
-	return new(Bucket)
+func (n Nonce) Int64() (int64, error) {
+	return strconv.ParseInt(string(n), base10, bit64) //nolint:wrapcheck
 }
 
-// putBucket puts a Rate Limit Bucket into the pool.
-func putBucket(b *Bucket) {
-	b.ID = ""
-	b.Limit = 0
-	b.Remaining = 0
-	b.Pending = 0
-	b.Date = time.Time{}
-	b.Expiry = time.Time{}
+/* Value */
 
-	rlbpool.Put(b)
+func (n Value) String() string {
+	return string(n)
 }
 
-// spool represents a synchronized Session pool.
-var spool sync.Pool
+func (n Value) Float64() (float64, error) {
+	return strconv.ParseFloat(string(n), bit64) //nolint:wrapcheck
+}
 
-// NewSession gets a Session from a pool.
-func NewSession() *Session {
-	if s := spool.Get(); s != nil {
-		return s.(*Session) //nolint:forcetypeassert
-	}
+func (n Value) Int64() (int64, error) {
+	return strconv.ParseInt(string(n), base10, bit64) //nolint:wrapcheck
+}
 
-	return new(Session)
+func (n Value) Bool() (bool, error) {
+	return strconv.ParseBool(string(n)) //nolint:wrapcheck
 }
 
-// putSession puts a Session into the pool.
-func putSession(s *Session) {
-	s.Lock()
-	defer s.Unlock()
+/* InteractionData */
+const (
+	errTypeConvert = "attempted to type convert InteractionData of type %v to type %s"
+)
 
-	// reset the Session.
-	s.ID = ""
-	s.Seq = 0
-	s.Endpoint = ""
-	s.Shard = nil
-	s.Context = nil
-	s.Conn = nil
-	s.heartbeat = nil
-	s.manager = nil
-	s.client_manager = nil
-	s.RateLimiter = nil
+// ApplicationCommand type converts an InteractionData field into an ApplicationCommandData struct.
+func (i *Interaction) ApplicationCommand() *ApplicationCommandData {
+	switch i.Data.InteractionDataType() {
+	case FlagInteractionTypeAPPLICATION_COMMAND,
+		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
+		return i.Data.(*ApplicationCommandData) //nolint:forcetypeassert
 
-	spool.Put(s)
-}
+	case FlagInteractionTypePING:
+		panic(fmt.Sprintf(errTypeConvert, "Ping", "ApplicationCommandData"))
 
-// gpool represents a synchronized Gateway Payload pool.
-var gpool sync.Pool
+	case FlagInteractionTypeMESSAGE_COMPONENT:
+		panic(fmt.Sprintf(errTypeConvert, "MessageComponentData", "ApplicationCommandData"))
 
-// getPayload gets a Gateway Payload from the pool.
-func getPayload() *GatewayPayload {
-	if g := gpool.Get(); g != nil {
-		return g.(*GatewayPayload) //nolint:forcetypeassert
+	case FlagInteractionTypeMODAL_SUBMIT:
+		panic(fmt.Sprintf(errTypeConvert, "ModalSubmitData", "ApplicationCommandData"))
 	}
 
-	return new(GatewayPayload)
+	panic(fmt.Sprintf(errTypeConvert, i.Data.InteractionDataType(), "ApplicationCommandData"))
 }
 
-// putPayload puts a Gateway Payload into the pool.
-func putPayload(g *GatewayPayload) {
-	// reset the Gateway Payload.
-	g.Op = 0
-	g.Data = nil
-	g.SequenceNumber = nil
-	g.EventName = nil
+// MessageComponent type converts an InteractionData field into a MessageComponentData struct.
+func (i *Interaction) MessageComponent() *MessageComponentData {
+	switch i.Data.InteractionDataType() {
+	case FlagInteractionTypeMESSAGE_COMPONENT:
+		return i.Data.(*MessageComponentData) //nolint:forcetypeassert
 
-	gpool.Put(g)
-}
+	case FlagInteractionTypePING:
+		panic(fmt.Sprintf(errTypeConvert, "Ping", "MessageComponentData"))
 
-const (
-	nilRouteBucket = "NIL"
-)
+	case FlagInteractionTypeAPPLICATION_COMMAND,
+		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
+		panic(fmt.Sprintf(errTypeConvert, "ApplicationCommandData", "MessageComponentData"))
 
-var (
-	// IgnoreGlobalRateLimitRouteIDs represents a set of Route IDs that do NOT adhere to the Global Rate Limit.
-	//
-	// Interaction endpoints are not bound to the bot's Global Rate Limit.
-	// https://discord.com/developers/docs/interactions/receiving-and-responding#endpoints
-	IgnoreGlobalRateLimitRouteIDs = map[string]bool{
-		"18": true, "19": true, "20": true, "21": true, "22": true, "23": true, "24": true, "25": true,
+	case FlagInteractionTypeMODAL_SUBMIT:
+		panic(fmt.Sprintf(errTypeConvert, "ModalSubmitData", "MessageComponentData"))
 	}
-)
 
-// RateLimit provides concurrency-safe rate limit functionality by implementing the RateLimiter interface.
-type RateLimit struct {
-	// ids represents a map of Route IDs to Bucket IDs (map[routeID]BucketID).
-	ids map[string]string
+	panic(fmt.Sprintf(errTypeConvert, i.Data.InteractionDataType(), "MessageComponentData"))
+}
 
-	// buckets represents a map of Bucket IDs to rate limit Bucket (map[BucketID]*Bucket).
-	buckets map[string]*Bucket
+// ModalSubmit type converts an InteractionData field into a ModalSubmitData struct.
+func (i *Interaction) ModalSubmit() *ModalSubmitData {
+	switch i.Data.InteractionDataType() {
+	case FlagInteractionTypeMODAL_SUBMIT:
+		return i.Data.(*ModalSubmitData) //nolint:forcetypeassert
 
-	// entries represents a map of Bucket IDs to a count of references to Routes (map[BucketID]count).
-	//
-	// Used to safely remove a Bucket once it's no longer in use.
-	entries map[string]int
+	case FlagInteractionTypePING:
+		panic(fmt.Sprintf(errTypeConvert, "Ping", "ModalSubmitData"))
 
-	// DefaultBucket represents a Default Rate Limit Bucket, which is used to control
-	// the rate of the "first request(s) for any given route".
-	//
-	// This is necessary since Discord uses dynamic per-route token rate limits.
-	// As a result, a route's actual Rate Limit Bucket can NOT be discovered until a request (for that route) is sent.
-	//
-	// A Default Rate Limit Bucket can be set at multiple levels.
-	//
-	//	Route (RateLimit.DefaultBucket):    Used when a per-route request's bucket is NOT initialized (i.e route 16*).
-	//	Resource (Route Bucket Hash):       Used when a per-resource request's bucket is NOT initialized (i.e route 16, resource 32*).
-	//	Resource(s) (Resource Bucket Hash): Used when an nth degree per-resource request's bucket is NOT initialized (i.e route 16; resource 32; resource 7*).
-	//	So on and so forth...
-	//	*A request is NOT initialized when it has never been set (to a bucket or nil).
-	//
-	// Set the DefaultBucket to `nil` to disable the Default Rate Limit Bucket mechanism.
-	//
-	// Use a Default Bucket's Limit field-value to control how many requests of
-	// a given route can be sent (per second) BEFORE the actual Rate Limit Bucket of that route is known.
-	DefaultBucket *Bucket
+	case FlagInteractionTypeAPPLICATION_COMMAND,
+		FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
+		panic(fmt.Sprintf(errTypeConvert, "ApplicationCommandData", "ModalSubmitData"))
 
-	// muQueue represents a mutex used to process a single request a time.
-	muQueue sync.Mutex
+	case FlagInteractionTypeMESSAGE_COMPONENT:
+		panic(fmt.Sprintf(errTypeConvert, "MessageComponentData", "ModalSubmitData"))
+	}
 
-	// muTx represents a mutex used to access multiple rate limit Buckets as a transaction.
-	muTx sync.Mutex
+	panic(fmt.Sprintf(errTypeConvert, i.Data.InteractionDataType(), "ModalSubmitData"))
 }
 
-func (r *RateLimit) SetBucketID(routeid string, bucketid string) {
-	currentBucketID := r.ids[routeid]
+// init is called at the start of the application.
+func init() {
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+}
 
-	// when the current Bucket ID is not the same as the new Bucket ID.
-	if currentBucketID != bucketid {
-		// update the entries for the current Bucket ID.
-		if currentBucketID != "" {
-			r.entries[currentBucketID]--
+var (
+	// Logger represents the Disgo Logger used to log information.
+	Logger = zerolog.New(os.Stdout)
+)
 
-			// when the current Bucket ID is no longer referenced by a Route,
-			// delete the respective Bucket (and recycle it).
-			if r.entries[currentBucketID] <= 0 {
-				if currentBucket := r.buckets[currentBucketID]; currentBucket != nil {
-					putBucket(currentBucket)
-				}
-				delete(r.entries, currentBucketID)
-				delete(r.buckets, currentBucketID)
+// Logger Contexts
+const (
+	// LogCtxClient represents the log key for a Client (Bot) Application ID.
+	LogCtxClient = "client"
 
-				Logger.Info().Timestamp().Str(LogCtxRequest, routeid).Str(LogCtxBucket, currentBucketID).Msg("deleted bucket")
-			}
-		}
+	// LogCtxCorrelation represents the log key for a Correlation ID.
+	LogCtxCorrelation = "xid"
 
-		// set the Route ID to the new Bucket ID.
-		r.ids[routeid] = bucketid
+	// LogCtxRequest represents the log key for a Request ID.
+	LogCtxRequest = "request"
 
-		// update the entries for the new Bucket ID.
-		r.entries[bucketid]++
+	// LogCtxRoute represents the log key for a Route ID.
+	LogCtxRoute = "route"
 
-		Logger.Info().Timestamp().Str(LogCtxRequest, routeid).Str(LogCtxBucket, bucketid).Msg("set route to bucket")
-	}
-}
+	// LogCtxResource represents the log key for a Resource ID.
+	LogCtxResource = "resource"
 
-func (r *RateLimit) GetBucketID(routeid string) string {
-	return r.ids[routeid]
-}
+	// LogCtxEndpoint represents the log key for an Endpoint.
+	LogCtxEndpoint = "endpoint"
 
-func (r *RateLimit) SetBucketFromID(bucketid string, bucket *Bucket) {
-	r.buckets[bucketid] = bucket
+	// LogCtxRequestBody represents the log key for an HTTP Request Body.
+	LogCtxRequestBody = "body"
 
-	Logger.Info().Timestamp().Str(LogCtxBucket, bucketid).Msgf("set bucket to object %p", bucket)
-}
+	// LogCtxBucket represents the log key for a Rate Limit Bucket ID.
+	LogCtxBucket = "bucket"
 
-func (r *RateLimit) GetBucketFromID(bucketid string) *Bucket {
-	return r.buckets[bucketid]
-}
+	// LogCtxReset represents the log key for a Discord Bucket reset time.
+	LogCtxReset = "reset"
 
-func (r *RateLimit) SetBucket(routeid string, bucket *Bucket) {
-	r.buckets[r.ids[routeid]] = bucket
-}
+	// LogCtxResponse represents the log key for an HTTP Request Response.
+	LogCtxResponse = "response"
 
-func (r *RateLimit) GetBucket(routeid string, resourceid string) *Bucket {
-	requestid := routeid + resourceid
+	// LogCtxResponseHeader represents the log key for an HTTP Request Response header.
+	LogCtxResponseHeader = "header"
 
-	// ID 0 is used as a Global Rate Limit Bucket (or nil).
-	if routeid != GlobalRateLimitRouteID {
-		switch r.ids[requestid] {
-		// when a non-global route is initialized and (BucketID == "NIL"), NO rate limit applies.
-		case nilRouteBucket:
-			return nil
+	// LogCtxResponseBody represents the log key for an HTTP Request Response body.
+	LogCtxResponseBody = "body"
 
-		// This rate limiter implementation points the Route ID 0 (which is reserved for a
-		// Global Rate Limit) to Bucket ID "".
-		//
-		// As a result (of the Default Bucket mechanism), non-0 Route IDs must be handled accordingly.
-		case "":
-			// when a non-global route is uninitialized, set it to the Default Bucket.
-			//
-			// While GetBucket() can be called multiple times BEFORE a request is sent,
-			// this case is only true the FIRST time a GetBucket() call is made (for that request),
-			// As a result, the Bucket that is allocated from this call will ALWAYS be
-			// immediately used.
-			//
-			// The Route's Bucket ID (Hash) is set to an artificial value while
-			// the Route ID is pointed to a Default Bucket. This results in
-			// subsequent calls to the Route's Default Bucket to return to
-			// the same initialized bucket.
-			//
-			// When a Default Bucket is exhausted, it will never expire.
-			// As a result, the Remaining field-value will remain at 0 until
-			// the pending request (and its actual Bucket) is confirmed.
-			//
-			// requestID = routeid + resourceid
-			// temporaryBucketID = requestID
-			r.SetBucketID(requestid, requestid)
+	// LogCtxRequestRateLimitCode represents the log key for an HTTP Rate Limit Response code.
+	LogCtxRequestRateLimitCode = "code"
 
-			// DefaultBucket (Per-Route) = RateLimit.DefaultBucket
-			if resourceid == "" {
-				if r.DefaultBucket == nil {
-					return nil
-				}
+	// LogCtxSession represents the log key for a Discord Session ID.
+	LogCtxSession = "session"
 
-				b := getBucket()
-				b.Limit = r.DefaultBucket.Limit
-				b.Remaining = r.DefaultBucket.Limit
-				r.SetBucketFromID(requestid, b)
+	// LogCtxPayload represents the log key for a Discord Gateway Payload.
+	LogCtxPayload = "payload"
 
-				return b
-			}
+	// LogCtxPayloadOpcode represents the log key for a Discord Gateway Payload opcode.
+	LogCtxPayloadOpcode = "opcode"
 
-			// DefaultBucket (Per-Resource) = GetBucket(routeid, "")
-			defaultBucket := r.GetBucket(routeid, "")
-			if defaultBucket == nil {
-				return nil
-			}
+	// LogCtxPayloadData represents the log key for Discord Gateway Payload data.
+	LogCtxPayloadData = "data"
 
-			b := getBucket()
-			b.Limit = defaultBucket.Limit
-			b.Remaining = defaultBucket.Limit
-			r.SetBucketFromID(requestid, b)
+	// LogCtxEvent represents the log key for a Discord Gateway Event.
+	LogCtxEvent = "event"
 
-			return b
-		}
-	}
+	// LogCtxCommand represents the log key for a Discord Gateway command.
+	LogCtxCommand = "command"
 
-	return r.buckets[r.ids[requestid]]
+	// LogCtxCommandOpcode represents the log key for a Discord Gateway command opcode.
+	LogCtxCommandOpcode = "opcode"
+
+	// LogCtxCommandName represents the log key for a Discord Gateway command name.
+	LogCtxCommandName = "name"
+)
+
+// LogRequest logs a request.
+func LogRequest(log *zerolog.Event, clientid, xid, routeid, resourceid, endpoint string) *zerolog.Event {
+	return log.Timestamp().
+		Str(LogCtxClient, clientid).
+		Dict(LogCtxRequest, zerolog.Dict().
+			Str(LogCtxCorrelation, xid).
+			Str(LogCtxRoute, routeid).
+			Str(LogCtxResource, resourceid).
+			Str(LogCtxEndpoint, endpoint),
+		)
 }
 
-func (r *RateLimit) SetDefaultBucket(bucket *Bucket) {
-	r.DefaultBucket = bucket
+// LogRequestBody logs a request with its body.
+func LogRequestBody(log *zerolog.Event, clientid, xid, routeid, resourceid, endpoint, body string) *zerolog.Event {
+	return log.Timestamp().
+		Str(LogCtxClient, clientid).
+		Dict(LogCtxRequest, zerolog.Dict().
+			Str(LogCtxCorrelation, xid).
+			Str(LogCtxRoute, routeid).
+			Str(LogCtxResource, resourceid).
+			Str(LogCtxEndpoint, endpoint).
+			Str(LogCtxRequestBody, body),
+		)
 }
 
-func (r *RateLimit) Lock() {
-	r.muQueue.Lock()
+// LogResponse logs a response (typically using LogRequest).
+func LogResponse(log *zerolog.Event, header, body string) *zerolog.Event {
+	return log.Dict(LogCtxResponse, zerolog.Dict().
+		Str(LogCtxResponseHeader, header).
+		Str(LogCtxResponseBody, body),
+	)
 }
 
-func (r *RateLimit) Unlock() {
-	r.muQueue.Unlock()
+// LogEventHandler logs an event handler action.
+func LogEventHandler(log *zerolog.Event, clientid, event string) *zerolog.Event {
+	return log.Timestamp().
+		Str(LogCtxClient, clientid).
+		Str(LogCtxEvent, event)
 }
 
-func (r *RateLimit) StartTx() {
-	r.muTx.Lock()
+// LogSession logs a session.
+func LogSession(log *zerolog.Event, sessionid string) *zerolog.Event {
+	return log.Timestamp().
+		Str(LogCtxSession, sessionid)
 }
 
-func (r *RateLimit) EndTx() {
-	r.muTx.Unlock()
+// LogPayload logs a Discord Gateway Payload (typically using LogSession).
+func LogPayload(log *zerolog.Event, op int, data json.RawMessage) *zerolog.Event {
+	return log.Dict(LogCtxPayload, zerolog.Dict().
+		Int(LogCtxPayloadOpcode, op).
+		Bytes(LogCtxPayloadData, data),
+	)
 }
 
-type hash func(routeid string, parameters ...string) (string, string)
+// LogCommand logs a Gateway Command (typically using a LogSession).
+func LogCommand(log *zerolog.Event, clientid string, op int, command string) *zerolog.Event {
+	return log.Str(LogCtxClient, clientid).
+		Dict(LogCtxCommand, zerolog.Dict().
+			Int(LogCtxCommandOpcode, op).
+			Str(LogCtxCommandName, command),
+		)
+}
 
-// Hash returns a hashing function which hashes a request using its routeID.
+const (
+	grantTypeAuthorizationCodeGrant = "authorization_code"
+	grantTypeRefreshToken           = "refresh_token"
+	grantTypeClientCredentials      = "client_credentials"
+	amountAuthURLParams             = 6
+	amountAuthURLParamsBot          = 3
+)
+
+// GenerateAuthorizationURL generates an authorization URL from a given client and response type.
+func GenerateAuthorizationURL(bot *Client, response string) string {
+	params := make([]string, 0, amountAuthURLParams)
+
+	// response_type is the type of response the redirect will return.
+	if response != "" {
+		params = append(params, "responsetype="+response)
+	}
+
+	// client_id is the application client id.
+	params = append(params, "client_id="+bot.Authorization.ClientID)
+
+	// scope is a list of OAuth2 scopes separated by url encoded spaces (%20).
+	scope := urlQueryStringScope(bot.Authorization.Scopes)
+	if scope != "" {
+		params = append(params, scope)
+	}
+
+	// redirect_uri is the URL registered while creating the application.
+	if bot.Authorization.RedirectURI != "" {
+		params = append(params, "redirect_uri="+url.QueryEscape(bot.Authorization.RedirectURI))
+	}
+
+	// state is the unique string mentioned in State and Security.
+	if bot.Authorization.State != "" {
+		params = append(params, "state="+bot.Authorization.State)
+	}
+
+	// prompt controls how the authorization flow handles existing authorizations.
+	if bot.Authorization.Prompt != "" {
+		params = append(params, "prompt="+bot.Authorization.Prompt)
+	}
+
+	return EndpointAuthorizationURL() + "?" + strings.Join(params, "&")
+}
+
+// BotAuthParams represents parameters used to generate a bot authorization URL.
+type BotAuthParams struct {
+	// Bot provides the client_id and scopes parameters.
+	Bot *Client
+
+	// GuildID pre-selects a guild in the authorization prompt.
+	GuildID string
+
+	// ResponseType provides the type of response the OAuth2 flow will return.
+	//
+	// In the context of bot authorization, response_type is only provided when
+	// a scope outside of `bot` and `applications.commands` is requested.
+	ResponseType string
+
+	// Permissions represents the permissions the bot is requesting.
+	Permissions BitFlag
+
+	// DisableGuildSelect disables the ability to select other guilds
+	// in the authorization prompt (when GuildID is provided).
+	DisableGuildSelect bool
+}
+
+// GenerateBotAuthorizationURL generates a bot authorization URL using the given BotAuthParams.
 //
-// n represents the degree of resources used to hash the request.
+// Bot.Scopes must include "bot" to enable the OAuth2 Bot Flow.
+func GenerateBotAuthorizationURL(p BotAuthParams) string {
+	params := make([]string, 0, amountAuthURLParamsBot)
+
+	// permissions is permissions the bot is requesting.
+	params = append(params, "permissions="+strconv.FormatUint(uint64(p.Permissions), base10))
+
+	// guild_id is the Guild ID of the guild that is pre-selected in the authorization prompt.
+	if p.GuildID != "" {
+		params = append(params, "guild_id="+p.GuildID)
+	}
+
+	// disable_guild_select determines whether the user will be allowed to select a guild
+	// other than the guild_id.
+	params = append(params, "disable_guild_select="+strconv.FormatBool(p.DisableGuildSelect))
+
+	return GenerateAuthorizationURL(p.Bot, p.ResponseType) + "&" + strings.Join(params, "&")
+}
+
+// AuthorizationCodeGrant performs an OAuth2 authorization code grant.
 //
-//		Per-Route: n = 0
-//		Per-Resource: n = 1
-//	 ...
-func Hash(n int) hash {
-	return func(r string, p ...string) (string, string) {
-		return r, strings.Join(p[:n], "")
+// Send the user a valid Authorization URL, which can be generated using
+// GenerateAuthorizationURL(bot, "code").
+//
+// When the user visits the Authorization URL, they will be prompted for authorization.
+// If the user accepts the prompt, they will be redirected to the `redirect_uri`.
+// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
+// by parsing the request's URL Query String into a disgo.RedirectURL object.
+//
+// Retrieve the user's access token by calling THIS FUNCTION (with the disgo.RedirectURL parameter),
+// which performs an Access Token Exchange.
+//
+// Refresh the token by using RefreshAuthorizationCodeGrant(bot, token).
+//
+// For more information, read https://discord.com/developers/docs/topics/oauth2#authorization-code-grant
+func AuthorizationCodeGrant(bot *Client, ru *RedirectURL) (*AccessTokenResponse, error) {
+	exchange := &AccessTokenExchange{
+		ClientID:     bot.Authorization.ClientID,
+		ClientSecret: bot.Authorization.ClientSecret,
+		GrantType:    grantTypeAuthorizationCodeGrant,
+		Code:         ru.Code,
+		RedirectURI:  bot.Authorization.RedirectURI,
 	}
+
+	return exchange.Send(bot)
 }
 
-var (
-	// HashPerRoute hashes a request that uses a per-route rate limit algorithm.
-	HashPerRoute = Hash(0)
-)
+// RefreshAuthorizationCodeGrant refreshes an Access Token from an OAuth2 authorization code grant.
+func RefreshAuthorizationCodeGrant(bot *Client, token *AccessTokenResponse) (*AccessTokenResponse, error) {
+	exchange := &RefreshTokenExchange{
+		ClientID:     bot.Authorization.ClientID,
+		ClientSecret: bot.Authorization.ClientSecret,
+		GrantType:    grantTypeRefreshToken,
+		RefreshToken: token.RefreshToken,
+	}
 
-var (
-	// RateLimitHashFuncs represents a map of routes to respective rate limit algorithms.
-	//
-	// used to determine the hashing function for routes during runtime (map[routeID]algorithm).
-	RateLimitHashFuncs = map[uint8]hash{
-		RouteIDs["OAuth"]:                                          HashPerRoute,
-		RouteIDs["GetGlobalApplicationCommands"]:                   HashPerRoute,
-		RouteIDs["CreateGlobalApplicationCommand"]:                 HashPerRoute,
-		RouteIDs["GetGlobalApplicationCommand"]:                    HashPerRoute,
-		RouteIDs["EditGlobalApplicationCommand"]:                   HashPerRoute,
-		RouteIDs["DeleteGlobalApplicationCommand"]:                 HashPerRoute,
-		RouteIDs["BulkOverwriteGlobalApplicationCommands"]:         HashPerRoute,
-		RouteIDs["GetGuildApplicationCommands"]:                    HashPerRoute,
-		RouteIDs["CreateGuildApplicationCommand"]:                  HashPerRoute,
-		RouteIDs["GetGuildApplicationCommand"]:                     HashPerRoute,
-		RouteIDs["EditGuildApplicationCommand"]:                    HashPerRoute,
-		RouteIDs["DeleteGuildApplicationCommand"]:                  HashPerRoute,
-		RouteIDs["BulkOverwriteGuildApplicationCommands"]:          HashPerRoute,
-		RouteIDs["GetGuildApplicationCommandPermissions"]:          HashPerRoute,
-		RouteIDs["GetApplicationCommandPermissions"]:               HashPerRoute,
-		RouteIDs["EditApplicationCommandPermissions"]:              HashPerRoute,
-		RouteIDs["BatchEditApplicationCommandPermissions"]:         HashPerRoute,
-		RouteIDs["CreateInteractionResponse"]:                      HashPerRoute,
-		RouteIDs["GetOriginalInteractionResponse"]:                 HashPerRoute,
-		RouteIDs["EditOriginalInteractionResponse"]:                HashPerRoute,
-		RouteIDs["DeleteOriginalInteractionResponse"]:              HashPerRoute,
-		RouteIDs["CreateFollowupMessage"]:                          HashPerRoute,
-		RouteIDs["GetFollowupMessage"]:                             HashPerRoute,
-		RouteIDs["EditFollowupMessage"]:                            HashPerRoute,
-		RouteIDs["DeleteFollowupMessage"]:                          HashPerRoute,
-		RouteIDs["GetCurrentApplication"]:                          HashPerRoute,
-		RouteIDs["GetApplicationRoleConnectionMetadataRecords"]:    HashPerRoute,
-		RouteIDs["UpdateApplicationRoleConnectionMetadataRecords"]: HashPerRoute,
-		RouteIDs["GetGuildAuditLog"]:                               HashPerRoute,
-		RouteIDs["ListAutoModerationRulesForGuild"]:                HashPerRoute,
-		RouteIDs["GetAutoModerationRule"]:                          HashPerRoute,
-		RouteIDs["CreateAutoModerationRule"]:                       HashPerRoute,
-		RouteIDs["ModifyAutoModerationRule"]:                       HashPerRoute,
-		RouteIDs["DeleteAutoModerationRule"]:                       HashPerRoute,
-		RouteIDs["GetChannel"]:                                     HashPerRoute,
-		RouteIDs["ModifyChannel"]:                                  HashPerRoute,
-		RouteIDs["ModifyChannelGroupDM"]:                           HashPerRoute,
-		RouteIDs["ModifyChannelGuild"]:                             HashPerRoute,
-		RouteIDs["ModifyChannelThread"]:                            HashPerRoute,
-		RouteIDs["DeleteCloseChannel"]:                             HashPerRoute,
-		RouteIDs["GetChannelMessages"]:                             HashPerRoute,
-		RouteIDs["GetChannelMessage"]:                              HashPerRoute,
-		RouteIDs["CreateMessage"]:                                  HashPerRoute,
-		RouteIDs["CrosspostMessage"]:                               HashPerRoute,
-		RouteIDs["CreateReaction"]:                                 HashPerRoute,
-		RouteIDs["DeleteOwnReaction"]:                              HashPerRoute,
-		RouteIDs["DeleteUserReaction"]:                             HashPerRoute,
-		RouteIDs["GetReactions"]:                                   HashPerRoute,
-		RouteIDs["DeleteAllReactions"]:                             HashPerRoute,
-		RouteIDs["DeleteAllReactionsforEmoji"]:                     HashPerRoute,
-		RouteIDs["EditMessage"]:                                    HashPerRoute,
-		RouteIDs["DeleteMessage"]:                                  HashPerRoute,
-		RouteIDs["BulkDeleteMessages"]:                             HashPerRoute,
+	return exchange.Send(bot)
+}
+
+// ImplicitGrant converts a RedirectURI (from a simplified OAuth2 grant) to an AccessTokenResponse.
+//
+// Send the user a valid Authorization URL, which can be generated using
+// GenerateAuthorizationURL(bot, "token").
+//
+// When the user visits the Authorization URL, they will be prompted for authorization.
+// If the user accepts the prompt, they will be redirected to the `redirect_uri`.
+// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
+// by parsing the request's URI Fragments into a disgo.RedirectURI object.
+//
+// A disgo.RedirectURI object is equivalent to a disgo.AccessTokenResponse,
+// but it does NOT contain a refresh token.
+//
+// For more information, read https://discord.com/developers/docs/topics/oauth2#implicit-grant
+func ImplicitGrant(ru *RedirectURI) *AccessTokenResponse {
+	return &AccessTokenResponse{
+		AccessToken:  ru.AccessToken,
+		TokenType:    ru.TokenType,
+		ExpiresIn:    ru.ExpiresIn,
+		RefreshToken: "",
+		Scope:        ru.Scope,
+	}
+}
+
+// ClientCredentialsGrant performs a client credential OAuth2 grant for TESTING PURPOSES.
+//
+// The bot client's Authentication Header will be set to a Basic Authentication Header that
+// uses the bot's ClientID as a username and ClientSecret as a password.
+//
+// A request will be made for a Client Credential grant which returns a disgo.AccessTokenResponse
+// that does NOT contain a refresh token.
+//
+// For more information, read https://discord.com/developers/docs/topics/oauth2#client-credentials-grant
+func ClientCredentialsGrant(bot *Client) (*AccessTokenResponse, error) {
+	bot.Authentication.Header = "Basic " +
+		base64.StdEncoding.EncodeToString([]byte(bot.Authorization.ClientID+":"+bot.Authorization.ClientSecret))
+
+	grant := &ClientCredentialsTokenRequest{
+		GrantType: grantTypeClientCredentials,
+		Scope:     urlQueryStringScope(bot.Authorization.Scopes),
+	}
+
+	return grant.Send(bot)
+}
+
+// BotAuthorization performs a specialized OAuth2 flow for users to add bots to guilds.
+//
+// Send the user a valid Bot Authorization URL, which can be generated using
+// GenerateBotAuthorizationURL(disgo.BotAuthParams{...}).
+//
+// When the user visits the Bot Authorization URL, they will be prompted for authorization.
+// If the user accepts the prompt (with a guild), the bot will be added to the selected guild.
+//
+// For more information read, https://discord.com/developers/docs/topics/oauth2#bot-authorization-flow
+func BotAuthorization() {}
+
+// AdvancedBotAuthorization performs a specialized OAuth2 flow for users to add bots to guilds.
+//
+// Send the user a valid Bot Authorization URL, which can be generated using
+// GenerateBotAuthorizationURL(disgo.BotAuthParams{...}).
+//
+// If the user accepts the prompt (with a guild), they will be redirected to the `redirect_uri`.
+// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
+// by parsing the request's URL Query String into a disgo.RedirectURL object.
+//
+// Retrieve the user's access token by calling THIS FUNCTION (with the disgo.RedirectURL parameter),
+// which performs an Access Token Exchange.
+//
+// Refresh the token by using RefreshAuthorizationCodeGrant(bot, token).
+//
+// For more information read, https://discord.com/developers/docs/topics/oauth2#advanced-bot-authorization
+func AdvancedBotAuthorization(bot *Client, ru *RedirectURL) (*AccessTokenResponse, error) {
+	return AuthorizationCodeGrant(bot, ru)
+}
+
+// WebhookAuthorization performs a specialized OAuth2 authorization code grant.
+//
+// Send the user a valid Authorization URL, which can be generated using
+// GenerateAuthorizationURL(bot, "code") when bot.Scopes is set to `webhook.incoming`.
+//
+// When the user visits the Authorization URL, they will be prompted for authorization.
+// If the user accepts the prompt (with a channel), they will be redirected to the `redirect_uri`.
+// This issues a GET request to the `redirect_uri` web server which YOU MUST HANDLE
+// by parsing the request's URL Query String into a disgo.RedirectURL object.
+//
+// Retrieve the user's access token by calling THIS FUNCTION (with the disgo.RedirectURL parameter),
+// which performs an Access Token Exchange.
+//
+// Refresh the token by using RefreshAuthorizationCodeGrant(bot, token).
+//
+// For more information read, https://discord.com/developers/docs/topics/oauth2#webhooks
+func WebhookAuthorization(bot *Client, ru *RedirectURL) (*AccessTokenResponse, *Webhook, error) {
+	exchange := &AccessTokenExchange{
+		ClientID:     bot.Authorization.ClientID,
+		ClientSecret: bot.Authorization.ClientSecret,
+		GrantType:    grantTypeAuthorizationCodeGrant,
+		Code:         ru.Code,
+		RedirectURI:  bot.Authorization.RedirectURI,
+	}
+
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[1]("1")
+	query, err := EndpointQueryString(exchange)
+	if err != nil {
+		return nil, nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointTokenURL() + "?" + query
+
+	result := new(WebhookTokenResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
+	if err != nil {
+		return nil, nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	// convert the webhook token response to an access token response (and webhook).
+	token := &AccessTokenResponse{
+		AccessToken:  result.AccessToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    result.ExpiresIn,
+		RefreshToken: result.RefreshToken,
+		Scope:        result.Scope,
+	}
+
+	return token, result.Webhook, nil
+}
+
+// Send sends an AccessTokenExchange request to Discord and returns an AccessTokenResponse.
+func (r *AccessTokenExchange) Send(bot *Client) (*AccessTokenResponse, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[1]("1")
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointTokenURL() + "?" + query
+
+	result := new(AccessTokenResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a RefreshTokenExchange request to Discord and returns an AccessTokenResponse.
+//
+// Uses the RefreshTokenExchange ClientID and ClientSecret.
+func (r *RefreshTokenExchange) Send(bot *Client) (*AccessTokenResponse, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[1]("1")
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointTokenURL() + "?" + query
+
+	result := new(AccessTokenResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a ClientCredentialsTokenRequest to Discord and returns a ClientCredentialsTokenRequest.
+func (r *ClientCredentialsTokenRequest) Send(bot *Client) (*AccessTokenResponse, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[1]("1")
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointTokenURL() + "?" + query
+
+	result := new(AccessTokenResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// urlQueryStringScope parses a given slice of scopes to generate a valid URL Query String.
+func urlQueryStringScope(scopes []string) string {
+	if len(scopes) > 0 {
+		var scope strings.Builder
+		scope.WriteString("scope=")
+
+		for i, s := range scopes {
+			if i > 0 {
+				scope.WriteString("%20")
+			}
+
+			scope.WriteString(s)
+		}
+
+		return scope.String()
+	}
+
+	return ""
+}
+
+// PermissionsAll represents every permission.
+const PermissionsAll = ^BitFlag(0)
+
+// Implicit Permissions
+// https://discord.com/developers/docs/topics/permissions#implicit-permissions
+const (
+	// permissionsTimeout represents the permissions of a member that is timed out.
+	permissionsTimeout = FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY
+
+	// permissionsSendMessages represents the permissions that are lost without SEND_MESSAGES.
+	permissionsSendMessages = FlagBitwisePermissionMENTION_EVERYONE | FlagBitwisePermissionSEND_TTS_MESSAGES |
+		FlagBitwisePermissionATTACH_FILES | FlagBitwisePermissionEMBED_LINKS
+)
+
+// PermissionFlagNames represents a map of permission flags to names.
+var PermissionFlagNames = map[BitFlag]string{
+	FlagBitwisePermissionCREATE_INSTANT_INVITE:               "CREATE_INSTANT_INVITE",
+	FlagBitwisePermissionKICK_MEMBERS:                        "KICK_MEMBERS",
+	FlagBitwisePermissionBAN_MEMBERS:                         "BAN_MEMBERS",
+	FlagBitwisePermissionADMINISTRATOR:                       "ADMINISTRATOR",
+	FlagBitwisePermissionMANAGE_CHANNELS:                     "MANAGE_CHANNELS",
+	FlagBitwisePermissionMANAGE_GUILD:                        "MANAGE_GUILD",
+	FlagBitwisePermissionADD_REACTIONS:                       "ADD_REACTIONS",
+	FlagBitwisePermissionVIEW_AUDIT_LOG:                      "VIEW_AUDIT_LOG",
+	FlagBitwisePermissionPRIORITY_SPEAKER:                    "PRIORITY_SPEAKER",
+	FlagBitwisePermissionSTREAM:                              "STREAM",
+	FlagBitwisePermissionVIEW_CHANNEL:                        "VIEW_CHANNEL",
+	FlagBitwisePermissionSEND_MESSAGES:                       "SEND_MESSAGES",
+	FlagBitwisePermissionSEND_TTS_MESSAGES:                   "SEND_TTS_MESSAGES",
+	FlagBitwisePermissionMANAGE_MESSAGES:                     "MANAGE_MESSAGES",
+	FlagBitwisePermissionEMBED_LINKS:                         "EMBED_LINKS",
+	FlagBitwisePermissionATTACH_FILES:                        "ATTACH_FILES",
+	FlagBitwisePermissionREAD_MESSAGE_HISTORY:                "READ_MESSAGE_HISTORY",
+	FlagBitwisePermissionMENTION_EVERYONE:                    "MENTION_EVERYONE",
+	FlagBitwisePermissionUSE_EXTERNAL_EMOJIS:                 "USE_EXTERNAL_EMOJIS",
+	FlagBitwisePermissionVIEW_GUILD_INSIGHTS:                 "VIEW_GUILD_INSIGHTS",
+	FlagBitwisePermissionCONNECT:                             "CONNECT",
+	FlagBitwisePermissionSPEAK:                               "SPEAK",
+	FlagBitwisePermissionMUTE_MEMBERS:                        "MUTE_MEMBERS",
+	FlagBitwisePermissionDEAFEN_MEMBERS:                      "DEAFEN_MEMBERS",
+	FlagBitwisePermissionMOVE_MEMBERS:                        "MOVE_MEMBERS",
+	FlagBitwisePermissionUSE_VAD:                             "USE_VAD",
+	FlagBitwisePermissionCHANGE_NICKNAME:                     "CHANGE_NICKNAME",
+	FlagBitwisePermissionMANAGE_NICKNAMES:                    "MANAGE_NICKNAMES",
+	FlagBitwisePermissionMANAGE_ROLES:                        "MANAGE_ROLES",
+	FlagBitwisePermissionMANAGE_WEBHOOKS:                     "MANAGE_WEBHOOKS",
+	FlagBitwisePermissionMANAGE_GUILD_EXPRESSIONS:            "MANAGE_GUILD_EXPRESSIONS",
+	FlagBitwisePermissionUSE_APPLICATION_COMMANDS:            "USE_APPLICATION_COMMANDS",
+	FlagBitwisePermissionREQUEST_TO_SPEAK:                    "REQUEST_TO_SPEAK",
+	FlagBitwisePermissionMANAGE_EVENTS:                       "MANAGE_EVENTS",
+	FlagBitwisePermissionMANAGE_THREADS:                      "MANAGE_THREADS",
+	FlagBitwisePermissionCREATE_PUBLIC_THREADS:               "CREATE_PUBLIC_THREADS",
+	FlagBitwisePermissionCREATE_PRIVATE_THREADS:              "CREATE_PRIVATE_THREADS",
+	FlagBitwisePermissionUSE_EXTERNAL_STICKERS:               "USE_EXTERNAL_STICKERS",
+	FlagBitwisePermissionSEND_MESSAGES_IN_THREADS:            "SEND_MESSAGES_IN_THREADS",
+	FlagBitwisePermissionUSE_EMBEDDED_ACTIVITIES:             "USE_EMBEDDED_ACTIVITIES",
+	FlagBitwisePermissionMODERATE_MEMBERS:                    "MODERATE_MEMBERS",
+	FlagBitwisePermissionVIEW_CREATOR_MONETIZATION_ANALYTICS: "VIEW_CREATOR_MONETIZATION_ANALYTICS",
+	FlagBitwisePermissionUSE_SOUNDBOARD:                      "USE_SOUNDBOARD",
+	FlagBitwisePermissionUSE_EXTERNAL_SOUNDS:                 "USE_EXTERNAL_SOUNDS",
+	FlagBitwisePermissionSEND_VOICE_MESSAGES:                 "SEND_VOICE_MESSAGES",
+}
+
+// ParsePermissions parses a permission string (i.e., Role.Permissions) into a BitFlag.
+func ParsePermissions(permissions string) (BitFlag, error) {
+	if permissions == "" {
+		return 0, nil
+	}
+
+	flags, err := strconv.ParseUint(permissions, base10, bit64)
+	if err != nil {
+		return 0, fmt.Errorf("permissions: %w", err)
+	}
+
+	return BitFlag(flags), nil
+}
+
+// HasPermissions returns whether the granted permissions contain the required permissions.
+func HasPermissions(granted, required BitFlag) bool {
+	return MissingPermissions(granted, required) == 0
+}
+
+// MissingPermissions returns the required permissions that are NOT granted.
+//
+// The ADMINISTRATOR permission grants every permission.
+func MissingPermissions(granted, required BitFlag) BitFlag {
+	if granted&FlagBitwisePermissionADMINISTRATOR != 0 {
+		return 0
+	}
+
+	return required &^ granted
+}
+
+// PermissionNames returns the names of the given permissions (in order of their bits).
+func PermissionNames(permissions BitFlag) []string {
+	names := make([]string, 0, bits.OnesCount64(uint64(permissions)))
+
+	for permissions != 0 {
+		flag := BitFlag(1) << bits.TrailingZeros64(uint64(permissions))
+		permissions &^= flag
+
+		name, ok := PermissionFlagNames[flag]
+		if !ok {
+			name = strconv.FormatUint(uint64(flag), 10)
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// BasePermissions returns the guild-level permissions of a member,
+// which are computed from the @everyone role and the roles of the member.
+//
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func BasePermissions(guild *Guild, member *GuildMember) (BitFlag, error) {
+	if member.User == nil {
+		return 0, fmt.Errorf("permissions: member of guild %q has no user", guild.ID)
+	}
+
+	if member.User.ID == guild.OwnerID {
+		return PermissionsAll, nil
+	}
+
+	roles := make(map[string]*Role, len(guild.Roles))
+	for _, role := range guild.Roles {
+		if role != nil {
+			roles[role.ID] = role
+		}
+	}
+
+	var permissions BitFlag
+
+	// the ID of the @everyone role is the ID of the guild.
+	if everyone, ok := roles[guild.ID]; ok {
+		flags, err := ParsePermissions(everyone.Permissions)
+		if err != nil {
+			return 0, fmt.Errorf("role %q: %w", everyone.ID, err)
+		}
+
+		permissions = flags
+	}
+
+	for _, id := range member.Roles {
+		if id == nil {
+			continue
+		}
+
+		role, ok := roles[*id]
+		if !ok {
+			continue
+		}
+
+		flags, err := ParsePermissions(role.Permissions)
+		if err != nil {
+			return 0, fmt.Errorf("role %q: %w", role.ID, err)
+		}
+
+		permissions |= flags
+	}
+
+	if permissions&FlagBitwisePermissionADMINISTRATOR != 0 {
+		return PermissionsAll, nil
+	}
+
+	if timedOut(member) {
+		permissions &= permissionsTimeout
+	}
+
+	return permissions, nil
+}
+
+// ChannelPermissions returns the permissions of a member in a channel, which are computed from
+// the base permissions of the member and the permission overwrites of the channel
+// (for the @everyone role, then the roles of the member, then the member).
+//
+// The permissions of a thread must be computed using its parent channel.
+//
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func ChannelPermissions(guild *Guild, member *GuildMember, channel *Channel) (BitFlag, error) {
+	permissions, err := BasePermissions(guild, member)
+	if err != nil {
+		return 0, err
+	}
+
+	if permissions == PermissionsAll {
+		return PermissionsAll, nil
+	}
+
+	memberRoles := make(map[string]bool, len(member.Roles))
+	for _, id := range member.Roles {
+		if id != nil {
+			memberRoles[*id] = true
+		}
+	}
+
+	var everyone, roles, user [2]BitFlag // allow, deny
+
+	for _, overwrite := range channel.PermissionOverwrites {
+		var target *[2]BitFlag
+
+		switch {
+		case overwrite == nil:
+			continue
+
+		case overwrite.Type == FlagPermissionOverwriteTypeRole && overwrite.ID == guild.ID:
+			target = &everyone
+
+		case overwrite.Type == FlagPermissionOverwriteTypeRole && memberRoles[overwrite.ID]:
+			target = &roles
+
+		case overwrite.Type == FlagPermissionOverwriteTypeMember && overwrite.ID == member.User.ID:
+			target = &user
+
+		default:
+			continue
+		}
+
+		allow, err := ParsePermissions(overwrite.Allow)
+		if err != nil {
+			return 0, fmt.Errorf("overwrite %q: %w", overwrite.ID, err)
+		}
+
+		deny, err := ParsePermissions(overwrite.Deny)
+		if err != nil {
+			return 0, fmt.Errorf("overwrite %q: %w", overwrite.ID, err)
+		}
+
+		target[0] |= allow
+		target[1] |= deny
+	}
+
+	for _, overwrite := range [][2]BitFlag{everyone, roles, user} {
+		permissions &^= overwrite[1]
+		permissions |= overwrite[0]
+	}
+
+	if timedOut(member) {
+		permissions &= permissionsTimeout
+	}
+
+	// a member that can NOT view a channel has no permissions in it.
+	if permissions&FlagBitwisePermissionVIEW_CHANNEL == 0 {
+		return 0, nil
+	}
+
+	if permissions&FlagBitwisePermissionSEND_MESSAGES == 0 {
+		permissions &^= permissionsSendMessages
+	}
+
+	return permissions, nil
+}
+
+// timedOut returns whether a member is timed out.
+func timedOut(member *GuildMember) bool {
+	return member.CommunicationDisabledUntil != nil && *member.CommunicationDisabledUntil != nil &&
+		(*member.CommunicationDisabledUntil).After(time.Now())
+}
+
+// rlbpool represents a synchronized Rate Limit Bucket pool.
+var rlbpool sync.Pool
+
+// getBucket gets a Bucket from a pool.
+func getBucket() *Bucket {
+	if b := rlbpool.Get(); b != nil {
+		return b.(*Bucket) //nolint:forcetypeassert
+	}
+
+	return new(Bucket)
+}
+
+// putBucket puts a Rate Limit Bucket into the pool.
+func putBucket(b *Bucket) {
+	b.ID = ""
+	b.Limit = 0
+	b.Remaining = 0
+	b.Pending = 0
+	b.Date = time.Time{}
+	b.Expiry = time.Time{}
+
+	rlbpool.Put(b)
+}
+
+// spool represents a synchronized Session pool.
+var spool sync.Pool
+
+// NewSession gets a Session from a pool.
+func NewSession() *Session {
+	if s := spool.Get(); s != nil {
+		return s.(*Session) //nolint:forcetypeassert
+	}
+
+	return new(Session)
+}
+
+// putSession puts a Session into the pool.
+func putSession(s *Session) {
+	s.Lock()
+	defer s.Unlock()
+
+	// reset the Session.
+	s.ID = ""
+	s.Seq = 0
+	s.Endpoint = ""
+	s.Shard = nil
+	s.Context = nil
+	s.Conn = nil
+	s.heartbeat = nil
+	s.manager = nil
+	s.client_manager = nil
+	s.statusHandler = nil
+	s.store = nil
+	s.transitions.queue = nil
+	s.status = SessionStatusDisconnected
+	s.attempts = 0
+	s.RateLimiter = nil
+
+	spool.Put(s)
+}
+
+// gpool represents a synchronized Gateway Payload pool.
+var gpool sync.Pool
+
+// getPayload gets a Gateway Payload from the pool.
+func getPayload() *GatewayPayload {
+	if g := gpool.Get(); g != nil {
+		return g.(*GatewayPayload) //nolint:forcetypeassert
+	}
+
+	return new(GatewayPayload)
+}
+
+// putPayload puts a Gateway Payload into the pool.
+func putPayload(g *GatewayPayload) {
+	// reset the Gateway Payload.
+	g.Op = 0
+	g.Data = nil
+	g.SequenceNumber = nil
+	g.EventName = nil
+
+	gpool.Put(g)
+}
+
+const (
+	nilRouteBucket = "NIL"
+)
+
+var (
+	// IgnoreGlobalRateLimitRouteIDs represents a set of Route IDs that do NOT adhere to the Global Rate Limit.
+	//
+	// Interaction endpoints are not bound to the bot's Global Rate Limit.
+	// https://discord.com/developers/docs/interactions/receiving-and-responding#endpoints
+	IgnoreGlobalRateLimitRouteIDs = map[string]bool{
+		"18": true, "19": true, "20": true, "21": true, "22": true, "23": true, "24": true, "25": true,
+	}
+)
+
+// RateLimit provides concurrency-safe rate limit functionality by implementing the RateLimiter interface.
+type RateLimit struct {
+	// ids represents a map of Route IDs to Bucket IDs (map[routeID]BucketID).
+	ids map[string]string
+
+	// buckets represents a map of Bucket IDs to rate limit Bucket (map[BucketID]*Bucket).
+	buckets map[string]*Bucket
+
+	// entries represents a map of Bucket IDs to a count of references to Routes (map[BucketID]count).
+	//
+	// Used to safely remove a Bucket once it's no longer in use.
+	entries map[string]int
+
+	// DefaultBucket represents a Default Rate Limit Bucket, which is used to control
+	// the rate of the "first request(s) for any given route".
+	//
+	// This is necessary since Discord uses dynamic per-route token rate limits.
+	// As a result, a route's actual Rate Limit Bucket can NOT be discovered until a request (for that route) is sent.
+	//
+	// A Default Rate Limit Bucket can be set at multiple levels.
+	//
+	//	Route (RateLimit.DefaultBucket):    Used when a per-route request's bucket is NOT initialized (i.e route 16*).
+	//	Resource (Route Bucket Hash):       Used when a per-resource request's bucket is NOT initialized (i.e route 16, resource 32*).
+	//	Resource(s) (Resource Bucket Hash): Used when an nth degree per-resource request's bucket is NOT initialized (i.e route 16; resource 32; resource 7*).
+	//	So on and so forth...
+	//	*A request is NOT initialized when it has never been set (to a bucket or nil).
+	//
+	// Set the DefaultBucket to `nil` to disable the Default Rate Limit Bucket mechanism.
+	//
+	// Use a Default Bucket's Limit field-value to control how many requests of
+	// a given route can be sent (per second) BEFORE the actual Rate Limit Bucket of that route is known.
+	DefaultBucket *Bucket
+
+	// muQueue represents a mutex used to process a single request a time.
+	muQueue sync.Mutex
+
+	// muTx represents a mutex used to access multiple rate limit Buckets as a transaction.
+	muTx sync.Mutex
+}
+
+func (r *RateLimit) SetBucketID(routeid string, bucketid string) {
+	currentBucketID := r.ids[routeid]
+
+	// when the current Bucket ID is not the same as the new Bucket ID.
+	if currentBucketID != bucketid {
+		// update the entries for the current Bucket ID.
+		if currentBucketID != "" {
+			r.entries[currentBucketID]--
+
+			// when the current Bucket ID is no longer referenced by a Route,
+			// delete the respective Bucket (and recycle it).
+			if r.entries[currentBucketID] <= 0 {
+				if currentBucket := r.buckets[currentBucketID]; currentBucket != nil {
+					putBucket(currentBucket)
+				}
+				delete(r.entries, currentBucketID)
+				delete(r.buckets, currentBucketID)
+
+				Logger.Info().Timestamp().Str(LogCtxRequest, routeid).Str(LogCtxBucket, currentBucketID).Msg("deleted bucket")
+			}
+		}
+
+		// set the Route ID to the new Bucket ID.
+		r.ids[routeid] = bucketid
+
+		// update the entries for the new Bucket ID.
+		r.entries[bucketid]++
+
+		Logger.Info().Timestamp().Str(LogCtxRequest, routeid).Str(LogCtxBucket, bucketid).Msg("set route to bucket")
+	}
+}
+
+func (r *RateLimit) GetBucketID(routeid string) string {
+	return r.ids[routeid]
+}
+
+func (r *RateLimit) SetBucketFromID(bucketid string, bucket *Bucket) {
+	r.buckets[bucketid] = bucket
+
+	Logger.Info().Timestamp().Str(LogCtxBucket, bucketid).Msgf("set bucket to object %p", bucket)
+}
+
+func (r *RateLimit) GetBucketFromID(bucketid string) *Bucket {
+	return r.buckets[bucketid]
+}
+
+func (r *RateLimit) SetBucket(routeid string, bucket *Bucket) {
+	r.buckets[r.ids[routeid]] = bucket
+}
+
+func (r *RateLimit) GetBucket(routeid string, resourceid string) *Bucket {
+	requestid := routeid + resourceid
+
+	// ID 0 is used as a Global Rate Limit Bucket (or nil).
+	if routeid != GlobalRateLimitRouteID {
+		switch r.ids[requestid] {
+		// when a non-global route is initialized and (BucketID == "NIL"), NO rate limit applies.
+		case nilRouteBucket:
+			return nil
+
+		// This rate limiter implementation points the Route ID 0 (which is reserved for a
+		// Global Rate Limit) to Bucket ID "".
+		//
+		// As a result (of the Default Bucket mechanism), non-0 Route IDs must be handled accordingly.
+		case "":
+			// when a non-global route is uninitialized, set it to the Default Bucket.
+			//
+			// While GetBucket() can be called multiple times BEFORE a request is sent,
+			// this case is only true the FIRST time a GetBucket() call is made (for that request),
+			// As a result, the Bucket that is allocated from this call will ALWAYS be
+			// immediately used.
+			//
+			// The Route's Bucket ID (Hash) is set to an artificial value while
+			// the Route ID is pointed to a Default Bucket. This results in
+			// subsequent calls to the Route's Default Bucket to return to
+			// the same initialized bucket.
+			//
+			// When a Default Bucket is exhausted, it will never expire.
+			// As a result, the Remaining field-value will remain at 0 until
+			// the pending request (and its actual Bucket) is confirmed.
+			//
+			// requestID = routeid + resourceid
+			// temporaryBucketID = requestID
+			r.SetBucketID(requestid, requestid)
+
+			// DefaultBucket (Per-Route) = RateLimit.DefaultBucket
+			if resourceid == "" {
+				if r.DefaultBucket == nil {
+					return nil
+				}
+
+				b := getBucket()
+				b.Limit = r.DefaultBucket.Limit
+				b.Remaining = r.DefaultBucket.Limit
+				r.SetBucketFromID(requestid, b)
+
+				return b
+			}
+
+			// DefaultBucket (Per-Resource) = GetBucket(routeid, "")
+			defaultBucket := r.GetBucket(routeid, "")
+			if defaultBucket == nil {
+				return nil
+			}
+
+			b := getBucket()
+			b.Limit = defaultBucket.Limit
+			b.Remaining = defaultBucket.Limit
+			r.SetBucketFromID(requestid, b)
+
+			return b
+		}
+	}
+
+	return r.buckets[r.ids[requestid]]
+}
+
+func (r *RateLimit) SetDefaultBucket(bucket *Bucket) {
+	r.DefaultBucket = bucket
+}
+
+func (r *RateLimit) Lock() {
+	r.muQueue.Lock()
+}
+
+func (r *RateLimit) Unlock() {
+	r.muQueue.Unlock()
+}
+
+func (r *RateLimit) StartTx() {
+	r.muTx.Lock()
+}
+
+func (r *RateLimit) EndTx() {
+	r.muTx.Unlock()
+}
+
+type hash func(routeid string, parameters ...string) (string, string)
+
+// Hash returns a hashing function which hashes a request using its routeID.
+//
+// n represents the degree of resources used to hash the request.
+//
+//		Per-Route: n = 0
+//		Per-Resource: n = 1
+//	 ...
+func Hash(n int) hash {
+	return func(r string, p ...string) (string, string) {
+		return r, strings.Join(p[:n], "")
+	}
+}
+
+var (
+	// HashPerRoute hashes a request that uses a per-route rate limit algorithm.
+	HashPerRoute = Hash(0)
+)
+
+var (
+	// RateLimitHashFuncs represents a map of routes to respective rate limit algorithms.
+	//
+	// used to determine the hashing function for routes during runtime (map[routeID]algorithm).
+	RateLimitHashFuncs = map[uint8]hash{
+		RouteIDs["OAuth"]:                                          HashPerRoute,
+		RouteIDs["GetGlobalApplicationCommands"]:                   HashPerRoute,
+		RouteIDs["CreateGlobalApplicationCommand"]:                 HashPerRoute,
+		RouteIDs["GetGlobalApplicationCommand"]:                    HashPerRoute,
+		RouteIDs["EditGlobalApplicationCommand"]:                   HashPerRoute,
+		RouteIDs["DeleteGlobalApplicationCommand"]:                 HashPerRoute,
+		RouteIDs["BulkOverwriteGlobalApplicationCommands"]:         HashPerRoute,
+		RouteIDs["GetGuildApplicationCommands"]:                    HashPerRoute,
+		RouteIDs["CreateGuildApplicationCommand"]:                  HashPerRoute,
+		RouteIDs["GetGuildApplicationCommand"]:                     HashPerRoute,
+		RouteIDs["EditGuildApplicationCommand"]:                    HashPerRoute,
+		RouteIDs["DeleteGuildApplicationCommand"]:                  HashPerRoute,
+		RouteIDs["BulkOverwriteGuildApplicationCommands"]:          HashPerRoute,
+		RouteIDs["GetGuildApplicationCommandPermissions"]:          HashPerRoute,
+		RouteIDs["GetApplicationCommandPermissions"]:               HashPerRoute,
+		RouteIDs["EditApplicationCommandPermissions"]:              HashPerRoute,
+		RouteIDs["BatchEditApplicationCommandPermissions"]:         HashPerRoute,
+		RouteIDs["CreateInteractionResponse"]:                      HashPerRoute,
+		RouteIDs["GetOriginalInteractionResponse"]:                 HashPerRoute,
+		RouteIDs["EditOriginalInteractionResponse"]:                HashPerRoute,
+		RouteIDs["DeleteOriginalInteractionResponse"]:              HashPerRoute,
+		RouteIDs["CreateFollowupMessage"]:                          HashPerRoute,
+		RouteIDs["GetFollowupMessage"]:                             HashPerRoute,
+		RouteIDs["EditFollowupMessage"]:                            HashPerRoute,
+		RouteIDs["DeleteFollowupMessage"]:                          HashPerRoute,
+		RouteIDs["GetCurrentApplication"]:                          HashPerRoute,
+		RouteIDs["GetApplicationRoleConnectionMetadataRecords"]:    HashPerRoute,
+		RouteIDs["UpdateApplicationRoleConnectionMetadataRecords"]: HashPerRoute,
+		RouteIDs["GetGuildAuditLog"]:                               HashPerRoute,
+		RouteIDs["ListAutoModerationRulesForGuild"]:                HashPerRoute,
+		RouteIDs["GetAutoModerationRule"]:                          HashPerRoute,
+		RouteIDs["CreateAutoModerationRule"]:                       HashPerRoute,
+		RouteIDs["ModifyAutoModerationRule"]:                       HashPerRoute,
+		RouteIDs["DeleteAutoModerationRule"]:                       HashPerRoute,
+		RouteIDs["GetChannel"]:                                     HashPerRoute,
+		RouteIDs["ModifyChannel"]:                                  HashPerRoute,
+		RouteIDs["ModifyChannelGroupDM"]:                           HashPerRoute,
+		RouteIDs["ModifyChannelGuild"]:                             HashPerRoute,
+		RouteIDs["ModifyChannelThread"]:                            HashPerRoute,
+		RouteIDs["DeleteCloseChannel"]:                             HashPerRoute,
+		RouteIDs["GetChannelMessages"]:                             HashPerRoute,
+		RouteIDs["GetChannelMessage"]:                              HashPerRoute,
+		RouteIDs["CreateMessage"]:                                  HashPerRoute,
+		RouteIDs["CrosspostMessage"]:                               HashPerRoute,
+		RouteIDs["CreateReaction"]:                                 HashPerRoute,
+		RouteIDs["DeleteOwnReaction"]:                              HashPerRoute,
+		RouteIDs["DeleteUserReaction"]:                             HashPerRoute,
+		RouteIDs["GetReactions"]:                                   HashPerRoute,
+		RouteIDs["DeleteAllReactions"]:                             HashPerRoute,
+		RouteIDs["DeleteAllReactionsforEmoji"]:                     HashPerRoute,
+		RouteIDs["EditMessage"]:                                    HashPerRoute,
+		RouteIDs["DeleteMessage"]:                                  HashPerRoute,
+		RouteIDs["BulkDeleteMessages"]:                             HashPerRoute,
 		RouteIDs["EditChannelPermissions"]:                         HashPerRoute,
 		RouteIDs["GetChannelInvites"]:                              HashPerRoute,
 		RouteIDs["CreateChannelInvite"]:                            HashPerRoute,
@@ -11407,904 +13627,4241 @@ var (
 		RouteIDs["GetCurrentBotApplicationInformation"]:            HashPerRoute,
 		RouteIDs["GetCurrentAuthorizationInformation"]:             HashPerRoute,
 	}
-)
-
-const (
-	GlobalRateLimitRouteID = "0"
-)
+)
+
+const (
+	GlobalRateLimitRouteID = "0"
+)
+
+// RateLimiter represents an interface for rate limits.
+//
+// RateLimiter is an interface which allows developers to use multi-application architectures,
+// which run multiple applications on separate processes or servers.
+type RateLimiter interface {
+	// SetBucketID maps a Route ID to a Rate Limit Bucket ID (Discord Hash).
+	//
+	// ID 0 is reserved for a Global Rate Limit Bucket or nil.
+	SetBucketID(routeid string, bucketid string)
+
+	// GetBucketID gets a Rate Limit Bucket ID (Discord Hash) using a Route ID.
+	GetBucketID(routeid string) string
+
+	// SetBucketFromID maps a Bucket ID to a Rate Limit Bucket.
+	SetBucketFromID(bucketid string, bucket *Bucket)
+
+	// GetBucketFromID gets a Rate Limit Bucket using the given Bucket ID.
+	GetBucketFromID(bucketid string) *Bucket
+
+	// SetBucket maps a Route ID to a Rate Limit Bucket.
+	//
+	// ID 0 is reserved for a Global Rate Limit Bucket or nil.
+	SetBucket(routeid string, bucket *Bucket)
+
+	// GetBucket gets a Rate Limit Bucket using the given Route ID + Resource ID.
+	//
+	// Implements the Default Bucket mechanism by assigning the GetBucketID(routeid) when applicable.
+	GetBucket(routeid string, resourceid string) *Bucket
+
+	// SetDefaultBucket sets the Default Bucket for per-route rate limits.
+	SetDefaultBucket(bucket *Bucket)
+
+	// Lock locks the rate limiter.
+	//
+	// If the lock is already in use, the calling goroutine blocks until the rate limiter is available.
+	//
+	// This prevents multiple requests from being PROCESSED at once, which prevents race conditions.
+	// In other words, a single request is PROCESSED from a rate limiter when Lock is implemented and called.
+	//
+	// This does NOT prevent multiple requests from being SENT at a time.
+	Lock()
+
+	// Unlock unlocks the rate limiter.
+	//
+	// If the rate limiter holds multiple locks, unlocking will unblock another goroutine,
+	// which allows another request to be processed.
+	Unlock()
+
+	// StartTx starts a transaction with the rate limiter.
+	//
+	// If a transaction is already started, the calling goroutine blocks until the rate limiter is available.
+	//
+	// This prevents the transaction (of Rate Limit Bucket reads and writes) from concurrent manipulation.
+	StartTx()
+
+	// EndTx ends a transaction with the rate limiter.
+	//
+	// If the rate limiter holds multiple transactions, ending one will unblock another goroutine,
+	// which allows another transaction to start.
+	EndTx()
+}
+
+// Bucket represents a Discord API Rate Limit Bucket.
+type Bucket struct {
+	// Date represents the time at which Discord received the last request of the Bucket.
+	//
+	// Date is only applicable to Global Rate Limit Buckets.
+	Date time.Time
+
+	// Expiry represents the time at which the Bucket will reset (or become outdated).
+	Expiry time.Time
+
+	// ID represents the Bucket ID.
+	ID string
+
+	// Limit represents the amount of requests a Bucket can send per reset.
+	Limit int16
+
+	// Remaining represents the amount of requests a Bucket can send until the next reset.
+	Remaining int16
+
+	// Pending represents the amount of requests that are sent and awaiting a response.
+	Pending int16
+}
+
+// Reset resets a Discord API Rate Limit Bucket and sets its expiry.
+func (b *Bucket) Reset(expiry time.Time) {
+	b.Expiry = expiry
+
+	// Remaining = Limit - Pending
+	b.Remaining = b.Limit - b.Pending
+}
+
+// Use uses the given amount of tokens for a Discord API Rate Limit Bucket.
+func (b *Bucket) Use(amount int16) {
+	b.Remaining -= amount
+	b.Pending += amount
+}
+
+// ConfirmDate confirms the usage of a given amount of tokens for a Discord API Rate Limit Bucket,
+// using the bucket's current expiry and given (Discord Header) Date time.
+//
+// Used for the Global Rate Limit Bucket.
+func (b *Bucket) ConfirmDate(amount int16, date time.Time) {
+	b.Pending -= amount
+
+	switch {
+	// Date is zero when a request has never been sent to Discord.
+	//
+	// set the Date of the current Bucket to the date of the current Discord Bucket.
+	case b.Date.IsZero():
+		b.Date = date
+
+		// The EXACT reset period of Discord's Global Rate Limit Bucket will always occur
+		// BEFORE the current Bucket resets (due to this implementation).
+		//
+		// reset the current Bucket with an expiry that occurs [0, 1) seconds
+		// AFTER the Discord Global Rate Limit Bucket will be reset.
+		//
+		// This results in a Bucket's expiry that is eventually consistent with
+		// Discord's Bucket expiry over time (once determined between requests).
+		b.Expiry = time.Now().Add(time.Second)
+
+	// Date is EQUAL to the Discord Bucket's Date when the request applies to the current Bucket.
+	case b.Date.Equal(date):
+
+	// Date occurs BEFORE a Discord Bucket's Date when the request applies to the next Bucket.
+	//
+	// update the current Bucket to the next Bucket.
+	case b.Date.Before(date):
+		b.Date = date
+
+		// align the current Bucket's expiry to Discord's Bucket expiry.
+		b.Expiry = time.Now().Add(time.Second)
+
+	// Date occurs AFTER a Discord Bucket's Date when the request applied to a previous Bucket.
+	case b.Date.After(date):
+		b.Remaining += amount
+	}
+}
+
+// ConfirmHeader confirms the usage of a given amount of tokens for a Discord API Rate Limit Bucket,
+// using a given Route ID and respective Discord Rate Limit Header.
+//
+// Used for Route Rate Limits.
+func (b *Bucket) ConfirmHeader(amount int16, header RateLimitHeader) {
+	b.Pending -= amount
+
+	// determine the reset time.
+	//
+	// Discord recommends to rely on the `Retry-After` header.
+	// https://discord.com/developers/docs/topics/rate-limits#exceeding-a-rate-limit
+	reset := time.Now().Add(time.Millisecond*time.Duration(header.ResetAfter*msPerSecond) + time.Millisecond)
+
+	// Expiry is zero when a request from the Route ID has never been sent to Discord.
+	//
+	// set the current Bucket to the current Discord Bucket.
+	if b.Expiry.IsZero() {
+		b.Limit = int16(header.Limit)
+		b.Remaining = int16(header.Remaining) - b.Pending
+		b.Expiry = reset
+
+		return
+	}
+
+	switch {
+	// Expiry is EQUAL to the Discord Bucket's Reset when the request applies to the current Bucket.
+	case b.Expiry == reset:
+
+	// Expiry occurs BEFORE a Discord Bucket's Reset when the request applies to the next Bucket.
+	//
+	// update the current Bucket to the next Bucket.
+	case b.Expiry.Before(reset):
+		b.Limit = int16(header.Limit)
+		b.Expiry = reset
+
+	// Expiry occurs AFTER a Discord Bucket's Reset when the request applied to a previous Bucket.
+	case b.Expiry.After(reset):
+		b.Remaining += amount
+	}
+}
+
+// Conversion Constants.
+const (
+	base10              = 10
+	bit64               = 64
+	msPerSecond float64 = 1000
+)
+
+// Content Types
+// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Type
+var (
+	// ContentTypeURLQueryString is an HTTP Header Content Type that indicates
+	// a payload with an encoded URL Query String.
+	ContentTypeURLQueryString = []byte("application/x-www-form-urlencoded")
+
+	// ContentTypeJSON is an HTTP Header Content Type that indicates a payload with a JSON body.
+	ContentTypeJSON = []byte("application/json")
+
+	// ContentTypeMultipartForm is an HTTP Header Content Type that indicates
+	// a payload with multiple content types.
+	ContentTypeMultipartForm = []byte("multipart/form-data")
+
+	// ContentTypeJPEG is an HTTP Header Content Type that indicates a payload with a JPEG image.
+	ContentTypeJPEG = []byte("image/jpeg")
+
+	// ContentTypePNG is an HTTP Header Content Type that indicates a payload with a PNG image.
+	ContentTypePNG = []byte("image/png")
+
+	// ContentTypeWebP is an HTTP Header Content Type that indicates a payload with a WebP image.
+	ContentTypeWebP = []byte("image/webp")
+
+	// ContentTypeGIF is an HTTP Header Content Type that indicates a payload with a GIF animated image.
+	ContentTypeGIF = []byte("image/gif")
+)
+
+// HTTP Header Variables.
+const (
+	// headerAuthorizationKey represents the key for an "Authorization" HTTP Header.
+	headerAuthorizationKey = "Authorization"
+)
+
+// HTTP Header Rate Limit Variables.
+var (
+	// headerDate represents a byte representation of "Date" for HTTP Header functionality.
+	headerDate = []byte(FlagRateLimitHeaderDate)
+
+	// headerRetryAfter represents a byte representation of "Retry-After" for HTTP Header functionality.
+	headerRateLimitRetryAfter = []byte(FlagRateLimitHeaderRetryAfter)
+
+	// headerRateLimit represents a byte representation of "X-RateLimit-Limit" for HTTP Header functionality.
+	headerRateLimit = []byte(FlagRateLimitHeaderLimit)
+
+	// headerRateLimitRemaining represents a byte representation of "X-RateLimit-Remaining" for HTTP Header functionality.
+	headerRateLimitRemaining = []byte(FlagRateLimitHeaderRemaining)
+
+	// headerRateLimitReset represents a byte representation of "X-RateLimit-Reset" for HTTP Header functionality.
+	headerRateLimitReset = []byte(FlagRateLimitHeaderReset)
+
+	// headerRateLimitResetAfter represents a byte representation of "X-RateLimit-Reset-After" for HTTP Header functionality.
+	headerRateLimitResetAfter = []byte(FlagRateLimitHeaderResetAfter)
+
+	// headerRateLimitBucket represents a byte representation of "X-RateLimit-Bucket" for HTTP Header functionality.
+	headerRateLimitBucket = []byte(FlagRateLimitHeaderBucket)
+
+	// headerRateLimitGlobal represents a byte representation of "X-RateLimit-Global" for HTTP Header functionality.
+	headerRateLimitGlobal = []byte(FlagRateLimitHeaderGlobal)
+
+	// headerRateLimitScope represents a byte representation of "X-RateLimit-Scope" for HTTP Header functionality.
+	headerRateLimitScope = []byte(FlagRateLimitHeaderScope)
+)
+
+// peekDate peeks an HTTP Header for the Date.
+func peekDate(r *fasthttp.Response) (time.Time, error) {
+	date, err := time.Parse(time.RFC1123, string(r.Header.PeekBytes(headerDate)))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error occurred parsing the \"Date\" HTTP Header: %w", err)
+	}
+
+	return date, nil
+}
+
+// peekHeaderRetryAfter peeks an HTTP Header for the Rate Limit Header "Retry-After".
+func peekHeaderRetryAfter(r *fasthttp.Response) (float64, error) {
+	retryafter, err := strconv.ParseFloat(string(r.Header.PeekBytes(headerRateLimitRetryAfter)), bit64)
+	if err != nil {
+		return 0, fmt.Errorf(errRateLimit, string(headerRateLimitRetryAfter), err)
+	}
+
+	return retryafter, nil
+}
+
+// peekHeaderRateLimit peeks an HTTP Header for Discord Rate Limit Header values.
+func peekHeaderRateLimit(r *fasthttp.Response) RateLimitHeader {
+	limit, _ := strconv.Atoi(string(r.Header.PeekBytes(headerRateLimit)))
+	remaining, _ := strconv.Atoi(string(r.Header.PeekBytes(headerRateLimitRemaining)))
+	reset, _ := strconv.ParseFloat(string(r.Header.PeekBytes(headerRateLimitReset)), bit64)
+	resetafter, _ := strconv.ParseFloat(string(r.Header.PeekBytes(headerRateLimitResetAfter)), bit64)
+	global, _ := strconv.ParseBool(string(r.Header.PeekBytes(headerRateLimitGlobal)))
+
+	return RateLimitHeader{
+		Limit:      limit,
+		Remaining:  remaining,
+		Reset:      reset,
+		ResetAfter: resetafter,
+		Bucket:     string(r.Header.PeekBytes(headerRateLimitBucket)),
+		Global:     global,
+		Scope:      string(r.Header.PeekBytes(headerRateLimitScope)),
+	}
+}
+
+// SendRequest sends a fasthttp.Request using the given route ID, HTTP method, URI, content type and body,
+// then parses the response into dst.
+func SendRequest(bot *Client, xid, routeid, resourceid, method, uri string, content, body []byte, dst any) error { //nolint:gocyclo,maintidx
+	retries := 0
+	requestid := routeid + resourceid
+	request := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(request)
+	request.Header.SetMethod(method)
+	request.Header.SetContentTypeBytes(content)
+	request.Header.Set(headerAuthorizationKey, bot.Authentication.Header)
+	request.SetRequestURI(uri)
+	request.SetBodyRaw(body)
+	response := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(response)
+
+	// Certain endpoints are not bound to the bot's Global Rate Limit.
+	if IgnoreGlobalRateLimitRouteIDs[requestid] {
+		goto SEND
+	}
+
+RATELIMIT:
+	// a single request or response is PROCESSED at any point in time.
+	bot.Config.Request.RateLimiter.Lock()
+
+	LogRequest(Logger.Trace(), bot.ApplicationID, xid, routeid, resourceid, uri).Msg("processing request")
+	if Logger.GetLevel() == zerolog.TraceLevel {
+		LogRequestBody(Logger.Trace(), bot.ApplicationID, xid, routeid, resourceid, uri, string(body)).Msg("")
+	}
+
+	// check Global and Route Rate Limit Buckets prior to sending the current request.
+	for {
+		bot.Config.Request.RateLimiter.StartTx()
+
+		globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, "")
+
+		// stop waiting when the Global Rate Limit Bucket is NOT empty.
+		if isNotEmpty(globalBucket) {
+			routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid)
+
+			if isNotEmpty(routeBucket) {
+				break
+			}
+
+			if isExpired(routeBucket) {
+				// When a Route Bucket expires, its new expiry becomes unknown.
+				// As a result, it will never reset (again) until a pending request's
+				// response sets a new expiry.
+				routeBucket.Reset(time.Time{})
+			}
+
+			var wait time.Time
+			if routeBucket != nil {
+				wait = routeBucket.Expiry
+			}
+
+			// do NOT block other requests due to a Route Rate Limit.
+			bot.Config.Request.RateLimiter.EndTx()
+			bot.Config.Request.RateLimiter.Unlock()
+
+			// reduce CPU usage by blocking the current goroutine
+			// until it's eligible for action.
+			if routeBucket != nil {
+				<-time.After(time.Until(wait))
+			}
+
+			goto RATELIMIT
+		}
+
+		// reset the Global Rate Limit Bucket when the current Bucket has passed its expiry.
+		if isExpired(globalBucket) {
+			globalBucket.Reset(time.Now().Add(time.Second))
+		}
+
+		bot.Config.Request.RateLimiter.EndTx()
+	}
+
+	if globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, ""); globalBucket != nil {
+		globalBucket.Use(1)
+	}
+
+	if routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid); routeBucket != nil {
+		routeBucket.Use(1)
+	}
+
+	bot.Config.Request.RateLimiter.EndTx()
+	bot.Config.Request.RateLimiter.Unlock()
+
+SEND:
+	LogRequest(Logger.Trace(), bot.ApplicationID, xid, routeid, resourceid, uri).Msg("sending request")
+
+	// send the request.
+	if err := bot.Config.Request.Client.DoTimeout(request, response, bot.Config.Request.Timeout); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	LogResponse(LogRequest(Logger.Info(), bot.ApplicationID, xid, routeid, resourceid, uri),
+		response.Header.String(), string(response.Body()),
+	).Msg("")
+
+	var header RateLimitHeader
+
+	// confirm the response with the rate limiter.
+	//
+	// Certain endpoints are not bound to the bot's Global Rate Limit.
+	if !IgnoreGlobalRateLimitRouteIDs[requestid] {
+		// parse the Rate Limit Header for per-route rate limit functionality.
+		header = peekHeaderRateLimit(response)
+
+		// parse the Date header for Global Rate Limit functionality.
+		date, err := peekDate(response)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		bot.Config.Request.RateLimiter.StartTx()
+
+		// confirm the Global Rate Limit Bucket.
+		globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, "")
+		if globalBucket != nil {
+			globalBucket.ConfirmDate(1, date)
+		}
+
+		// confirm the Route Rate Limit Bucket (if applicable).
+		routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid)
+		switch {
+		// when there is no Discord Bucket, remove the route's mapping to a rate limit Bucket.
+		case header.Bucket == "":
+			if routeBucket != nil {
+				bot.Config.Request.RateLimiter.SetBucketID(requestid, nilRouteBucket)
+				routeBucket = nil
+			}
+
+		// when the route's Bucket ID does NOT match the Discord Bucket, update it.
+		case routeBucket == nil && header.Bucket != "" || routeBucket.ID != header.Bucket:
+			var pending int16
+			if routeBucket != nil {
+				pending = routeBucket.Pending
+			}
+
+			// update the route ID mapping to a rate limit Bucket ID.
+			bot.Config.Request.RateLimiter.SetBucketID(requestid, header.Bucket)
+
+			// map the Bucket ID to the updated Rate Limit Bucket.
+			if bucket := bot.Config.Request.RateLimiter.GetBucketFromID(header.Bucket); bucket != nil {
+				routeBucket = bucket
+			} else {
+				routeBucket = getBucket()
+				bot.Config.Request.RateLimiter.SetBucketFromID(header.Bucket, routeBucket)
+			}
+
+			routeBucket.Pending += pending
+			routeBucket.ID = header.Bucket
+		}
+
+		if routeBucket != nil {
+			routeBucket.ConfirmHeader(1, header)
+		}
+
+		if response.StatusCode() != fasthttp.StatusTooManyRequests {
+			bot.Config.Request.RateLimiter.EndTx()
+		}
+	}
+
+	// handle the response.
+	switch response.StatusCode() {
+	case fasthttp.StatusOK, fasthttp.StatusCreated:
+		// parse the response data.
+		if err := json.Unmarshal(response.Body(), dst); err != nil {
+			return fmt.Errorf(errUnmarshal, dst, err)
+		}
+
+		return nil
+
+	case fasthttp.StatusNoContent:
+		return nil
+
+	// process the rate limit.
+	case fasthttp.StatusTooManyRequests:
+		retry := retries < bot.Config.Request.Retries
+		retries++
+
+		switch header.Scope { //nolint:gocritic
+		// Discord per-resource (shared) rate limit headers include the per-route (user) bucket.
+		//
+		// when a per-resource rate limit is encountered, send another request or return.
+		case RateLimitScopeValueShared:
+			bot.Config.Request.RateLimiter.EndTx()
+
+			if retry || bot.Config.Request.RetryShared {
+				goto RATELIMIT
+			}
+
+			return StatusCodeError(response.StatusCode())
+		}
+
+		// parse the rate limit response data for `retry_after`.
+		var data RateLimitResponse
+		if err := json.Unmarshal(response.Body(), &data); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		// determine the reset time.
+		var reset time.Time
+		if data.RetryAfter == 0 {
+			// when the 429 is from Discord, use the `retry_after` value (s).
+			reset = time.Now().Add(time.Millisecond * time.Duration(data.RetryAfter*msPerSecond))
+		} else {
+			// when the 429 is from a Cloudflare Ban, use the `"Retry-After"` value (s).
+			retryafter, err := peekHeaderRetryAfter(response)
+			if err != nil {
+				return fmt.Errorf("%w", err)
+			}
+
+			reset = time.Now().Add(time.Millisecond * time.Duration(retryafter*msPerSecond))
+		}
+
+		if data.Code == nil {
+			LogRequest(Logger.Debug(), bot.ApplicationID, xid, routeid, resourceid, uri).
+				Time(LogCtxReset, reset).Msg("")
+		} else {
+			LogRequest(Logger.Debug(), bot.ApplicationID, xid, routeid, resourceid, uri).
+				Time(LogCtxReset, reset).
+				Err(JSONCodeError(*data.Code)).Msg("")
+		}
+
+		switch header.Global {
+		// when the global request rate limit is encountered.
+		case true:
+			// when the current time is BEFORE the reset time,
+			// all requests must wait until the 429 expires.
+			if time.Now().Before(reset) {
+				if globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, ""); globalBucket != nil {
+					globalBucket.Remaining = 0
+					globalBucket.Expiry = reset.Add(time.Millisecond)
+				}
+			}
+
+			bot.Config.Request.RateLimiter.EndTx()
+
+		// when a per-route (user) rate limit is encountered.
+		case false:
+			// do NOT block other requests while waiting for a Route Rate Limit.
+			bot.Config.Request.RateLimiter.EndTx()
+
+			// when the current time is BEFORE the reset time,
+			// requests with the same Rate Limit Bucket must wait until the 429 expires.
+			if time.Now().Before(reset) {
+				if routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid); routeBucket != nil {
+					routeBucket.Remaining = 0
+					routeBucket.Expiry = reset.Add(time.Millisecond)
+				}
+			}
+		}
+
+		if retry {
+			goto RATELIMIT
+		}
+
+		return StatusCodeError(fasthttp.StatusTooManyRequests)
+
+	// retry the request on a bad gateway server error.
+	case fasthttp.StatusBadGateway:
+		if retries < bot.Config.Request.Retries {
+			retries++
+
+			goto RATELIMIT
+		}
+
+		return StatusCodeError(fasthttp.StatusBadGateway)
+
+	default:
+		return StatusCodeError(response.StatusCode())
+	}
+}
+
+// isExpired determines whether a rate limit Bucket is expired.
+func isExpired(b *Bucket) bool {
+	// a rate limit bucket is expired when
+	// 1. the bucket exists AND
+	// 2. the current time occurs after the non-zero expiry time.
+	return b != nil && !b.Expiry.IsZero() && time.Now().After(b.Expiry)
+}
+
+// isNotEmpty determines whether a rate limit Bucket is NOT empty.
+func isNotEmpty(b *Bucket) bool {
+	// a rate limit bucket is NOT empty when
+	// 1. the bucket does not exist OR
+	// 2. there is one or more remaining request token(s).
+	return b == nil || b.Remaining > 0
+}
+
+// boundary represents the boundary that is used in every multipart form.
+var boundary = randomBoundary()
+
+// randomBoundary generates a random value to be used as a boundary in multipart forms.
+//
+// Implemented from the mime/multipart package.
+func randomBoundary() string {
+	var buf [30]byte
+	_, err := io.ReadFull(rand.Reader, buf[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return fmt.Sprintf("%x", buf[:])
+}
+
+// quoteEscaper escapes quotes and backslashes in a multipart form.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// createMultipartForm creates a multipart/form for Discord using a given JSON body and files.
+func createMultipartForm(json []byte, files ...*File) ([]byte, []byte, error) {
+	form := bytes.NewBuffer(nil)
+
+	// set the boundary.
+	multipartWriter := multipart.NewWriter(form)
+	err := multipartWriter.SetBoundary(boundary)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error setting multipart form boundary: %w", err)
+	}
+
+	// add the `payload_json` JSON to the form.
+	multipartPayloadJSONPart, err := createPayloadJSONForm(multipartWriter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error adding JSON payload header to multipart form: %w", err)
+	}
+
+	if _, err := multipartPayloadJSONPart.Write(json); err != nil {
+		return nil, nil, fmt.Errorf("error writing JSON payload data to multipart form: %w", err)
+	}
+
+	// add the remaining files to the form.
+	for i, file := range files {
+		name := strings.Join([]string{"files[", strconv.Itoa(i), "]"}, "")
+		multipartFilePart, err := createFormFile(multipartWriter, name, file.Name, file.ContentType)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error adding a file %q to a multipart form: %w", file.Name, err)
+		}
+
+		if _, err := multipartFilePart.Write(file.Data); err != nil {
+			return nil, nil, fmt.Errorf("error writing file %q data to multipart form: %w", file.Name, err)
+		}
+	}
+
+	// write the trailing boundary.
+	if err := multipartWriter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("error closing the multipart form: %w", err)
+	}
+
+	return []byte(multipartWriter.FormDataContentType()), form.Bytes(), nil
+}
+
+var (
+	// contentTypeJSONString is an HTTP Header Content Type that indicates a payload with a JSON body.
+	contentTypeJSONString = "application/json"
+
+	// contentTypeOctetStreamString is an HTTP Header Content Type that indicates a payload with binary data.
+	contentTypeOctetStreamString = "application/octet-stream"
+)
+
+// createPayloadJSONForm creates a form-data header for the `payload_json` file in a multipart form.
+func createPayloadJSONForm(m *multipart.Writer) (io.Writer, error) {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="payload_json"`)
+	h.Set("Content-Type", contentTypeJSONString)
+
+	return m.CreatePart(h) //nolint:wrapcheck
+}
+
+// createFormFile creates a form-data header for file attachments in a multipart form.
+func createFormFile(m *multipart.Writer, name, filename, contentType string) (io.Writer, error) {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition",
+		fmt.Sprintf(`form-data; name="%s"; filename="%s"`, name, quoteEscaper.Replace(filename)))
+
+	if contentType == "" {
+		contentType = contentTypeOctetStreamString
+	}
+
+	h.Set("Content-Type", contentType)
+
+	return m.CreatePart(h) //nolint:wrapcheck
+}
+
+// DefaultAllowedMentions returns the default allowed mentions of a client (see Request.AllowedMentions),
+// which mention the users of a message (and the author of a replied message),
+// but NEVER @everyone, @here, or roles.
+//
+// The Roles and Users are NOT set, since Discord rejects allowed mentions which parse users
+// while specifying users (or parse roles while specifying roles).
+//
+// https://discord.com/developers/docs/resources/channel#allowed-mentions-object
+func DefaultAllowedMentions() *AllowedMentions {
+	return &AllowedMentions{
+		Parse:       []*string{Pointer(FlagAllowedMentionTypeUsers)},
+		Roles:       nil,
+		Users:       nil,
+		RepliedUser: true,
+	}
+}
+
+// applyAllowedMentions returns a request with the default allowed mentions of the client
+// when the request produces a message without allowed mentions.
+//
+// The given request is NOT modified: A copy is returned when the default is applied.
+func applyAllowedMentions[T any](bot *Client, r *T) *T {
+	if bot.Config == nil || bot.Config.Request.AllowedMentions == nil {
+		return r
+	}
+
+	mentions := bot.Config.Request.AllowedMentions
+
+	c := *r
+
+	switch request := any(&c).(type) {
+	case *CreateMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = mentions
+
+	case *EditMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	case *StartThreadinForumChannel:
+		if request.Message == nil || request.Message.AllowedMentions != nil {
+			return r
+		}
+
+		message := *request.Message
+		message.AllowedMentions = mentions
+		request.Message = &message
+
+	case *ExecuteWebhook:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = mentions
+
+	case *EditWebhookMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	case *CreateInteractionResponse:
+		if request.InteractionResponse == nil {
+			return r
+		}
+
+		var data Messages
+
+		switch message := request.Data.(type) {
+		case *Messages:
+			if message == nil || message.AllowedMentions != nil {
+				return r
+			}
+
+			data = *message
+
+		case Messages:
+			if message.AllowedMentions != nil {
+				return r
+			}
+
+			data = message
+
+		default:
+			return r
+		}
+
+		data.AllowedMentions = mentions
+		response := *request.InteractionResponse
+		response.Data = &data
+		request.InteractionResponse = &response
+
+	case *EditOriginalInteractionResponse:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	case *CreateFollowupMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = mentions
+
+	case *EditFollowupMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	default:
+		return r
+	}
+
+	return &c
+}
+
+// PermissionState represents an interface for the state (i.e., a cache) of guilds, members, and channels,
+// which is used to check the permissions of a request before it's sent.
+type PermissionState interface {
+	// Guild returns the guild (with its roles) with the given ID.
+	Guild(guildID string) (*Guild, bool)
+
+	// Member returns the member of a guild with the given user ID.
+	Member(guildID, userID string) (*GuildMember, bool)
+
+	// Channel returns the channel with the given ID.
+	Channel(channelID string) (*Channel, bool)
+}
+
+// RoutePermission represents the permissions that a route requires.
+type RoutePermission struct {
+	// Fields represents a map of request field names to the permissions
+	// that are required when the field is set.
+	Fields map[string]BitFlag
+
+	// HierarchyFields represents the request fields that require the role hierarchy when set,
+	// such that the role hierarchy is NOT required when none of these fields are set.
+	HierarchyFields []string
+
+	// Permissions represents the permissions that are required.
+	Permissions BitFlag
+
+	// Hierarchy represents whether the highest role of the bot must be higher than
+	// the highest role of the request's member (UserID) and the request's role (RoleID).
+	Hierarchy bool
+}
+
+// RoutePermissions represents a map of Routes (see RouteIDs) to the permissions they require.
+//
+// The fields of a route's RoutePermission are resolved (and cached) when a request of the route is first checked.
+//
+// https://discord.com/developers/docs/topics/permissions#permissions-bitwise-permission-flags
+var RoutePermissions = map[string]RoutePermission{
+	"GetGuildAuditLog":                {Permissions: FlagBitwisePermissionVIEW_AUDIT_LOG},
+	"ListAutoModerationRulesForGuild": {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"GetAutoModerationRule":           {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"CreateAutoModerationRule":        {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"ModifyAutoModerationRule":        {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"DeleteAutoModerationRule":        {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"ModifyChannelGuild":              {Permissions: FlagBitwisePermissionMANAGE_CHANNELS},
+	"GetChannelMessages":              {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY},
+	"GetChannelMessage":               {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY},
+	"CreateMessage":                   {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionSEND_MESSAGES},
+	"CreateReaction":                  {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY | FlagBitwisePermissionADD_REACTIONS},
+	"DeleteUserReaction":              {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"DeleteAllReactions":              {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"DeleteAllReactionsforEmoji":      {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"BulkDeleteMessages":              {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"EditChannelPermissions":          {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"DeleteChannelPermission":         {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"GetChannelInvites":               {Permissions: FlagBitwisePermissionMANAGE_CHANNELS},
+	"CreateChannelInvite":             {Permissions: FlagBitwisePermissionCREATE_INSTANT_INVITE},
+	"TriggerTypingIndicator":          {Permissions: FlagBitwisePermissionSEND_MESSAGES},
+	"PinMessage":                      {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"UnpinMessage":                    {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"ModifyGuild":                     {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"CreateGuildChannel":              {Permissions: FlagBitwisePermissionMANAGE_CHANNELS},
+	"ModifyGuildMember": {
+		Fields: map[string]BitFlag{
+			"Nick":                       FlagBitwisePermissionMANAGE_NICKNAMES,
+			"Roles":                      FlagBitwisePermissionMANAGE_ROLES,
+			"Mute":                       FlagBitwisePermissionMUTE_MEMBERS,
+			"Deaf":                       FlagBitwisePermissionDEAFEN_MEMBERS,
+			"ChannelID":                  FlagBitwisePermissionMOVE_MEMBERS,
+			"CommunicationDisabledUntil": FlagBitwisePermissionMODERATE_MEMBERS,
+		},
+		HierarchyFields: []string{"Nick", "Roles", "CommunicationDisabledUntil"},
+		Hierarchy:       true,
+	},
+	"AddGuildMemberRole":       {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"RemoveGuildMemberRole":    {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"RemoveGuildMember":        {Permissions: FlagBitwisePermissionKICK_MEMBERS, Hierarchy: true},
+	"GetGuildBans":             {Permissions: FlagBitwisePermissionBAN_MEMBERS},
+	"GetGuildBan":              {Permissions: FlagBitwisePermissionBAN_MEMBERS},
+	"CreateGuildBan":           {Permissions: FlagBitwisePermissionBAN_MEMBERS, Hierarchy: true},
+	"RemoveGuildBan":           {Permissions: FlagBitwisePermissionBAN_MEMBERS},
+	"CreateGuildRole":          {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"ModifyGuildRolePositions": {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"ModifyGuildRole":          {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"DeleteGuildRole":          {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"GetGuildPruneCount":       {Permissions: FlagBitwisePermissionKICK_MEMBERS},
+	"BeginGuildPrune":          {Permissions: FlagBitwisePermissionKICK_MEMBERS},
+	"GetGuildInvites":          {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"GetGuildIntegrations":     {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"DeleteGuildIntegration":   {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"CreateWebhook":            {Permissions: FlagBitwisePermissionMANAGE_WEBHOOKS},
+	"GetChannelWebhooks":       {Permissions: FlagBitwisePermissionMANAGE_WEBHOOKS},
+	"GetGuildWebhooks":         {Permissions: FlagBitwisePermissionMANAGE_WEBHOOKS},
+}
+
+// Preflight checks whether the bot has the permissions (and role hierarchy) that a request requires
+// using the bot's PermissionState (Config.Request.Preflight), such that a request that would fail with
+// a Missing Permissions error (50013) is NOT sent.
+//
+// An ErrorPermissions is returned when a permission is missing. A request is NOT checked when
+// the bot has no PermissionState (or PreflightUserID), its route has no RoutePermission,
+// or its state is NOT available.
+func Preflight(bot *Client, request any) error {
+	state := bot.Config.Request.Preflight
+	if state == nil || bot.Config.Request.PreflightUserID == "" {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(request))
+
+	route := v.Type().Name()
+
+	permission, ok := RoutePermissions[route]
+	if !ok {
+		return nil
+	}
+
+	fields := routeFieldsOf(v.Type(), permission)
+
+	required := permission.Permissions
+	for _, field := range fields.permissions {
+		if isSet(v, field.index) {
+			required |= field.permissions
+		}
+	}
+
+	guildID := stringField(v, fields.guildID)
+	channelID := stringField(v, fields.channelID)
+
+	var channel *Channel
+	if channelID != "" {
+		if channel, ok = state.Channel(channelID); !ok || channel.GuildID == nil {
+			return nil
+		}
+
+		guildID = *channel.GuildID
+
+		// the permissions of a thread are the permissions of its parent channel.
+		if channel.Type != nil && (*channel.Type == FlagChannelTypeANNOUNCEMENT_THREAD ||
+			*channel.Type == FlagChannelTypePUBLIC_THREAD ||
+			*channel.Type == FlagChannelTypePRIVATE_THREAD) {
+			if channel.ParentID == nil || *channel.ParentID == nil {
+				return nil
+			}
+
+			if channel, ok = state.Channel(**channel.ParentID); !ok {
+				return nil
+			}
+
+			if required&FlagBitwisePermissionSEND_MESSAGES != 0 {
+				required = required&^FlagBitwisePermissionSEND_MESSAGES | FlagBitwisePermissionSEND_MESSAGES_IN_THREADS
+			}
+		}
+	}
+
+	if guildID == "" {
+		return nil
+	}
+
+	guild, ok := state.Guild(guildID)
+	if !ok {
+		return nil
+	}
+
+	member, ok := state.Member(guildID, bot.Config.Request.PreflightUserID)
+	if !ok {
+		return nil
+	}
+
+	var granted BitFlag
+	var err error
+
+	if channel != nil {
+		granted, err = ChannelPermissions(guild, member, channel)
+	} else {
+		granted, err = BasePermissions(guild, member)
+	}
+
+	if err != nil {
+		return fmt.Errorf("preflight: %w", err)
+	}
+
+	if missing := MissingPermissions(granted, required); missing != 0 {
+		return ErrorPermissions{Route: route, GuildID: guildID, ChannelID: channelID, Missing: missing, Hierarchy: false}
+	}
+
+	if permission.Hierarchy && hierarchyRequired(fields, v) && !hierarchy(state, guild, member, fields, v) {
+		return ErrorPermissions{Route: route, GuildID: guildID, ChannelID: channelID, Missing: 0, Hierarchy: true}
+	}
+
+	return nil
+}
+
+// routeFields represents the indexes of the request fields that are used to check a route's permissions.
+type routeFields struct {
+	// permissions represents the fields that require permissions when set.
+	permissions []routeField
+
+	// hierarchy represents the indexes of the fields that require the role hierarchy when set.
+	hierarchy [][]int
+
+	// guildID, channelID, userID, and roleID represent the indexes of the request's ID fields.
+	guildID   []int
+	channelID []int
+	userID    []int
+	roleID    []int
+}
+
+// routeField represents the index of a request field that requires permissions when set.
+type routeField struct {
+	index       []int
+	permissions BitFlag
+}
+
+// routeFieldsCache represents a map of request types to their routeFields.
+var routeFieldsCache sync.Map
+
+// routeFieldsOf returns the (cached) routeFields of a request type.
+func routeFieldsOf(t reflect.Type, permission RoutePermission) *routeFields {
+	if fields, ok := routeFieldsCache.Load(t); ok {
+		return fields.(*routeFields) //nolint:forcetypeassert
+	}
+
+	fields := &routeFields{
+		permissions: make([]routeField, 0, len(permission.Fields)),
+		hierarchy:   make([][]int, 0, len(permission.HierarchyFields)),
+		guildID:     stringFieldIndex(t, "GuildID"),
+		channelID:   stringFieldIndex(t, "ChannelID"),
+		userID:      stringFieldIndex(t, "UserID"),
+		roleID:      stringFieldIndex(t, "RoleID"),
+	}
+
+	for name, permissions := range permission.Fields {
+		if field, ok := t.FieldByName(name); ok {
+			fields.permissions = append(fields.permissions, routeField{index: field.Index, permissions: permissions})
+		}
+	}
+
+	for _, name := range permission.HierarchyFields {
+		if field, ok := t.FieldByName(name); ok {
+			fields.hierarchy = append(fields.hierarchy, field.Index)
+		}
+	}
+
+	cached, _ := routeFieldsCache.LoadOrStore(t, fields)
+
+	return cached.(*routeFields) //nolint:forcetypeassert
+}
+
+// stringFieldIndex returns the index of a struct type's string field (or nil).
+func stringFieldIndex(t reflect.Type, name string) []int {
+	field, ok := t.FieldByName(name)
+	if !ok || field.Type.Kind() != reflect.String {
+		return nil
+	}
+
+	return field.Index
+}
+
+// hierarchyRequired determines whether a request requires the role hierarchy using its set fields.
+func hierarchyRequired(fields *routeFields, request reflect.Value) bool {
+	if len(fields.hierarchy) == 0 {
+		return true
+	}
+
+	for _, index := range fields.hierarchy {
+		if isSet(request, index) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hierarchy determines whether the highest role of a member is higher than
+// the highest role of the request's member (UserID) and the request's role (RoleID).
+func hierarchy(state PermissionState, guild *Guild, member *GuildMember, fields *routeFields, request reflect.Value) bool {
+	if member.User.ID == guild.OwnerID {
+		return true
+	}
+
+	position := highestRole(guild, member)
+
+	if userID := stringField(request, fields.userID); userID != "" && userID != member.User.ID {
+		if userID == guild.OwnerID {
+			return false
+		}
+
+		if target, ok := state.Member(guild.ID, userID); ok && highestRole(guild, target) >= position {
+			return false
+		}
+	}
+
+	if roleID := stringField(request, fields.roleID); roleID != "" {
+		for _, role := range guild.Roles {
+			if role != nil && role.ID == roleID && role.Position >= position {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// highestRole returns the position of the highest role of a member.
+func highestRole(guild *Guild, member *GuildMember) int {
+	roles := make(map[string]bool, len(member.Roles))
+	for _, id := range member.Roles {
+		if id != nil {
+			roles[*id] = true
+		}
+	}
+
+	var position int
+	for _, role := range guild.Roles {
+		if role != nil && roles[role.ID] && role.Position > position {
+			position = role.Position
+		}
+	}
+
+	return position
+}
+
+// stringField returns the value of a struct's string field at the given index (or an empty string).
+func stringField(v reflect.Value, index []int) string {
+	if index == nil {
+		return ""
+	}
+
+	field, err := v.FieldByIndexErr(index)
+	if err != nil {
+		return ""
+	}
+
+	return field.String()
+}
+
+// isSet determines whether a struct's field at the given index is set (non-zero).
+func isSet(v reflect.Value, index []int) bool {
+	field, err := v.FieldByIndexErr(index)
+
+	return err == nil && !field.IsZero()
+}
+
+var (
+	// qsEncoder is used to create URL Query Strings from objects.
+	qsEncoder = schema.NewEncoder()
+)
+
+// init runs at the start of the program.
+func init() {
+	// use `url` tags for the URL Query String encoder and decoder.
+	qsEncoder.SetAliasTag("url")
+}
+
+// EndpointQueryString returns a URL Query String from a given object.
+func EndpointQueryString(dst any) (string, error) {
+	params := url.Values{}
+	err := qsEncoder.Encode(dst, params)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	return params.Encode(), nil
+}
+
+var (
+	// RouteIDs represents a map of Routes to Route IDs (map[string]uint8).
+	RouteIDs = map[string]uint8{
+		"":                                               0,
+		"OAuth":                                          1,
+		"GetGlobalApplicationCommands":                   2,
+		"CreateGlobalApplicationCommand":                 3,
+		"GetGlobalApplicationCommand":                    4,
+		"EditGlobalApplicationCommand":                   5,
+		"DeleteGlobalApplicationCommand":                 6,
+		"BulkOverwriteGlobalApplicationCommands":         7,
+		"GetGuildApplicationCommands":                    8,
+		"CreateGuildApplicationCommand":                  9,
+		"GetGuildApplicationCommand":                     10,
+		"EditGuildApplicationCommand":                    11,
+		"DeleteGuildApplicationCommand":                  12,
+		"BulkOverwriteGuildApplicationCommands":          13,
+		"GetGuildApplicationCommandPermissions":          14,
+		"GetApplicationCommandPermissions":               15,
+		"EditApplicationCommandPermissions":              16,
+		"BatchEditApplicationCommandPermissions":         17,
+		"CreateInteractionResponse":                      18,
+		"GetOriginalInteractionResponse":                 19,
+		"EditOriginalInteractionResponse":                20,
+		"DeleteOriginalInteractionResponse":              21,
+		"CreateFollowupMessage":                          22,
+		"GetFollowupMessage":                             23,
+		"EditFollowupMessage":                            24,
+		"DeleteFollowupMessage":                          25,
+		"GetCurrentApplication":                          26,
+		"GetApplicationRoleConnectionMetadataRecords":    27,
+		"UpdateApplicationRoleConnectionMetadataRecords": 28,
+		"GetGuildAuditLog":                               29,
+		"ListAutoModerationRulesForGuild":                30,
+		"GetAutoModerationRule":                          31,
+		"CreateAutoModerationRule":                       32,
+		"ModifyAutoModerationRule":                       33,
+		"DeleteAutoModerationRule":                       34,
+		"GetChannel":                                     35,
+		"ModifyChannel":                                  36,
+		"ModifyChannelGroupDM":                           37,
+		"ModifyChannelGuild":                             38,
+		"ModifyChannelThread":                            39,
+		"DeleteCloseChannel":                             40,
+		"GetChannelMessages":                             41,
+		"GetChannelMessage":                              42,
+		"CreateMessage":                                  43,
+		"CrosspostMessage":                               44,
+		"CreateReaction":                                 45,
+		"DeleteOwnReaction":                              46,
+		"DeleteUserReaction":                             47,
+		"GetReactions":                                   48,
+		"DeleteAllReactions":                             49,
+		"DeleteAllReactionsforEmoji":                     50,
+		"EditMessage":                                    51,
+		"DeleteMessage":                                  52,
+		"BulkDeleteMessages":                             53,
+		"EditChannelPermissions":                         54,
+		"GetChannelInvites":                              55,
+		"CreateChannelInvite":                            56,
+		"DeleteChannelPermission":                        57,
+		"FollowAnnouncementChannel":                      58,
+		"TriggerTypingIndicator":                         59,
+		"GetPinnedMessages":                              60,
+		"PinMessage":                                     61,
+		"UnpinMessage":                                   62,
+		"GroupDMAddRecipient":                            63,
+		"GroupDMRemoveRecipient":                         64,
+		"StartThreadfromMessage":                         65,
+		"StartThreadwithoutMessage":                      66,
+		"StartThreadinForumChannel":                      67,
+		"JoinThread":                                     68,
+		"AddThreadMember":                                69,
+		"LeaveThread":                                    70,
+		"RemoveThreadMember":                             71,
+		"GetThreadMember":                                72,
+		"ListThreadMembers":                              73,
+		"ListPublicArchivedThreads":                      74,
+		"ListPrivateArchivedThreads":                     75,
+		"ListJoinedPrivateArchivedThreads":               76,
+		"ListGuildEmojis":                                77,
+		"GetGuildEmoji":                                  78,
+		"CreateGuildEmoji":                               79,
+		"ModifyGuildEmoji":                               80,
+		"DeleteGuildEmoji":                               81,
+		"CreateGuild":                                    82,
+		"GetGuild":                                       83,
+		"GetGuildPreview":                                84,
+		"ModifyGuild":                                    85,
+		"DeleteGuild":                                    86,
+		"GetGuildChannels":                               87,
+		"CreateGuildChannel":                             88,
+		"ModifyGuildChannelPositions":                    89,
+		"ListActiveGuildThreads":                         90,
+		"GetGuildMember":                                 91,
+		"ListGuildMembers":                               92,
+		"SearchGuildMembers":                             93,
+		"AddGuildMember":                                 94,
+		"ModifyGuildMember":                              95,
+		"ModifyCurrentMember":                            96,
+		"AddGuildMemberRole":                             97,
+		"RemoveGuildMemberRole":                          98,
+		"RemoveGuildMember":                              99,
+		"GetGuildBans":                                   100,
+		"GetGuildBan":                                    101,
+		"CreateGuildBan":                                 102,
+		"RemoveGuildBan":                                 103,
+		"GetGuildRoles":                                  104,
+		"CreateGuildRole":                                105,
+		"ModifyGuildRolePositions":                       106,
+		"ModifyGuildRole":                                107,
+		"DeleteGuildRole":                                108,
+		"ModifyGuildMFALevel":                            109,
+		"GetGuildPruneCount":                             110,
+		"BeginGuildPrune":                                111,
+		"GetGuildVoiceRegions":                           112,
+		"GetGuildInvites":                                113,
+		"GetGuildIntegrations":                           114,
+		"DeleteGuildIntegration":                         115,
+		"GetGuildWidgetSettings":                         116,
+		"ModifyGuildWidget":                              117,
+		"GetGuildWidget":                                 118,
+		"GetGuildVanityURL":                              119,
+		"GetGuildWidgetImage":                            120,
+		"GetGuildWelcomeScreen":                          121,
+		"ModifyGuildWelcomeScreen":                       122,
+		"GetGuildOnboarding":                             123,
+		"ModifyGuildOnboarding":                          124,
+		"ModifyCurrentUserVoiceState":                    125,
+		"ModifyUserVoiceState":                           126,
+		"ListScheduledEventsforGuild":                    127,
+		"CreateGuildScheduledEvent":                      128,
+		"GetGuildScheduledEvent":                         129,
+		"ModifyGuildScheduledEvent":                      130,
+		"DeleteGuildScheduledEvent":                      131,
+		"GetGuildScheduledEventUsers":                    132,
+		"GetGuildTemplate":                               133,
+		"CreateGuildfromGuildTemplate":                   134,
+		"GetGuildTemplates":                              135,
+		"CreateGuildTemplate":                            136,
+		"SyncGuildTemplate":                              137,
+		"ModifyGuildTemplate":                            138,
+		"DeleteGuildTemplate":                            139,
+		"GetInvite":                                      140,
+		"DeleteInvite":                                   141,
+		"CreateStageInstance":                            142,
+		"GetStageInstance":                               143,
+		"ModifyStageInstance":                            144,
+		"DeleteStageInstance":                            145,
+		"GetSticker":                                     146,
+		"ListNitroStickerPacks":                          147,
+		"ListGuildStickers":                              148,
+		"GetGuildSticker":                                149,
+		"CreateGuildSticker":                             150,
+		"ModifyGuildSticker":                             151,
+		"DeleteGuildSticker":                             152,
+		"GetCurrentUser":                                 153,
+		"GetUser":                                        154,
+		"ModifyCurrentUser":                              155,
+		"GetCurrentUserGuilds":                           156,
+		"GetCurrentUserGuildMember":                      157,
+		"LeaveGuild":                                     158,
+		"CreateDM":                                       159,
+		"CreateGroupDM":                                  160,
+		"GetUserConnections":                             161,
+		"GetUserApplicationRoleConnection":               162,
+		"UpdateUserApplicationRoleConnection":            163,
+		"ListVoiceRegions":                               164,
+		"CreateWebhook":                                  165,
+		"GetChannelWebhooks":                             166,
+		"GetGuildWebhooks":                               167,
+		"GetWebhook":                                     168,
+		"GetWebhookwithToken":                            169,
+		"ModifyWebhook":                                  170,
+		"ModifyWebhookwithToken":                         171,
+		"DeleteWebhook":                                  172,
+		"DeleteWebhookwithToken":                         173,
+		"ExecuteWebhook":                                 174,
+		"ExecuteSlackCompatibleWebhook":                  175,
+		"ExecuteGitHubCompatibleWebhook":                 176,
+		"GetWebhookMessage":                              177,
+		"EditWebhookMessage":                             178,
+		"DeleteWebhookMessage":                           179,
+		"GetGateway":                                     180,
+		"GetGatewayBot":                                  181,
+		"GetCurrentBotApplicationInformation":            182,
+		"GetCurrentAuthorizationInformation":             183,
+	}
+)
+
+// Send sends a GetGlobalApplicationCommands request to Discord and returns a []*ApplicationCommand.
+func (r *GetGlobalApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[2]("2")
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetGlobalApplicationCommands(bot.ApplicationID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*ApplicationCommand, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a CreateGlobalApplicationCommand request to Discord and returns a ApplicationCommand.
+func (r *CreateGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[3]("3")
+	endpoint := EndpointCreateGlobalApplicationCommand(bot.ApplicationID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(ApplicationCommand)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetGlobalApplicationCommand request to Discord and returns a ApplicationCommand.
+func (r *GetGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[4]("4", "297ffb1f"+r.CommandID)
+	endpoint := EndpointGetGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(ApplicationCommand)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a EditGlobalApplicationCommand request to Discord and returns a ApplicationCommand.
+func (r *EditGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[5]("5", "297ffb1f"+r.CommandID)
+	endpoint := EndpointEditGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(ApplicationCommand)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a DeleteGlobalApplicationCommand request to Discord and returns a error.
+func (r *DeleteGlobalApplicationCommand) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[6]("6", "297ffb1f"+r.CommandID)
+	endpoint := EndpointDeleteGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return nil
+}
+
+// Send sends a BulkOverwriteGlobalApplicationCommands request to Discord and returns a []*ApplicationCommand.
+func (r *BulkOverwriteGlobalApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[7]("7")
+	endpoint := EndpointBulkOverwriteGlobalApplicationCommands(bot.ApplicationID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := make([]*ApplicationCommand, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetGuildApplicationCommands request to Discord and returns a []*ApplicationCommand.
+func (r *GetGuildApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[8]("8", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetGuildApplicationCommands(bot.ApplicationID, r.GuildID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*ApplicationCommand, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a CreateGuildApplicationCommand request to Discord and returns a ApplicationCommand.
+func (r *CreateGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[9]("9", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateGuildApplicationCommand(bot.ApplicationID, r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(ApplicationCommand)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetGuildApplicationCommand request to Discord and returns a ApplicationCommand.
+func (r *GetGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[10]("10", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
+	endpoint := EndpointGetGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(ApplicationCommand)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a EditGuildApplicationCommand request to Discord and returns a ApplicationCommand.
+func (r *EditGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[11]("11", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
+	endpoint := EndpointEditGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(ApplicationCommand)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a DeleteGuildApplicationCommand request to Discord and returns a error.
+func (r *DeleteGuildApplicationCommand) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[12]("12", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
+	endpoint := EndpointDeleteGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return nil
+}
+
+// Send sends a BulkOverwriteGuildApplicationCommands request to Discord and returns a []*ApplicationCommand.
+func (r *BulkOverwriteGuildApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[13]("13", "45892a5d"+r.GuildID)
+	endpoint := EndpointBulkOverwriteGuildApplicationCommands(bot.ApplicationID, r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := make([]*ApplicationCommand, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetGuildApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
+func (r *GetGuildApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[14]("14", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildApplicationCommandPermissions(bot.ApplicationID, r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(GuildApplicationCommandPermissions)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
+func (r *GetApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[15]("15", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
+	endpoint := EndpointGetApplicationCommandPermissions(bot.ApplicationID, r.GuildID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(GuildApplicationCommandPermissions)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a EditApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
+func (r *EditApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[16]("16", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
+	endpoint := EndpointEditApplicationCommandPermissions(bot.ApplicationID, r.GuildID, r.CommandID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(GuildApplicationCommandPermissions)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a BatchEditApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
+func (r *BatchEditApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[17]("17", "45892a5d"+r.GuildID)
+	endpoint := EndpointBatchEditApplicationCommandPermissions(bot.ApplicationID, r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(GuildApplicationCommandPermissions)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a CreateInteractionResponse request to Discord and returns a error.
+func (r *CreateInteractionResponse) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[18]("18", "beb3d0e6"+r.InteractionID, "cb69bb28"+r.InteractionToken)
+	endpoint := EndpointCreateInteractionResponse(r.InteractionID, r.InteractionToken)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return nil
+}
+
+// Send sends a GetOriginalInteractionResponse request to Discord and returns a Message.
+func (r *GetOriginalInteractionResponse) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[19]("19", "cb69bb28"+r.InteractionToken)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a EditOriginalInteractionResponse request to Discord and returns a Message.
+func (r *EditOriginalInteractionResponse) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[20]("20", "cb69bb28"+r.InteractionToken)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointEditOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	contentType := ContentTypeJSON
+	if len(r.Files) != 0 {
+		var multipartErr error
+		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
+			return nil, ErrorRequest{
+				ClientID:      bot.ApplicationID,
+				CorrelationID: xid,
+				RouteID:       routeid,
+				ResourceID:    resourceid,
+				Endpoint:      "",
+				Err:           err,
+			}
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a DeleteOriginalInteractionResponse request to Discord and returns a error.
+func (r *DeleteOriginalInteractionResponse) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[21]("21", "cb69bb28"+r.InteractionToken)
+	endpoint := EndpointDeleteOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return nil
+}
+
+// Send sends a CreateFollowupMessage request to Discord and returns a Message.
+func (r *CreateFollowupMessage) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[22]("22", "cb69bb28"+r.InteractionToken)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointCreateFollowupMessage(bot.ApplicationID, r.InteractionToken) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	contentType := ContentTypeJSON
+	if len(r.Files) != 0 {
+		var multipartErr error
+		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
+			return nil, ErrorRequest{
+				ClientID:      bot.ApplicationID,
+				CorrelationID: xid,
+				RouteID:       routeid,
+				ResourceID:    resourceid,
+				Endpoint:      "",
+				Err:           err,
+			}
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetFollowupMessage request to Discord and returns a Message.
+func (r *GetFollowupMessage) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[23]("23", "cb69bb28"+r.InteractionToken, "d57d6589"+r.MessageID)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a EditFollowupMessage request to Discord and returns a Message.
+func (r *EditFollowupMessage) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[24]("24", "cb69bb28"+r.InteractionToken, "d57d6589"+r.MessageID)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointEditFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	contentType := ContentTypeJSON
+	if len(r.Files) != 0 {
+		var multipartErr error
+		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
+			return nil, ErrorRequest{
+				ClientID:      bot.ApplicationID,
+				CorrelationID: xid,
+				RouteID:       routeid,
+				ResourceID:    resourceid,
+				Endpoint:      "",
+				Err:           err,
+			}
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a DeleteFollowupMessage request to Discord and returns a error.
+func (r *DeleteFollowupMessage) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[25]("25", "cb69bb28"+r.InteractionToken, "d57d6589"+r.MessageID)
+	endpoint := EndpointDeleteFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return nil
+}
+
+// Send sends a GetCurrentApplication request to Discord and returns a Application.
+func (r *GetCurrentApplication) Send(bot *Client) (*Application, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[26]("26")
+	endpoint := EndpointGetCurrentApplication()
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Application)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetApplicationRoleConnectionMetadataRecords request to Discord and returns a []*ApplicationRoleConnectionMetadata.
+func (r *GetApplicationRoleConnectionMetadataRecords) Send(bot *Client) ([]*ApplicationRoleConnectionMetadata, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[27]("27")
+	endpoint := EndpointGetApplicationRoleConnectionMetadataRecords(bot.ApplicationID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*ApplicationRoleConnectionMetadata, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a UpdateApplicationRoleConnectionMetadataRecords request to Discord and returns a []*ApplicationRoleConnectionMetadata.
+func (r *UpdateApplicationRoleConnectionMetadataRecords) Send(bot *Client) ([]*ApplicationRoleConnectionMetadata, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[28]("28")
+	endpoint := EndpointUpdateApplicationRoleConnectionMetadataRecords(bot.ApplicationID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*ApplicationRoleConnectionMetadata, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetGuildAuditLog request to Discord and returns a AuditLog.
+func (r *GetGuildAuditLog) Send(bot *Client) (*AuditLog, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[29]("29", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetGuildAuditLog(r.GuildID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(AuditLog)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a ListAutoModerationRulesForGuild request to Discord and returns a []*AutoModerationAction.
+func (r *ListAutoModerationRulesForGuild) Send(bot *Client) ([]*AutoModerationAction, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[30]("30", "45892a5d"+r.GuildID)
+	endpoint := EndpointListAutoModerationRulesForGuild(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*AutoModerationAction, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a GetAutoModerationRule request to Discord and returns a AutoModerationRule.
+func (r *GetAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[31]("31", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
+	endpoint := EndpointGetAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(AutoModerationRule)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a CreateAutoModerationRule request to Discord and returns a AutoModerationRule.
+func (r *CreateAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[32]("32", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateAutoModerationRule(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(AutoModerationRule)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a ModifyAutoModerationRule request to Discord and returns a AutoModerationRule.
+func (r *ModifyAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[33]("33", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
+	endpoint := EndpointModifyAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(AutoModerationRule)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a DeleteAutoModerationRule request to Discord and returns a error.
+func (r *DeleteAutoModerationRule) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[34]("34", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
+	endpoint := EndpointDeleteAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return nil
+}
+
+// Send sends a GetChannel request to Discord and returns a Channel.
+func (r *GetChannel) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[35]("35", "e5416649"+r.ChannelID)
+	endpoint := EndpointGetChannel(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a ModifyChannel request to Discord and returns a Channel.
+func (r *ModifyChannel) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[36]("36", "e5416649"+r.ChannelID)
+	endpoint := EndpointModifyChannel(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a ModifyChannelGroupDM request to Discord and returns a Channel.
+func (r *ModifyChannelGroupDM) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[37]("37", "e5416649"+r.ChannelID)
+	endpoint := EndpointModifyChannelGroupDM(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// Send sends a ModifyChannelGuild request to Discord and returns a Channel.
+func (r *ModifyChannelGuild) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[38]("38", "e5416649"+r.ChannelID)
+	endpoint := EndpointModifyChannelGuild(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-// RateLimiter represents an interface for rate limits.
-//
-// RateLimiter is an interface which allows developers to use multi-application architectures,
-// which run multiple applications on separate processes or servers.
-type RateLimiter interface {
-	// SetBucketID maps a Route ID to a Rate Limit Bucket ID (Discord Hash).
-	//
-	// ID 0 is reserved for a Global Rate Limit Bucket or nil.
-	SetBucketID(routeid string, bucketid string)
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// GetBucketID gets a Rate Limit Bucket ID (Discord Hash) using a Route ID.
-	GetBucketID(routeid string) string
+	return result, nil
+}
 
-	// SetBucketFromID maps a Bucket ID to a Rate Limit Bucket.
-	SetBucketFromID(bucketid string, bucket *Bucket)
+// Send sends a ModifyChannelThread request to Discord and returns a Channel.
+func (r *ModifyChannelThread) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[39]("39", "e5416649"+r.ChannelID)
+	endpoint := EndpointModifyChannelThread(r.ChannelID)
 
-	// GetBucketFromID gets a Rate Limit Bucket using the given Bucket ID.
-	GetBucketFromID(bucketid string) *Bucket
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// SetBucket maps a Route ID to a Rate Limit Bucket.
-	//
-	// ID 0 is reserved for a Global Rate Limit Bucket or nil.
-	SetBucket(routeid string, bucket *Bucket)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-	// GetBucket gets a Rate Limit Bucket using the given Route ID + Resource ID.
-	//
-	// Implements the Default Bucket mechanism by assigning the GetBucketID(routeid) when applicable.
-	GetBucket(routeid string, resourceid string) *Bucket
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// SetDefaultBucket sets the Default Bucket for per-route rate limits.
-	SetDefaultBucket(bucket *Bucket)
+	return result, nil
+}
 
-	// Lock locks the rate limiter.
-	//
-	// If the lock is already in use, the calling goroutine blocks until the rate limiter is available.
-	//
-	// This prevents multiple requests from being PROCESSED at once, which prevents race conditions.
-	// In other words, a single request is PROCESSED from a rate limiter when Lock is implemented and called.
-	//
-	// This does NOT prevent multiple requests from being SENT at a time.
-	Lock()
+// Send sends a DeleteCloseChannel request to Discord and returns a Channel.
+func (r *DeleteCloseChannel) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[40]("40", "e5416649"+r.ChannelID)
+	endpoint := EndpointDeleteCloseChannel(r.ChannelID)
 
-	// Unlock unlocks the rate limiter.
-	//
-	// If the rate limiter holds multiple locks, unlocking will unblock another goroutine,
-	// which allows another request to be processed.
-	Unlock()
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// StartTx starts a transaction with the rate limiter.
-	//
-	// If a transaction is already started, the calling goroutine blocks until the rate limiter is available.
-	//
-	// This prevents the transaction (of Rate Limit Bucket reads and writes) from concurrent manipulation.
-	StartTx()
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// EndTx ends a transaction with the rate limiter.
-	//
-	// If the rate limiter holds multiple transactions, ending one will unblock another goroutine,
-	// which allows another transaction to start.
-	EndTx()
+	return result, nil
 }
 
-// Bucket represents a Discord API Rate Limit Bucket.
-type Bucket struct {
-	// Date represents the time at which Discord received the last request of the Bucket.
-	//
-	// Date is only applicable to Global Rate Limit Buckets.
-	Date time.Time
+// Send sends a GetChannelMessages request to Discord and returns a []*Message.
+func (r *GetChannelMessages) Send(bot *Client) ([]*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[41]("41", "e5416649"+r.ChannelID)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetChannelMessages(r.ChannelID) + "?" + query
 
-	// Expiry represents the time at which the Bucket will reset (or become outdated).
-	Expiry time.Time
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// ID represents the Bucket ID.
-	ID string
+	result := make([]*Message, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// Limit represents the amount of requests a Bucket can send per reset.
-	Limit int16
+	return result, nil
+}
 
-	// Remaining represents the amount of requests a Bucket can send until the next reset.
-	Remaining int16
+// Send sends a GetChannelMessage request to Discord and returns a Message.
+func (r *GetChannelMessage) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[42]("42", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointGetChannelMessage(r.ChannelID, r.MessageID)
 
-	// Pending represents the amount of requests that are sent and awaiting a response.
-	Pending int16
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
 }
 
-// Reset resets a Discord API Rate Limit Bucket and sets its expiry.
-func (b *Bucket) Reset(expiry time.Time) {
-	b.Expiry = expiry
+// Send sends a CreateMessage request to Discord and returns a Message.
+func (r *CreateMessage) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[43]("43", "e5416649"+r.ChannelID)
+	endpoint := EndpointCreateMessage(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	contentType := ContentTypeJSON
+	if len(r.Files) != 0 {
+		var multipartErr error
+		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
+			return nil, ErrorRequest{
+				ClientID:      bot.ApplicationID,
+				CorrelationID: xid,
+				RouteID:       routeid,
+				ResourceID:    resourceid,
+				Endpoint:      "",
+				Err:           err,
+			}
+		}
+	}
 
-	// Remaining = Limit - Pending
-	b.Remaining = b.Limit - b.Pending
-}
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-// Use uses the given amount of tokens for a Discord API Rate Limit Bucket.
-func (b *Bucket) Use(amount int16) {
-	b.Remaining -= amount
-	b.Pending += amount
+	return result, nil
 }
 
-// ConfirmDate confirms the usage of a given amount of tokens for a Discord API Rate Limit Bucket,
-// using the bucket's current expiry and given (Discord Header) Date time.
-//
-// Used for the Global Rate Limit Bucket.
-func (b *Bucket) ConfirmDate(amount int16, date time.Time) {
-	b.Pending -= amount
+// Send sends a CrosspostMessage request to Discord and returns a Message.
+func (r *CrosspostMessage) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[44]("44", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointCrosspostMessage(r.ChannelID, r.MessageID)
 
-	switch {
-	// Date is zero when a request has never been sent to Discord.
-	//
-	// set the Date of the current Bucket to the date of the current Discord Bucket.
-	case b.Date.IsZero():
-		b.Date = date
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-		// The EXACT reset period of Discord's Global Rate Limit Bucket will always occur
-		// BEFORE the current Bucket resets (due to this implementation).
-		//
-		// reset the current Bucket with an expiry that occurs [0, 1) seconds
-		// AFTER the Discord Global Rate Limit Bucket will be reset.
-		//
-		// This results in a Bucket's expiry that is eventually consistent with
-		// Discord's Bucket expiry over time (once determined between requests).
-		b.Expiry = time.Now().Add(time.Second)
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// Date is EQUAL to the Discord Bucket's Date when the request applies to the current Bucket.
-	case b.Date.Equal(date):
+	return result, nil
+}
 
-	// Date occurs BEFORE a Discord Bucket's Date when the request applies to the next Bucket.
-	//
-	// update the current Bucket to the next Bucket.
-	case b.Date.Before(date):
-		b.Date = date
+// Send sends a CreateReaction request to Discord and returns a error.
+func (r *CreateReaction) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[45]("45", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
+	endpoint := EndpointCreateReaction(r.ChannelID, r.MessageID, r.Emoji)
 
-		// align the current Bucket's expiry to Discord's Bucket expiry.
-		b.Expiry = time.Now().Add(time.Second)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// Date occurs AFTER a Discord Bucket's Date when the request applied to a previous Bucket.
-	case b.Date.After(date):
-		b.Remaining += amount
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
-}
 
-// ConfirmHeader confirms the usage of a given amount of tokens for a Discord API Rate Limit Bucket,
-// using a given Route ID and respective Discord Rate Limit Header.
-//
-// Used for Route Rate Limits.
-func (b *Bucket) ConfirmHeader(amount int16, header RateLimitHeader) {
-	b.Pending -= amount
+	return nil
+}
 
-	// determine the reset time.
-	//
-	// Discord recommends to rely on the `Retry-After` header.
-	// https://discord.com/developers/docs/topics/rate-limits#exceeding-a-rate-limit
-	reset := time.Now().Add(time.Millisecond*time.Duration(header.ResetAfter*msPerSecond) + time.Millisecond)
+// Send sends a DeleteOwnReaction request to Discord and returns a error.
+func (r *DeleteOwnReaction) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[46]("46", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
+	endpoint := EndpointDeleteOwnReaction(r.ChannelID, r.MessageID, r.Emoji)
 
-	// Expiry is zero when a request from the Route ID has never been sent to Discord.
-	//
-	// set the current Bucket to the current Discord Bucket.
-	if b.Expiry.IsZero() {
-		b.Limit = int16(header.Limit)
-		b.Remaining = int16(header.Remaining) - b.Pending
-		b.Expiry = reset
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-		return
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	switch {
-	// Expiry is EQUAL to the Discord Bucket's Reset when the request applies to the current Bucket.
-	case b.Expiry == reset:
+	return nil
+}
 
-	// Expiry occurs BEFORE a Discord Bucket's Reset when the request applies to the next Bucket.
-	//
-	// update the current Bucket to the next Bucket.
-	case b.Expiry.Before(reset):
-		b.Limit = int16(header.Limit)
-		b.Expiry = reset
+// Send sends a DeleteUserReaction request to Discord and returns a error.
+func (r *DeleteUserReaction) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[47]("47", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji, "209c92df"+r.UserID)
+	endpoint := EndpointDeleteUserReaction(r.ChannelID, r.MessageID, r.Emoji, r.UserID)
 
-	// Expiry occurs AFTER a Discord Bucket's Reset when the request applied to a previous Bucket.
-	case b.Expiry.After(reset):
-		b.Remaining += amount
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
-}
-
-// Conversion Constants.
-const (
-	base10              = 10
-	bit64               = 64
-	msPerSecond float64 = 1000
-)
 
-// Content Types
-// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Content-Type
-var (
-	// ContentTypeURLQueryString is an HTTP Header Content Type that indicates
-	// a payload with an encoded URL Query String.
-	ContentTypeURLQueryString = []byte("application/x-www-form-urlencoded")
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// ContentTypeJSON is an HTTP Header Content Type that indicates a payload with a JSON body.
-	ContentTypeJSON = []byte("application/json")
+	return nil
+}
 
-	// ContentTypeMultipartForm is an HTTP Header Content Type that indicates
-	// a payload with multiple content types.
-	ContentTypeMultipartForm = []byte("multipart/form-data")
+// Send sends a GetReactions request to Discord and returns a []*User.
+func (r *GetReactions) Send(bot *Client) ([]*User, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[48]("48", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
+	query, err := EndpointQueryString(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetReactions(r.ChannelID, r.MessageID, r.Emoji) + "?" + query
 
-	// ContentTypeJPEG is an HTTP Header Content Type that indicates a payload with a JPEG image.
-	ContentTypeJPEG = []byte("image/jpeg")
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// ContentTypePNG is an HTTP Header Content Type that indicates a payload with a PNG image.
-	ContentTypePNG = []byte("image/png")
+	result := make([]*User, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// ContentTypeWebP is an HTTP Header Content Type that indicates a payload with a WebP image.
-	ContentTypeWebP = []byte("image/webp")
+	return result, nil
+}
 
-	// ContentTypeGIF is an HTTP Header Content Type that indicates a payload with a GIF animated image.
-	ContentTypeGIF = []byte("image/gif")
-)
+// Send sends a DeleteAllReactions request to Discord and returns a error.
+func (r *DeleteAllReactions) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[49]("49", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointDeleteAllReactions(r.ChannelID, r.MessageID)
 
-// HTTP Header Variables.
-const (
-	// headerAuthorizationKey represents the key for an "Authorization" HTTP Header.
-	headerAuthorizationKey = "Authorization"
-)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-// HTTP Header Rate Limit Variables.
-var (
-	// headerDate represents a byte representation of "Date" for HTTP Header functionality.
-	headerDate = []byte(FlagRateLimitHeaderDate)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// headerRetryAfter represents a byte representation of "Retry-After" for HTTP Header functionality.
-	headerRateLimitRetryAfter = []byte(FlagRateLimitHeaderRetryAfter)
+	return nil
+}
 
-	// headerRateLimit represents a byte representation of "X-RateLimit-Limit" for HTTP Header functionality.
-	headerRateLimit = []byte(FlagRateLimitHeaderLimit)
+// Send sends a DeleteAllReactionsforEmoji request to Discord and returns a error.
+func (r *DeleteAllReactionsforEmoji) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[50]("50", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
+	endpoint := EndpointDeleteAllReactionsforEmoji(r.ChannelID, r.MessageID, r.Emoji)
 
-	// headerRateLimitRemaining represents a byte representation of "X-RateLimit-Remaining" for HTTP Header functionality.
-	headerRateLimitRemaining = []byte(FlagRateLimitHeaderRemaining)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// headerRateLimitReset represents a byte representation of "X-RateLimit-Reset" for HTTP Header functionality.
-	headerRateLimitReset = []byte(FlagRateLimitHeaderReset)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// headerRateLimitResetAfter represents a byte representation of "X-RateLimit-Reset-After" for HTTP Header functionality.
-	headerRateLimitResetAfter = []byte(FlagRateLimitHeaderResetAfter)
+	return nil
+}
 
-	// headerRateLimitBucket represents a byte representation of "X-RateLimit-Bucket" for HTTP Header functionality.
-	headerRateLimitBucket = []byte(FlagRateLimitHeaderBucket)
+// Send sends a EditMessage request to Discord and returns a Message.
+func (r *EditMessage) Send(bot *Client) (*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[51]("51", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointEditMessage(r.ChannelID, r.MessageID)
 
-	// headerRateLimitGlobal represents a byte representation of "X-RateLimit-Global" for HTTP Header functionality.
-	headerRateLimitGlobal = []byte(FlagRateLimitHeaderGlobal)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// headerRateLimitScope represents a byte representation of "X-RateLimit-Scope" for HTTP Header functionality.
-	headerRateLimitScope = []byte(FlagRateLimitHeaderScope)
-)
+	r = applyAllowedMentions(bot, r)
 
-// peekDate peeks an HTTP Header for the Date.
-func peekDate(r *fasthttp.Response) (time.Time, error) {
-	date, err := time.Parse(time.RFC1123, string(r.Header.PeekBytes(headerDate)))
+	body, err := json.Marshal(r)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("error occurred parsing the \"Date\" HTTP Header: %w", err)
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
 	}
 
-	return date, nil
-}
+	contentType := ContentTypeJSON
+	if len(r.Files) != 0 {
+		var multipartErr error
+		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
+			return nil, ErrorRequest{
+				ClientID:      bot.ApplicationID,
+				CorrelationID: xid,
+				RouteID:       routeid,
+				ResourceID:    resourceid,
+				Endpoint:      "",
+				Err:           err,
+			}
+		}
+	}
 
-// peekHeaderRetryAfter peeks an HTTP Header for the Rate Limit Header "Retry-After".
-func peekHeaderRetryAfter(r *fasthttp.Response) (float64, error) {
-	retryafter, err := strconv.ParseFloat(string(r.Header.PeekBytes(headerRateLimitRetryAfter)), bit64)
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
 	if err != nil {
-		return 0, fmt.Errorf(errRateLimit, string(headerRateLimitRetryAfter), err)
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	return retryafter, nil
+	return result, nil
 }
 
-// peekHeaderRateLimit peeks an HTTP Header for Discord Rate Limit Header values.
-func peekHeaderRateLimit(r *fasthttp.Response) RateLimitHeader {
-	limit, _ := strconv.Atoi(string(r.Header.PeekBytes(headerRateLimit)))
-	remaining, _ := strconv.Atoi(string(r.Header.PeekBytes(headerRateLimitRemaining)))
-	reset, _ := strconv.ParseFloat(string(r.Header.PeekBytes(headerRateLimitReset)), bit64)
-	resetafter, _ := strconv.ParseFloat(string(r.Header.PeekBytes(headerRateLimitResetAfter)), bit64)
-	global, _ := strconv.ParseBool(string(r.Header.PeekBytes(headerRateLimitGlobal)))
+// Send sends a DeleteMessage request to Discord and returns a error.
+func (r *DeleteMessage) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[52]("52", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointDeleteMessage(r.ChannelID, r.MessageID)
 
-	return RateLimitHeader{
-		Limit:      limit,
-		Remaining:  remaining,
-		Reset:      reset,
-		ResetAfter: resetafter,
-		Bucket:     string(r.Header.PeekBytes(headerRateLimitBucket)),
-		Global:     global,
-		Scope:      string(r.Header.PeekBytes(headerRateLimitScope)),
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
+
+	return nil
 }
 
-// SendRequest sends a fasthttp.Request using the given route ID, HTTP method, URI, content type and body,
-// then parses the response into dst.
-func SendRequest(bot *Client, xid, routeid, resourceid, method, uri string, content, body []byte, dst any) error { //nolint:gocyclo,maintidx
-	retries := 0
-	requestid := routeid + resourceid
-	request := fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(request)
-	request.Header.SetMethod(method)
-	request.Header.SetContentTypeBytes(content)
-	request.Header.Set(headerAuthorizationKey, bot.Authentication.Header)
-	request.SetRequestURI(uri)
-	request.SetBodyRaw(body)
-	response := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(response)
+// Send sends a BulkDeleteMessages request to Discord and returns a error.
+func (r *BulkDeleteMessages) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[53]("53", "e5416649"+r.ChannelID)
+	endpoint := EndpointBulkDeleteMessages(r.ChannelID)
 
-	// Certain endpoints are not bound to the bot's Global Rate Limit.
-	if IgnoreGlobalRateLimitRouteIDs[requestid] {
-		goto SEND
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-RATELIMIT:
-	// a single request or response is PROCESSED at any point in time.
-	bot.Config.Request.RateLimiter.Lock()
-
-	LogRequest(Logger.Trace(), bot.ApplicationID, xid, routeid, resourceid, uri).Msg("processing request")
-	if Logger.GetLevel() == zerolog.TraceLevel {
-		LogRequestBody(Logger.Trace(), bot.ApplicationID, xid, routeid, resourceid, uri, string(body)).Msg("")
+	body, err := json.Marshal(r)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
 	}
 
-	// check Global and Route Rate Limit Buckets prior to sending the current request.
-	for {
-		bot.Config.Request.RateLimiter.StartTx()
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-		globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, "")
+	return nil
+}
 
-		// stop waiting when the Global Rate Limit Bucket is NOT empty.
-		if isNotEmpty(globalBucket) {
-			routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid)
+// Send sends a EditChannelPermissions request to Discord and returns a error.
+func (r *EditChannelPermissions) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[54]("54", "e5416649"+r.ChannelID, "9167175f"+r.OverwriteID)
+	endpoint := EndpointEditChannelPermissions(r.ChannelID, r.OverwriteID)
 
-			if isNotEmpty(routeBucket) {
-				break
-			}
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-			if isExpired(routeBucket) {
-				// When a Route Bucket expires, its new expiry becomes unknown.
-				// As a result, it will never reset (again) until a pending request's
-				// response sets a new expiry.
-				routeBucket.Reset(time.Time{})
-			}
+	body, err := json.Marshal(r)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-			var wait time.Time
-			if routeBucket != nil {
-				wait = routeBucket.Expiry
-			}
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-			// do NOT block other requests due to a Route Rate Limit.
-			bot.Config.Request.RateLimiter.EndTx()
-			bot.Config.Request.RateLimiter.Unlock()
+	return nil
+}
 
-			// reduce CPU usage by blocking the current goroutine
-			// until it's eligible for action.
-			if routeBucket != nil {
-				<-time.After(time.Until(wait))
-			}
+// Send sends a GetChannelInvites request to Discord and returns a []*Invite.
+func (r *GetChannelInvites) Send(bot *Client) ([]*Invite, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[55]("55", "e5416649"+r.ChannelID)
+	endpoint := EndpointGetChannelInvites(r.ChannelID)
 
-			goto RATELIMIT
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		// reset the Global Rate Limit Bucket when the current Bucket has passed its expiry.
-		if isExpired(globalBucket) {
-			globalBucket.Reset(time.Now().Add(time.Second))
+	result := make([]*Invite, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		bot.Config.Request.RateLimiter.EndTx()
+	return result, nil
+}
+
+// Send sends a CreateChannelInvite request to Discord and returns a Invite.
+func (r *CreateChannelInvite) Send(bot *Client) (*Invite, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[56]("56", "e5416649"+r.ChannelID)
+	endpoint := EndpointCreateChannelInvite(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	if globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, ""); globalBucket != nil {
-		globalBucket.Use(1)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
 	}
 
-	if routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid); routeBucket != nil {
-		routeBucket.Use(1)
+	result := new(Invite)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	bot.Config.Request.RateLimiter.EndTx()
-	bot.Config.Request.RateLimiter.Unlock()
+	return result, nil
+}
 
-SEND:
-	LogRequest(Logger.Trace(), bot.ApplicationID, xid, routeid, resourceid, uri).Msg("sending request")
+// Send sends a DeleteChannelPermission request to Discord and returns a error.
+func (r *DeleteChannelPermission) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[57]("57", "e5416649"+r.ChannelID, "9167175f"+r.OverwriteID)
+	endpoint := EndpointDeleteChannelPermission(r.ChannelID, r.OverwriteID)
 
-	// send the request.
-	if err := bot.Config.Request.Client.DoTimeout(request, response, bot.Config.Request.Timeout); err != nil {
-		return fmt.Errorf("%w", err)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	LogResponse(LogRequest(Logger.Info(), bot.ApplicationID, xid, routeid, resourceid, uri),
-		response.Header.String(), string(response.Body()),
-	).Msg("")
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	var header RateLimitHeader
+	return nil
+}
 
-	// confirm the response with the rate limiter.
-	//
-	// Certain endpoints are not bound to the bot's Global Rate Limit.
-	if !IgnoreGlobalRateLimitRouteIDs[requestid] {
-		// parse the Rate Limit Header for per-route rate limit functionality.
-		header = peekHeaderRateLimit(response)
+// Send sends a FollowAnnouncementChannel request to Discord and returns a FollowedChannel.
+func (r *FollowAnnouncementChannel) Send(bot *Client) (*FollowedChannel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[58]("58", "e5416649"+r.ChannelID)
+	endpoint := EndpointFollowAnnouncementChannel(r.ChannelID)
 
-		// parse the Date header for Global Rate Limit functionality.
-		date, err := peekDate(response)
-		if err != nil {
-			return fmt.Errorf("%w", err)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		bot.Config.Request.RateLimiter.StartTx()
-
-		// confirm the Global Rate Limit Bucket.
-		globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, "")
-		if globalBucket != nil {
-			globalBucket.ConfirmDate(1, date)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
+	}
 
-		// confirm the Route Rate Limit Bucket (if applicable).
-		routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid)
-		switch {
-		// when there is no Discord Bucket, remove the route's mapping to a rate limit Bucket.
-		case header.Bucket == "":
-			if routeBucket != nil {
-				bot.Config.Request.RateLimiter.SetBucketID(requestid, nilRouteBucket)
-				routeBucket = nil
-			}
-
-		// when the route's Bucket ID does NOT match the Discord Bucket, update it.
-		case routeBucket == nil && header.Bucket != "" || routeBucket.ID != header.Bucket:
-			var pending int16
-			if routeBucket != nil {
-				pending = routeBucket.Pending
-			}
+	result := new(FollowedChannel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-			// update the route ID mapping to a rate limit Bucket ID.
-			bot.Config.Request.RateLimiter.SetBucketID(requestid, header.Bucket)
+	return result, nil
+}
 
-			// map the Bucket ID to the updated Rate Limit Bucket.
-			if bucket := bot.Config.Request.RateLimiter.GetBucketFromID(header.Bucket); bucket != nil {
-				routeBucket = bucket
-			} else {
-				routeBucket = getBucket()
-				bot.Config.Request.RateLimiter.SetBucketFromID(header.Bucket, routeBucket)
-			}
+// Send sends a TriggerTypingIndicator request to Discord and returns a error.
+func (r *TriggerTypingIndicator) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[59]("59", "e5416649"+r.ChannelID)
+	endpoint := EndpointTriggerTypingIndicator(r.ChannelID)
 
-			routeBucket.Pending += pending
-			routeBucket.ID = header.Bucket
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		if routeBucket != nil {
-			routeBucket.ConfirmHeader(1, header)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		if response.StatusCode() != fasthttp.StatusTooManyRequests {
-			bot.Config.Request.RateLimiter.EndTx()
+	return nil
+}
+
+// Send sends a GetPinnedMessages request to Discord and returns a []*Message.
+func (r *GetPinnedMessages) Send(bot *Client) ([]*Message, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[60]("60", "e5416649"+r.ChannelID)
+	endpoint := EndpointGetPinnedMessages(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
 	}
 
-	// handle the response.
-	switch response.StatusCode() {
-	case fasthttp.StatusOK, fasthttp.StatusCreated:
-		// parse the response data.
-		if err := json.Unmarshal(response.Body(), dst); err != nil {
-			return fmt.Errorf(errUnmarshal, dst, err)
+	result := make([]*Message, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		return nil
+	return result, nil
+}
 
-	case fasthttp.StatusNoContent:
-		return nil
+// Send sends a PinMessage request to Discord and returns a error.
+func (r *PinMessage) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[61]("61", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointPinMessage(r.ChannelID, r.MessageID)
 
-	// process the rate limit.
-	case fasthttp.StatusTooManyRequests:
-		retry := retries < bot.Config.Request.Retries
-		retries++
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-		switch header.Scope { //nolint:gocritic
-		// Discord per-resource (shared) rate limit headers include the per-route (user) bucket.
-		//
-		// when a per-resource rate limit is encountered, send another request or return.
-		case RateLimitScopeValueShared:
-			bot.Config.Request.RateLimiter.EndTx()
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-			if retry || bot.Config.Request.RetryShared {
-				goto RATELIMIT
-			}
+	return nil
+}
 
-			return StatusCodeError(response.StatusCode())
+// Send sends a UnpinMessage request to Discord and returns a error.
+func (r *UnpinMessage) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[62]("62", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointUnpinMessage(r.ChannelID, r.MessageID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		// parse the rate limit response data for `retry_after`.
-		var data RateLimitResponse
-		if err := json.Unmarshal(response.Body(), &data); err != nil {
-			return fmt.Errorf("%w", err)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		// determine the reset time.
-		var reset time.Time
-		if data.RetryAfter == 0 {
-			// when the 429 is from Discord, use the `retry_after` value (s).
-			reset = time.Now().Add(time.Millisecond * time.Duration(data.RetryAfter*msPerSecond))
-		} else {
-			// when the 429 is from a Cloudflare Ban, use the `"Retry-After"` value (s).
-			retryafter, err := peekHeaderRetryAfter(response)
-			if err != nil {
-				return fmt.Errorf("%w", err)
-			}
+	return nil
+}
 
-			reset = time.Now().Add(time.Millisecond * time.Duration(retryafter*msPerSecond))
+// Send sends a GroupDMAddRecipient request to Discord and returns a error.
+func (r *GroupDMAddRecipient) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[63]("63", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
+	endpoint := EndpointGroupDMAddRecipient(r.ChannelID, r.UserID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		if data.Code == nil {
-			LogRequest(Logger.Debug(), bot.ApplicationID, xid, routeid, resourceid, uri).
-				Time(LogCtxReset, reset).Msg("")
-		} else {
-			LogRequest(Logger.Debug(), bot.ApplicationID, xid, routeid, resourceid, uri).
-				Time(LogCtxReset, reset).
-				Err(JSONCodeError(*data.Code)).Msg("")
+	body, err := json.Marshal(r)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
+	}
 
-		switch header.Global {
-		// when the global request rate limit is encountered.
-		case true:
-			// when the current time is BEFORE the reset time,
-			// all requests must wait until the 429 expires.
-			if time.Now().Before(reset) {
-				if globalBucket := bot.Config.Request.RateLimiter.GetBucket(GlobalRateLimitRouteID, ""); globalBucket != nil {
-					globalBucket.Remaining = 0
-					globalBucket.Expiry = reset.Add(time.Millisecond)
-				}
-			}
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-			bot.Config.Request.RateLimiter.EndTx()
+	return nil
+}
 
-		// when a per-route (user) rate limit is encountered.
-		case false:
-			// do NOT block other requests while waiting for a Route Rate Limit.
-			bot.Config.Request.RateLimiter.EndTx()
+// Send sends a GroupDMRemoveRecipient request to Discord and returns a error.
+func (r *GroupDMRemoveRecipient) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[64]("64", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
+	endpoint := EndpointGroupDMRemoveRecipient(r.ChannelID, r.UserID)
 
-			// when the current time is BEFORE the reset time,
-			// requests with the same Rate Limit Bucket must wait until the 429 expires.
-			if time.Now().Before(reset) {
-				if routeBucket := bot.Config.Request.RateLimiter.GetBucket(routeid, resourceid); routeBucket != nil {
-					routeBucket.Remaining = 0
-					routeBucket.Expiry = reset.Add(time.Millisecond)
-				}
-			}
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		if retry {
-			goto RATELIMIT
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		return StatusCodeError(fasthttp.StatusTooManyRequests)
+	return nil
+}
 
-	// retry the request on a bad gateway server error.
-	case fasthttp.StatusBadGateway:
-		if retries < bot.Config.Request.Retries {
-			retries++
+// Send sends a StartThreadfromMessage request to Discord and returns a Channel.
+func (r *StartThreadfromMessage) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[65]("65", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
+	endpoint := EndpointStartThreadfromMessage(r.ChannelID, r.MessageID)
 
-			goto RATELIMIT
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
-
-		return StatusCodeError(fasthttp.StatusBadGateway)
-
-	default:
-		return StatusCodeError(response.StatusCode())
 	}
-}
-
-// isExpired determines whether a rate limit Bucket is expired.
-func isExpired(b *Bucket) bool {
-	// a rate limit bucket is expired when
-	// 1. the bucket exists AND
-	// 2. the current time occurs after the non-zero expiry time.
-	return b != nil && !b.Expiry.IsZero() && time.Now().After(b.Expiry)
-}
 
-// isNotEmpty determines whether a rate limit Bucket is NOT empty.
-func isNotEmpty(b *Bucket) bool {
-	// a rate limit bucket is NOT empty when
-	// 1. the bucket does not exist OR
-	// 2. there is one or more remaining request token(s).
-	return b == nil || b.Remaining > 0
-}
-
-// boundary represents the boundary that is used in every multipart form.
-var boundary = randomBoundary()
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-// randomBoundary generates a random value to be used as a boundary in multipart forms.
-//
-// Implemented from the mime/multipart package.
-func randomBoundary() string {
-	var buf [30]byte
-	_, err := io.ReadFull(rand.Reader, buf[:])
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
-		panic(err)
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	return fmt.Sprintf("%x", buf[:])
+	return result, nil
 }
 
-// quoteEscaper escapes quotes and backslashes in a multipart form.
-var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+// Send sends a StartThreadwithoutMessage request to Discord and returns a Channel.
+func (r *StartThreadwithoutMessage) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[66]("66", "e5416649"+r.ChannelID)
+	endpoint := EndpointStartThreadwithoutMessage(r.ChannelID)
 
-// createMultipartForm creates a multipart/form for Discord using a given JSON body and files.
-func createMultipartForm(json []byte, files ...*File) ([]byte, []byte, error) {
-	form := bytes.NewBuffer(nil)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	// set the boundary.
-	multipartWriter := multipart.NewWriter(form)
-	err := multipartWriter.SetBoundary(boundary)
+	body, err := json.Marshal(r)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error setting multipart form boundary: %w", err)
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
 	}
 
-	// add the `payload_json` JSON to the form.
-	multipartPayloadJSONPart, err := createPayloadJSONForm(multipartWriter)
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error adding JSON payload header to multipart form: %w", err)
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	if _, err := multipartPayloadJSONPart.Write(json); err != nil {
-		return nil, nil, fmt.Errorf("error writing JSON payload data to multipart form: %w", err)
-	}
+	return result, nil
+}
 
-	// add the remaining files to the form.
-	for i, file := range files {
-		name := strings.Join([]string{"files[", strconv.Itoa(i), "]"}, "")
-		multipartFilePart, err := createFormFile(multipartWriter, name, file.Name, file.ContentType)
-		if err != nil {
-			return nil, nil, fmt.Errorf("error adding a file %q to a multipart form: %w", file.Name, err)
+// Send sends a StartThreadinForumChannel request to Discord and returns a Channel.
+func (r *StartThreadinForumChannel) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[67]("67", "e5416649"+r.ChannelID)
+	endpoint := EndpointStartThreadinForumChannel(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
+	}
 
-		if _, err := multipartFilePart.Write(file.Data); err != nil {
-			return nil, nil, fmt.Errorf("error writing file %q data to multipart form: %w", file.Name, err)
+	r = applyAllowedMentions(bot, r)
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
 
-	// write the trailing boundary.
-	if err := multipartWriter.Close(); err != nil {
-		return nil, nil, fmt.Errorf("error closing the multipart form: %w", err)
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	return []byte(multipartWriter.FormDataContentType()), form.Bytes(), nil
+	return result, nil
 }
 
-var (
-	// contentTypeJSONString is an HTTP Header Content Type that indicates a payload with a JSON body.
-	contentTypeJSONString = "application/json"
+// Send sends a JoinThread request to Discord and returns a error.
+func (r *JoinThread) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[68]("68", "e5416649"+r.ChannelID)
+	endpoint := EndpointJoinThread(r.ChannelID)
 
-	// contentTypeOctetStreamString is an HTTP Header Content Type that indicates a payload with binary data.
-	contentTypeOctetStreamString = "application/octet-stream"
-)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-// createPayloadJSONForm creates a form-data header for the `payload_json` file in a multipart form.
-func createPayloadJSONForm(m *multipart.Writer) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", `form-data; name="payload_json"`)
-	h.Set("Content-Type", contentTypeJSONString)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	return m.CreatePart(h) //nolint:wrapcheck
+	return nil
 }
 
-// createFormFile creates a form-data header for file attachments in a multipart form.
-func createFormFile(m *multipart.Writer, name, filename, contentType string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition",
-		fmt.Sprintf(`form-data; name="%s"; filename="%s"`, name, quoteEscaper.Replace(filename)))
+// Send sends a AddThreadMember request to Discord and returns a error.
+func (r *AddThreadMember) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[69]("69", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
+	endpoint := EndpointAddThreadMember(r.ChannelID, r.UserID)
 
-	if contentType == "" {
-		contentType = contentTypeOctetStreamString
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	h.Set("Content-Type", contentType)
-
-	return m.CreatePart(h) //nolint:wrapcheck
+	return nil
 }
 
-var (
-	// qsEncoder is used to create URL Query Strings from objects.
-	qsEncoder = schema.NewEncoder()
-)
+// Send sends a LeaveThread request to Discord and returns a error.
+func (r *LeaveThread) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[70]("70", "e5416649"+r.ChannelID)
+	endpoint := EndpointLeaveThread(r.ChannelID)
 
-// init runs at the start of the program.
-func init() {
-	// use `url` tags for the URL Query String encoder and decoder.
-	qsEncoder.SetAliasTag("url")
-}
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-// EndpointQueryString returns a URL Query String from a given object.
-func EndpointQueryString(dst any) (string, error) {
-	params := url.Values{}
-	err := qsEncoder.Encode(dst, params)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return "", err //nolint:wrapcheck
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
 
-	return params.Encode(), nil
+	return nil
 }
 
-var (
-	// RouteIDs represents a map of Routes to Route IDs (map[string]uint8).
-	RouteIDs = map[string]uint8{
-		"":                                               0,
-		"OAuth":                                          1,
-		"GetGlobalApplicationCommands":                   2,
-		"CreateGlobalApplicationCommand":                 3,
-		"GetGlobalApplicationCommand":                    4,
-		"EditGlobalApplicationCommand":                   5,
-		"DeleteGlobalApplicationCommand":                 6,
-		"BulkOverwriteGlobalApplicationCommands":         7,
-		"GetGuildApplicationCommands":                    8,
-		"CreateGuildApplicationCommand":                  9,
-		"GetGuildApplicationCommand":                     10,
-		"EditGuildApplicationCommand":                    11,
-		"DeleteGuildApplicationCommand":                  12,
-		"BulkOverwriteGuildApplicationCommands":          13,
-		"GetGuildApplicationCommandPermissions":          14,
-		"GetApplicationCommandPermissions":               15,
-		"EditApplicationCommandPermissions":              16,
-		"BatchEditApplicationCommandPermissions":         17,
-		"CreateInteractionResponse":                      18,
-		"GetOriginalInteractionResponse":                 19,
-		"EditOriginalInteractionResponse":                20,
-		"DeleteOriginalInteractionResponse":              21,
-		"CreateFollowupMessage":                          22,
-		"GetFollowupMessage":                             23,
-		"EditFollowupMessage":                            24,
-		"DeleteFollowupMessage":                          25,
-		"GetCurrentApplication":                          26,
-		"GetApplicationRoleConnectionMetadataRecords":    27,
-		"UpdateApplicationRoleConnectionMetadataRecords": 28,
-		"GetGuildAuditLog":                               29,
-		"ListAutoModerationRulesForGuild":                30,
-		"GetAutoModerationRule":                          31,
-		"CreateAutoModerationRule":                       32,
-		"ModifyAutoModerationRule":                       33,
-		"DeleteAutoModerationRule":                       34,
-		"GetChannel":                                     35,
-		"ModifyChannel":                                  36,
-		"ModifyChannelGroupDM":                           37,
-		"ModifyChannelGuild":                             38,
-		"ModifyChannelThread":                            39,
-		"DeleteCloseChannel":                             40,
-		"GetChannelMessages":                             41,
-		"GetChannelMessage":                              42,
-		"CreateMessage":                                  43,
-		"CrosspostMessage":                               44,
-		"CreateReaction":                                 45,
-		"DeleteOwnReaction":                              46,
-		"DeleteUserReaction":                             47,
-		"GetReactions":                                   48,
-		"DeleteAllReactions":                             49,
-		"DeleteAllReactionsforEmoji":                     50,
-		"EditMessage":                                    51,
-		"DeleteMessage":                                  52,
-		"BulkDeleteMessages":                             53,
-		"EditChannelPermissions":                         54,
-		"GetChannelInvites":                              55,
-		"CreateChannelInvite":                            56,
-		"DeleteChannelPermission":                        57,
-		"FollowAnnouncementChannel":                      58,
-		"TriggerTypingIndicator":                         59,
-		"GetPinnedMessages":                              60,
-		"PinMessage":                                     61,
-		"UnpinMessage":                                   62,
-		"GroupDMAddRecipient":                            63,
-		"GroupDMRemoveRecipient":                         64,
-		"StartThreadfromMessage":                         65,
-		"StartThreadwithoutMessage":                      66,
-		"StartThreadinForumChannel":                      67,
-		"JoinThread":                                     68,
-		"AddThreadMember":                                69,
-		"LeaveThread":                                    70,
-		"RemoveThreadMember":                             71,
-		"GetThreadMember":                                72,
-		"ListThreadMembers":                              73,
-		"ListPublicArchivedThreads":                      74,
-		"ListPrivateArchivedThreads":                     75,
-		"ListJoinedPrivateArchivedThreads":               76,
-		"ListGuildEmojis":                                77,
-		"GetGuildEmoji":                                  78,
-		"CreateGuildEmoji":                               79,
-		"ModifyGuildEmoji":                               80,
-		"DeleteGuildEmoji":                               81,
-		"CreateGuild":                                    82,
-		"GetGuild":                                       83,
-		"GetGuildPreview":                                84,
-		"ModifyGuild":                                    85,
-		"DeleteGuild":                                    86,
-		"GetGuildChannels":                               87,
-		"CreateGuildChannel":                             88,
-		"ModifyGuildChannelPositions":                    89,
-		"ListActiveGuildThreads":                         90,
-		"GetGuildMember":                                 91,
-		"ListGuildMembers":                               92,
-		"SearchGuildMembers":                             93,
-		"AddGuildMember":                                 94,
-		"ModifyGuildMember":                              95,
-		"ModifyCurrentMember":                            96,
-		"AddGuildMemberRole":                             97,
-		"RemoveGuildMemberRole":                          98,
-		"RemoveGuildMember":                              99,
-		"GetGuildBans":                                   100,
-		"GetGuildBan":                                    101,
-		"CreateGuildBan":                                 102,
-		"RemoveGuildBan":                                 103,
-		"GetGuildRoles":                                  104,
-		"CreateGuildRole":                                105,
-		"ModifyGuildRolePositions":                       106,
-		"ModifyGuildRole":                                107,
-		"DeleteGuildRole":                                108,
-		"ModifyGuildMFALevel":                            109,
-		"GetGuildPruneCount":                             110,
-		"BeginGuildPrune":                                111,
-		"GetGuildVoiceRegions":                           112,
-		"GetGuildInvites":                                113,
-		"GetGuildIntegrations":                           114,
-		"DeleteGuildIntegration":                         115,
-		"GetGuildWidgetSettings":                         116,
-		"ModifyGuildWidget":                              117,
-		"GetGuildWidget":                                 118,
-		"GetGuildVanityURL":                              119,
-		"GetGuildWidgetImage":                            120,
-		"GetGuildWelcomeScreen":                          121,
-		"ModifyGuildWelcomeScreen":                       122,
-		"GetGuildOnboarding":                             123,
-		"ModifyGuildOnboarding":                          124,
-		"ModifyCurrentUserVoiceState":                    125,
-		"ModifyUserVoiceState":                           126,
-		"ListScheduledEventsforGuild":                    127,
-		"CreateGuildScheduledEvent":                      128,
-		"GetGuildScheduledEvent":                         129,
-		"ModifyGuildScheduledEvent":                      130,
-		"DeleteGuildScheduledEvent":                      131,
-		"GetGuildScheduledEventUsers":                    132,
-		"GetGuildTemplate":                               133,
-		"CreateGuildfromGuildTemplate":                   134,
-		"GetGuildTemplates":                              135,
-		"CreateGuildTemplate":                            136,
-		"SyncGuildTemplate":                              137,
-		"ModifyGuildTemplate":                            138,
-		"DeleteGuildTemplate":                            139,
-		"GetInvite":                                      140,
-		"DeleteInvite":                                   141,
-		"CreateStageInstance":                            142,
-		"GetStageInstance":                               143,
-		"ModifyStageInstance":                            144,
-		"DeleteStageInstance":                            145,
-		"GetSticker":                                     146,
-		"ListNitroStickerPacks":                          147,
-		"ListGuildStickers":                              148,
-		"GetGuildSticker":                                149,
-		"CreateGuildSticker":                             150,
-		"ModifyGuildSticker":                             151,
-		"DeleteGuildSticker":                             152,
-		"GetCurrentUser":                                 153,
-		"GetUser":                                        154,
-		"ModifyCurrentUser":                              155,
-		"GetCurrentUserGuilds":                           156,
-		"GetCurrentUserGuildMember":                      157,
-		"LeaveGuild":                                     158,
-		"CreateDM":                                       159,
-		"CreateGroupDM":                                  160,
-		"GetUserConnections":                             161,
-		"GetUserApplicationRoleConnection":               162,
-		"UpdateUserApplicationRoleConnection":            163,
-		"ListVoiceRegions":                               164,
-		"CreateWebhook":                                  165,
-		"GetChannelWebhooks":                             166,
-		"GetGuildWebhooks":                               167,
-		"GetWebhook":                                     168,
-		"GetWebhookwithToken":                            169,
-		"ModifyWebhook":                                  170,
-		"ModifyWebhookwithToken":                         171,
-		"DeleteWebhook":                                  172,
-		"DeleteWebhookwithToken":                         173,
-		"ExecuteWebhook":                                 174,
-		"ExecuteSlackCompatibleWebhook":                  175,
-		"ExecuteGitHubCompatibleWebhook":                 176,
-		"GetWebhookMessage":                              177,
-		"EditWebhookMessage":                             178,
-		"DeleteWebhookMessage":                           179,
-		"GetGateway":                                     180,
-		"GetGatewayBot":                                  181,
-		"GetCurrentBotApplicationInformation":            182,
-		"GetCurrentAuthorizationInformation":             183,
+// Send sends a RemoveThreadMember request to Discord and returns a error.
+func (r *RemoveThreadMember) Send(bot *Client) error {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[71]("71", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
+	endpoint := EndpointRemoveThreadMember(r.ChannelID, r.UserID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
 	}
-)
 
-// Send sends a GetGlobalApplicationCommands request to Discord and returns a []*ApplicationCommand.
-func (r *GetGlobalApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
+	return nil
+}
+
+// Send sends a GetThreadMember request to Discord and returns a ThreadMember.
+func (r *GetThreadMember) Send(bot *Client) (*ThreadMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[2]("2")
+	routeid, resourceid := RateLimitHashFuncs[72]("72", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
 	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -12316,10 +17873,21 @@ func (r *GetGlobalApplicationCommands) Send(bot *Client) ([]*ApplicationCommand,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGlobalApplicationCommands(bot.ApplicationID) + "?" + query
+	endpoint := EndpointGetThreadMember(r.ChannelID, r.UserID) + "?" + query
 
-	result := make([]*ApplicationCommand, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(ThreadMember)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12334,28 +17902,25 @@ func (r *GetGlobalApplicationCommands) Send(bot *Client) ([]*ApplicationCommand,
 	return result, nil
 }
 
-// Send sends a CreateGlobalApplicationCommand request to Discord and returns a ApplicationCommand.
-func (r *CreateGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+// Send sends a ListThreadMembers request to Discord and returns a []*ThreadMember.
+func (r *ListThreadMembers) Send(bot *Client) ([]*ThreadMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[3]("3")
-	endpoint := EndpointCreateGlobalApplicationCommand(bot.ApplicationID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[73]("73", "e5416649"+r.ChannelID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Endpoint:      "",
+			Err:           err,
 		}
 	}
+	endpoint := EndpointListThreadMembers(r.ChannelID) + "?" + query
 
-	result := new(ApplicationCommand)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -12366,18 +17931,8 @@ func (r *CreateGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand,
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetGlobalApplicationCommand request to Discord and returns a ApplicationCommand.
-func (r *GetGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[4]("4", "297ffb1f"+r.CommandID)
-	endpoint := EndpointGetGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
-
-	result := new(ApplicationCommand)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	result := make([]*ThreadMember, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12392,27 +17947,37 @@ func (r *GetGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, er
 	return result, nil
 }
 
-// Send sends a EditGlobalApplicationCommand request to Discord and returns a ApplicationCommand.
-func (r *EditGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+// Send sends a ListPublicArchivedThreads request to Discord and returns a ListPublicArchivedThreadsResponse.
+func (r *ListPublicArchivedThreads) Send(bot *Client) (*ListPublicArchivedThreadsResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[5]("5", "297ffb1f"+r.CommandID)
-	endpoint := EndpointEditGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[74]("74", "e5416649"+r.ChannelID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointListPublicArchivedThreads(r.ChannelID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(ApplicationCommand)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := new(ListPublicArchivedThreadsResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12427,49 +17992,37 @@ func (r *EditGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, e
 	return result, nil
 }
 
-// Send sends a DeleteGlobalApplicationCommand request to Discord and returns a error.
-func (r *DeleteGlobalApplicationCommand) Send(bot *Client) error {
+// Send sends a ListPrivateArchivedThreads request to Discord and returns a ListPrivateArchivedThreadsResponse.
+func (r *ListPrivateArchivedThreads) Send(bot *Client) (*ListPrivateArchivedThreadsResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[6]("6", "297ffb1f"+r.CommandID)
-	endpoint := EndpointDeleteGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	routeid, resourceid := RateLimitHashFuncs[75]("75", "e5416649"+r.ChannelID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
+	endpoint := EndpointListPrivateArchivedThreads(r.ChannelID) + "?" + query
 
-	return nil
-}
-
-// Send sends a BulkOverwriteGlobalApplicationCommands request to Discord and returns a []*ApplicationCommand.
-func (r *BulkOverwriteGlobalApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[7]("7")
-	endpoint := EndpointBulkOverwriteGlobalApplicationCommands(bot.ApplicationID)
-
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := make([]*ApplicationCommand, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, &result)
+	result := new(ListPrivateArchivedThreadsResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12484,11 +18037,11 @@ func (r *BulkOverwriteGlobalApplicationCommands) Send(bot *Client) ([]*Applicati
 	return result, nil
 }
 
-// Send sends a GetGuildApplicationCommands request to Discord and returns a []*ApplicationCommand.
-func (r *GetGuildApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
+// Send sends a ListJoinedPrivateArchivedThreads request to Discord and returns a ListJoinedPrivateArchivedThreadsResponse.
+func (r *ListJoinedPrivateArchivedThreads) Send(bot *Client) (*ListJoinedPrivateArchivedThreadsResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[8]("8", "45892a5d"+r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[76]("76", "e5416649"+r.ChannelID)
 	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -12500,10 +18053,21 @@ func (r *GetGuildApplicationCommands) Send(bot *Client) ([]*ApplicationCommand,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuildApplicationCommands(bot.ApplicationID, r.GuildID) + "?" + query
+	endpoint := EndpointListJoinedPrivateArchivedThreads(r.ChannelID) + "?" + query
 
-	result := make([]*ApplicationCommand, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(ListJoinedPrivateArchivedThreadsResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12518,27 +18082,26 @@ func (r *GetGuildApplicationCommands) Send(bot *Client) ([]*ApplicationCommand,
 	return result, nil
 }
 
-// Send sends a CreateGuildApplicationCommand request to Discord and returns a ApplicationCommand.
-func (r *CreateGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+// Send sends a ListGuildEmojis request to Discord and returns a []*Emoji.
+func (r *ListGuildEmojis) Send(bot *Client) ([]*Emoji, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[9]("9", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateGuildApplicationCommand(bot.ApplicationID, r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[77]("77", "45892a5d"+r.GuildID)
+	endpoint := EndpointListGuildEmojis(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(ApplicationCommand)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := make([]*Emoji, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12553,14 +18116,25 @@ func (r *CreateGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand,
 	return result, nil
 }
 
-// Send sends a GetGuildApplicationCommand request to Discord and returns a ApplicationCommand.
-func (r *GetGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+// Send sends a GetGuildEmoji request to Discord and returns a Emoji.
+func (r *GetGuildEmoji) Send(bot *Client) (*Emoji, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[10]("10", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
-	endpoint := EndpointGetGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
+	routeid, resourceid := RateLimitHashFuncs[78]("78", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
+	endpoint := EndpointGetGuildEmoji(r.GuildID, r.EmojiID)
 
-	result := new(ApplicationCommand)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Emoji)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -12576,12 +18150,23 @@ func (r *GetGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, err
 	return result, nil
 }
 
-// Send sends a EditGuildApplicationCommand request to Discord and returns a ApplicationCommand.
-func (r *EditGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, error) {
+// Send sends a CreateGuildEmoji request to Discord and returns a Emoji.
+func (r *CreateGuildEmoji) Send(bot *Client) (*Emoji, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[11]("11", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
-	endpoint := EndpointEditGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
+	routeid, resourceid := RateLimitHashFuncs[79]("79", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateGuildEmoji(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -12595,8 +18180,8 @@ func (r *EditGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, er
 		}
 	}
 
-	result := new(ApplicationCommand)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := new(Emoji)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12611,16 +18196,15 @@ func (r *EditGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, er
 	return result, nil
 }
 
-// Send sends a DeleteGuildApplicationCommand request to Discord and returns a error.
-func (r *DeleteGuildApplicationCommand) Send(bot *Client) error {
+// Send sends a ModifyGuildEmoji request to Discord and returns a Emoji.
+func (r *ModifyGuildEmoji) Send(bot *Client) (*Emoji, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[12]("12", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
-	endpoint := EndpointDeleteGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
+	routeid, resourceid := RateLimitHashFuncs[80]("80", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
+	endpoint := EndpointModifyGuildEmoji(r.GuildID, r.EmojiID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -12630,16 +18214,6 @@ func (r *DeleteGuildApplicationCommand) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a BulkOverwriteGuildApplicationCommands request to Discord and returns a []*ApplicationCommand.
-func (r *BulkOverwriteGuildApplicationCommands) Send(bot *Client) ([]*ApplicationCommand, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[13]("13", "45892a5d"+r.GuildID)
-	endpoint := EndpointBulkOverwriteGuildApplicationCommands(bot.ApplicationID, r.GuildID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -12652,8 +18226,8 @@ func (r *BulkOverwriteGuildApplicationCommands) Send(bot *Client) ([]*Applicatio
 		}
 	}
 
-	result := make([]*ApplicationCommand, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, &result)
+	result := new(Emoji)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12668,17 +18242,15 @@ func (r *BulkOverwriteGuildApplicationCommands) Send(bot *Client) ([]*Applicatio
 	return result, nil
 }
 
-// Send sends a GetGuildApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
-func (r *GetGuildApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
+// Send sends a DeleteGuildEmoji request to Discord and returns a error.
+func (r *DeleteGuildEmoji) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[14]("14", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildApplicationCommandPermissions(bot.ApplicationID, r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[81]("81", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
+	endpoint := EndpointDeleteGuildEmoji(r.GuildID, r.EmojiID)
 
-	result := new(GuildApplicationCommandPermissions)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -12688,20 +18260,9 @@ func (r *GetGuildApplicationCommandPermissions) Send(bot *Client) (*GuildApplica
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
-func (r *GetApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[15]("15", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
-	endpoint := EndpointGetApplicationCommandPermissions(bot.ApplicationID, r.GuildID, r.CommandID)
-
-	result := new(GuildApplicationCommandPermissions)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -12711,15 +18272,26 @@ func (r *GetApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationC
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a EditApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
-func (r *EditApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
+// Send sends a CreateGuild request to Discord and returns a Guild.
+func (r *CreateGuild) Send(bot *Client) (*Guild, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[16]("16", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
-	endpoint := EndpointEditApplicationCommandPermissions(bot.ApplicationID, r.GuildID, r.CommandID)
+	routeid, resourceid := RateLimitHashFuncs[82]("82")
+	endpoint := EndpointCreateGuild()
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -12733,8 +18305,8 @@ func (r *EditApplicationCommandPermissions) Send(bot *Client) (*GuildApplication
 		}
 	}
 
-	result := new(GuildApplicationCommandPermissions)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
+	result := new(Guild)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12749,51 +18321,39 @@ func (r *EditApplicationCommandPermissions) Send(bot *Client) (*GuildApplication
 	return result, nil
 }
 
-// Send sends a BatchEditApplicationCommandPermissions request to Discord and returns a GuildApplicationCommandPermissions.
-func (r *BatchEditApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationCommandPermissions, error) {
+// Send sends a GetGuild request to Discord and returns a Guild.
+func (r *GetGuild) Send(bot *Client) (*Guild, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[17]("17", "45892a5d"+r.GuildID)
-	endpoint := EndpointBatchEditApplicationCommandPermissions(bot.ApplicationID, r.GuildID)
-
-	result := new(GuildApplicationCommandPermissions)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, result)
+	routeid, resourceid := RateLimitHashFuncs[83]("83", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
+	endpoint := EndpointGetGuild(r.GuildID) + "?" + query
 
-	return result, nil
-}
-
-// Send sends a CreateInteractionResponse request to Discord and returns a error.
-func (r *CreateInteractionResponse) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[18]("18", "beb3d0e6"+r.InteractionID, "cb69bb28"+r.InteractionToken)
-	endpoint := EndpointCreateInteractionResponse(r.InteractionID, r.InteractionToken)
-
-	body, err := json.Marshal(r)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, nil)
+	result := new(Guild)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -12803,30 +18363,31 @@ func (r *CreateInteractionResponse) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a GetOriginalInteractionResponse request to Discord and returns a error.
-func (r *GetOriginalInteractionResponse) Send(bot *Client) error {
+// Send sends a GetGuildPreview request to Discord and returns a GuildPreview.
+func (r *GetGuildPreview) Send(bot *Client) (*GuildPreview, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[19]("19", "cb69bb28"+r.InteractionToken)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return ErrorRequest{
+	routeid, resourceid := RateLimitHashFuncs[84]("84", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildPreview(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken) + "?" + query
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeURLQueryString, nil, nil)
+	result := new(GuildPreview)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -12836,26 +18397,26 @@ func (r *GetOriginalInteractionResponse) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a EditOriginalInteractionResponse request to Discord and returns a Message.
-func (r *EditOriginalInteractionResponse) Send(bot *Client) (*Message, error) {
+// Send sends a ModifyGuild request to Discord and returns a Guild.
+func (r *ModifyGuild) Send(bot *Client) (*Guild, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[20]("20", "cb69bb28"+r.InteractionToken)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[85]("85", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyGuild(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointEditOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken) + "?" + query
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -12869,23 +18430,8 @@ func (r *EditOriginalInteractionResponse) Send(bot *Client) (*Message, error) {
 		}
 	}
 
-	contentType := ContentTypeJSON
-	if len(r.Files) != 0 {
-		var multipartErr error
-		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
-			return nil, ErrorRequest{
-				ClientID:      bot.ApplicationID,
-				CorrelationID: xid,
-				RouteID:       routeid,
-				ResourceID:    resourceid,
-				Endpoint:      "",
-				Err:           err,
-			}
-		}
-	}
-
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
+	result := new(Guild)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12900,12 +18446,23 @@ func (r *EditOriginalInteractionResponse) Send(bot *Client) (*Message, error) {
 	return result, nil
 }
 
-// Send sends a DeleteOriginalInteractionResponse request to Discord and returns a error.
-func (r *DeleteOriginalInteractionResponse) Send(bot *Client) error {
+// Send sends a DeleteGuild request to Discord and returns a error.
+func (r *DeleteGuild) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[21]("21", "cb69bb28"+r.InteractionToken)
-	endpoint := EndpointDeleteOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken)
+	routeid, resourceid := RateLimitHashFuncs[86]("86", "45892a5d"+r.GuildID)
+	endpoint := EndpointDeleteGuild(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
@@ -12922,25 +18479,26 @@ func (r *DeleteOriginalInteractionResponse) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a CreateFollowupMessage request to Discord and returns a Message.
-func (r *CreateFollowupMessage) Send(bot *Client) (*Message, error) {
+// Send sends a GetGuildChannels request to Discord and returns a []*Channel.
+func (r *GetGuildChannels) Send(bot *Client) ([]*Channel, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[22]("22", "cb69bb28"+r.InteractionToken)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[87]("87", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildChannels(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointCreateFollowupMessage(bot.ApplicationID, r.InteractionToken) + "?" + query
 
-	body, err := json.Marshal(r)
+	result := make([]*Channel, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -12948,28 +18506,21 @@ func (r *CreateFollowupMessage) Send(bot *Client) (*Message, error) {
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	contentType := ContentTypeJSON
-	if len(r.Files) != 0 {
-		var multipartErr error
-		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
-			return nil, ErrorRequest{
-				ClientID:      bot.ApplicationID,
-				CorrelationID: xid,
-				RouteID:       routeid,
-				ResourceID:    resourceid,
-				Endpoint:      "",
-				Err:           err,
-			}
-		}
-	}
+	return result, nil
+}
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, result)
-	if err != nil {
+// Send sends a CreateGuildChannel request to Discord and returns a Channel.
+func (r *CreateGuildChannel) Send(bot *Client) (*Channel, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[88]("88", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateGuildChannel(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -12980,29 +18531,20 @@ func (r *CreateFollowupMessage) Send(bot *Client) (*Message, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetFollowupMessage request to Discord and returns a Message.
-func (r *GetFollowupMessage) Send(bot *Client) (*Message, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[23]("23", "cb69bb28"+r.InteractionToken, "d57d6589"+r.MessageID)
-	query, err := EndpointQueryString(r)
+	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
-	endpoint := EndpointGetFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID) + "?" + query
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13017,27 +18559,27 @@ func (r *GetFollowupMessage) Send(bot *Client) (*Message, error) {
 	return result, nil
 }
 
-// Send sends a EditFollowupMessage request to Discord and returns a Message.
-func (r *EditFollowupMessage) Send(bot *Client) (*Message, error) {
+// Send sends a ModifyGuildChannelPositions request to Discord and returns a error.
+func (r *ModifyGuildChannelPositions) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[24]("24", "cb69bb28"+r.InteractionToken, "d57d6589"+r.MessageID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
+	routeid, resourceid := RateLimitHashFuncs[89]("89", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyGuildChannelPositions(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointEditFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID) + "?" + query
 
 	body, err := json.Marshal(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13047,23 +18589,41 @@ func (r *EditFollowupMessage) Send(bot *Client) (*Message, error) {
 		}
 	}
 
-	contentType := ContentTypeJSON
-	if len(r.Files) != 0 {
-		var multipartErr error
-		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
-			return nil, ErrorRequest{
-				ClientID:      bot.ApplicationID,
-				CorrelationID: xid,
-				RouteID:       routeid,
-				ResourceID:    resourceid,
-				Endpoint:      "",
-				Err:           err,
-			}
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, nil)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
 		}
 	}
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
+	return nil
+}
+
+// Send sends a ListActiveGuildThreads request to Discord and returns a ListActiveGuildThreadsResponse.
+func (r *ListActiveGuildThreads) Send(bot *Client) (*ListActiveGuildThreadsResponse, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[90]("90", "45892a5d"+r.GuildID)
+	endpoint := EndpointListActiveGuildThreads(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(ListActiveGuildThreadsResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13078,16 +18638,15 @@ func (r *EditFollowupMessage) Send(bot *Client) (*Message, error) {
 	return result, nil
 }
 
-// Send sends a DeleteFollowupMessage request to Discord and returns a error.
-func (r *DeleteFollowupMessage) Send(bot *Client) error {
+// Send sends a GetGuildMember request to Discord and returns a GuildMember.
+func (r *GetGuildMember) Send(bot *Client) (*GuildMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[25]("25", "cb69bb28"+r.InteractionToken, "d57d6589"+r.MessageID)
-	endpoint := EndpointDeleteFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[91]("91", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointGetGuildMember(r.GuildID, r.UserID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13097,17 +18656,7 @@ func (r *DeleteFollowupMessage) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetCurrentApplication request to Discord and returns a Application.
-func (r *GetCurrentApplication) Send(bot *Client) (*Application, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[26]("26")
-	endpoint := EndpointGetCurrentApplication()
-
-	result := new(Application)
+	result := new(GuildMember)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -13123,38 +18672,37 @@ func (r *GetCurrentApplication) Send(bot *Client) (*Application, error) {
 	return result, nil
 }
 
-// Send sends a GetApplicationRoleConnectionMetadataRecords request to Discord and returns a []*ApplicationRoleConnectionMetadata.
-func (r *GetApplicationRoleConnectionMetadataRecords) Send(bot *Client) ([]*ApplicationRoleConnectionMetadata, error) {
+// Send sends a ListGuildMembers request to Discord and returns a []*GuildMember.
+func (r *ListGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[27]("27")
-	endpoint := EndpointGetApplicationRoleConnectionMetadataRecords(bot.ApplicationID)
-
-	result := make([]*ApplicationRoleConnectionMetadata, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	routeid, resourceid := RateLimitHashFuncs[92]("92", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
+	endpoint := EndpointListGuildMembers(r.GuildID) + "?" + query
 
-	return result, nil
-}
-
-// Send sends a UpdateApplicationRoleConnectionMetadataRecords request to Discord and returns a []*ApplicationRoleConnectionMetadata.
-func (r *UpdateApplicationRoleConnectionMetadataRecords) Send(bot *Client) ([]*ApplicationRoleConnectionMetadata, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[28]("28")
-	endpoint := EndpointUpdateApplicationRoleConnectionMetadataRecords(bot.ApplicationID)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	result := make([]*ApplicationRoleConnectionMetadata, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, &result)
+	result := make([]*GuildMember, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13169,11 +18717,11 @@ func (r *UpdateApplicationRoleConnectionMetadataRecords) Send(bot *Client) ([]*A
 	return result, nil
 }
 
-// Send sends a GetGuildAuditLog request to Discord and returns a AuditLog.
-func (r *GetGuildAuditLog) Send(bot *Client) (*AuditLog, error) {
+// Send sends a SearchGuildMembers request to Discord and returns a []*GuildMember.
+func (r *SearchGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[29]("29", "45892a5d"+r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[93]("93", "45892a5d"+r.GuildID)
 	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -13185,10 +18733,21 @@ func (r *GetGuildAuditLog) Send(bot *Client) (*AuditLog, error) {
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuildAuditLog(r.GuildID) + "?" + query
+	endpoint := EndpointSearchGuildMembers(r.GuildID) + "?" + query
 
-	result := new(AuditLog)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*GuildMember, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13203,16 +18762,14 @@ func (r *GetGuildAuditLog) Send(bot *Client) (*AuditLog, error) {
 	return result, nil
 }
 
-// Send sends a ListAutoModerationRulesForGuild request to Discord and returns a []*AutoModerationAction.
-func (r *ListAutoModerationRulesForGuild) Send(bot *Client) ([]*AutoModerationAction, error) {
+// Send sends a AddGuildMember request to Discord and returns a GuildMember.
+func (r *AddGuildMember) Send(bot *Client) (*GuildMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[30]("30", "45892a5d"+r.GuildID)
-	endpoint := EndpointListAutoModerationRulesForGuild(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[94]("94", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointAddGuildMember(r.GuildID, r.UserID)
 
-	result := make([]*AutoModerationAction, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -13223,18 +18780,20 @@ func (r *ListAutoModerationRulesForGuild) Send(bot *Client) ([]*AutoModerationAc
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetAutoModerationRule request to Discord and returns a AutoModerationRule.
-func (r *GetAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[31]("31", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
-	endpoint := EndpointGetAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-	result := new(AutoModerationRule)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	result := new(GuildMember)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13249,12 +18808,23 @@ func (r *GetAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
 	return result, nil
 }
 
-// Send sends a CreateAutoModerationRule request to Discord and returns a AutoModerationRule.
-func (r *CreateAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
+// Send sends a ModifyGuildMember request to Discord and returns a GuildMember.
+func (r *ModifyGuildMember) Send(bot *Client) (*GuildMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[32]("32", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateAutoModerationRule(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[95]("95", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointModifyGuildMember(r.GuildID, r.UserID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -13268,8 +18838,8 @@ func (r *CreateAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error
 		}
 	}
 
-	result := new(AutoModerationRule)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := new(GuildMember)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13284,12 +18854,23 @@ func (r *CreateAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error
 	return result, nil
 }
 
-// Send sends a ModifyAutoModerationRule request to Discord and returns a AutoModerationRule.
-func (r *ModifyAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
+// Send sends a ModifyCurrentMember request to Discord and returns a GuildMember.
+func (r *ModifyCurrentMember) Send(bot *Client) (*GuildMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[33]("33", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
-	endpoint := EndpointModifyAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
+	routeid, resourceid := RateLimitHashFuncs[96]("96", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyCurrentMember(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -13303,7 +18884,7 @@ func (r *ModifyAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error
 		}
 	}
 
-	result := new(AutoModerationRule)
+	result := new(GuildMember)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -13319,14 +18900,25 @@ func (r *ModifyAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error
 	return result, nil
 }
 
-// Send sends a DeleteAutoModerationRule request to Discord and returns a error.
-func (r *DeleteAutoModerationRule) Send(bot *Client) error {
+// Send sends a AddGuildMemberRole request to Discord and returns a error.
+func (r *AddGuildMemberRole) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[34]("34", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
-	endpoint := EndpointDeleteAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
+	routeid, resourceid := RateLimitHashFuncs[97]("97", "45892a5d"+r.GuildID, "209c92df"+r.UserID, "3cf7dd7c"+r.RoleID)
+	endpoint := EndpointAddGuildMemberRole(r.GuildID, r.UserID, r.RoleID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13341,17 +18933,15 @@ func (r *DeleteAutoModerationRule) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a GetChannel request to Discord and returns a Channel.
-func (r *GetChannel) Send(bot *Client) (*Channel, error) {
+// Send sends a RemoveGuildMemberRole request to Discord and returns a error.
+func (r *RemoveGuildMemberRole) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[35]("35", "e5416649"+r.ChannelID)
-	endpoint := EndpointGetChannel(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[98]("98", "45892a5d"+r.GuildID, "209c92df"+r.UserID, "3cf7dd7c"+r.RoleID)
+	endpoint := EndpointRemoveGuildMemberRole(r.GuildID, r.UserID, r.RoleID)
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13361,20 +18951,9 @@ func (r *GetChannel) Send(bot *Client) (*Channel, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a ModifyChannel request to Discord and returns a Channel.
-func (r *ModifyChannel) Send(bot *Client) (*Channel, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[36]("36", "e5416649"+r.ChannelID)
-	endpoint := EndpointModifyChannel(r.ChannelID)
-
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, nil, nil, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13384,32 +18963,30 @@ func (r *ModifyChannel) Send(bot *Client) (*Channel, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ModifyChannelGroupDM request to Discord and returns a Channel.
-func (r *ModifyChannelGroupDM) Send(bot *Client) (*Channel, error) {
+// Send sends a RemoveGuildMember request to Discord and returns a error.
+func (r *RemoveGuildMember) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[37]("37", "e5416649"+r.ChannelID)
-	endpoint := EndpointModifyChannelGroupDM(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[99]("99", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointRemoveGuildMember(r.GuildID, r.UserID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13419,30 +18996,40 @@ func (r *ModifyChannelGroupDM) Send(bot *Client) (*Channel, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ModifyChannelGuild request to Discord and returns a Channel.
-func (r *ModifyChannelGuild) Send(bot *Client) (*Channel, error) {
+// Send sends a GetGuildBans request to Discord and returns a []*Ban.
+func (r *GetGuildBans) Send(bot *Client) ([]*Ban, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[38]("38", "e5416649"+r.ChannelID)
-	endpoint := EndpointModifyChannelGuild(r.ChannelID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[100]("100", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointGetGuildBans(r.GuildID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := make([]*Ban, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13457,27 +19044,26 @@ func (r *ModifyChannelGuild) Send(bot *Client) (*Channel, error) {
 	return result, nil
 }
 
-// Send sends a ModifyChannelThread request to Discord and returns a Channel.
-func (r *ModifyChannelThread) Send(bot *Client) (*Channel, error) {
+// Send sends a GetGuildBan request to Discord and returns a Ban.
+func (r *GetGuildBan) Send(bot *Client) (*Ban, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[39]("39", "e5416649"+r.ChannelID)
-	endpoint := EndpointModifyChannelThread(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[101]("101", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointGetGuildBan(r.GuildID, r.UserID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := new(Ban)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13492,17 +19078,15 @@ func (r *ModifyChannelThread) Send(bot *Client) (*Channel, error) {
 	return result, nil
 }
 
-// Send sends a DeleteCloseChannel request to Discord and returns a Channel.
-func (r *DeleteCloseChannel) Send(bot *Client) (*Channel, error) {
+// Send sends a CreateGuildBan request to Discord and returns a error.
+func (r *CreateGuildBan) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[40]("40", "e5416649"+r.ChannelID)
-	endpoint := EndpointDeleteCloseChannel(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[102]("102", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointCreateGuildBan(r.GuildID, r.UserID)
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13512,31 +19096,21 @@ func (r *DeleteCloseChannel) Send(bot *Client) (*Channel, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetChannelMessages request to Discord and returns a []*Message.
-func (r *GetChannelMessages) Send(bot *Client) ([]*Message, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[41]("41", "e5416649"+r.ChannelID)
-	query, err := EndpointQueryString(r)
+	body, err := json.Marshal(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
-	endpoint := EndpointGetChannelMessages(r.ChannelID) + "?" + query
 
-	result := make([]*Message, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13546,20 +19120,30 @@ func (r *GetChannelMessages) Send(bot *Client) ([]*Message, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a GetChannelMessage request to Discord and returns a Message.
-func (r *GetChannelMessage) Send(bot *Client) (*Message, error) {
+// Send sends a RemoveGuildBan request to Discord and returns a error.
+func (r *RemoveGuildBan) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[42]("42", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointGetChannelMessage(r.ChannelID, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[103]("103", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointRemoveGuildBan(r.GuildID, r.UserID)
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13569,45 +19153,29 @@ func (r *GetChannelMessage) Send(bot *Client) (*Message, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a CreateMessage request to Discord and returns a Message.
-func (r *CreateMessage) Send(bot *Client) (*Message, error) {
+// Send sends a GetGuildRoles request to Discord and returns a []*Role.
+func (r *GetGuildRoles) Send(bot *Client) ([]*Role, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[43]("43", "e5416649"+r.ChannelID)
-	endpoint := EndpointCreateMessage(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[104]("104", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildRoles(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
-		}
-	}
-
-	contentType := ContentTypeJSON
-	if len(r.Files) != 0 {
-		var multipartErr error
-		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
-			return nil, ErrorRequest{
-				ClientID:      bot.ApplicationID,
-				CorrelationID: xid,
-				RouteID:       routeid,
-				ResourceID:    resourceid,
-				Endpoint:      "",
-				Err:           err,
-			}
+			Err:           err,
 		}
 	}
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, result)
+	result := make([]*Role, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13622,16 +19190,14 @@ func (r *CreateMessage) Send(bot *Client) (*Message, error) {
 	return result, nil
 }
 
-// Send sends a CrosspostMessage request to Discord and returns a Message.
-func (r *CrosspostMessage) Send(bot *Client) (*Message, error) {
+// Send sends a CreateGuildRole request to Discord and returns a Role.
+func (r *CreateGuildRole) Send(bot *Client) (*Role, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[44]("44", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointCrosspostMessage(r.ChannelID, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[105]("105", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateGuildRole(r.GuildID)
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -13642,41 +19208,22 @@ func (r *CrosspostMessage) Send(bot *Client) (*Message, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a CreateReaction request to Discord and returns a error.
-func (r *CreateReaction) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[45]("45", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
-	endpoint := EndpointCreateReaction(r.ChannelID, r.MessageID, r.Emoji)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
+	body, err := json.Marshal(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           err,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
 
-	return nil
-}
-
-// Send sends a DeleteOwnReaction request to Discord and returns a error.
-func (r *DeleteOwnReaction) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[46]("46", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
-	endpoint := EndpointDeleteOwnReaction(r.ChannelID, r.MessageID, r.Emoji)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	result := new(Role)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13686,19 +19233,18 @@ func (r *DeleteOwnReaction) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a DeleteUserReaction request to Discord and returns a error.
-func (r *DeleteUserReaction) Send(bot *Client) error {
+// Send sends a ModifyGuildRolePositions request to Discord and returns a []*Role.
+func (r *ModifyGuildRolePositions) Send(bot *Client) ([]*Role, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[47]("47", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji, "209c92df"+r.UserID)
-	endpoint := EndpointDeleteUserReaction(r.ChannelID, r.MessageID, r.Emoji, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[106]("106", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyGuildRolePositions(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13708,29 +19254,20 @@ func (r *DeleteUserReaction) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetReactions request to Discord and returns a []*User.
-func (r *GetReactions) Send(bot *Client) ([]*User, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[48]("48", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
-	query, err := EndpointQueryString(r)
+	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
-	endpoint := EndpointGetReactions(r.ChannelID, r.MessageID, r.Emoji) + "?" + query
 
-	result := make([]*User, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	result := make([]*Role, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13745,16 +19282,15 @@ func (r *GetReactions) Send(bot *Client) ([]*User, error) {
 	return result, nil
 }
 
-// Send sends a DeleteAllReactions request to Discord and returns a error.
-func (r *DeleteAllReactions) Send(bot *Client) error {
+// Send sends a ModifyGuildRole request to Discord and returns a Role.
+func (r *ModifyGuildRole) Send(bot *Client) (*Role, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[49]("49", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointDeleteAllReactions(r.ChannelID, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[107]("107", "45892a5d"+r.GuildID, "3cf7dd7c"+r.RoleID)
+	endpoint := EndpointModifyGuildRole(r.GuildID, r.RoleID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13764,19 +19300,22 @@ func (r *DeleteAllReactions) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a DeleteAllReactionsforEmoji request to Discord and returns a error.
-func (r *DeleteAllReactionsforEmoji) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[50]("50", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
-	endpoint := EndpointDeleteAllReactionsforEmoji(r.ChannelID, r.MessageID, r.Emoji)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	result := new(Role)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13786,47 +19325,30 @@ func (r *DeleteAllReactionsforEmoji) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a EditMessage request to Discord and returns a Message.
-func (r *EditMessage) Send(bot *Client) (*Message, error) {
+// Send sends a DeleteGuildRole request to Discord and returns a error.
+func (r *DeleteGuildRole) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[51]("51", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointEditMessage(r.ChannelID, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[108]("108", "45892a5d"+r.GuildID, "3cf7dd7c"+r.RoleID)
+	endpoint := EndpointDeleteGuildRole(r.GuildID, r.RoleID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
-		}
-	}
-
-	contentType := ContentTypeJSON
-	if len(r.Files) != 0 {
-		var multipartErr error
-		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
-			return nil, ErrorRequest{
-				ClientID:      bot.ApplicationID,
-				CorrelationID: xid,
-				RouteID:       routeid,
-				ResourceID:    resourceid,
-				Endpoint:      "",
-				Err:           err,
-			}
+			Err:           err,
 		}
 	}
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13836,19 +19358,18 @@ func (r *EditMessage) Send(bot *Client) (*Message, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a DeleteMessage request to Discord and returns a error.
-func (r *DeleteMessage) Send(bot *Client) error {
+// Send sends a ModifyGuildMFALevel request to Discord and returns a ModifyGuildMFALevelResponse.
+func (r *ModifyGuildMFALevel) Send(bot *Client) (*ModifyGuildMFALevelResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[52]("52", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointDeleteMessage(r.ChannelID, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[109]("109", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyGuildMFALevel(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13858,19 +19379,9 @@ func (r *DeleteMessage) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a BulkDeleteMessages request to Discord and returns a error.
-func (r *BulkDeleteMessages) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[53]("53", "e5416649"+r.ChannelID)
-	endpoint := EndpointBulkDeleteMessages(r.ChannelID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13880,9 +19391,10 @@ func (r *BulkDeleteMessages) Send(bot *Client) error {
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, nil)
+	result := new(ModifyGuildMFALevelResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13892,31 +19404,29 @@ func (r *BulkDeleteMessages) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a EditChannelPermissions request to Discord and returns a error.
-func (r *EditChannelPermissions) Send(bot *Client) error {
+// Send sends a GetGuildPruneCount request to Discord and returns a GetGuildPruneCountResponse.
+func (r *GetGuildPruneCount) Send(bot *Client) (*GetGuildPruneCountResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[54]("54", "e5416649"+r.ChannelID, "9167175f"+r.OverwriteID)
-	endpoint := EndpointEditChannelPermissions(r.ChannelID, r.OverwriteID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[110]("110", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Endpoint:      "",
+			Err:           err,
 		}
 	}
+	endpoint := EndpointGetGuildPruneCount(r.GuildID) + "?" + query
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13926,18 +19436,8 @@ func (r *EditChannelPermissions) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetChannelInvites request to Discord and returns a []*Invite.
-func (r *GetChannelInvites) Send(bot *Client) ([]*Invite, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[55]("55", "e5416649"+r.ChannelID)
-	endpoint := EndpointGetChannelInvites(r.ChannelID)
-
-	result := make([]*Invite, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	result := new(GetGuildPruneCountResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -13952,16 +19452,27 @@ func (r *GetChannelInvites) Send(bot *Client) ([]*Invite, error) {
 	return result, nil
 }
 
-// Send sends a CreateChannelInvite request to Discord and returns a Invite.
-func (r *CreateChannelInvite) Send(bot *Client) (*Invite, error) {
+// Send sends a BeginGuildPrune request to Discord and returns a error.
+func (r *BeginGuildPrune) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[56]("56", "e5416649"+r.ChannelID)
-	endpoint := EndpointCreateChannelInvite(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[111]("111", "45892a5d"+r.GuildID)
+	endpoint := EndpointBeginGuildPrune(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13971,10 +19482,9 @@ func (r *CreateChannelInvite) Send(bot *Client) (*Invite, error) {
 		}
 	}
 
-	result := new(Invite)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -13984,19 +19494,31 @@ func (r *CreateChannelInvite) Send(bot *Client) (*Invite, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a DeleteChannelPermission request to Discord and returns a error.
-func (r *DeleteChannelPermission) Send(bot *Client) error {
+// Send sends a GetGuildVoiceRegions request to Discord and returns a []*VoiceRegion.
+func (r *GetGuildVoiceRegions) Send(bot *Client) ([]*VoiceRegion, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[57]("57", "e5416649"+r.ChannelID, "9167175f"+r.OverwriteID)
-	endpoint := EndpointDeleteChannelPermission(r.ChannelID, r.OverwriteID)
+	routeid, resourceid := RateLimitHashFuncs[112]("112", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildVoiceRegions(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*VoiceRegion, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14006,30 +19528,29 @@ func (r *DeleteChannelPermission) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a FollowAnnouncementChannel request to Discord and returns a FollowedChannel.
-func (r *FollowAnnouncementChannel) Send(bot *Client) (*FollowedChannel, error) {
+// Send sends a GetGuildInvites request to Discord and returns a []*Invite.
+func (r *GetGuildInvites) Send(bot *Client) ([]*Invite, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[58]("58", "e5416649"+r.ChannelID)
-	endpoint := EndpointFollowAnnouncementChannel(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[113]("113", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildInvites(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(FollowedChannel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := make([]*Invite, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14044,16 +19565,15 @@ func (r *FollowAnnouncementChannel) Send(bot *Client) (*FollowedChannel, error)
 	return result, nil
 }
 
-// Send sends a TriggerTypingIndicator request to Discord and returns a error.
-func (r *TriggerTypingIndicator) Send(bot *Client) error {
+// Send sends a GetGuildIntegrations request to Discord and returns a []*Integration.
+func (r *GetGuildIntegrations) Send(bot *Client) ([]*Integration, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[59]("59", "e5416649"+r.ChannelID)
-	endpoint := EndpointTriggerTypingIndicator(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[114]("114", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildIntegrations(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14063,17 +19583,7 @@ func (r *TriggerTypingIndicator) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetPinnedMessages request to Discord and returns a []*Message.
-func (r *GetPinnedMessages) Send(bot *Client) ([]*Message, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[60]("60", "e5416649"+r.ChannelID)
-	endpoint := EndpointGetPinnedMessages(r.ChannelID)
-
-	result := make([]*Message, 0)
+	result := make([]*Integration, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -14089,15 +19599,14 @@ func (r *GetPinnedMessages) Send(bot *Client) ([]*Message, error) {
 	return result, nil
 }
 
-// Send sends a PinMessage request to Discord and returns a error.
-func (r *PinMessage) Send(bot *Client) error {
+// Send sends a DeleteGuildIntegration request to Discord and returns a error.
+func (r *DeleteGuildIntegration) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[61]("61", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointPinMessage(r.ChannelID, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[115]("115", "45892a5d"+r.GuildID, "cb4479f8"+r.IntegrationID)
+	endpoint := EndpointDeleteGuildIntegration(r.GuildID, r.IntegrationID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -14108,16 +19617,6 @@ func (r *PinMessage) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a UnpinMessage request to Discord and returns a error.
-func (r *UnpinMessage) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[62]("62", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointUnpinMessage(r.ChannelID, r.MessageID)
-
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -14133,28 +19632,28 @@ func (r *UnpinMessage) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a GroupDMAddRecipient request to Discord and returns a error.
-func (r *GroupDMAddRecipient) Send(bot *Client) error {
+// Send sends a GetGuildWidgetSettings request to Discord and returns a GuildWidget.
+func (r *GetGuildWidgetSettings) Send(bot *Client) (*GuildWidget, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[63]("63", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
-	endpoint := EndpointGroupDMAddRecipient(r.ChannelID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[116]("116", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildWidgetSettings(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, nil)
+	result := new(GuildWidget)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14164,19 +19663,31 @@ func (r *GroupDMAddRecipient) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a GroupDMRemoveRecipient request to Discord and returns a error.
-func (r *GroupDMRemoveRecipient) Send(bot *Client) error {
+// Send sends a ModifyGuildWidget request to Discord and returns a GuildWidget.
+func (r *ModifyGuildWidget) Send(bot *Client) (*GuildWidget, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[64]("64", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
-	endpoint := EndpointGroupDMRemoveRecipient(r.ChannelID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[117]("117", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyGuildWidget(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(GuildWidget)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, nil, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14186,30 +19697,29 @@ func (r *GroupDMRemoveRecipient) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a StartThreadfromMessage request to Discord and returns a Channel.
-func (r *StartThreadfromMessage) Send(bot *Client) (*Channel, error) {
+// Send sends a GetGuildWidget request to Discord and returns a GuildWidget.
+func (r *GetGuildWidget) Send(bot *Client) (*GuildWidget, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[65]("65", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
-	endpoint := EndpointStartThreadfromMessage(r.ChannelID, r.MessageID)
+	routeid, resourceid := RateLimitHashFuncs[118]("118", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildWidget(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := new(GuildWidget)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14224,12 +19734,23 @@ func (r *StartThreadfromMessage) Send(bot *Client) (*Channel, error) {
 	return result, nil
 }
 
-// Send sends a StartThreadwithoutMessage request to Discord and returns a Channel.
-func (r *StartThreadwithoutMessage) Send(bot *Client) (*Channel, error) {
+// Send sends a GetGuildVanityURL request to Discord and returns a Invite.
+func (r *GetGuildVanityURL) Send(bot *Client) (*Invite, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[66]("66", "e5416649"+r.ChannelID)
-	endpoint := EndpointStartThreadwithoutMessage(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[119]("119", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildVanityURL(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -14243,8 +19764,8 @@ func (r *StartThreadwithoutMessage) Send(bot *Client) (*Channel, error) {
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := new(Invite)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14259,28 +19780,25 @@ func (r *StartThreadwithoutMessage) Send(bot *Client) (*Channel, error) {
 	return result, nil
 }
 
-// Send sends a StartThreadinForumChannel request to Discord and returns a Channel.
-func (r *StartThreadinForumChannel) Send(bot *Client) (*Channel, error) {
+// Send sends a GetGuildWidgetImage request to Discord and returns a EmbedImage.
+func (r *GetGuildWidgetImage) Send(bot *Client) (*EmbedImage, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[67]("67", "e5416649"+r.ChannelID)
-	endpoint := EndpointStartThreadinForumChannel(r.ChannelID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[120]("120", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Endpoint:      "",
+			Err:           err,
 		}
 	}
+	endpoint := EndpointGetGuildWidgetImage(r.GuildID) + "?" + query
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -14291,19 +19809,10 @@ func (r *StartThreadinForumChannel) Send(bot *Client) (*Channel, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a JoinThread request to Discord and returns a error.
-func (r *JoinThread) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[68]("68", "e5416649"+r.ChannelID)
-	endpoint := EndpointJoinThread(r.ChannelID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
+	result := new(EmbedImage)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14313,19 +19822,18 @@ func (r *JoinThread) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a AddThreadMember request to Discord and returns a error.
-func (r *AddThreadMember) Send(bot *Client) error {
+// Send sends a GetGuildWelcomeScreen request to Discord and returns a WelcomeScreen.
+func (r *GetGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[69]("69", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
-	endpoint := EndpointAddThreadMember(r.ChannelID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[121]("121", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildWelcomeScreen(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14335,19 +19843,10 @@ func (r *AddThreadMember) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a LeaveThread request to Discord and returns a error.
-func (r *LeaveThread) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[70]("70", "e5416649"+r.ChannelID)
-	endpoint := EndpointLeaveThread(r.ChannelID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	result := new(WelcomeScreen)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14357,19 +19856,18 @@ func (r *LeaveThread) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a RemoveThreadMember request to Discord and returns a error.
-func (r *RemoveThreadMember) Send(bot *Client) error {
+// Send sends a ModifyGuildWelcomeScreen request to Discord and returns a WelcomeScreen.
+func (r *ModifyGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[71]("71", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
-	endpoint := EndpointRemoveThreadMember(r.ChannelID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[122]("122", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyGuildWelcomeScreen(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14379,29 +19877,20 @@ func (r *RemoveThreadMember) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetThreadMember request to Discord and returns a ThreadMember.
-func (r *GetThreadMember) Send(bot *Client) (*ThreadMember, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[72]("72", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
-	query, err := EndpointQueryString(r)
+	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
-	endpoint := EndpointGetThreadMember(r.ChannelID, r.UserID) + "?" + query
 
-	result := new(ThreadMember)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	result := new(WelcomeScreen)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14416,26 +19905,26 @@ func (r *GetThreadMember) Send(bot *Client) (*ThreadMember, error) {
 	return result, nil
 }
 
-// Send sends a ListThreadMembers request to Discord and returns a []*ThreadMember.
-func (r *ListThreadMembers) Send(bot *Client) ([]*ThreadMember, error) {
+// Send sends a GetGuildOnboarding request to Discord and returns a GuildOnboarding.
+func (r *GetGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[73]("73", "e5416649"+r.ChannelID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[123]("123", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildOnboarding(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointListThreadMembers(r.ChannelID) + "?" + query
 
-	result := make([]*ThreadMember, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	result := new(GuildOnboarding)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14450,27 +19939,14 @@ func (r *ListThreadMembers) Send(bot *Client) ([]*ThreadMember, error) {
 	return result, nil
 }
 
-// Send sends a ListPublicArchivedThreads request to Discord and returns a ListPublicArchivedThreadsResponse.
-func (r *ListPublicArchivedThreads) Send(bot *Client) (*ListPublicArchivedThreadsResponse, error) {
+// Send sends a ModifyGuildOnboarding request to Discord and returns a GuildOnboarding.
+func (r *ModifyGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[74]("74", "e5416649"+r.ChannelID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
-	}
-	endpoint := EndpointListPublicArchivedThreads(r.ChannelID) + "?" + query
+	routeid, resourceid := RateLimitHashFuncs[124]("124", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyGuildOnboarding(r.GuildID)
 
-	result := new(ListPublicArchivedThreadsResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -14481,29 +19957,20 @@ func (r *ListPublicArchivedThreads) Send(bot *Client) (*ListPublicArchivedThread
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a ListPrivateArchivedThreads request to Discord and returns a ListPrivateArchivedThreadsResponse.
-func (r *ListPrivateArchivedThreads) Send(bot *Client) (*ListPrivateArchivedThreadsResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[75]("75", "e5416649"+r.ChannelID)
-	query, err := EndpointQueryString(r)
+	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
-	endpoint := EndpointListPrivateArchivedThreads(r.ChannelID) + "?" + query
 
-	result := new(ListPrivateArchivedThreadsResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	result := new(GuildOnboarding)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14518,51 +19985,39 @@ func (r *ListPrivateArchivedThreads) Send(bot *Client) (*ListPrivateArchivedThre
 	return result, nil
 }
 
-// Send sends a ListJoinedPrivateArchivedThreads request to Discord and returns a ListJoinedPrivateArchivedThreadsResponse.
-func (r *ListJoinedPrivateArchivedThreads) Send(bot *Client) (*ListJoinedPrivateArchivedThreadsResponse, error) {
+// Send sends a ModifyCurrentUserVoiceState request to Discord and returns a error.
+func (r *ModifyCurrentUserVoiceState) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[76]("76", "e5416649"+r.ChannelID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
+	routeid, resourceid := RateLimitHashFuncs[125]("125", "45892a5d"+r.GuildID)
+	endpoint := EndpointModifyCurrentUserVoiceState(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointListJoinedPrivateArchivedThreads(r.ChannelID) + "?" + query
 
-	result := new(ListJoinedPrivateArchivedThreadsResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	body, err := json.Marshal(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           err,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a ListGuildEmojis request to Discord and returns a []*Emoji.
-func (r *ListGuildEmojis) Send(bot *Client) ([]*Emoji, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[77]("77", "45892a5d"+r.GuildID)
-	endpoint := EndpointListGuildEmojis(r.GuildID)
-
-	result := make([]*Emoji, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14572,20 +20027,18 @@ func (r *ListGuildEmojis) Send(bot *Client) ([]*Emoji, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a GetGuildEmoji request to Discord and returns a Emoji.
-func (r *GetGuildEmoji) Send(bot *Client) (*Emoji, error) {
+// Send sends a ModifyUserVoiceState request to Discord and returns a error.
+func (r *ModifyUserVoiceState) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[78]("78", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
-	endpoint := EndpointGetGuildEmoji(r.GuildID, r.EmojiID)
+	routeid, resourceid := RateLimitHashFuncs[126]("126", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
+	endpoint := EndpointModifyUserVoiceState(r.GuildID, r.UserID)
 
-	result := new(Emoji)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14595,19 +20048,9 @@ func (r *GetGuildEmoji) Send(bot *Client) (*Emoji, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a CreateGuildEmoji request to Discord and returns a Emoji.
-func (r *CreateGuildEmoji) Send(bot *Client) (*Emoji, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[79]("79", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateGuildEmoji(r.GuildID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14617,10 +20060,9 @@ func (r *CreateGuildEmoji) Send(bot *Client) (*Emoji, error) {
 		}
 	}
 
-	result := new(Emoji)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14630,30 +20072,40 @@ func (r *CreateGuildEmoji) Send(bot *Client) (*Emoji, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ModifyGuildEmoji request to Discord and returns a Emoji.
-func (r *ModifyGuildEmoji) Send(bot *Client) (*Emoji, error) {
+// Send sends a ListScheduledEventsforGuild request to Discord and returns a []*GuildScheduledEvent.
+func (r *ListScheduledEventsforGuild) Send(bot *Client) ([]*GuildScheduledEvent, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[80]("80", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
-	endpoint := EndpointModifyGuildEmoji(r.GuildID, r.EmojiID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[127]("127", "45892a5d"+r.GuildID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointListScheduledEventsforGuild(r.GuildID) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Emoji)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := make([]*GuildScheduledEvent, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14668,16 +20120,15 @@ func (r *ModifyGuildEmoji) Send(bot *Client) (*Emoji, error) {
 	return result, nil
 }
 
-// Send sends a DeleteGuildEmoji request to Discord and returns a error.
-func (r *DeleteGuildEmoji) Send(bot *Client) error {
+// Send sends a CreateGuildScheduledEvent request to Discord and returns a GuildScheduledEvent.
+func (r *CreateGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[81]("81", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
-	endpoint := EndpointDeleteGuildEmoji(r.GuildID, r.EmojiID)
+	routeid, resourceid := RateLimitHashFuncs[128]("128", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateGuildScheduledEvent(r.GuildID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14687,16 +20138,6 @@ func (r *DeleteGuildEmoji) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a CreateGuild request to Discord and returns a Guild.
-func (r *CreateGuild) Send(bot *Client) (*Guild, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[82]("82")
-	endpoint := EndpointCreateGuild()
-
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -14709,7 +20150,7 @@ func (r *CreateGuild) Send(bot *Client) (*Guild, error) {
 		}
 	}
 
-	result := new(Guild)
+	result := new(GuildScheduledEvent)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -14725,11 +20166,11 @@ func (r *CreateGuild) Send(bot *Client) (*Guild, error) {
 	return result, nil
 }
 
-// Send sends a GetGuild request to Discord and returns a Guild.
-func (r *GetGuild) Send(bot *Client) (*Guild, error) {
+// Send sends a GetGuildScheduledEvent request to Discord and returns a GuildScheduledEvent.
+func (r *GetGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[83]("83", "45892a5d"+r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[129]("129", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
 	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -14741,9 +20182,20 @@ func (r *GetGuild) Send(bot *Client) (*Guild, error) {
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuild(r.GuildID) + "?" + query
+	endpoint := EndpointGetGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID) + "?" + query
 
-	result := new(Guild)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(GuildScheduledEvent)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -14759,16 +20211,14 @@ func (r *GetGuild) Send(bot *Client) (*Guild, error) {
 	return result, nil
 }
 
-// Send sends a GetGuildPreview request to Discord and returns a GuildPreview.
-func (r *GetGuildPreview) Send(bot *Client) (*GuildPreview, error) {
+// Send sends a ModifyGuildScheduledEvent request to Discord and returns a GuildScheduledEvent.
+func (r *ModifyGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[84]("84", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildPreview(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[130]("130", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
+	endpoint := EndpointModifyGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID)
 
-	result := new(GuildPreview)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -14779,16 +20229,6 @@ func (r *GetGuildPreview) Send(bot *Client) (*GuildPreview, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a ModifyGuild request to Discord and returns a Guild.
-func (r *ModifyGuild) Send(bot *Client) (*Guild, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[85]("85", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyGuild(r.GuildID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -14801,7 +20241,7 @@ func (r *ModifyGuild) Send(bot *Client) (*Guild, error) {
 		}
 	}
 
-	result := new(Guild)
+	result := new(GuildScheduledEvent)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -14817,12 +20257,23 @@ func (r *ModifyGuild) Send(bot *Client) (*Guild, error) {
 	return result, nil
 }
 
-// Send sends a DeleteGuild request to Discord and returns a error.
-func (r *DeleteGuild) Send(bot *Client) error {
+// Send sends a DeleteGuildScheduledEvent request to Discord and returns a error.
+func (r *DeleteGuildScheduledEvent) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[86]("86", "45892a5d"+r.GuildID)
-	endpoint := EndpointDeleteGuild(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[131]("131", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
+	endpoint := EndpointDeleteGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
@@ -14839,50 +20290,37 @@ func (r *DeleteGuild) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a GetGuildChannels request to Discord and returns a []*Channel.
-func (r *GetGuildChannels) Send(bot *Client) ([]*Channel, error) {
+// Send sends a GetGuildScheduledEventUsers request to Discord and returns a []*GuildScheduledEventUser.
+func (r *GetGuildScheduledEventUsers) Send(bot *Client) ([]*GuildScheduledEventUser, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[87]("87", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildChannels(r.GuildID)
-
-	result := make([]*Channel, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	routeid, resourceid := RateLimitHashFuncs[132]("132", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
+	endpoint := EndpointGetGuildScheduledEventUsers(r.GuildID, r.GuildScheduledEventID) + "?" + query
 
-	return result, nil
-}
-
-// Send sends a CreateGuildChannel request to Discord and returns a Channel.
-func (r *CreateGuildChannel) Send(bot *Client) (*Channel, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[88]("88", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateGuildChannel(r.GuildID)
-
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := make([]*GuildScheduledEventUser, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14897,28 +20335,28 @@ func (r *CreateGuildChannel) Send(bot *Client) (*Channel, error) {
 	return result, nil
 }
 
-// Send sends a ModifyGuildChannelPositions request to Discord and returns a error.
-func (r *ModifyGuildChannelPositions) Send(bot *Client) error {
+// Send sends a GetGuildTemplate request to Discord and returns a GuildTemplate.
+func (r *GetGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[89]("89", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyGuildChannelPositions(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[133]("133", "61437152"+r.TemplateCode)
+	endpoint := EndpointGetGuildTemplate(r.TemplateCode)
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, nil)
+	result := new(GuildTemplate)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -14928,19 +20366,17 @@ func (r *ModifyGuildChannelPositions) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a ListActiveGuildThreads request to Discord and returns a ListActiveGuildThreadsResponse.
-func (r *ListActiveGuildThreads) Send(bot *Client) (*ListActiveGuildThreadsResponse, error) {
+// Send sends a CreateGuildfromGuildTemplate request to Discord and returns a []*GuildTemplate.
+func (r *CreateGuildfromGuildTemplate) Send(bot *Client) ([]*GuildTemplate, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[90]("90", "45892a5d"+r.GuildID)
-	endpoint := EndpointListActiveGuildThreads(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[134]("134", "61437152"+r.TemplateCode)
+	endpoint := EndpointCreateGuildfromGuildTemplate(r.TemplateCode)
 
-	result := new(ListActiveGuildThreadsResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -14951,18 +20387,20 @@ func (r *ListActiveGuildThreads) Send(bot *Client) (*ListActiveGuildThreadsRespo
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetGuildMember request to Discord and returns a GuildMember.
-func (r *GetGuildMember) Send(bot *Client) (*GuildMember, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[91]("91", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointGetGuildMember(r.GuildID, r.UserID)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-	result := new(GuildMember)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	result := make([]*GuildTemplate, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -14977,26 +20415,26 @@ func (r *GetGuildMember) Send(bot *Client) (*GuildMember, error) {
 	return result, nil
 }
 
-// Send sends a ListGuildMembers request to Discord and returns a []*GuildMember.
-func (r *ListGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
+// Send sends a GetGuildTemplates request to Discord and returns a []*GuildTemplate.
+func (r *GetGuildTemplates) Send(bot *Client) ([]*GuildTemplate, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[92]("92", "45892a5d"+r.GuildID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[135]("135", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildTemplates(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointListGuildMembers(r.GuildID) + "?" + query
 
-	result := make([]*GuildMember, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	result := make([]*GuildTemplate, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15011,26 +20449,38 @@ func (r *ListGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
 	return result, nil
 }
 
-// Send sends a SearchGuildMembers request to Discord and returns a []*GuildMember.
-func (r *SearchGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
+// Send sends a CreateGuildTemplate request to Discord and returns a GuildTemplate.
+func (r *CreateGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[93]("93", "45892a5d"+r.GuildID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[136]("136", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateGuildTemplate(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointSearchGuildMembers(r.GuildID) + "?" + query
 
-	result := make([]*GuildMember, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
+
+	result := new(GuildTemplate)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15045,27 +20495,26 @@ func (r *SearchGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
 	return result, nil
 }
 
-// Send sends a AddGuildMember request to Discord and returns a GuildMember.
-func (r *AddGuildMember) Send(bot *Client) (*GuildMember, error) {
+// Send sends a SyncGuildTemplate request to Discord and returns a GuildTemplate.
+func (r *SyncGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[94]("94", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointAddGuildMember(r.GuildID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[137]("137", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
+	endpoint := EndpointSyncGuildTemplate(r.GuildID, r.TemplateCode)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(GuildMember)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
+	result := new(GuildTemplate)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15080,12 +20529,23 @@ func (r *AddGuildMember) Send(bot *Client) (*GuildMember, error) {
 	return result, nil
 }
 
-// Send sends a ModifyGuildMember request to Discord and returns a GuildMember.
-func (r *ModifyGuildMember) Send(bot *Client) (*GuildMember, error) {
+// Send sends a ModifyGuildTemplate request to Discord and returns a GuildTemplate.
+func (r *ModifyGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[95]("95", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointModifyGuildMember(r.GuildID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[138]("138", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
+	endpoint := EndpointModifyGuildTemplate(r.GuildID, r.TemplateCode)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -15099,7 +20559,7 @@ func (r *ModifyGuildMember) Send(bot *Client) (*GuildMember, error) {
 		}
 	}
 
-	result := new(GuildMember)
+	result := new(GuildTemplate)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -15115,27 +20575,26 @@ func (r *ModifyGuildMember) Send(bot *Client) (*GuildMember, error) {
 	return result, nil
 }
 
-// Send sends a ModifyCurrentMember request to Discord and returns a GuildMember.
-func (r *ModifyCurrentMember) Send(bot *Client) (*GuildMember, error) {
+// Send sends a DeleteGuildTemplate request to Discord and returns a GuildTemplate.
+func (r *DeleteGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[96]("96", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyCurrentMember(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[139]("139", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
+	endpoint := EndpointDeleteGuildTemplate(r.GuildID, r.TemplateCode)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(GuildMember)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := new(GuildTemplate)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15150,38 +20609,26 @@ func (r *ModifyCurrentMember) Send(bot *Client) (*GuildMember, error) {
 	return result, nil
 }
 
-// Send sends a AddGuildMemberRole request to Discord and returns a error.
-func (r *AddGuildMemberRole) Send(bot *Client) error {
+// Send sends a GetInvite request to Discord and returns a Invite.
+func (r *GetInvite) Send(bot *Client) (*Invite, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[97]("97", "45892a5d"+r.GuildID, "209c92df"+r.UserID, "3cf7dd7c"+r.RoleID)
-	endpoint := EndpointAddGuildMemberRole(r.GuildID, r.UserID, r.RoleID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
+	routeid, resourceid := RateLimitHashFuncs[140]("140", "781d4865"+r.InviteCode)
+	query, err := EndpointQueryString(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
+	endpoint := EndpointGetInvite(r.InviteCode) + "?" + query
 
-	return nil
-}
-
-// Send sends a RemoveGuildMemberRole request to Discord and returns a error.
-func (r *RemoveGuildMemberRole) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[98]("98", "45892a5d"+r.GuildID, "209c92df"+r.UserID, "3cf7dd7c"+r.RoleID)
-	endpoint := EndpointRemoveGuildMemberRole(r.GuildID, r.UserID, r.RoleID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15191,19 +20638,10 @@ func (r *RemoveGuildMemberRole) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a RemoveGuildMember request to Discord and returns a error.
-func (r *RemoveGuildMember) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[99]("99", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointRemoveGuildMember(r.GuildID, r.UserID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	result := new(Invite)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15213,29 +20651,29 @@ func (r *RemoveGuildMember) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a GetGuildBans request to Discord and returns a []*Ban.
-func (r *GetGuildBans) Send(bot *Client) ([]*Ban, error) {
+// Send sends a DeleteInvite request to Discord and returns a Invite.
+func (r *DeleteInvite) Send(bot *Client) (*Invite, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[100]("100", "45892a5d"+r.GuildID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[141]("141", "781d4865"+r.InviteCode)
+	endpoint := EndpointDeleteInvite(r.InviteCode)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuildBans(r.GuildID) + "?" + query
 
-	result := make([]*Ban, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	result := new(Invite)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15250,16 +20688,14 @@ func (r *GetGuildBans) Send(bot *Client) ([]*Ban, error) {
 	return result, nil
 }
 
-// Send sends a GetGuildBan request to Discord and returns a Ban.
-func (r *GetGuildBan) Send(bot *Client) (*Ban, error) {
+// Send sends a CreateStageInstance request to Discord and returns a StageInstance.
+func (r *CreateStageInstance) Send(bot *Client) (*StageInstance, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[101]("101", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointGetGuildBan(r.GuildID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[142]("142")
+	endpoint := EndpointCreateStageInstance()
 
-	result := new(Ban)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -15270,19 +20706,9 @@ func (r *GetGuildBan) Send(bot *Client) (*Ban, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a CreateGuildBan request to Discord and returns a error.
-func (r *CreateGuildBan) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[102]("102", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointCreateGuildBan(r.GuildID, r.UserID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15292,9 +20718,10 @@ func (r *CreateGuildBan) Send(bot *Client) error {
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, nil)
+	result := new(StageInstance)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15304,19 +20731,18 @@ func (r *CreateGuildBan) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a RemoveGuildBan request to Discord and returns a error.
-func (r *RemoveGuildBan) Send(bot *Client) error {
+// Send sends a GetStageInstance request to Discord and returns a StageInstance.
+func (r *GetStageInstance) Send(bot *Client) (*StageInstance, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[103]("103", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointRemoveGuildBan(r.GuildID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[143]("143", "e5416649"+r.ChannelID)
+	endpoint := EndpointGetStageInstance(r.ChannelID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15326,18 +20752,8 @@ func (r *RemoveGuildBan) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetGuildRoles request to Discord and returns a []*Role.
-func (r *GetGuildRoles) Send(bot *Client) ([]*Role, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[104]("104", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildRoles(r.GuildID)
-
-	result := make([]*Role, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	result := new(StageInstance)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15352,12 +20768,23 @@ func (r *GetGuildRoles) Send(bot *Client) ([]*Role, error) {
 	return result, nil
 }
 
-// Send sends a CreateGuildRole request to Discord and returns a Role.
-func (r *CreateGuildRole) Send(bot *Client) (*Role, error) {
+// Send sends a ModifyStageInstance request to Discord and returns a StageInstance.
+func (r *ModifyStageInstance) Send(bot *Client) (*StageInstance, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[105]("105", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateGuildRole(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[144]("144", "e5416649"+r.ChannelID)
+	endpoint := EndpointModifyStageInstance(r.ChannelID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -15371,8 +20798,8 @@ func (r *CreateGuildRole) Send(bot *Client) (*Role, error) {
 		}
 	}
 
-	result := new(Role)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := new(StageInstance)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15387,29 +20814,27 @@ func (r *CreateGuildRole) Send(bot *Client) (*Role, error) {
 	return result, nil
 }
 
-// Send sends a ModifyGuildRolePositions request to Discord and returns a []*Role.
-func (r *ModifyGuildRolePositions) Send(bot *Client) ([]*Role, error) {
+// Send sends a DeleteStageInstance request to Discord and returns a error.
+func (r *DeleteStageInstance) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[106]("106", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyGuildRolePositions(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[145]("145", "e5416649"+r.ChannelID)
+	endpoint := EndpointDeleteStageInstance(r.ChannelID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := make([]*Role, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, &result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15419,30 +20844,29 @@ func (r *ModifyGuildRolePositions) Send(bot *Client) ([]*Role, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ModifyGuildRole request to Discord and returns a Role.
-func (r *ModifyGuildRole) Send(bot *Client) (*Role, error) {
+// Send sends a GetSticker request to Discord and returns a Sticker.
+func (r *GetSticker) Send(bot *Client) (*Sticker, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[107]("107", "45892a5d"+r.GuildID, "3cf7dd7c"+r.RoleID)
-	endpoint := EndpointModifyGuildRole(r.GuildID, r.RoleID)
+	routeid, resourceid := RateLimitHashFuncs[146]("146", "6eeeabf1"+r.StickerID)
+	endpoint := EndpointGetSticker(r.StickerID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Role)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := new(Sticker)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15457,16 +20881,28 @@ func (r *ModifyGuildRole) Send(bot *Client) (*Role, error) {
 	return result, nil
 }
 
-// Send sends a DeleteGuildRole request to Discord and returns a error.
-func (r *DeleteGuildRole) Send(bot *Client) error {
+// Send sends a ListNitroStickerPacks request to Discord and returns a ListNitroStickerPacksResponse.
+func (r *ListNitroStickerPacks) Send(bot *Client) (*ListNitroStickerPacksResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[108]("108", "45892a5d"+r.GuildID, "3cf7dd7c"+r.RoleID)
-	endpoint := EndpointDeleteGuildRole(r.GuildID, r.RoleID)
+	routeid, resourceid := RateLimitHashFuncs[147]("147")
+	endpoint := EndpointListNitroStickerPacks()
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(ListNitroStickerPacksResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15476,30 +20912,29 @@ func (r *DeleteGuildRole) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a ModifyGuildMFALevel request to Discord and returns a ModifyGuildMFALevelResponse.
-func (r *ModifyGuildMFALevel) Send(bot *Client) (*ModifyGuildMFALevelResponse, error) {
+// Send sends a ListGuildStickers request to Discord and returns a []*Sticker.
+func (r *ListGuildStickers) Send(bot *Client) ([]*Sticker, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[109]("109", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyGuildMFALevel(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[148]("148", "45892a5d"+r.GuildID)
+	endpoint := EndpointListGuildStickers(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(ModifyGuildMFALevelResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := make([]*Sticker, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15514,26 +20949,26 @@ func (r *ModifyGuildMFALevel) Send(bot *Client) (*ModifyGuildMFALevelResponse, e
 	return result, nil
 }
 
-// Send sends a GetGuildPruneCount request to Discord and returns a GetGuildPruneCountResponse.
-func (r *GetGuildPruneCount) Send(bot *Client) (*GetGuildPruneCountResponse, error) {
+// Send sends a GetGuildSticker request to Discord and returns a Sticker.
+func (r *GetGuildSticker) Send(bot *Client) (*Sticker, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[110]("110", "45892a5d"+r.GuildID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[149]("149", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
+	endpoint := EndpointGetGuildSticker(r.GuildID, r.StickerID)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuildPruneCount(r.GuildID) + "?" + query
 
-	result := new(GetGuildPruneCountResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	result := new(Sticker)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15548,16 +20983,27 @@ func (r *GetGuildPruneCount) Send(bot *Client) (*GetGuildPruneCountResponse, err
 	return result, nil
 }
 
-// Send sends a BeginGuildPrune request to Discord and returns a error.
-func (r *BeginGuildPrune) Send(bot *Client) error {
+// Send sends a CreateGuildSticker request to Discord and returns a Sticker.
+func (r *CreateGuildSticker) Send(bot *Client) (*Sticker, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[111]("111", "45892a5d"+r.GuildID)
-	endpoint := EndpointBeginGuildPrune(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[150]("150", "45892a5d"+r.GuildID)
+	endpoint := EndpointCreateGuildSticker(r.GuildID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15567,30 +21013,21 @@ func (r *BeginGuildPrune) Send(bot *Client) error {
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, nil)
-	if err != nil {
-		return ErrorRequest{
+	var contentType []byte
+	var multipartErr error
+	if contentType, body, multipartErr = createMultipartForm(body, &r.File); multipartErr != nil {
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetGuildVoiceRegions request to Discord and returns a []*VoiceRegion.
-func (r *GetGuildVoiceRegions) Send(bot *Client) ([]*VoiceRegion, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[112]("112", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildVoiceRegions(r.GuildID)
-
-	result := make([]*VoiceRegion, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	result := new(Sticker)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15605,16 +21042,14 @@ func (r *GetGuildVoiceRegions) Send(bot *Client) ([]*VoiceRegion, error) {
 	return result, nil
 }
 
-// Send sends a GetGuildInvites request to Discord and returns a []*Invite.
-func (r *GetGuildInvites) Send(bot *Client) ([]*Invite, error) {
+// Send sends a ModifyGuildSticker request to Discord and returns a Sticker.
+func (r *ModifyGuildSticker) Send(bot *Client) (*Sticker, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[113]("113", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildInvites(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[151]("151", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
+	endpoint := EndpointModifyGuildSticker(r.GuildID, r.StickerID)
 
-	result := make([]*Invite, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -15625,18 +21060,20 @@ func (r *GetGuildInvites) Send(bot *Client) ([]*Invite, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetGuildIntegrations request to Discord and returns a []*Integration.
-func (r *GetGuildIntegrations) Send(bot *Client) ([]*Integration, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[114]("114", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildIntegrations(r.GuildID)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-	result := make([]*Integration, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	result := new(Sticker)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15651,15 +21088,14 @@ func (r *GetGuildIntegrations) Send(bot *Client) ([]*Integration, error) {
 	return result, nil
 }
 
-// Send sends a DeleteGuildIntegration request to Discord and returns a error.
-func (r *DeleteGuildIntegration) Send(bot *Client) error {
+// Send sends a DeleteGuildSticker request to Discord and returns a error.
+func (r *DeleteGuildSticker) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[115]("115", "45892a5d"+r.GuildID, "cb4479f8"+r.IntegrationID)
-	endpoint := EndpointDeleteGuildIntegration(r.GuildID, r.IntegrationID)
+	routeid, resourceid := RateLimitHashFuncs[152]("152", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
+	endpoint := EndpointDeleteGuildSticker(r.GuildID, r.StickerID)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -15670,20 +21106,9 @@ func (r *DeleteGuildIntegration) Send(bot *Client) error {
 		}
 	}
 
-	return nil
-}
-
-// Send sends a GetGuildWidgetSettings request to Discord and returns a GuildWidget.
-func (r *GetGuildWidgetSettings) Send(bot *Client) (*GuildWidget, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[116]("116", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildWidgetSettings(r.GuildID)
-
-	result := new(GuildWidget)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15693,19 +21118,17 @@ func (r *GetGuildWidgetSettings) Send(bot *Client) (*GuildWidget, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ModifyGuildWidget request to Discord and returns a GuildWidget.
-func (r *ModifyGuildWidget) Send(bot *Client) (*GuildWidget, error) {
+// Send sends a GetCurrentUser request to Discord and returns a User.
+func (r *GetCurrentUser) Send(bot *Client) (*User, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[117]("117", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyGuildWidget(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[153]("153")
+	endpoint := EndpointGetCurrentUser()
 
-	result := new(GuildWidget)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -15716,17 +21139,7 @@ func (r *ModifyGuildWidget) Send(bot *Client) (*GuildWidget, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetGuildWidget request to Discord and returns a GuildWidget.
-func (r *GetGuildWidget) Send(bot *Client) (*GuildWidget, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[118]("118", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildWidget(r.GuildID)
-
-	result := new(GuildWidget)
+	result := new(User)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -15742,27 +21155,26 @@ func (r *GetGuildWidget) Send(bot *Client) (*GuildWidget, error) {
 	return result, nil
 }
 
-// Send sends a GetGuildVanityURL request to Discord and returns a Invite.
-func (r *GetGuildVanityURL) Send(bot *Client) (*Invite, error) {
+// Send sends a GetUser request to Discord and returns a User.
+func (r *GetUser) Send(bot *Client) (*User, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[119]("119", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildVanityURL(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[154]("154", "209c92df"+r.UserID)
+	endpoint := EndpointGetUser(r.UserID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Invite)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeJSON, body, result)
+	result := new(User)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15777,26 +21189,25 @@ func (r *GetGuildVanityURL) Send(bot *Client) (*Invite, error) {
 	return result, nil
 }
 
-// Send sends a GetGuildWidgetImage request to Discord and returns a EmbedImage.
-func (r *GetGuildWidgetImage) Send(bot *Client) (*EmbedImage, error) {
+// Send sends a ModifyCurrentUser request to Discord and returns a User.
+func (r *ModifyCurrentUser) Send(bot *Client) (*User, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[120]("120", "45892a5d"+r.GuildID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[155]("155")
+	endpoint := EndpointModifyCurrentUser()
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuildWidgetImage(r.GuildID) + "?" + query
 
-	result := new(EmbedImage)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15804,22 +21215,12 @@ func (r *GetGuildWidgetImage) Send(bot *Client) (*EmbedImage, error) {
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           err,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetGuildWelcomeScreen request to Discord and returns a WelcomeScreen.
-func (r *GetGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[121]("121", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildWelcomeScreen(r.GuildID)
-
-	result := new(WelcomeScreen)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	result := new(User)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15834,27 +21235,25 @@ func (r *GetGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
 	return result, nil
 }
 
-// Send sends a ModifyGuildWelcomeScreen request to Discord and returns a WelcomeScreen.
-func (r *ModifyGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
+// Send sends a GetCurrentUserGuilds request to Discord and returns a []*Guild.
+func (r *GetCurrentUserGuilds) Send(bot *Client) ([]*Guild, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[122]("122", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyGuildWelcomeScreen(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[156]("156")
+	endpoint := EndpointGetCurrentUserGuilds()
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(WelcomeScreen)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15862,22 +21261,12 @@ func (r *ModifyGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           err,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetGuildOnboarding request to Discord and returns a GuildOnboarding.
-func (r *GetGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[123]("123", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildOnboarding(r.GuildID)
-
-	result := new(GuildOnboarding)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	result := make([]*Guild, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeJSON, body, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15892,27 +21281,26 @@ func (r *GetGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
 	return result, nil
 }
 
-// Send sends a ModifyGuildOnboarding request to Discord and returns a GuildOnboarding.
-func (r *ModifyGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
+// Send sends a GetCurrentUserGuildMember request to Discord and returns a GuildMember.
+func (r *GetCurrentUserGuildMember) Send(bot *Client) (*GuildMember, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[124]("124", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyGuildOnboarding(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[157]("157", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetCurrentUserGuildMember(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(GuildOnboarding)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
+	result := new(GuildMember)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15927,26 +21315,25 @@ func (r *ModifyGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
 	return result, nil
 }
 
-// Send sends a ModifyCurrentUserVoiceState request to Discord and returns a error.
-func (r *ModifyCurrentUserVoiceState) Send(bot *Client) error {
+// Send sends a LeaveGuild request to Discord and returns a error.
+func (r *LeaveGuild) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[125]("125", "45892a5d"+r.GuildID)
-	endpoint := EndpointModifyCurrentUserVoiceState(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[158]("158", "45892a5d"+r.GuildID)
+	endpoint := EndpointLeaveGuild(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, nil)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -15961,16 +21348,27 @@ func (r *ModifyCurrentUserVoiceState) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a ModifyUserVoiceState request to Discord and returns a error.
-func (r *ModifyUserVoiceState) Send(bot *Client) error {
+// Send sends a CreateDM request to Discord and returns a Channel.
+func (r *CreateDM) Send(bot *Client) (*Channel, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[126]("126", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
-	endpoint := EndpointModifyUserVoiceState(r.GuildID, r.UserID)
+	routeid, resourceid := RateLimitHashFuncs[159]("159")
+	endpoint := EndpointCreateDM()
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15980,9 +21378,10 @@ func (r *ModifyUserVoiceState) Send(bot *Client) error {
 		}
 	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, nil)
+	result := new(Channel)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -15992,30 +21391,17 @@ func (r *ModifyUserVoiceState) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// Send sends a ListScheduledEventsforGuild request to Discord and returns a []*GuildScheduledEvent.
-func (r *ListScheduledEventsforGuild) Send(bot *Client) ([]*GuildScheduledEvent, error) {
+// Send sends a CreateGroupDM request to Discord and returns a Channel.
+func (r *CreateGroupDM) Send(bot *Client) (*Channel, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[127]("127", "45892a5d"+r.GuildID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
-	}
-	endpoint := EndpointListScheduledEventsforGuild(r.GuildID) + "?" + query
+	routeid, resourceid := RateLimitHashFuncs[160]("160")
+	endpoint := EndpointCreateGroupDM()
 
-	result := make([]*GuildScheduledEvent, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -16026,16 +21412,6 @@ func (r *ListScheduledEventsforGuild) Send(bot *Client) ([]*GuildScheduledEvent,
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a CreateGuildScheduledEvent request to Discord and returns a GuildScheduledEvent.
-func (r *CreateGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[128]("128", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateGuildScheduledEvent(r.GuildID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -16048,7 +21424,7 @@ func (r *CreateGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, err
 		}
 	}
 
-	result := new(GuildScheduledEvent)
+	result := new(Channel)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -16064,26 +21440,26 @@ func (r *CreateGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, err
 	return result, nil
 }
 
-// Send sends a GetGuildScheduledEvent request to Discord and returns a GuildScheduledEvent.
-func (r *GetGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error) {
+// Send sends a GetUserConnections request to Discord and returns a []*Connection.
+func (r *GetUserConnections) Send(bot *Client) ([]*Connection, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[129]("129", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	routeid, resourceid := RateLimitHashFuncs[161]("161")
+	endpoint := EndpointGetUserConnections()
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID) + "?" + query
 
-	result := new(GuildScheduledEvent)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
+	result := make([]*Connection, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16098,27 +21474,26 @@ func (r *GetGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error)
 	return result, nil
 }
 
-// Send sends a ModifyGuildScheduledEvent request to Discord and returns a GuildScheduledEvent.
-func (r *ModifyGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error) {
+// Send sends a GetUserApplicationRoleConnection request to Discord and returns a ApplicationRoleConnection.
+func (r *GetUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRoleConnection, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[130]("130", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
-	endpoint := EndpointModifyGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID)
+	routeid, resourceid := RateLimitHashFuncs[162]("162")
+	endpoint := EndpointGetUserApplicationRoleConnection(bot.ApplicationID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(GuildScheduledEvent)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := new(ApplicationRoleConnection)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16133,48 +21508,25 @@ func (r *ModifyGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, err
 	return result, nil
 }
 
-// Send sends a DeleteGuildScheduledEvent request to Discord and returns a error.
-func (r *DeleteGuildScheduledEvent) Send(bot *Client) error {
+// Send sends a UpdateUserApplicationRoleConnection request to Discord and returns a ApplicationRoleConnection.
+func (r *UpdateUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRoleConnection, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[131]("131", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
-	endpoint := EndpointDeleteGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
-	}
-
-	return nil
-}
+	routeid, resourceid := RateLimitHashFuncs[163]("163")
+	endpoint := EndpointUpdateUserApplicationRoleConnection(bot.ApplicationID)
 
-// Send sends a GetGuildScheduledEventUsers request to Discord and returns a []*GuildScheduledEventUser.
-func (r *GetGuildScheduledEventUsers) Send(bot *Client) ([]*GuildScheduledEventUser, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[132]("132", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetGuildScheduledEventUsers(r.GuildID, r.GuildScheduledEventID) + "?" + query
 
-	result := make([]*GuildScheduledEventUser, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
+	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16182,22 +21534,12 @@ func (r *GetGuildScheduledEventUsers) Send(bot *Client) ([]*GuildScheduledEventU
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           err,
+			Err:           fmt.Errorf(errSendMarshal, err),
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetGuildTemplate request to Discord and returns a GuildTemplate.
-func (r *GetGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[133]("133", "61437152"+r.TemplateCode)
-	endpoint := EndpointGetGuildTemplate(r.TemplateCode)
-
-	result := new(GuildTemplate)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	result := new(ApplicationRoleConnection)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16212,27 +21554,26 @@ func (r *GetGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	return result, nil
 }
 
-// Send sends a CreateGuildfromGuildTemplate request to Discord and returns a []*GuildTemplate.
-func (r *CreateGuildfromGuildTemplate) Send(bot *Client) ([]*GuildTemplate, error) {
+// Send sends a ListVoiceRegions request to Discord and returns a []*VoiceRegion.
+func (r *ListVoiceRegions) Send(bot *Client) ([]*VoiceRegion, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[134]("134", "61437152"+r.TemplateCode)
-	endpoint := EndpointCreateGuildfromGuildTemplate(r.TemplateCode)
+	routeid, resourceid := RateLimitHashFuncs[164]("164")
+	endpoint := EndpointListVoiceRegions()
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := make([]*GuildTemplate, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, &result)
+	result := make([]*VoiceRegion, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16247,16 +21588,14 @@ func (r *CreateGuildfromGuildTemplate) Send(bot *Client) ([]*GuildTemplate, erro
 	return result, nil
 }
 
-// Send sends a GetGuildTemplates request to Discord and returns a []*GuildTemplate.
-func (r *GetGuildTemplates) Send(bot *Client) ([]*GuildTemplate, error) {
+// Send sends a CreateWebhook request to Discord and returns a Webhook.
+func (r *CreateWebhook) Send(bot *Client) (*Webhook, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[135]("135", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildTemplates(r.GuildID)
+	routeid, resourceid := RateLimitHashFuncs[165]("165", "e5416649"+r.ChannelID)
+	endpoint := EndpointCreateWebhook(r.ChannelID)
 
-	result := make([]*GuildTemplate, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -16267,16 +21606,6 @@ func (r *GetGuildTemplates) Send(bot *Client) ([]*GuildTemplate, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a CreateGuildTemplate request to Discord and returns a GuildTemplate.
-func (r *CreateGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[136]("136", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateGuildTemplate(r.GuildID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -16289,7 +21618,7 @@ func (r *CreateGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 		}
 	}
 
-	result := new(GuildTemplate)
+	result := new(Webhook)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -16305,15 +21634,26 @@ func (r *CreateGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	return result, nil
 }
 
-// Send sends a SyncGuildTemplate request to Discord and returns a GuildTemplate.
-func (r *SyncGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
+// Send sends a GetChannelWebhooks request to Discord and returns a []*Webhook.
+func (r *GetChannelWebhooks) Send(bot *Client) ([]*Webhook, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[137]("137", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
-	endpoint := EndpointSyncGuildTemplate(r.GuildID, r.TemplateCode)
+	routeid, resourceid := RateLimitHashFuncs[166]("166", "e5416649"+r.ChannelID)
+	endpoint := EndpointGetChannelWebhooks(r.ChannelID)
 
-	result := new(GuildTemplate)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, result)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := make([]*Webhook, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16328,27 +21668,26 @@ func (r *SyncGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	return result, nil
 }
 
-// Send sends a ModifyGuildTemplate request to Discord and returns a GuildTemplate.
-func (r *ModifyGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
+// Send sends a GetGuildWebhooks request to Discord and returns a []*Webhook.
+func (r *GetGuildWebhooks) Send(bot *Client) ([]*Webhook, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[138]("138", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
-	endpoint := EndpointModifyGuildTemplate(r.GuildID, r.TemplateCode)
+	routeid, resourceid := RateLimitHashFuncs[167]("167", "45892a5d"+r.GuildID)
+	endpoint := EndpointGetGuildWebhooks(r.GuildID)
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(GuildTemplate)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
+	result := make([]*Webhook, 0)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16363,16 +21702,14 @@ func (r *ModifyGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	return result, nil
 }
 
-// Send sends a DeleteGuildTemplate request to Discord and returns a GuildTemplate.
-func (r *DeleteGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
+// Send sends a GetWebhook request to Discord and returns a Webhook.
+func (r *GetWebhook) Send(bot *Client) (*Webhook, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[139]("139", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
-	endpoint := EndpointDeleteGuildTemplate(r.GuildID, r.TemplateCode)
+	routeid, resourceid := RateLimitHashFuncs[168]("168", "6d62b21b"+r.WebhookID)
+	endpoint := EndpointGetWebhook(r.WebhookID)
 
-	result := new(GuildTemplate)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -16383,30 +21720,30 @@ func (r *DeleteGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetInvite request to Discord and returns a Invite.
-func (r *GetInvite) Send(bot *Client) (*Invite, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[140]("140", "781d4865"+r.InviteCode)
-	query, err := EndpointQueryString(r)
+	result := new(Webhook)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
-	endpoint := EndpointGetInvite(r.InviteCode) + "?" + query
 
-	result := new(Invite)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
-	if err != nil {
+	return result, nil
+}
+
+// Send sends a GetWebhookwithToken request to Discord and returns a Webhook.
+func (r *GetWebhookwithToken) Send(bot *Client) (*Webhook, error) {
+	var err error
+	xid := xid.New().String()
+	routeid, resourceid := RateLimitHashFuncs[169]("169", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
+	endpoint := EndpointGetWebhookwithToken(r.WebhookID, r.WebhookToken)
+
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -16417,18 +21754,8 @@ func (r *GetInvite) Send(bot *Client) (*Invite, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a DeleteInvite request to Discord and returns a Invite.
-func (r *DeleteInvite) Send(bot *Client) (*Invite, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[141]("141", "781d4865"+r.InviteCode)
-	endpoint := EndpointDeleteInvite(r.InviteCode)
-
-	result := new(Invite)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
+	result := new(Webhook)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16443,12 +21770,23 @@ func (r *DeleteInvite) Send(bot *Client) (*Invite, error) {
 	return result, nil
 }
 
-// Send sends a CreateStageInstance request to Discord and returns a StageInstance.
-func (r *CreateStageInstance) Send(bot *Client) (*StageInstance, error) {
+// Send sends a ModifyWebhook request to Discord and returns a Webhook.
+func (r *ModifyWebhook) Send(bot *Client) (*Webhook, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[142]("142")
-	endpoint := EndpointCreateStageInstance()
+	routeid, resourceid := RateLimitHashFuncs[170]("170", "6d62b21b"+r.WebhookID)
+	endpoint := EndpointModifyWebhook(r.WebhookID)
+
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -16462,8 +21800,8 @@ func (r *CreateStageInstance) Send(bot *Client) (*StageInstance, error) {
 		}
 	}
 
-	result := new(StageInstance)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := new(Webhook)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16478,16 +21816,14 @@ func (r *CreateStageInstance) Send(bot *Client) (*StageInstance, error) {
 	return result, nil
 }
 
-// Send sends a GetStageInstance request to Discord and returns a StageInstance.
-func (r *GetStageInstance) Send(bot *Client) (*StageInstance, error) {
+// Send sends a ModifyWebhookwithToken request to Discord and returns a Webhook.
+func (r *ModifyWebhookwithToken) Send(bot *Client) (*Webhook, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[143]("143", "e5416649"+r.ChannelID)
-	endpoint := EndpointGetStageInstance(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[171]("171", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
+	endpoint := EndpointModifyWebhookwithToken(r.WebhookID, r.WebhookToken)
 
-	result := new(StageInstance)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -16498,16 +21834,6 @@ func (r *GetStageInstance) Send(bot *Client) (*StageInstance, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a ModifyStageInstance request to Discord and returns a StageInstance.
-func (r *ModifyStageInstance) Send(bot *Client) (*StageInstance, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[144]("144", "e5416649"+r.ChannelID)
-	endpoint := EndpointModifyStageInstance(r.ChannelID)
-
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -16520,7 +21846,7 @@ func (r *ModifyStageInstance) Send(bot *Client) (*StageInstance, error) {
 		}
 	}
 
-	result := new(StageInstance)
+	result := new(Webhook)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -16536,12 +21862,23 @@ func (r *ModifyStageInstance) Send(bot *Client) (*StageInstance, error) {
 	return result, nil
 }
 
-// Send sends a DeleteStageInstance request to Discord and returns a error.
-func (r *DeleteStageInstance) Send(bot *Client) error {
+// Send sends a DeleteWebhook request to Discord and returns a error.
+func (r *DeleteWebhook) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[145]("145", "e5416649"+r.ChannelID)
-	endpoint := EndpointDeleteStageInstance(r.ChannelID)
+	routeid, resourceid := RateLimitHashFuncs[172]("172", "6d62b21b"+r.WebhookID)
+	endpoint := EndpointDeleteWebhook(r.WebhookID)
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
@@ -16558,17 +21895,15 @@ func (r *DeleteStageInstance) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a GetSticker request to Discord and returns a Sticker.
-func (r *GetSticker) Send(bot *Client) (*Sticker, error) {
+// Send sends a DeleteWebhookwithToken request to Discord and returns a error.
+func (r *DeleteWebhookwithToken) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[146]("146", "6eeeabf1"+r.StickerID)
-	endpoint := EndpointGetSticker(r.StickerID)
+	routeid, resourceid := RateLimitHashFuncs[173]("173", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
+	endpoint := EndpointDeleteWebhookwithToken(r.WebhookID, r.WebhookToken)
 
-	result := new(Sticker)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -16578,20 +21913,9 @@ func (r *GetSticker) Send(bot *Client) (*Sticker, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a ListNitroStickerPacks request to Discord and returns a ListNitroStickerPacksResponse.
-func (r *ListNitroStickerPacks) Send(bot *Client) (*ListNitroStickerPacksResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[147]("147")
-	endpoint := EndpointListNitroStickerPacks()
-
-	result := new(ListNitroStickerPacksResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -16601,20 +21925,29 @@ func (r *ListNitroStickerPacks) Send(bot *Client) (*ListNitroStickerPacksRespons
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ListGuildStickers request to Discord and returns a []*Sticker.
-func (r *ListGuildStickers) Send(bot *Client) ([]*Sticker, error) {
+// Send sends a ExecuteWebhook request to Discord and returns a error.
+func (r *ExecuteWebhook) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[148]("148", "45892a5d"+r.GuildID)
-	endpoint := EndpointListGuildStickers(r.GuildID)
-
-	result := make([]*Sticker, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
+	routeid, resourceid := RateLimitHashFuncs[174]("174", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
+	query, err := EndpointQueryString(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      "",
+			Err:           err,
+		}
+	}
+	endpoint := EndpointExecuteWebhook(r.WebhookID, r.WebhookToken) + "?" + query
+
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -16624,20 +21957,38 @@ func (r *ListGuildStickers) Send(bot *Client) ([]*Sticker, error) {
 		}
 	}
 
-	return result, nil
-}
+	r = applyAllowedMentions(bot, r)
 
-// Send sends a GetGuildSticker request to Discord and returns a Sticker.
-func (r *GetGuildSticker) Send(bot *Client) (*Sticker, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[149]("149", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
-	endpoint := EndpointGetGuildSticker(r.GuildID, r.StickerID)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf(errSendMarshal, err),
+		}
+	}
 
-	result := new(Sticker)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	contentType := ContentTypeJSON
+	if len(r.Files) != 0 {
+		var multipartErr error
+		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
+			return ErrorRequest{
+				ClientID:      bot.ApplicationID,
+				CorrelationID: xid,
+				RouteID:       routeid,
+				ResourceID:    resourceid,
+				Endpoint:      "",
+				Err:           err,
+			}
+		}
+	}
+
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -16647,45 +21998,41 @@ func (r *GetGuildSticker) Send(bot *Client) (*Sticker, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a CreateGuildSticker request to Discord and returns a Sticker.
-func (r *CreateGuildSticker) Send(bot *Client) (*Sticker, error) {
+// Send sends a ExecuteSlackCompatibleWebhook request to Discord and returns a error.
+func (r *ExecuteSlackCompatibleWebhook) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[150]("150", "45892a5d"+r.GuildID)
-	endpoint := EndpointCreateGuildSticker(r.GuildID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[175]("175", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
+	query, err := EndpointQueryString(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Endpoint:      "",
+			Err:           err,
 		}
 	}
+	endpoint := EndpointExecuteSlackCompatibleWebhook(r.WebhookID, r.WebhookToken) + "?" + query
 
-	var contentType []byte
-	var multipartErr error
-	if contentType, body, multipartErr = createMultipartForm(body, &r.File); multipartErr != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      "",
+			Endpoint:      endpoint,
 			Err:           err,
 		}
 	}
 
-	result := new(Sticker)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, result)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, nil)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -16695,32 +22042,29 @@ func (r *CreateGuildSticker) Send(bot *Client) (*Sticker, error) {
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a ModifyGuildSticker request to Discord and returns a Sticker.
-func (r *ModifyGuildSticker) Send(bot *Client) (*Sticker, error) {
+// Send sends a ExecuteGitHubCompatibleWebhook request to Discord and returns a error.
+func (r *ExecuteGitHubCompatibleWebhook) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[151]("151", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
-	endpoint := EndpointModifyGuildSticker(r.GuildID, r.StickerID)
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[176]("176", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
+	query, err := EndpointQueryString(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Endpoint:      "",
+			Err:           err,
 		}
 	}
+	endpoint := EndpointExecuteGitHubCompatibleWebhook(r.WebhookID, r.WebhookToken) + "?" + query
 
-	result := new(Sticker)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
-	if err != nil {
-		return nil, ErrorRequest{
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -16730,17 +22074,7 @@ func (r *ModifyGuildSticker) Send(bot *Client) (*Sticker, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a DeleteGuildSticker request to Discord and returns a error.
-func (r *DeleteGuildSticker) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[152]("152", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
-	endpoint := EndpointDeleteGuildSticker(r.GuildID, r.StickerID)
-
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, nil)
 	if err != nil {
 		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16755,38 +22089,37 @@ func (r *DeleteGuildSticker) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a GetCurrentUser request to Discord and returns a User.
-func (r *GetCurrentUser) Send(bot *Client) (*User, error) {
+// Send sends a GetWebhookMessage request to Discord and returns a Message.
+func (r *GetWebhookMessage) Send(bot *Client) (*Message, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[153]("153")
-	endpoint := EndpointGetCurrentUser()
-
-	result := new(User)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	routeid, resourceid := RateLimitHashFuncs[177]("177", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken, "d57d6589"+r.MessageID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
+	endpoint := EndpointGetWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
-	return result, nil
-}
-
-// Send sends a GetUser request to Discord and returns a User.
-func (r *GetUser) Send(bot *Client) (*User, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[154]("154", "209c92df"+r.UserID)
-	endpoint := EndpointGetUser(r.UserID)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	result := new(User)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16801,28 +22134,25 @@ func (r *GetUser) Send(bot *Client) (*User, error) {
 	return result, nil
 }
 
-// Send sends a ModifyCurrentUser request to Discord and returns a User.
-func (r *ModifyCurrentUser) Send(bot *Client) (*User, error) {
+// Send sends a EditWebhookMessage request to Discord and returns a Message.
+func (r *EditWebhookMessage) Send(bot *Client) (*Message, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[155]("155")
-	endpoint := EndpointModifyCurrentUser()
-
-	body, err := json.Marshal(r)
+	routeid, resourceid := RateLimitHashFuncs[178]("178", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken, "d57d6589"+r.MessageID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Endpoint:      "",
+			Err:           err,
 		}
 	}
+	endpoint := EndpointEditWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
-	result := new(User)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -16833,15 +22163,7 @@ func (r *ModifyCurrentUser) Send(bot *Client) (*User, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetCurrentUserGuilds request to Discord and returns a []*Guild.
-func (r *GetCurrentUserGuilds) Send(bot *Client) ([]*Guild, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[156]("156")
-	endpoint := EndpointGetCurrentUserGuilds()
+	r = applyAllowedMentions(bot, r)
 
 	body, err := json.Marshal(r)
 	if err != nil {
@@ -16855,8 +22177,23 @@ func (r *GetCurrentUserGuilds) Send(bot *Client) ([]*Guild, error) {
 		}
 	}
 
-	result := make([]*Guild, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeJSON, body, &result)
+	contentType := ContentTypeJSON
+	if len(r.Files) != 0 {
+		var multipartErr error
+		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
+			return nil, ErrorRequest{
+				ClientID:      bot.ApplicationID,
+				CorrelationID: xid,
+				RouteID:       routeid,
+				ResourceID:    resourceid,
+				Endpoint:      "",
+				Err:           err,
+			}
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16871,37 +22208,36 @@ func (r *GetCurrentUserGuilds) Send(bot *Client) ([]*Guild, error) {
 	return result, nil
 }
 
-// Send sends a GetCurrentUserGuildMember request to Discord and returns a GuildMember.
-func (r *GetCurrentUserGuildMember) Send(bot *Client) (*GuildMember, error) {
+// Send sends a DeleteWebhookMessage request to Discord and returns a error.
+func (r *DeleteWebhookMessage) Send(bot *Client) error {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[157]("157", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetCurrentUserGuildMember(r.GuildID)
-
-	result := new(GuildMember)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	routeid, resourceid := RateLimitHashFuncs[179]("179", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken, "d57d6589"+r.MessageID)
+	query, err := EndpointQueryString(r)
 	if err != nil {
-		return nil, ErrorRequest{
+		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
-			Endpoint:      endpoint,
+			Endpoint:      "",
 			Err:           err,
 		}
 	}
+	endpoint := EndpointDeleteWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
-	return result, nil
-}
-
-// Send sends a LeaveGuild request to Discord and returns a error.
-func (r *LeaveGuild) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[158]("158", "45892a5d"+r.GuildID)
-	endpoint := EndpointLeaveGuild(r.GuildID)
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, ContentTypeURLQueryString, nil, nil)
 	if err != nil {
 		return ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16916,27 +22252,26 @@ func (r *LeaveGuild) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a CreateDM request to Discord and returns a Channel.
-func (r *CreateDM) Send(bot *Client) (*Channel, error) {
+// Send sends a GetGateway request to Discord and returns a GetGatewayBotResponse.
+func (r *GetGateway) Send(bot *Client) (*GetGatewayBotResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[159]("159")
-	endpoint := EndpointCreateDM()
+	routeid, resourceid := RateLimitHashFuncs[180]("180")
+	endpoint := EndpointGetGateway()
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := new(GetGatewayBotResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16951,27 +22286,26 @@ func (r *CreateDM) Send(bot *Client) (*Channel, error) {
 	return result, nil
 }
 
-// Send sends a CreateGroupDM request to Discord and returns a Channel.
-func (r *CreateGroupDM) Send(bot *Client) (*Channel, error) {
+// Send sends a GetGatewayBot request to Discord and returns a GetGatewayBotResponse.
+func (r *GetGatewayBot) Send(bot *Client) (*GetGatewayBotResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[160]("160")
-	endpoint := EndpointCreateGroupDM()
+	routeid, resourceid := RateLimitHashFuncs[181]("181")
+	endpoint := EndpointGetGatewayBot()
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(Channel)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
+	result := new(GetGatewayBotResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -16986,16 +22320,14 @@ func (r *CreateGroupDM) Send(bot *Client) (*Channel, error) {
 	return result, nil
 }
 
-// Send sends a GetUserConnections request to Discord and returns a []*Connection.
-func (r *GetUserConnections) Send(bot *Client) ([]*Connection, error) {
+// Send sends a GetCurrentBotApplicationInformation request to Discord and returns a Application.
+func (r *GetCurrentBotApplicationInformation) Send(bot *Client) (*Application, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[161]("161")
-	endpoint := EndpointGetUserConnections()
+	routeid, resourceid := RateLimitHashFuncs[182]("182")
+	endpoint := EndpointGetCurrentBotApplicationInformation()
 
-	result := make([]*Connection, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -17006,17 +22338,7 @@ func (r *GetUserConnections) Send(bot *Client) ([]*Connection, error) {
 		}
 	}
 
-	return result, nil
-}
-
-// Send sends a GetUserApplicationRoleConnection request to Discord and returns a ApplicationRoleConnection.
-func (r *GetUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRoleConnection, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[162]("162")
-	endpoint := EndpointGetUserApplicationRoleConnection(bot.ApplicationID)
-
-	result := new(ApplicationRoleConnection)
+	result := new(Application)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -17032,27 +22354,26 @@ func (r *GetUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRoleCo
 	return result, nil
 }
 
-// Send sends a UpdateUserApplicationRoleConnection request to Discord and returns a ApplicationRoleConnection.
-func (r *UpdateUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRoleConnection, error) {
+// Send sends a GetCurrentAuthorizationInformation request to Discord and returns a CurrentAuthorizationInformationResponse.
+func (r *GetCurrentAuthorizationInformation) Send(bot *Client) (*CurrentAuthorizationInformationResponse, error) {
 	var err error
 	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[163]("163")
-	endpoint := EndpointUpdateUserApplicationRoleConnection(bot.ApplicationID)
+	routeid, resourceid := RateLimitHashFuncs[183]("183")
+	endpoint := EndpointGetCurrentAuthorizationInformation()
 
-	body, err := json.Marshal(r)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
 			ResourceID:    resourceid,
 			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+			Err:           err,
 		}
 	}
 
-	result := new(ApplicationRoleConnection)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, ContentTypeJSON, body, result)
+	result := new(CurrentAuthorizationInformationResponse)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
@@ -17064,1793 +22385,2074 @@ func (r *UpdateUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRol
 		}
 	}
 
-	return result, nil
-}
+	return result, nil
+}
+
+const (
+	gatewayEndpointParams     = "?v=" + VersionDiscordAPI + "&encoding=json"
+	invalidSessionWaitTime    = 1 * time.Second
+	maxIdentifyLargeThreshold = 250
+)
+
+// Session represents a Discord Gateway WebSocket Session.
+type Session struct {
+	// Context carries request-scoped data for the Discord Gateway Connection.
+	//
+	// Context is also used as a signal for the Session's goroutines.
+	Context context.Context
+
+	// RateLimiter represents an object that provides rate limit functionality.
+	RateLimiter RateLimiter
+
+	// store represents the SessionStore that persists the state of the Session.
+	store SessionStore
+
+	// client_manager represents the *Client Session Manager of the Session.
+	client_manager *SessionManager
+
+	// Shard represents the [shard_id, num_shards] for this session.
+	//
+	// https://discord.com/developers/docs/topics/gateway#sharding
+	Shard *[2]int
+
+	// Conn represents a connection to the Discord Gateway.
+	Conn *websocket.Conn
+
+	// heartbeat contains the fields required to implement the heartbeat mechanism.
+	heartbeat *heartbeat
+
+	// manager represents a manager of a Session's goroutines.
+	manager *manager
+
+	// statusHandler represents the handler of the Session's status transitions.
+	statusHandler func(s *Session, transition *SessionTransition)
+
+	// ID represents the session ID of the Session.
+	ID string
+
+	// Endpoint represents the endpoint that is used to reconnect to the Gateway.
+	Endpoint string
+
+	// transitions represents the transitions of the Session that are queued for its status handler.
+	transitions transitions
+
+	// Seq represents the last sequence number received by the client.
+	//
+	// https://discord.com/developers/docs/topics/gateway#heartbeat
+	Seq int64
+
+	// RWMutex is used to protect the Session's variables from data races
+	// by providing transactional functionality.
+	sync.RWMutex
+
+	// status represents the status of the Session (i.e., SessionStatusReady).
+	status int32
+
+	// attempts represents the amount of times the Session attempted to connect since it was last ready.
+	attempts int32
+}
+
+// isConnected returns whether the session is connected.
+func (s *Session) isConnected() bool {
+	if s.Context == nil {
+		return false
+	}
+
+	select {
+	case <-s.Context.Done():
+		return false
+	default:
+		return true
+	}
+}
+
+// canReconnect determines whether the session is in a valid state to reconnect.
+func (s *Session) canReconnect() bool {
+	return s.ID != "" && s.Endpoint != "" && atomic.LoadInt64(&s.Seq) != 0
+}
+
+// Connect connects a session to the Discord Gateway (WebSocket Connection).
+func (s *Session) Connect(bot *Client) error {
+	s.Lock()
+
+	// call the status handler once the Session is unlocked.
+	defer s.notify()
+	defer s.Unlock()
+
+	LogSession(Logger.Info(), s.ID).Str(LogCtxClient, bot.ApplicationID).Msg("connecting session")
+
+	if err := s.connect(bot); err != nil {
+		if s.connecting() {
+			return s.fail(err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// connect connects a session to a WebSocket Connection.
+func (s *Session) connect(bot *Client) error {
+	if bot.Sessions == nil {
+		return fmt.Errorf(errNoSessionManager) //lint:ignore ST1005 format help message.
+	}
+
+	s.client_manager = bot.Sessions
+
+	if s.isConnected() {
+		return fmt.Errorf("session %q is already connected", s.ID)
+	}
+
+	s.statusHandler = bot.Config.Gateway.SessionStatusHandler
+	s.store = bot.Config.Gateway.SessionStore
+	s.transition(SessionStatusConnecting, "connecting", 0, nil)
+
+	// restore the state of a stored session (if applicable).
+	s.load()
 
-// Send sends a ListVoiceRegions request to Discord and returns a []*VoiceRegion.
-func (r *ListVoiceRegions) Send(bot *Client) ([]*VoiceRegion, error) {
 	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[164]("164")
-	endpoint := EndpointListVoiceRegions()
 
-	result := make([]*VoiceRegion, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+	// request a valid Gateway URL endpoint and response from the Discord API.
+	gatewayEndpoint := s.Endpoint
+	var response *GetGatewayBotResponse
+
+	if bot.Config.Gateway.ShardManager != nil {
+		if gatewayEndpoint, response, err = bot.Config.Gateway.ShardManager.SetLimit(bot); err != nil {
+			return fmt.Errorf("shardmanager: %w", err)
+		}
+
+		// a resumable session must reconnect using its resume gateway URL.
+		if s.canReconnect() {
+			gatewayEndpoint = s.Endpoint
+		}
+	} else {
+		if gatewayEndpoint == "" || !s.canReconnect() {
+			gateway := GetGatewayBot{}
+			response, err = gateway.Send(bot)
+			if err != nil {
+				return fmt.Errorf("error getting the Gateway API Endpoint: %w", err)
+			}
+
+			gatewayEndpoint = response.URL
 		}
 	}
 
-	return result, nil
-}
+	// set the maximum allowed (Identify) concurrency rate limit.
+	//
+	// https://discord.com/developers/docs/topics/gateway#rate-limiting
+	if response != nil {
+		bot.Config.Gateway.RateLimiter.StartTx()
 
-// Send sends a CreateWebhook request to Discord and returns a Webhook.
-func (r *CreateWebhook) Send(bot *Client) (*Webhook, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[165]("165", "e5416649"+r.ChannelID)
-	endpoint := EndpointCreateWebhook(r.ChannelID)
+		identifyBucket := bot.Config.Gateway.RateLimiter.GetBucketFromID(FlagGatewaySendEventNameIdentify)
+		if identifyBucket == nil {
+			identifyBucket = getBucket()
+			bot.Config.Gateway.RateLimiter.SetBucketFromID(FlagGatewaySendEventNameIdentify, identifyBucket)
+		}
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+		identifyBucket.Limit = int16(response.SessionStartLimit.MaxConcurrency)
+
+		if identifyBucket.Expiry.IsZero() {
+			identifyBucket.Remaining = identifyBucket.Limit
+			identifyBucket.Expiry = time.Now().Add(FlagGlobalRateLimitIdentifyInterval)
 		}
+
+		bot.Config.Gateway.RateLimiter.EndTx()
 	}
 
-	result := new(Webhook)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeJSON, body, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+	// connect to the Discord Gateway Websocket.
+	s.manager = new(manager)
+	s.Context, s.manager.cancel = context.WithCancel(context.Background())
+	if s.Conn, _, err = websocket.Dial(s.Context, gatewayEndpoint+gatewayEndpointParams, nil); err != nil {
+		return fmt.Errorf("error connecting to the Discord Gateway: %w", err)
 	}
 
-	return result, nil
-}
+	// set up the Session's Rate Limiter (applied per WebSocket Connection).
+	// https://discord.com/developers/docs/topics/gateway#rate-limiting
+	s.RateLimiter = &RateLimit{ //nolint:exhaustruct
+		ids:     make(map[string]string, totalGatewayBucketsPerConnection),
+		buckets: make(map[string]*Bucket, totalGatewayBucketsPerConnection),
+	}
 
-// Send sends a GetChannelWebhooks request to Discord and returns a []*Webhook.
-func (r *GetChannelWebhooks) Send(bot *Client) ([]*Webhook, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[166]("166", "e5416649"+r.ChannelID)
-	endpoint := EndpointGetChannelWebhooks(r.ChannelID)
+	s.RateLimiter.SetBucket(
+		GlobalRateLimitRouteID, &Bucket{ //nolint:exhaustruct
+			Limit:     FlagGlobalRateLimitGateway,
+			Remaining: FlagGlobalRateLimitGateway,
+			Expiry:    time.Now().Add(FlagGlobalRateLimitGatewayInterval),
+		},
+	)
 
-	result := make([]*Webhook, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+	// handle the incoming Hello event upon connecting to the Gateway.
+	hello := new(Hello)
+	if err := readEvent(s, hello); err != nil {
+		err = fmt.Errorf("error reading initial Hello event: %w", err)
+		sessionErr := ErrorSession{SessionID: s.ID, Err: err}
+		if disconnectErr := s.disconnect(FlagClientCloseEventCodeNormal); disconnectErr != nil {
+			sessionErr.Err = ErrorDisconnect{
+				Action:     err,
+				Err:        disconnectErr,
+				Connection: ErrConnectionSession,
+			}
 		}
+
+		return sessionErr
 	}
 
-	return result, nil
-}
+	for _, handler := range bot.Handlers.Hello {
+		call(newDispatch(bot, s, FlagGatewayEventNameHello, 0, nil), handler, hello)
+	}
 
-// Send sends a GetGuildWebhooks request to Discord and returns a []*Webhook.
-func (r *GetGuildWebhooks) Send(bot *Client) ([]*Webhook, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[167]("167", "45892a5d"+r.GuildID)
-	endpoint := EndpointGetGuildWebhooks(r.GuildID)
+	// begin sending heartbeat payloads every heartbeat_interval ms.
+	ms := time.Millisecond * time.Duration(hello.HeartbeatInterval)
+	s.heartbeat = &heartbeat{
+		interval: ms,
+		ticker:   time.NewTicker(ms),
+		send:     make(chan Heartbeat),
 
-	result := make([]*Webhook, 0)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+		// add a HeartbeatACK to the HeartbeatACK channel to prevent
+		// the length of the HeartbeatACK channel from being 0 immediately,
+		// which results in an attempt to reconnect.
+		acks: 1,
 	}
 
-	return result, nil
-}
+	// create a goroutine group for the Session.
+	s.manager.Group, s.manager.signal = errgroup.WithContext(s.Context)
+	s.manager.err = make(chan error, 1)
 
-// Send sends a GetWebhook request to Discord and returns a Webhook.
-func (r *GetWebhook) Send(bot *Client) (*Webhook, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[168]("168", "6d62b21b"+r.WebhookID)
-	endpoint := EndpointGetWebhook(r.WebhookID)
+	// spawn the heartbeat pulse goroutine.
+	s.manager.routines.Add(1)
+	atomic.AddInt32(&s.manager.pulses, 1)
+	s.manager.Go(func() error {
+		s.pulse()
+		return nil
+	})
 
-	result := new(Webhook)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+	// spawn the heartbeat beat goroutine.
+	s.manager.routines.Add(1)
+	s.manager.Go(func() error {
+		if err := s.beat(bot); err != nil {
+			return ErrorSession{
+				SessionID: s.ID,
+				Err:       fmt.Errorf("heartbeat: %w", err),
+			}
 		}
-	}
 
-	return result, nil
-}
-
-// Send sends a GetWebhookwithToken request to Discord and returns a Webhook.
-func (r *GetWebhookwithToken) Send(bot *Client) (*Webhook, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[169]("169", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
-	endpoint := EndpointGetWebhookwithToken(r.WebhookID, r.WebhookToken)
+		return nil
+	})
 
-	result := new(Webhook)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+	// send the initial Identify or Resumed packet.
+	if err := s.initial(bot, 0); err != nil {
+		sessionErr := ErrorSession{SessionID: s.ID, Err: err}
+		if disconnectErr := s.disconnect(FlagClientCloseEventCodeNormal); disconnectErr != nil {
+			sessionErr.Err = ErrorDisconnect{
+				Action:     err,
+				Err:        disconnectErr,
+				Connection: ErrConnectionSession,
+			}
 		}
+
+		return sessionErr
 	}
 
-	return result, nil
+	// spawn the event listener listen goroutine.
+	s.manager.routines.Add(1)
+	s.manager.Go(func() error {
+		if err := s.listen(bot); err != nil {
+			return ErrorSession{
+				SessionID: s.ID,
+				Err:       fmt.Errorf("listen: %w", err),
+			}
+		}
+
+		return nil
+	})
+
+	// spawn the manager goroutine.
+	s.manager.routines.Add(1)
+	go s.manage(bot)
+
+	// ensure that the Session's goroutines are spawned.
+	s.manager.routines.Wait()
+
+	return nil
 }
 
-// Send sends a ModifyWebhook request to Discord and returns a Webhook.
-func (r *ModifyWebhook) Send(bot *Client) (*Webhook, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[170]("170", "6d62b21b"+r.WebhookID)
-	endpoint := EndpointModifyWebhook(r.WebhookID)
+// initial sends the initial Identify or Resume packet required to connect to the Gateway,
+// then handles the incoming Ready or Resumed packet that indicates a successful connection.
+func (s *Session) initial(bot *Client, attempt int) error {
+	if !s.canReconnect() {
+		s.transition(SessionStatusIdentifying, "identifying", 0, nil)
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+		// send an Opcode 2 Identify to the Discord Gateway.
+		identify := Identify{
+			Token: bot.Authentication.Token,
+			Properties: IdentifyConnectionProperties{
+				OS:      runtime.GOOS,
+				Browser: module,
+				Device:  module,
+			},
+			Compress:       Pointer(true),
+			LargeThreshold: Pointer(maxIdentifyLargeThreshold),
+			Shard:          s.Shard,
+			Presence:       bot.Config.Gateway.GatewayPresenceUpdate,
+			Intents:        bot.Config.Gateway.Intents,
 		}
-	}
 
-	result := new(Webhook)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+		if err := identify.SendEvent(bot, s); err != nil {
+			return err
 		}
-	}
-
-	return result, nil
-}
+	} else {
+		s.transition(SessionStatusResuming, "resuming", 0, nil)
 
-// Send sends a ModifyWebhookwithToken request to Discord and returns a Webhook.
-func (r *ModifyWebhookwithToken) Send(bot *Client) (*Webhook, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[171]("171", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
-	endpoint := EndpointModifyWebhookwithToken(r.WebhookID, r.WebhookToken)
+		// send an Opcode 6 Resume to the Discord Gateway to reconnect the session.
+		resume := Resume{
+			Token:     bot.Authentication.Token,
+			SessionID: s.ID,
+			Seq:       atomic.LoadInt64(&s.Seq),
+		}
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+		if err := resume.SendEvent(bot, s); err != nil {
+			return err
 		}
 	}
 
-	result := new(Webhook)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeJSON, body, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+	// handle the incoming Ready, Resumed or Replayed event (or Opcode 9 Invalid Session).
+	payload := new(GatewayPayload)
+	if err := socket.Read(s.Context, s.Conn, payload); err != nil {
+		return fmt.Errorf("error reading initial payload: %w", err)
 	}
 
-	return result, nil
-}
+	LogPayload(LogSession(Logger.Info(), s.ID), payload.Op, payload.Data).Msg("received initial payload")
 
-// Send sends a DeleteWebhook request to Discord and returns a error.
-func (r *DeleteWebhook) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[172]("172", "6d62b21b"+r.WebhookID)
-	endpoint := EndpointDeleteWebhook(r.WebhookID)
+	switch payload.Op {
+	case FlagGatewayOpcodeDispatch:
+		switch {
+		// When a connection is successful, the Discord Gateway will respond with a Ready event.
+		case *payload.EventName == FlagGatewayEventNameReady:
+			ready := new(Ready)
+			if err := json.Unmarshal(payload.Data, ready); err != nil {
+				return fmt.Errorf("error reading ready event: %w", err)
+			}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
-	}
+			LogSession(Logger.Info(), ready.SessionID).Msg("received Ready event")
 
-	return nil
-}
+			// Configure the session.
+			s.ID = ready.SessionID
+			atomic.StoreInt64(&s.Seq, *payload.SequenceNumber)
+			s.Endpoint = ready.ResumeGatewayURL
 
-// Send sends a DeleteWebhookwithToken request to Discord and returns a error.
-func (r *DeleteWebhookwithToken) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[173]("173", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
-	endpoint := EndpointDeleteWebhookwithToken(r.WebhookID, r.WebhookToken)
+			// Store the session in the session manager.
+			s.client_manager.Gateway.Store(s.ID, s)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
-	}
+			// Store the state of the session.
+			s.save()
 
-	return nil
-}
+			if bot.Config.Gateway.ShardManager != nil {
+				bot.Config.Gateway.ShardManager.Ready(bot, s, ready)
+			}
 
-// Send sends a ExecuteWebhook request to Discord and returns a error.
-func (r *ExecuteWebhook) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[174]("174", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
-	}
-	endpoint := EndpointExecuteWebhook(r.WebhookID, r.WebhookToken) + "?" + query
+			s.transition(SessionStatusReady, "received Ready event", 0, nil)
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
-		}
-	}
+			d := newDispatch(bot, s, FlagGatewayEventNameReady, *payload.SequenceNumber, payload.Data)
+			bot.raw(d)
 
-	contentType := ContentTypeJSON
-	if len(r.Files) != 0 {
-		var multipartErr error
-		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
-			return ErrorRequest{
-				ClientID:      bot.ApplicationID,
-				CorrelationID: xid,
-				RouteID:       routeid,
-				ResourceID:    resourceid,
-				Endpoint:      "",
-				Err:           err,
+			for _, handler := range bot.Handlers.Ready {
+				call(d, handler, ready)
 			}
-		}
-	}
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, contentType, body, nil)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
-	}
+		// When a reconnection is successful, the Discord Gateway will respond
+		// by replaying all missed events in order, finalized by a Resumed event.
+		case *payload.EventName == FlagGatewayEventNameResumed:
+			s.resumed(bot, payload)
 
-	return nil
-}
+		// When a reconnection is successful, the Discord Gateway will respond
+		// by replaying all missed events in order, finalized by a Resumed event.
+		default:
+			// handle the replayed events (in order) until a Resumed event is encountered.
+			var replays []*dispatch
 
-// Send sends a ExecuteSlackCompatibleWebhook request to Discord and returns a error.
-func (r *ExecuteSlackCompatibleWebhook) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[175]("175", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
-	}
-	endpoint := EndpointExecuteSlackCompatibleWebhook(r.WebhookID, r.WebhookToken) + "?" + query
+			for {
+				switch payload.Op {
+				case FlagGatewayOpcodeDispatch:
+					if *payload.EventName == FlagGatewayEventNameResumed {
+						bot.replay(replays)
+						s.resumed(bot, payload)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, nil)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+						return nil
+					}
+
+					event := newDispatch(bot, s, *payload.EventName, *payload.SequenceNumber, payload.Data)
+					event.replayed = true
+
+					if s.sequence(event) {
+						replays = append(replays, event)
+					}
+
+				// the heartbeat goroutines are blocked while events are replayed,
+				// so an Opcode 1 Heartbeat is responded to immediately.
+				case FlagGatewayOpcodeHeartbeat:
+					heartbeat := Heartbeat{Data: atomic.LoadInt64(&s.Seq)}
+					if err := heartbeat.SendEvent(bot, s); err != nil {
+						return fmt.Errorf("error responding to heartbeat while replaying events: %w", err)
+					}
+
+					s.heartbeat.sent = time.Now()
+
+				case FlagGatewayOpcodeHeartbeatACK:
+					s.heartbeat.ack()
+
+				// the session reconnects (once it's connected) after handling the events that were replayed.
+				case FlagGatewayOpcodeReconnect:
+					bot.replay(replays)
+					s.reconnect("reconnecting session due to Opcode 7 Reconnect while replaying events")
+
+					return nil
+
+				// the session is invalidated before it resumes, so it must identify again.
+				case FlagGatewayOpcodeInvalidSession:
+					bot.replay(replays)
+
+					return s.invalidated(bot, attempt)
+				}
+
+				payload = new(GatewayPayload)
+				if err := socket.Read(s.Context, s.Conn, payload); err != nil {
+					return fmt.Errorf("error replaying events: %w", err)
+				}
+			}
 		}
+
+	// When the maximum concurrency limit has been reached while connecting, or when
+	// the session does NOT reconnect in time, the Discord Gateway send an Opcode 9 Invalid Session.
+	case FlagGatewayOpcodeInvalidSession:
+		return s.invalidated(bot, attempt)
+
+	default:
+		return fmt.Errorf("session %q received payload %d during connection which is unexpected", s.ID, payload.Op)
 	}
 
 	return nil
 }
 
-// Send sends a ExecuteGitHubCompatibleWebhook request to Discord and returns a error.
-func (r *ExecuteGitHubCompatibleWebhook) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[176]("176", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
-	}
-	endpoint := EndpointExecuteGitHubCompatibleWebhook(r.WebhookID, r.WebhookToken) + "?" + query
+// invalidated handles an Opcode 9 Invalid Session that is received while connecting.
+func (s *Session) invalidated(bot *Client, attempt int) error {
+	// Remove the session from the session manager.
+	s.client_manager.Gateway.Store(s.ID, nil)
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, nil)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+	// Remove the state of the invalidated session.
+	s.forget()
+
+	if attempt < 1 {
+		// wait for Discord to close the session, then complete a fresh connect.
+		<-time.NewTimer(invalidSessionWaitTime).C
+
+		s.ID = ""
+		atomic.StoreInt64(&s.Seq, 0)
+		if err := s.initial(bot, attempt+1); err != nil {
+			return err
 		}
+
+		return nil
 	}
 
-	return nil
+	return fmt.Errorf("session %q couldn't connect to the Discord Gateway or has invalidated an active session", s.ID)
 }
 
-// Send sends a GetWebhookMessage request to Discord and returns a Message.
-func (r *GetWebhookMessage) Send(bot *Client) (*Message, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[177]("177", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken, "d57d6589"+r.MessageID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
+// resumed handles the Resumed event that indicates a successful reconnection.
+func (s *Session) resumed(bot *Client, payload *GatewayPayload) {
+	LogSession(Logger.Info(), s.ID).Msg("received Resumed event")
+
+	d := newDispatch(bot, s, FlagGatewayEventNameResumed, *payload.SequenceNumber, payload.Data)
+	s.sequence(d)
+
+	// Store the session in the session manager.
+	s.client_manager.Gateway.Store(s.ID, s)
+
+	// Store the state of the session.
+	s.save()
+
+	s.transition(SessionStatusReady, "received Resumed event", 0, nil)
+
+	bot.raw(d)
+
+	for _, handler := range bot.Handlers.Resumed {
+		call(d, handler, &Resumed{})
 	}
-	endpoint := EndpointGetWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
+}
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+// Disconnect disconnects a session from the Discord Gateway using the given status code.
+func (s *Session) Disconnect() error {
+	s.Lock()
+
+	if !s.isConnected() {
+		s.Unlock()
+
+		return fmt.Errorf("session %q is already disconnected", s.ID)
+	}
+
+	id := s.ID
+	LogSession(Logger.Info(), id).Msgf("disconnecting session with code %d", FlagClientCloseEventCodeNormal)
+
+	s.manager.signal = context.WithValue(s.manager.signal, keySignal, signalDisconnect)
+
+	if err := s.disconnect(FlagClientCloseEventCodeNormal); err != nil {
+		s.Unlock()
+
+		return ErrorDisconnect{
+			Connection: ErrConnectionSession,
+			Action:     nil,
+			Err:        err,
 		}
 	}
 
-	return result, nil
+	s.Unlock()
+
+	if err := <-s.manager.err; err != nil {
+		return err
+	}
+
+	// Remove the state of the session, which is invalidated by a normal closure.
+	s.forget()
+
+	s.transition(SessionStatusDisconnected, "disconnected", FlagClientCloseEventCodeNormal, nil)
+	s.notify()
+
+	putSession(s)
+
+	LogSession(Logger.Info(), id).Msgf("disconnected session with code %d", FlagClientCloseEventCodeNormal)
+
+	return nil
 }
 
-// Send sends a EditWebhookMessage request to Discord and returns a Message.
-func (r *EditWebhookMessage) Send(bot *Client) (*Message, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[178]("178", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken, "d57d6589"+r.MessageID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
+// Suspend disconnects a session from the Discord Gateway without invalidating the session,
+// then returns the state that is required to resume the session (i.e., from another process).
+//
+// In contrast to Disconnect(), Suspend() closes the connection with a non-1000 status code,
+// so Discord keeps the session resumable.
+func (s *Session) Suspend() (*SessionState, error) {
+	s.Lock()
+
+	if !s.isConnected() {
+		s.Unlock()
+
+		return nil, fmt.Errorf("session %q is already disconnected", s.ID)
 	}
-	endpoint := EndpointEditWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
-	body, err := json.Marshal(r)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           fmt.Errorf(errSendMarshal, err),
+	id := s.ID
+	LogSession(Logger.Info(), id).Msgf("suspending session with code %d", FlagClientCloseEventCodeReconnect)
+
+	s.manager.signal = context.WithValue(s.manager.signal, keySignal, signalDisconnect)
+
+	if err := s.disconnect(FlagClientCloseEventCodeReconnect); err != nil {
+		s.Unlock()
+
+		return nil, ErrorDisconnect{
+			Connection: ErrConnectionSession,
+			Action:     nil,
+			Err:        err,
 		}
 	}
 
-	contentType := ContentTypeJSON
-	if len(r.Files) != 0 {
-		var multipartErr error
-		if contentType, body, multipartErr = createMultipartForm(body, r.Files...); multipartErr != nil {
-			return nil, ErrorRequest{
-				ClientID:      bot.ApplicationID,
-				CorrelationID: xid,
-				RouteID:       routeid,
-				ResourceID:    resourceid,
-				Endpoint:      "",
-				Err:           err,
-			}
-		}
+	s.Unlock()
+
+	if err := <-s.manager.err; err != nil {
+		return nil, err
 	}
 
-	result := new(Message)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, contentType, body, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+	s.RLock()
+	state := s.State()
+	s.RUnlock()
+
+	s.transition(SessionStatusDisconnected, "suspended", FlagClientCloseEventCodeReconnect, nil)
+	s.notify()
+
+	putSession(s)
+
+	LogSession(Logger.Info(), id).Msgf("suspended session with code %d", FlagClientCloseEventCodeReconnect)
+
+	return state, nil
+}
+
+// disconnect disconnects a session from a WebSocket Connection using the given status code.
+func (s *Session) disconnect(code int) error {
+	// cancel the context to kill the goroutines of the Session.
+	defer s.manager.cancel()
+
+	// Remove the session from the session manager.
+	s.client_manager.Gateway.Store(s.ID, nil)
+
+	if err := s.Conn.Close(websocket.StatusCode(code), ""); err != nil {
+		return fmt.Errorf("%w", err)
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a DeleteWebhookMessage request to Discord and returns a error.
-func (r *DeleteWebhookMessage) Send(bot *Client) error {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[179]("179", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken, "d57d6589"+r.MessageID)
-	query, err := EndpointQueryString(r)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      "",
-			Err:           err,
-		}
+// Reconnect reconnects an already connected session to the Discord Gateway
+// by disconnecting the session, then connecting again.
+func (s *Session) Reconnect(bot *Client) error {
+	s.reconnect("reconnecting")
+
+	if err := <-s.manager.err; err != nil {
+		return err
 	}
-	endpoint := EndpointDeleteWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, ContentTypeURLQueryString, nil, nil)
-	if err != nil {
-		return ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+	// connect to the Discord Gateway again.
+	if err := s.Connect(bot); err != nil {
+		return fmt.Errorf("error reconnecting session %q: %w", s.ID, err)
 	}
 
 	return nil
 }
 
-// Send sends a GetGateway request to Discord and returns a GetGatewayBotResponse.
-func (r *GetGateway) Send(bot *Client) (*GetGatewayBotResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[180]("180")
-	endpoint := EndpointGetGateway()
-
-	result := new(GetGatewayBotResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
+// readEvent is a helper function for reading events from the WebSocket Session.
+func readEvent(s *Session, dst any) error {
+	payload := new(GatewayPayload)
+	if err := socket.Read(s.Context, s.Conn, payload); err != nil {
+		return fmt.Errorf("readEvent: %w", err)
+	}
+
+	if err := json.Unmarshal(payload.Data, dst); err != nil {
+		return fmt.Errorf("readEvent: %w", err)
 	}
 
-	return result, nil
+	return nil
 }
 
-// Send sends a GetGatewayBot request to Discord and returns a GetGatewayBotResponse.
-func (r *GetGatewayBot) Send(bot *Client) (*GetGatewayBotResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[181]("181")
-	endpoint := EndpointGetGatewayBot()
+// writeEvent is a helper function for writing events to the WebSocket Session.
+func writeEvent(bot *Client, s *Session, op int, name string, dst any) error {
+RATELIMIT:
+	// a single send event is PROCESSED at any point in time.
+	s.RateLimiter.Lock()
 
-	result := new(GetGatewayBotResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
-	}
+	LogCommand(LogSession(Logger.Trace(), s.ID), bot.ApplicationID, op, name).Msg("processing gateway command")
 
-	return result, nil
-}
+	for {
+		s.RateLimiter.StartTx()
 
-// Send sends a GetCurrentBotApplicationInformation request to Discord and returns a Application.
-func (r *GetCurrentBotApplicationInformation) Send(bot *Client) (*Application, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[182]("182")
-	endpoint := EndpointGetCurrentBotApplicationInformation()
+		globalBucket := s.RateLimiter.GetBucket(GlobalRateLimitRouteID, "")
 
-	result := new(Application)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
+		// reset the Global Rate Limit Bucket when the current Bucket has passed its expiry.
+		if isExpired(globalBucket) {
+			globalBucket.Reset(time.Now().Add(time.Minute))
 		}
-	}
 
-	return result, nil
-}
+		// stop waiting when the Global Rate Limit Bucket is NOT empty.
+		if isNotEmpty(globalBucket) {
+			switch op {
+			// Identify is also bound by the max_concurrency rate limit.
+			case FlagGatewayOpcodeIdentify:
+				bot.Config.Gateway.RateLimiter.StartTx()
 
-// Send sends a GetCurrentAuthorizationInformation request to Discord and returns a CurrentAuthorizationInformationResponse.
-func (r *GetCurrentAuthorizationInformation) Send(bot *Client) (*CurrentAuthorizationInformationResponse, error) {
-	var err error
-	xid := xid.New().String()
-	routeid, resourceid := RateLimitHashFuncs[183]("183")
-	endpoint := EndpointGetCurrentAuthorizationInformation()
+				identifyBucket := bot.Config.Gateway.RateLimiter.GetBucketFromID(FlagGatewaySendEventNameIdentify)
 
-	result := new(CurrentAuthorizationInformationResponse)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
-		return nil, ErrorRequest{
-			ClientID:      bot.ApplicationID,
-			CorrelationID: xid,
-			RouteID:       routeid,
-			ResourceID:    resourceid,
-			Endpoint:      endpoint,
-			Err:           err,
-		}
-	}
+				if isNotEmpty(identifyBucket) {
+					if globalBucket != nil {
+						if globalBucket.Remaining == FlagGlobalRateLimitGateway {
+							globalBucket.Reset(time.Now().Add(time.Minute))
+						}
 
-	return result, nil
-}
+						globalBucket.Remaining--
+					}
 
-const (
-	gatewayEndpointParams     = "?v=" + VersionDiscordAPI + "&encoding=json"
-	invalidSessionWaitTime    = 1 * time.Second
-	maxIdentifyLargeThreshold = 250
-)
+					if identifyBucket != nil {
+						identifyBucket.Remaining--
+					}
 
-// Session represents a Discord Gateway WebSocket Session.
-type Session struct {
-	// Context carries request-scoped data for the Discord Gateway Connection.
-	//
-	// Context is also used as a signal for the Session's goroutines.
-	Context context.Context
+					bot.Config.Gateway.RateLimiter.EndTx()
+					s.RateLimiter.EndTx()
 
-	// RateLimiter represents an object that provides rate limit functionality.
-	RateLimiter RateLimiter
+					goto SEND
+				}
 
-	// Shard represents the [shard_id, num_shards] for this session.
-	//
-	// https://discord.com/developers/docs/topics/gateway#sharding
-	Shard *[2]int
+				if isExpired(identifyBucket) {
+					if globalBucket != nil {
+						if globalBucket.Remaining == FlagGlobalRateLimitGateway {
+							globalBucket.Reset(time.Now().Add(time.Minute))
+						}
 
-	// Conn represents a connection to the Discord Gateway.
-	Conn *websocket.Conn
+						globalBucket.Remaining--
+					}
 
-	// heartbeat contains the fields required to implement the heartbeat mechanism.
-	heartbeat *heartbeat
+					if identifyBucket != nil {
+						identifyBucket.Reset(time.Now().Add(FlagGlobalRateLimitIdentifyInterval))
+						identifyBucket.Remaining--
+					}
 
-	// manager represents a manager of a Session's goroutines.
-	manager *manager
+					bot.Config.Gateway.RateLimiter.EndTx()
+					s.RateLimiter.EndTx()
 
-	// client_manager represents the *Client Session Manager of the Session.
-	client_manager *SessionManager
+					goto SEND
+				}
 
-	// ID represents the session ID of the Session.
-	ID string
+				var wait time.Time
+				if identifyBucket != nil {
+					wait = identifyBucket.Expiry
+				}
 
-	// Endpoint represents the endpoint that is used to reconnect to the Gateway.
-	Endpoint string
+				// do NOT block other send events due to a Send Event Rate Limit.
+				bot.Config.Gateway.RateLimiter.EndTx()
+				s.RateLimiter.EndTx()
+				s.RateLimiter.Unlock()
 
-	// Seq represents the last sequence number received by the client.
-	//
-	// https://discord.com/developers/docs/topics/gateway#heartbeat
-	Seq int64
+				// reduce CPU usage by blocking the current goroutine
+				// until it's eligible for action.
+				if identifyBucket != nil {
+					<-time.After(time.Until(wait))
+				}
 
-	// RWMutex is used to protect the Session's variables from data races
-	// by providing transactional functionality.
-	sync.RWMutex
-}
+				goto RATELIMIT
 
-// isConnected returns whether the session is connected.
-func (s *Session) isConnected() bool {
-	if s.Context == nil {
-		return false
+			default:
+				if globalBucket != nil {
+					if globalBucket.Remaining == FlagGlobalRateLimitGateway {
+						globalBucket.Reset(time.Now().Add(time.Minute))
+					}
+
+					globalBucket.Remaining--
+				}
+
+				s.RateLimiter.EndTx()
+
+				goto SEND
+			}
+		}
+
+		s.RateLimiter.EndTx()
 	}
 
-	select {
-	case <-s.Context.Done():
-		return false
-	default:
-		return true
+SEND:
+	s.RateLimiter.Unlock()
+
+	LogCommand(LogSession(Logger.Trace(), s.ID), bot.ApplicationID, op, name).Msg("sending gateway command")
+
+	// write the event to the WebSocket Connection.
+	event, err := json.Marshal(dst)
+	if err != nil {
+		return fmt.Errorf("writeEvent: %w", err)
+	}
+
+	if err = socket.Write(s.Context, s.Conn, websocket.MessageBinary,
+		GatewayPayload{ //nolint:exhaustruct
+			Op:   op,
+			Data: event,
+		}); err != nil {
+		return fmt.Errorf("writeEvent: %w", err)
 	}
+
+	LogCommand(LogSession(Logger.Trace(), s.ID), bot.ApplicationID, op, name).Msg("sent gateway command")
+
+	return nil
 }
 
-// canReconnect determines whether the session is in a valid state to reconnect.
-func (s *Session) canReconnect() bool {
-	return s.ID != "" && s.Endpoint != "" && atomic.LoadInt64(&s.Seq) != 0
+// SessionManager manages sessions.
+type SessionManager struct {
+	// Gateway represents a map of Discord Gateway (TCP WebSocket Connections) session IDs to Sessions.
+	// map[ID]Session (map[string]*Session)
+	Gateway *sync.Map
+
+	// Voice represents a map of Discord Voice (UDP WebSocket Connection) session IDs to Sessions.
+	// map[ID]Session (map[string]*Session)
+	Voice *sync.Map
 }
 
-// Connect connects a session to the Discord Gateway (WebSocket Connection).
-func (s *Session) Connect(bot *Client) error {
-	s.Lock()
-	defer s.Unlock()
+// NewSessionManager creates a new SessionManager.
+func NewSessionManager() *SessionManager {
+	return &SessionManager{
+		Gateway: new(sync.Map),
+		Voice:   new(sync.Map),
+	}
+}
 
-	LogSession(Logger.Info(), s.ID).Str(LogCtxClient, bot.ApplicationID).Msg("connecting session")
+// SendEvent sends an Opcode 1 Heartbeat event to the Discord Gateway.
+func (c *Heartbeat) SendEvent(bot *Client, session *Session) error {
+	if err := writeEvent(bot, session, FlagGatewayOpcodeHeartbeat, FlagGatewaySendEventNameHeartbeat, c); err != nil {
+		return err
+	}
 
-	return s.connect(bot)
+	return nil
 }
 
-// connect connects a session to a WebSocket Connection.
-func (s *Session) connect(bot *Client) error {
-	if bot.Sessions == nil {
-		return fmt.Errorf(errNoSessionManager) //lint:ignore ST1005 format help message.
+// SendEvent sends an Opcode 2 Identify event to the Discord Gateway.
+func (c *Identify) SendEvent(bot *Client, session *Session) error {
+	if err := writeEvent(bot, session, FlagGatewayOpcodeIdentify, FlagGatewaySendEventNameIdentify, c); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SendEvent sends an Opcode 3 UpdatePresence event to the Discord Gateway.
+func (c *GatewayPresenceUpdate) SendEvent(bot *Client, session *Session) error {
+	if err := writeEvent(bot, session, FlagGatewayOpcodePresenceUpdate, FlagGatewaySendEventNameUpdatePresence, c); err != nil {
+		return err
 	}
 
-	s.client_manager = bot.Sessions
+	return nil
+}
 
-	if s.isConnected() {
-		return fmt.Errorf("session %q is already connected", s.ID)
+// SendEvent sends an Opcode 4 UpdateVoiceState event to the Discord Gateway.
+func (c *VoiceStateUpdate) SendEvent(bot *Client, session *Session) error {
+	if err := writeEvent(bot, session, FlagGatewayOpcodeVoiceStateUpdate, FlagGatewaySendEventNameUpdateVoiceState, c); err != nil {
+		return err
 	}
 
-	var err error
+	return nil
+}
 
-	// request a valid Gateway URL endpoint and response from the Discord API.
-	gatewayEndpoint := s.Endpoint
-	var response *GetGatewayBotResponse
+// SendEvent sends an Opcode 6 Resume event to the Discord Gateway.
+func (c *Resume) SendEvent(bot *Client, session *Session) error {
+	if err := writeEvent(bot, session, FlagGatewayOpcodeResume, FlagGatewaySendEventNameResume, c); err != nil {
+		return err
+	}
 
-	if bot.Config.Gateway.ShardManager != nil {
-		if gatewayEndpoint, response, err = bot.Config.Gateway.ShardManager.SetLimit(bot); err != nil {
-			return fmt.Errorf("shardmanager: %w", err)
-		}
-	} else {
-		if gatewayEndpoint == "" || !s.canReconnect() {
-			gateway := GetGatewayBot{}
-			response, err = gateway.Send(bot)
-			if err != nil {
-				return fmt.Errorf("error getting the Gateway API Endpoint: %w", err)
-			}
+	return nil
+}
 
-			gatewayEndpoint = response.URL
-		}
+// SendEvent sends an Opcode 8 RequestGuildMembers event to the Discord Gateway.
+func (c *RequestGuildMembers) SendEvent(bot *Client, session *Session) error {
+	if err := writeEvent(bot, session, FlagGatewayOpcodeRequestGuildMembers, FlagGatewaySendEventNameRequestGuildMembers, c); err != nil {
+		return err
 	}
 
-	// set the maximum allowed (Identify) concurrency rate limit.
-	//
-	// https://discord.com/developers/docs/topics/gateway#rate-limiting
-	if response != nil {
-		bot.Config.Gateway.RateLimiter.StartTx()
-
-		identifyBucket := bot.Config.Gateway.RateLimiter.GetBucketFromID(FlagGatewaySendEventNameIdentify)
-		if identifyBucket == nil {
-			identifyBucket = getBucket()
-			bot.Config.Gateway.RateLimiter.SetBucketFromID(FlagGatewaySendEventNameIdentify, identifyBucket)
-		}
+	return nil
+}
 
-		identifyBucket.Limit = int16(response.SessionStartLimit.MaxConcurrency)
+// heartbeat represents the heartbeat mechanism for a Session.
+type heartbeat struct {
+	sent      time.Time
+	ticker    *time.Ticker
+	send      chan Heartbeat
+	latencies []time.Duration
+	interval  time.Duration
+	next      int
+	acks      uint32
+}
 
-		if identifyBucket.Expiry.IsZero() {
-			identifyBucket.Remaining = identifyBucket.Limit
-			identifyBucket.Expiry = time.Now().Add(FlagGlobalRateLimitIdentifyInterval)
-		}
+const (
+	// heartbeatLatencyWindow represents the amount of latencies used to calculate a Session's latency.
+	heartbeatLatencyWindow = 5
 
-		bot.Config.Gateway.RateLimiter.EndTx()
-	}
+	// heartbeatLatencySamples represents the minimum amount of latencies required to
+	// determine whether a Session's latency is degraded.
+	heartbeatLatencySamples = 3
+)
 
-	// connect to the Discord Gateway Websocket.
-	s.manager = new(manager)
-	s.Context, s.manager.cancel = context.WithCancel(context.Background())
-	if s.Conn, _, err = websocket.Dial(s.Context, gatewayEndpoint+gatewayEndpointParams, nil); err != nil {
-		return fmt.Errorf("error connecting to the Discord Gateway: %w", err)
-	}
+// ack acknowledges the last Heartbeat sent to the Discord Gateway
+// and records its latency.
+func (hb *heartbeat) ack() {
+	atomic.AddUint32(&hb.acks, 1)
 
-	// set up the Session's Rate Limiter (applied per WebSocket Connection).
-	// https://discord.com/developers/docs/topics/gateway#rate-limiting
-	s.RateLimiter = &RateLimit{ //nolint:exhaustruct
-		ids:     make(map[string]string, totalGatewayBucketsPerConnection),
-		buckets: make(map[string]*Bucket, totalGatewayBucketsPerConnection),
+	if hb.sent.IsZero() {
+		return
 	}
 
-	s.RateLimiter.SetBucket(
-		GlobalRateLimitRouteID, &Bucket{ //nolint:exhaustruct
-			Limit:     FlagGlobalRateLimitGateway,
-			Remaining: FlagGlobalRateLimitGateway,
-			Expiry:    time.Now().Add(FlagGlobalRateLimitGatewayInterval),
-		},
-	)
-
-	// handle the incoming Hello event upon connecting to the Gateway.
-	hello := new(Hello)
-	if err := readEvent(s, hello); err != nil {
-		err = fmt.Errorf("error reading initial Hello event: %w", err)
-		sessionErr := ErrorSession{SessionID: s.ID, Err: err}
-		if disconnectErr := s.disconnect(FlagClientCloseEventCodeNormal); disconnectErr != nil {
-			sessionErr.Err = ErrorDisconnect{
-				Action:     err,
-				Err:        disconnectErr,
-				Connection: ErrConnectionSession,
-			}
-		}
+	latency := time.Since(hb.sent)
+	hb.sent = time.Time{}
 
-		return sessionErr
-	}
+	if len(hb.latencies) < heartbeatLatencyWindow {
+		hb.latencies = append(hb.latencies, latency)
 
-	for _, handler := range bot.Handlers.Hello {
-		go handler(hello)
+		return
 	}
 
-	// begin sending heartbeat payloads every heartbeat_interval ms.
-	ms := time.Millisecond * time.Duration(hello.HeartbeatInterval)
-	s.heartbeat = &heartbeat{
-		interval: ms,
-		ticker:   time.NewTicker(ms),
-		send:     make(chan Heartbeat),
+	hb.latencies[hb.next] = latency
+	hb.next = (hb.next + 1) % heartbeatLatencyWindow
+}
 
-		// add a HeartbeatACK to the HeartbeatACK channel to prevent
-		// the length of the HeartbeatACK channel from being 0 immediately,
-		// which results in an attempt to reconnect.
-		acks: 1,
+// latency returns the average latency of the heartbeat's rolling window.
+func (hb *heartbeat) latency() time.Duration {
+	if len(hb.latencies) == 0 {
+		return 0
 	}
 
-	// create a goroutine group for the Session.
-	s.manager.Group, s.manager.signal = errgroup.WithContext(s.Context)
-	s.manager.err = make(chan error, 1)
-
-	// spawn the heartbeat pulse goroutine.
-	s.manager.routines.Add(1)
-	atomic.AddInt32(&s.manager.pulses, 1)
-	s.manager.Go(func() error {
-		s.pulse()
-		return nil
-	})
+	var total time.Duration
+	for _, latency := range hb.latencies {
+		total += latency
+	}
 
-	// spawn the heartbeat beat goroutine.
-	s.manager.routines.Add(1)
-	s.manager.Go(func() error {
-		if err := s.beat(bot); err != nil {
-			return ErrorSession{
-				SessionID: s.ID,
-				Err:       fmt.Errorf("heartbeat: %w", err),
-			}
-		}
+	return total / time.Duration(len(hb.latencies))
+}
 
-		return nil
-	})
+// degraded determines whether the latency of the heartbeat exceeds the given threshold.
+//
+// A heartbeat is never degraded when the threshold is 0.
+func (hb *heartbeat) degraded(threshold time.Duration) bool {
+	return threshold != 0 &&
+		len(hb.latencies) >= heartbeatLatencySamples &&
+		hb.latency() > threshold
+}
 
-	// send the initial Identify or Resumed packet.
-	if err := s.initial(bot, 0); err != nil {
-		sessionErr := ErrorSession{SessionID: s.ID, Err: err}
-		if disconnectErr := s.disconnect(FlagClientCloseEventCodeNormal); disconnectErr != nil {
-			sessionErr.Err = ErrorDisconnect{
-				Action:     err,
-				Err:        disconnectErr,
-				Connection: ErrConnectionSession,
-			}
-		}
+// Latency returns the average round-trip latency between the last Heartbeats
+// that a Session sent to the Discord Gateway and their HeartbeatACKs.
+//
+// Latency returns 0 when the Session has not received a HeartbeatACK.
+func (s *Session) Latency() time.Duration {
+	s.RLock()
+	defer s.RUnlock()
 
-		return sessionErr
+	if s.heartbeat == nil {
+		return 0
 	}
 
-	// spawn the event listener listen goroutine.
-	s.manager.routines.Add(1)
-	s.manager.Go(func() error {
-		if err := s.listen(bot); err != nil {
-			return ErrorSession{
-				SessionID: s.ID,
-				Err:       fmt.Errorf("listen: %w", err),
-			}
-		}
+	return s.heartbeat.latency()
+}
 
-		return nil
-	})
+// Monitor returns the current amount of HeartbeatACKs for a Session's heartbeat.
+func (s *Session) Monitor() uint32 {
+	s.Lock()
+	acks := atomic.LoadUint32(&s.heartbeat.acks)
+	s.Unlock()
 
-	// spawn the manager goroutine.
-	s.manager.routines.Add(1)
-	go s.manage()
+	return acks
+}
 
-	// ensure that the Session's goroutines are spawned.
-	s.manager.routines.Wait()
+// beat listens for pulses to send Opcode 1 Heartbeats to the Discord Gateway (to verify the connection is alive).
+func (s *Session) beat(bot *Client) error {
+	s.manager.routines.Done()
 
-	return nil
-}
+	// ensure that all pulse routines are closed prior to closing.
+	defer func() {
+		for {
+			select {
+			case <-s.heartbeat.send:
+			case <-s.Context.Done():
+				if atomic.LoadInt32(&s.manager.pulses) != 0 {
+					break
+				}
 
-// initial sends the initial Identify or Resume packet required to connect to the Gateway,
-// then handles the incoming Ready or Resumed packet that indicates a successful connection.
-func (s *Session) initial(bot *Client, attempt int) error {
-	if !s.canReconnect() {
-		// send an Opcode 2 Identify to the Discord Gateway.
-		identify := Identify{
-			Token: bot.Authentication.Token,
-			Properties: IdentifyConnectionProperties{
-				OS:      runtime.GOOS,
-				Browser: module,
-				Device:  module,
-			},
-			Compress:       Pointer(true),
-			LargeThreshold: Pointer(maxIdentifyLargeThreshold),
-			Shard:          s.Shard,
-			Presence:       bot.Config.Gateway.GatewayPresenceUpdate,
-			Intents:        bot.Config.Gateway.Intents,
-		}
+				s.logClose("heartbeat")
 
-		if err := identify.SendEvent(bot, s); err != nil {
-			return err
-		}
-	} else {
-		// send an Opcode 6 Resume to the Discord Gateway to reconnect the session.
-		resume := Resume{
-			Token:     bot.Authentication.Token,
-			SessionID: s.ID,
-			Seq:       atomic.LoadInt64(&s.Seq),
+				return
+			}
 		}
+	}()
 
-		if err := resume.SendEvent(bot, s); err != nil {
-			return err
-		}
-	}
+	for {
+		select {
+		case hb := <-s.heartbeat.send:
+			s.Lock()
 
-	// handle the incoming Ready, Resumed or Replayed event (or Opcode 9 Invalid Session).
-	payload := new(GatewayPayload)
-	if err := socket.Read(s.Context, s.Conn, payload); err != nil {
-		return fmt.Errorf("error reading initial payload: %w", err)
-	}
+			// close the connection if the last sent Heartbeat never received a HeartbeatACK.
+			if atomic.LoadUint32(&s.heartbeat.acks) == 0 {
+				s.Unlock()
 
-	LogPayload(LogSession(Logger.Info(), s.ID), payload.Op, payload.Data).Msg("received initial payload")
+				s.reconnect("attempting to reconnect session due to no HeartbeatACK")
 
-	switch payload.Op {
-	case FlagGatewayOpcodeDispatch:
-		switch {
-		// When a connection is successful, the Discord Gateway will respond with a Ready event.
-		case *payload.EventName == FlagGatewayEventNameReady:
-			ready := new(Ready)
-			if err := json.Unmarshal(payload.Data, ready); err != nil {
-				return fmt.Errorf("error reading ready event: %w", err)
+				return nil
+			}
+
+			// prevent two Heartbeat Payloads being sent to the Discord Gateway consecutively within nanoseconds,
+			// when the ticker queues a Heartbeat while the listen thread (onPayload) queues a Heartbeat
+			// (in response to the Discord Gateway).
+			//
+			// clear queued (outdated) heartbeats.
+			for len(s.heartbeat.send) > 0 {
+				// ensure the latest sequence is sent.
+				if h := <-s.heartbeat.send; h.Data > hb.Data {
+					hb.Data = h.Data
+				}
 			}
 
-			LogSession(Logger.Info(), ready.SessionID).Msg("received Ready event")
-
-			// Configure the session.
-			s.ID = ready.SessionID
-			atomic.StoreInt64(&s.Seq, 0)
-			s.Endpoint = ready.ResumeGatewayURL
-
-			// Store the session in the session manager.
-			s.client_manager.Gateway.Store(s.ID, s)
+			// send a Heartbeat to the Discord Gateway (WebSocket Connection).
+			if err := hb.SendEvent(bot, s); err != nil {
+				s.Unlock()
 
-			if bot.Config.Gateway.ShardManager != nil {
-				bot.Config.Gateway.ShardManager.Ready(bot, s, ready)
+				return err
 			}
 
-			for _, handler := range bot.Handlers.Ready {
-				go handler(ready)
+			// measure the latency of the Heartbeat using its HeartbeatACK.
+			s.heartbeat.sent = time.Now()
+
+			// reset the ticker (and empty existing ticks).
+			s.heartbeat.ticker.Reset(s.heartbeat.interval)
+			for len(s.heartbeat.ticker.C) > 0 {
+				<-s.heartbeat.ticker.C
 			}
 
-		// When a reconnection is successful, the Discord Gateway will respond
-		// by replaying all missed events in order, finalized by a Resumed event.
-		case *payload.EventName == FlagGatewayEventNameResumed:
-			LogSession(Logger.Info(), s.ID).Msg("received Resumed event")
+			// reset the amount of HeartbeatACKs since the last heartbeat.
+			atomic.StoreUint32(&s.heartbeat.acks, 0)
 
-			// Store the session in the session manager.
-			s.client_manager.Gateway.Store(s.ID, s)
+			LogSession(Logger.Info(), s.ID).Msg("sent heartbeat")
 
-			for _, handler := range bot.Handlers.Resumed {
-				go handler(&Resumed{})
-			}
+			// store the state of the session (with the latest sequence number)
+			// without holding the lock during the SessionStore's I/O.
+			state := s.snapshot()
 
-		// When a reconnection is successful, the Discord Gateway will respond
-		// by replaying all missed events in order, finalized by a Resumed event.
-		default:
-			// handle the initial payload(s) until a Resumed event is encountered.
-			go bot.handle(*payload.EventName, payload.Data)
+			s.Unlock()
 
-			for {
-				replayed := new(GatewayPayload)
-				if err := socket.Read(s.Context, s.Conn, replayed); err != nil {
-					return fmt.Errorf("error replaying events: %w", err)
-				}
+			s.persist(state)
 
-				if replayed.Op == FlagGatewayOpcodeDispatch && *replayed.EventName == FlagGatewayEventNameResumed {
-					LogSession(Logger.Info(), s.ID).Msg("received Resumed event")
+		case <-s.Context.Done():
+			return nil
+		}
+	}
+}
 
-					// Store the session in the session manager.
-					s.client_manager.Gateway.Store(s.ID, s)
+// pulse generates Opcode 1 Heartbeats for a Session's heartbeat channel.
+func (s *Session) pulse() {
+	s.manager.routines.Done()
+	defer s.decrementPulses()
 
-					for _, handler := range bot.Handlers.Resumed {
-						go handler(&Resumed{})
-					}
+	// send an Opcode 1 Heartbeat payload after heartbeat_interval * jitter milliseconds
+	// (where jitter is a random value between 0 and 1).
+	s.Lock()
+	s.heartbeat.send <- Heartbeat{Data: atomic.LoadInt64(&s.Seq)}
+	LogSession(Logger.Info(), s.ID).Msg("queued jitter heartbeat")
+	s.Unlock()
 
-					return nil
-				}
+	for {
+		select {
+		// every Heartbeat Interval...
+		case <-s.heartbeat.ticker.C:
+			s.Lock()
 
-				go bot.handle(*payload.EventName, payload.Data)
-			}
-		}
+			// queue a heartbeat.
+			s.heartbeat.send <- Heartbeat{Data: atomic.LoadInt64(&s.Seq)}
 
-	// When the maximum concurrency limit has been reached while connecting, or when
-	// the session does NOT reconnect in time, the Discord Gateway send an Opcode 9 Invalid Session.
-	case FlagGatewayOpcodeInvalidSession:
-		// Remove the session from the session manager.
-		s.client_manager.Gateway.Store(s.ID, nil)
+			LogSession(Logger.Info(), s.ID).Msg("queued heartbeat")
 
-		if attempt < 1 {
-			// wait for Discord to close the session, then complete a fresh connect.
-			<-time.NewTimer(invalidSessionWaitTime).C
+			s.Unlock()
 
-			s.ID = ""
-			atomic.StoreInt64(&s.Seq, 0)
-			if err := s.initial(bot, attempt+1); err != nil {
-				return err
-			}
+		case <-s.Context.Done():
+			s.Lock()
+			s.logClose("pulse")
+			s.Unlock()
 
-			return nil
+			return
 		}
+	}
+}
 
-		return fmt.Errorf("session %q couldn't connect to the Discord Gateway or has invalidated an active session", s.ID)
-	default:
-		return fmt.Errorf("session %q received payload %d during connection which is unexpected", s.ID, payload.Op)
+// respond responds to Opcode 1 Heartbeats from the Discord Gateway.
+func (s *Session) respond(data json.RawMessage) error {
+	defer s.decrementPulses()
+
+	var heartbeat Heartbeat
+	if err := json.Unmarshal(data, &heartbeat); err != nil {
+		return fmt.Errorf("error unmarshalling incoming Heartbeat: %w", err)
 	}
 
-	return nil
-}
+	atomic.StoreInt64(&s.Seq, heartbeat.Data)
 
-// Disconnect disconnects a session from the Discord Gateway using the given status code.
-func (s *Session) Disconnect() error {
 	s.Lock()
 
-	if !s.isConnected() {
+	// ensure that the heartbeat routine has not been closed.
+	if atomic.LoadInt32(&s.manager.pulses) <= 1 {
 		s.Unlock()
 
-		return fmt.Errorf("session %q is already disconnected", s.ID)
+		return nil
 	}
 
-	id := s.ID
-	LogSession(Logger.Info(), id).Msgf("disconnecting session with code %d", FlagClientCloseEventCodeNormal)
+	// heartbeat() checks for the amount of HeartbeatACKs received since the last Heartbeat.
+	// There is a possibility for this value to be 0 due to latency rather than a dead connection.
+	// For example, when a Heartbeat is queued, sent, responded, and sent.
+	//
+	// Prevent this possibility by treating this response from Discord as an indication that the
+	// connection is still alive.
+	atomic.AddUint32(&s.heartbeat.acks, 1)
 
-	s.manager.signal = context.WithValue(s.manager.signal, keySignal, signalDisconnect)
+	// send an Opcode 1 Heartbeat without waiting the remainder of the current interval.
+	s.heartbeat.send <- heartbeat
 
-	if err := s.disconnect(FlagClientCloseEventCodeNormal); err != nil {
-		s.Unlock()
+	LogSession(Logger.Info(), s.ID).Msg("responded to heartbeat")
 
-		return ErrorDisconnect{
-			Connection: ErrConnectionSession,
-			Action:     nil,
-			Err:        err,
+	s.Unlock()
+
+	return nil
+}
+
+// listen listens to the connection for payloads from the Discord Gateway.
+func (s *Session) listen(bot *Client) error {
+	s.manager.routines.Done()
+
+	var err error
+
+	for {
+		payload := getPayload()
+		if err = socket.Read(s.Context, s.Conn, payload); err != nil {
+			break
+		}
+
+		LogPayload(LogSession(Logger.Info(), s.ID), payload.Op, payload.Data).Msg("received payload")
+
+		if err = s.onPayload(bot, *payload); err != nil {
+			break
 		}
 	}
 
-	s.Unlock()
+	s.Lock()
+	defer s.Unlock()
+	defer s.logClose("listen")
 
-	if err := <-s.manager.err; err != nil {
+	select {
+	case <-s.Context.Done():
+		return nil
+
+	default:
 		return err
 	}
+}
 
-	putSession(s)
+// onPayload handles an Discord Gateway Payload.
+func (s *Session) onPayload(bot *Client, payload GatewayPayload) error {
+	defer putPayload(&payload)
 
-	LogSession(Logger.Info(), id).Msgf("disconnected session with code %d", FlagClientCloseEventCodeNormal)
+	// https://discord.com/developers/docs/topics/opcodes-and-status-codes#gateway-gateway-opcodes
+	switch payload.Op {
+	// run the bot's event handlers.
+	case FlagGatewayOpcodeDispatch:
+		event := newDispatch(bot, s, *payload.EventName, *payload.SequenceNumber, payload.Data)
+		if s.sequence(event) {
+			bot.dispatch(event)
+		}
 
-	return nil
-}
+	// send an Opcode 1 Heartbeat to the Discord Gateway.
+	case FlagGatewayOpcodeHeartbeat:
+		s.Lock()
+		atomic.AddInt32(&s.manager.pulses, 1)
+		s.Unlock()
 
-// disconnect disconnects a session from a WebSocket Connection using the given status code.
-func (s *Session) disconnect(code int) error {
-	// cancel the context to kill the goroutines of the Session.
-	defer s.manager.cancel()
+		s.manager.Go(func() error {
+			if err := s.respond(payload.Data); err != nil {
+				return fmt.Errorf("respond: %w", err)
+			}
 
-	// Remove the session from the session manager.
-	s.client_manager.Gateway.Store(s.ID, nil)
+			return nil
+		})
 
-	if err := s.Conn.Close(websocket.StatusCode(code), ""); err != nil {
-		return fmt.Errorf("%w", err)
+	// handle the successful acknowledgement of the client's last heartbeat.
+	case FlagGatewayOpcodeHeartbeatACK:
+		s.Lock()
+		s.heartbeat.ack()
+		degraded := s.heartbeat.degraded(bot.Config.Gateway.HeartbeatLatencyThreshold)
+		s.Unlock()
+
+		// a connection with degraded latency is reconnected before it becomes a zombie connection.
+		if degraded {
+			s.reconnect("attempting to reconnect session due to degraded heartbeat latency")
+
+			return nil
+		}
+
+	// occurs when the Discord Gateway is shutting down the connection, while signalling the client to reconnect.
+	case FlagGatewayOpcodeReconnect:
+		s.reconnect("reconnecting session due to Opcode 7 Reconnect")
+
+		return nil
+
+	// in the context of onPayload, an Invalid Session occurs when an active session is invalidated.
+	case FlagGatewayOpcodeInvalidSession:
+		// Remove the session from the session manager.
+		s.client_manager.Gateway.Store(s.ID, nil)
+
+		// wait for Discord to close the session, then complete a fresh connect.
+		<-time.NewTimer(invalidSessionWaitTime).C
+
+		s.Lock()
+		defer s.notify()
+		defer s.Unlock()
+
+		if err := s.initial(bot, 0); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// Reconnect reconnects an already connected session to the Discord Gateway
-// by disconnecting the session, then connecting again.
-func (s *Session) Reconnect(bot *Client) error {
-	s.reconnect("reconnecting")
+// sequence updates the Session's sequence number using the sequence number of a dispatched event,
+// then returns whether the event should be dispatched.
+//
+// An event with an unexpected sequence number (i.e., a gap or duplicate) is reported,
+// while a duplicate event (with a sequence number that was already received) is NOT dispatched.
+func (s *Session) sequence(d *dispatch) bool {
+	last := atomic.LoadInt64(&s.Seq)
+	if last != 0 && d.seq != last+1 {
+		err := ErrorSequence{
+			SessionID: s.ID,
+			Event:     d.eventname,
+			Expected:  last + 1,
+			Received:  d.seq,
+		}
 
-	if err := <-s.manager.err; err != nil {
-		return err
-	}
+		LogSession(Logger.Error(), s.ID).Err(err).Msg("")
+		d.bot.report(err, d.context())
 
-	// connect to the Discord Gateway again.
-	if err := s.Connect(bot); err != nil {
-		return fmt.Errorf("error reconnecting session %q: %w", s.ID, err)
+		if d.seq <= last {
+			return false
+		}
 	}
 
-	return nil
+	atomic.StoreInt64(&s.Seq, d.seq)
+
+	return true
 }
 
-// readEvent is a helper function for reading events from the WebSocket Session.
-func readEvent(s *Session, dst any) error {
-	payload := new(GatewayPayload)
-	if err := socket.Read(s.Context, s.Conn, payload); err != nil {
-		return fmt.Errorf("readEvent: %w", err)
-	}
+// signal represents a manager Context Signal.
+type signal string
+
+// manager Context Signals.
+const (
+	// keySignal represents the Context key for a manager's signals.
+	keySignal = signal("signal")
+
+	// keyReason represents the Context key for a manager's reason for disconnection.
+	keyReason = signal("reason")
+
+	// signalDisconnect indicates that a disconnection was called purposefully.
+	signalDisconnect = 1
+
+	// signalReconnect signals the manager to reconnect upon a successful disconnection.
+	signalReconnect = 2
+)
+
+// manager represents a manager of a Session's goroutines.
+type manager struct {
+	signal context.Context
+	cancel context.CancelFunc
+	err    chan error
+	*errgroup.Group
+	routines sync.WaitGroup
+	pulses   int32
+}
 
-	if err := json.Unmarshal(payload.Data, dst); err != nil {
-		return fmt.Errorf("readEvent: %w", err)
-	}
+// decrementPulses safely decrements the pulses counter of a Session manager.
+func (s *Session) decrementPulses() {
+	s.Lock()
+	defer s.Unlock()
 
-	return nil
+	atomic.AddInt32(&s.manager.pulses, -1)
 }
 
-// writeEvent is a helper function for writing events to the WebSocket Session.
-func writeEvent(bot *Client, s *Session, op int, name string, dst any) error {
-RATELIMIT:
-	// a single send event is PROCESSED at any point in time.
-	s.RateLimiter.Lock()
-
-	LogCommand(LogSession(Logger.Trace(), s.ID), bot.ApplicationID, op, name).Msg("processing gateway command")
+// logClose safely logs the close of a Session's goroutine.
+func (s *Session) logClose(routine string) {
+	LogSession(Logger.Info(), s.ID).Msgf("closed %s routine", routine)
+}
 
-	for {
-		s.RateLimiter.StartTx()
+// reconnect spawns a goroutine for reconnection which prompts the manager
+// to reconnect upon a disconnection.
+func (s *Session) reconnect(reason string) {
+	s.manager.Go(func() error {
+		s.Lock()
+		defer s.notify()
+		defer s.logClose("reconnect")
+		defer s.Unlock()
 
-		globalBucket := s.RateLimiter.GetBucket(GlobalRateLimitRouteID, "")
+		LogSession(Logger.Info(), s.ID).Msg(reason)
+		s.transition(SessionStatusReconnecting, reason, FlagClientCloseEventCodeReconnect, nil)
 
-		// reset the Global Rate Limit Bucket when the current Bucket has passed its expiry.
-		if isExpired(globalBucket) {
-			globalBucket.Reset(time.Now().Add(time.Minute))
+		s.manager.signal = context.WithValue(s.manager.signal, keySignal, signalReconnect)
+		if err := s.disconnect(FlagClientCloseEventCodeReconnect); err != nil {
+			return fmt.Errorf("reconnect: %w", err)
 		}
 
-		// stop waiting when the Global Rate Limit Bucket is NOT empty.
-		if isNotEmpty(globalBucket) {
-			switch op {
-			// Identify is also bound by the max_concurrency rate limit.
-			case FlagGatewayOpcodeIdentify:
-				bot.Config.Gateway.RateLimiter.StartTx()
+		return nil
+	})
+}
 
-				identifyBucket := bot.Config.Gateway.RateLimiter.GetBucketFromID(FlagGatewaySendEventNameIdentify)
+// manage manages a Session's goroutines.
+func (s *Session) manage(bot *Client) {
+	s.manager.routines.Done()
+	defer func() {
+		s.Lock()
+		s.logClose("manager")
+		s.Unlock()
+	}()
 
-				if isNotEmpty(identifyBucket) {
-					if globalBucket != nil {
-						if globalBucket.Remaining == FlagGlobalRateLimitGateway {
-							globalBucket.Reset(time.Now().Add(time.Minute))
-						}
+	// wait until all of a Session's goroutines are closed.
+	err := s.manager.Wait()
+	s.Lock()
+	defer s.notify()
+	defer s.Unlock()
 
-						globalBucket.Remaining--
-					}
+	// log the reason for disconnection (if applicable).
+	if reason := s.manager.signal.Value(keyReason); reason != nil {
+		LogSession(Logger.Info(), s.ID).Msgf("%v", reason)
+	}
 
-					if identifyBucket != nil {
-						identifyBucket.Remaining--
-					}
+	// when a signal is provided, it indicates that the disconnection was purposeful.
+	signal := s.manager.signal.Value(keySignal)
+	switch signal {
+	case signalDisconnect:
+		LogSession(Logger.Info(), s.ID).Msg("successfully disconnected")
 
-					bot.Config.Gateway.RateLimiter.EndTx()
-					s.RateLimiter.EndTx()
+		s.manager.err <- nil
 
-					goto SEND
-				}
+		return
 
-				if isExpired(identifyBucket) {
-					if globalBucket != nil {
-						if globalBucket.Remaining == FlagGlobalRateLimitGateway {
-							globalBucket.Reset(time.Now().Add(time.Minute))
-						}
+	case signalReconnect:
+		LogSession(Logger.Info(), s.ID).Msg("successfully disconnected (while reconnecting)")
 
-						globalBucket.Remaining--
-					}
+		// allow Discord to close the session.
+		<-time.After(time.Second)
 
-					if identifyBucket != nil {
-						identifyBucket.Reset(time.Now().Add(FlagGlobalRateLimitIdentifyInterval))
-						identifyBucket.Remaining--
-					}
+		s.manager.err <- nil
 
-					bot.Config.Gateway.RateLimiter.EndTx()
-					s.RateLimiter.EndTx()
+		return
+	}
 
-					goto SEND
-				}
+	// when an error caused goroutines to close, manage the state of disconnection.
+	if err != nil {
+		disconnectErr := new(ErrorDisconnect)
+		closeErr := new(websocket.CloseError)
+		switch {
+		// when an error occurs from a purposeful disconnection.
+		case errors.As(err, disconnectErr):
 
-				var wait time.Time
-				if identifyBucket != nil {
-					wait = identifyBucket.Expiry
+		// when an error occurs from a WebSocket Close Error.
+		case errors.As(err, closeErr):
+			err = s.handleGatewayCloseError(closeErr)
+
+		default:
+			if cErr := s.Conn.Close(websocket.StatusCode(FlagClientCloseEventCodeAway), ""); cErr != nil {
+				err = ErrorDisconnect{
+					Action:     err,
+					Err:        cErr,
+					Connection: ErrConnectionSession,
 				}
+			}
+		}
 
-				// do NOT block other send events due to a Send Event Rate Limit.
-				bot.Config.Gateway.RateLimiter.EndTx()
-				s.RateLimiter.EndTx()
-				s.RateLimiter.Unlock()
+		// report the error that caused the disconnection.
+		if err != nil {
+			err = s.fail(err)
 
-				// reduce CPU usage by blocking the current goroutine
-				// until it's eligible for action.
-				if identifyBucket != nil {
-					<-time.After(time.Until(wait))
-				}
+			// a disconnection is NOT caused by an event, so the context has no event or payload.
+			ctx := &ErrorContext{
+				Shard:     s.Shard,
+				SessionID: s.ID,
+				Event:     "",
+				Payload:   nil,
+			}
 
-				goto RATELIMIT
+			go bot.report(err, ctx)
+		}
+	}
 
-			default:
-				if globalBucket != nil {
-					if globalBucket.Remaining == FlagGlobalRateLimitGateway {
-						globalBucket.Reset(time.Now().Add(time.Minute))
-					}
+	s.manager.err <- err
+}
 
-					globalBucket.Remaining--
-				}
+// handleGatewayCloseError handles a WebSocket CloseError.
+func (s *Session) handleGatewayCloseError(closeErr *websocket.CloseError) error {
+	code, ok := GatewayCloseEventCodes[int(closeErr.Code)]
+	switch ok {
+	// Gateway Close Event Code is known.
+	case true:
+		LogSession(Logger.Info(), s.ID).
+			Msgf("received Gateway Close Event Code %d %s: %s",
+				code.Code, code.Description, code.Explanation,
+			)
 
-				s.RateLimiter.EndTx()
+		if code.Reconnect {
+			s.reconnect(fmt.Sprintf("reconnecting due to Gateway Close Event Code %d", code.Code))
 
-				goto SEND
-			}
+			return nil
 		}
 
-		s.RateLimiter.EndTx()
-	}
+		return ErrorGatewayClose{
+			Err:       *closeErr,
+			Code:      code,
+			SessionID: s.ID,
+		}
 
-SEND:
-	s.RateLimiter.Unlock()
+	// Gateway Close Event Code is unknown.
+	default:
 
-	LogCommand(LogSession(Logger.Trace(), s.ID), bot.ApplicationID, op, name).Msg("sending gateway command")
+		// when another goroutine calls disconnect(),
+		// s.Conn.Close is called before s.cancel which will result in
+		// a CloseError with the close code that Disgo uses to reconnect.
+		if closeErr.Code == websocket.StatusCode(FlagClientCloseEventCodeReconnect) {
+			return nil
+		}
 
-	// write the event to the WebSocket Connection.
-	event, err := json.Marshal(dst)
-	if err != nil {
-		return fmt.Errorf("writeEvent: %w", err)
-	}
+		LogSession(Logger.Info(), s.ID).
+			Msgf("received unknown Gateway Close Event Code %d with reason %q",
+				closeErr.Code, closeErr.Reason,
+			)
 
-	if err = socket.Write(s.Context, s.Conn, websocket.MessageBinary,
-		GatewayPayload{ //nolint:exhaustruct
-			Op:   op,
-			Data: event,
-		}); err != nil {
-		return fmt.Errorf("writeEvent: %w", err)
+		return closeErr
 	}
-
-	LogCommand(LogSession(Logger.Trace(), s.ID), bot.ApplicationID, op, name).Msg("sent gateway command")
-
-	return nil
 }
 
-// SessionManager manages sessions.
-type SessionManager struct {
-	// Gateway represents a map of Discord Gateway (TCP WebSocket Connections) session IDs to Sessions.
-	// map[ID]Session (map[string]*Session)
-	Gateway *sync.Map
+const (
+	// SignalNone indicates that Wait() was called on an already disconnected session.
+	SignalNone = 0
 
-	// Voice represents a map of Discord Voice (UDP WebSocket Connection) session IDs to Sessions.
-	// map[ID]Session (map[string]*Session)
-	Voice *sync.Map
-}
+	// SignalDisconnect indicates that a disconnection was called purposefully.
+	SignalDisconnect = signalDisconnect
 
-// NewSessionManager creates a new SessionManager.
-func NewSessionManager() *SessionManager {
-	return &SessionManager{
-		Gateway: new(sync.Map),
-		Voice:   new(sync.Map),
-	}
-}
+	// SignalReconnect indicates that a disconnection was called purposefully in order to reconnect.
+	SignalReconnect = signalReconnect
 
-// SendEvent sends an Opcode 1 Heartbeat event to the Discord Gateway.
-func (c *Heartbeat) SendEvent(bot *Client, session *Session) error {
-	if err := writeEvent(bot, session, FlagGatewayOpcodeHeartbeat, FlagGatewaySendEventNameHeartbeat, c); err != nil {
-		return err
-	}
+	// SignalError indicates that a disconnection occurred as an error.
+	SignalError = 3
 
-	return nil
-}
+	// SignalDisconnectError indicates that a disconnection was called purposefully (for any reason),
+	// but the Session experienced an error while disconnecting.
+	SignalDisconnectError = 4
 
-// SendEvent sends an Opcode 2 Identify event to the Discord Gateway.
-func (c *Identify) SendEvent(bot *Client, session *Session) error {
-	if err := writeEvent(bot, session, FlagGatewayOpcodeIdentify, FlagGatewaySendEventNameIdentify, c); err != nil {
-		return err
+	// SignalUndefined indicates that a disconnection occurred in an undefined manner.
+	//
+	// This signal should NEVER be returned: If it is, report it.
+	SignalUndefined = 5
+)
+
+// Wait blocks until the calling Session has disconnected, then returns the reason
+// (disgo.SignalReason) for disconnecting and the disconnection error (if it exists).
+//
+// If Wait() is called on a Session that isn't connected, it will return immediately
+// with code SignalNone.
+//
+// It's NOT recommended to modify a Session after it has disconnected,
+// since it will be cleared and placed into a memory pool shortly after.
+func (s *Session) Wait() (int, error) {
+	if !s.isConnected() {
+		return SignalNone, nil
 	}
 
-	return nil
-}
+	// NOTE: Wait() is equivalent to the s.manage() s.manager.Wait() handling logic,
+	// but without the management of the disconnection state,
+	// and without the usage of a channel that tells another goroutine to unblock.
+	//
+	// wait until all of a Session's goroutines are closed.
+	err := s.manager.Wait()
+	s.Lock()
+	defer s.Unlock()
 
-// SendEvent sends an Opcode 3 UpdatePresence event to the Discord Gateway.
-func (c *GatewayPresenceUpdate) SendEvent(bot *Client, session *Session) error {
-	if err := writeEvent(bot, session, FlagGatewayOpcodePresenceUpdate, FlagGatewaySendEventNameUpdatePresence, c); err != nil {
-		return err
+	// when a signal is provided, it indicates that the disconnection was purposeful.
+	signal := s.manager.signal.Value(keySignal)
+	switch signal {
+	case signalDisconnect:
+		return SignalDisconnect, nil
+
+	case signalReconnect:
+		return SignalReconnect, nil
 	}
 
-	return nil
-}
+	// when an error caused goroutines to close.
+	if err != nil {
+		disconnectErr := new(ErrorDisconnect)
+		closeErr := new(websocket.CloseError)
+		switch {
+		// when an error occurs from a purposeful disconnection.
+		case errors.As(err, disconnectErr):
+			if signal != nil {
+				if signalValue, ok := signal.(int); ok {
+					return signalValue, err //nolint:wrapcheck
+				}
+			}
 
-// SendEvent sends an Opcode 4 UpdateVoiceState event to the Discord Gateway.
-func (c *VoiceStateUpdate) SendEvent(bot *Client, session *Session) error {
-	if err := writeEvent(bot, session, FlagGatewayOpcodeVoiceStateUpdate, FlagGatewaySendEventNameUpdateVoiceState, c); err != nil {
-		return err
-	}
+			return SignalDisconnectError, err //nolint:wrapcheck
 
-	return nil
-}
+		// when an error occurs from a WebSocket Close Error.
+		case errors.As(err, closeErr):
+			return SignalError, s.handleGatewayCloseError(closeErr)
+		}
 
-// SendEvent sends an Opcode 6 Resume event to the Discord Gateway.
-func (c *Resume) SendEvent(bot *Client, session *Session) error {
-	if err := writeEvent(bot, session, FlagGatewayOpcodeResume, FlagGatewaySendEventNameResume, c); err != nil {
-		return err
+		return SignalError, err //nolint:wrapcheck
 	}
 
-	return nil
+	return SignalUndefined, nil
 }
 
-// SendEvent sends an Opcode 8 RequestGuildMembers event to the Discord Gateway.
-func (c *RequestGuildMembers) SendEvent(bot *Client, session *Session) error {
-	if err := writeEvent(bot, session, FlagGatewayOpcodeRequestGuildMembers, FlagGatewaySendEventNameRequestGuildMembers, c); err != nil {
-		return err
-	}
+// GuildMembers represents the guild members that are sent in response to a RequestGuildMembers event.
+type GuildMembers struct {
+	// GuildID represents the ID of the guild.
+	GuildID string
 
-	return nil
-}
+	// Nonce represents the nonce that correlates the GuildMembersChunk events to the request.
+	Nonce string
 
-// heartbeat represents the heartbeat mechanism for a Session.
-type heartbeat struct {
-	ticker   *time.Ticker
-	send     chan Heartbeat
-	interval time.Duration
-	acks     uint32
-}
+	// Members represents the guild members of every GuildMembersChunk (in order of chunk_index).
+	Members []*GuildMember
 
-// Monitor returns the current amount of HeartbeatACKs for a Session's heartbeat.
-func (s *Session) Monitor() uint32 {
-	s.Lock()
-	acks := atomic.LoadUint32(&s.heartbeat.acks)
-	s.Unlock()
+	// Presences represents the presences of the guild members (when presences are requested).
+	Presences []*PresenceUpdate
 
-	return acks
+	// NotFound represents the requested user IDs that were NOT found.
+	NotFound []string
 }
 
-// beat listens for pulses to send Opcode 1 Heartbeats to the Discord Gateway (to verify the connection is alive).
-func (s *Session) beat(bot *Client) error {
-	s.manager.routines.Done()
-
-	// ensure that all pulse routines are closed prior to closing.
-	defer func() {
-		for {
-			select {
-			case <-s.heartbeat.send:
-			case <-s.Context.Done():
-				if atomic.LoadInt32(&s.manager.pulses) != 0 {
-					break
-				}
+const (
+	// nonceLength represents the amount of random bytes used to generate a nonce.
+	//
+	// A nonce sent in a RequestGuildMembers event can be up to 32 bytes (hex encoded).
+	nonceLength = 16
+)
 
-				s.logClose("heartbeat")
+// Request sends an Opcode 8 RequestGuildMembers event to the Discord Gateway
+// using the bot's session for the guild, then returns the guild members
+// from every GuildMembersChunk event that Discord sends in response.
+//
+// A nonce is generated when the RequestGuildMembers event has no nonce (without modifying the event).
+// The request is cancelled (with the context's error) when the context is done.
+func (c *RequestGuildMembers) Request(ctx context.Context, bot *Client) (*GuildMembers, error) {
+	session, err := bot.GuildSession(c.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("request guild members: %w", err)
+	}
 
-				return
-			}
+	// the nonce is generated for a copy of the request, such that the given request is NOT modified.
+	request := *c
+	if request.Nonce == nil {
+		nonce := make([]byte, nonceLength)
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, fmt.Errorf("request guild members: nonce: %w", err)
 		}
-	}()
 
-	for {
-		select {
-		case hb := <-s.heartbeat.send:
-			s.Lock()
+		request.Nonce = Pointer(hex.EncodeToString(nonce))
+	}
 
-			// close the connection if the last sent Heartbeat never received a HeartbeatACK.
-			if atomic.LoadUint32(&s.heartbeat.acks) == 0 {
-				s.Unlock()
+	var mu sync.Mutex
 
-				s.reconnect("attempting to reconnect session due to no HeartbeatACK")
+	nonce := *request.Nonce
+	received := make(chan struct{})
 
-				return nil
-			}
+	var chunks []*GuildMembersChunk
+	var count int
 
-			// prevent two Heartbeat Payloads being sent to the Discord Gateway consecutively within nanoseconds,
-			// when the ticker queues a Heartbeat while the listen thread (onPayload) queues a Heartbeat
-			// (in response to the Discord Gateway).
-			//
-			// clear queued (outdated) heartbeats.
-			for len(s.heartbeat.send) > 0 {
-				// ensure the latest sequence is sent.
-				if h := <-s.heartbeat.send; h.Data > hb.Data {
-					hb.Data = h.Data
-				}
-			}
+	// add the event handler prior to sending the request, such that no chunk is missed.
+	sub := Listen(bot, func(chunk *GuildMembersChunk) {
+		if chunk.Nonce == nil || *chunk.Nonce != nonce {
+			return
+		}
 
-			// send a Heartbeat to the Discord Gateway (WebSocket Connection).
-			if err := hb.SendEvent(bot, s); err != nil {
-				s.Unlock()
+		mu.Lock()
+		defer mu.Unlock()
 
-				return err
-			}
+		if chunks == nil {
+			// a chunk without a chunk count is the only chunk.
+			if chunk.ChunkCount <= 0 {
+				chunks = []*GuildMembersChunk{chunk}
+				close(received)
 
-			// reset the ticker (and empty existing ticks).
-			s.heartbeat.ticker.Reset(s.heartbeat.interval)
-			for len(s.heartbeat.ticker.C) > 0 {
-				<-s.heartbeat.ticker.C
+				return
 			}
 
-			// reset the amount of HeartbeatACKs since the last heartbeat.
-			atomic.StoreUint32(&s.heartbeat.acks, 0)
-
-			LogSession(Logger.Info(), s.ID).Msg("sent heartbeat")
-
-			s.Unlock()
+			chunks = make([]*GuildMembersChunk, chunk.ChunkCount)
+		}
 
-		case <-s.Context.Done():
-			return nil
+		if chunk.ChunkIndex < 0 || chunk.ChunkIndex >= len(chunks) || chunks[chunk.ChunkIndex] != nil {
+			return
 		}
-	}
-}
 
-// pulse generates Opcode 1 Heartbeats for a Session's heartbeat channel.
-func (s *Session) pulse() {
-	s.manager.routines.Done()
-	defer s.decrementPulses()
+		chunks[chunk.ChunkIndex] = chunk
 
-	// send an Opcode 1 Heartbeat payload after heartbeat_interval * jitter milliseconds
-	// (where jitter is a random value between 0 and 1).
-	s.Lock()
-	s.heartbeat.send <- Heartbeat{Data: atomic.LoadInt64(&s.Seq)}
-	LogSession(Logger.Info(), s.ID).Msg("queued jitter heartbeat")
-	s.Unlock()
+		if count++; count == len(chunks) {
+			close(received)
+		}
+	})
 
-	for {
-		select {
-		// every Heartbeat Interval...
-		case <-s.heartbeat.ticker.C:
-			s.Lock()
+	defer sub.Cancel()
 
-			// queue a heartbeat.
-			s.heartbeat.send <- Heartbeat{Data: atomic.LoadInt64(&s.Seq)}
+	if err := request.SendEvent(bot, session); err != nil {
+		return nil, fmt.Errorf("request guild members: %w", err)
+	}
 
-			LogSession(Logger.Info(), s.ID).Msg("queued heartbeat")
+	select {
+	case <-received:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("request guild members: %w", ctx.Err())
+	}
 
-			s.Unlock()
+	mu.Lock()
+	defer mu.Unlock()
 
-		case <-s.Context.Done():
-			s.Lock()
-			s.logClose("pulse")
-			s.Unlock()
+	members := &GuildMembers{ //nolint:exhaustruct
+		GuildID: c.GuildID,
+		Nonce:   nonce,
+	}
 
-			return
-		}
+	for _, chunk := range chunks {
+		members.Members = append(members.Members, chunk.Members...)
+		members.Presences = append(members.Presences, chunk.Presences...)
+		members.NotFound = append(members.NotFound, chunk.NotFound...)
 	}
+
+	return members, nil
 }
 
-// respond responds to Opcode 1 Heartbeats from the Discord Gateway.
-func (s *Session) respond(data json.RawMessage) error {
-	defer s.decrementPulses()
+// Session Statuses
+const (
+	// SessionStatusDisconnected indicates that the Session is NOT connected to the Discord Gateway.
+	SessionStatusDisconnected = 0
 
-	var heartbeat Heartbeat
-	if err := json.Unmarshal(data, &heartbeat); err != nil {
-		return fmt.Errorf("error unmarshalling incoming Heartbeat: %w", err)
-	}
+	// SessionStatusConnecting indicates that the Session is connecting to the Discord Gateway.
+	SessionStatusConnecting = 1
 
-	atomic.StoreInt64(&s.Seq, heartbeat.Data)
+	// SessionStatusIdentifying indicates that the Session sent an Identify and awaits a Ready event.
+	SessionStatusIdentifying = 2
 
-	s.Lock()
+	// SessionStatusResuming indicates that the Session sent a Resume and awaits a Resumed event.
+	SessionStatusResuming = 3
 
-	// ensure that the heartbeat routine has not been closed.
-	if atomic.LoadInt32(&s.manager.pulses) <= 1 {
-		s.Unlock()
+	// SessionStatusReady indicates that the Session is connected and receiving events.
+	SessionStatusReady = 4
 
-		return nil
-	}
+	// SessionStatusReconnecting indicates that the Session is disconnecting in order to reconnect.
+	SessionStatusReconnecting = 5
 
-	// heartbeat() checks for the amount of HeartbeatACKs received since the last Heartbeat.
-	// There is a possibility for this value to be 0 due to latency rather than a dead connection.
-	// For example, when a Heartbeat is queued, sent, responded, and sent.
-	//
-	// Prevent this possibility by treating this response from Discord as an indication that the
-	// connection is still alive.
-	atomic.AddUint32(&s.heartbeat.acks, 1)
+	// SessionStatusFailed indicates that the Session was disconnected with an error
+	// that does NOT allow the Session to reconnect (i.e., an invalid token or intents).
+	SessionStatusFailed = 6
+)
 
-	// send an Opcode 1 Heartbeat without waiting the remainder of the current interval.
-	s.heartbeat.send <- heartbeat
+// SessionStatuses represents a map of Session Statuses to their names.
+var SessionStatuses = map[int]string{
+	SessionStatusDisconnected: "disconnected",
+	SessionStatusConnecting:   "connecting",
+	SessionStatusIdentifying:  "identifying",
+	SessionStatusResuming:     "resuming",
+	SessionStatusReady:        "ready",
+	SessionStatusReconnecting: "reconnecting",
+	SessionStatusFailed:       "failed",
+}
 
-	LogSession(Logger.Info(), s.ID).Msg("responded to heartbeat")
+// SessionTransition represents a transition of a Session from one status to another.
+type SessionTransition struct {
+	// Err represents the error that caused the transition (if applicable).
+	//
+	// Err is an ErrorGatewayClose when the Session transitions to SessionStatusFailed
+	// due to a Gateway Close Event Code.
+	Err error
 
-	s.Unlock()
+	// Reason represents the reason for the transition.
+	Reason string
 
-	return nil
-}
+	// From represents the status of the Session before the transition.
+	From int
 
-// listen listens to the connection for payloads from the Discord Gateway.
-func (s *Session) listen(bot *Client) error {
-	s.manager.routines.Done()
+	// To represents the status of the Session after the transition.
+	To int
 
-	var err error
+	// Code represents the WebSocket close code of the connection (if applicable).
+	Code int
 
-	for {
-		payload := getPayload()
-		if err = socket.Read(s.Context, s.Conn, payload); err != nil {
-			break
-		}
+	// Attempt represents the amount of times the Session attempted to connect
+	// since it was last ready.
+	Attempt int
+}
 
-		LogPayload(LogSession(Logger.Info(), s.ID), payload.Op, payload.Data).Msg("received payload")
+// Status returns the status of the Session (i.e., SessionStatusReady).
+func (s *Session) Status() int {
+	return int(atomic.LoadInt32(&s.status))
+}
 
-		if err = s.onPayload(bot, *payload); err != nil {
-			break
-		}
+// connecting determines whether the Session is connecting to the Discord Gateway.
+func (s *Session) connecting() bool {
+	switch s.Status() {
+	case SessionStatusConnecting, SessionStatusIdentifying, SessionStatusResuming:
+		return true
 	}
 
-	s.Lock()
-	defer s.Unlock()
-	defer s.logClose("listen")
+	return false
+}
 
-	select {
-	case <-s.Context.Done():
-		return nil
+// transitions represents the transitions of a Session that are queued for its status handler.
+type transitions struct {
+	// queue represents the calls to the status handler that are NOT delivered yet.
+	queue []func()
 
-	default:
-		return err
-	}
+	// Mutex is used to protect the queue from data races.
+	sync.Mutex
+
+	// delivering indicates whether a goroutine is delivering the queue.
+	delivering bool
 }
 
-// onPayload handles an Discord Gateway Payload.
-func (s *Session) onPayload(bot *Client, payload GatewayPayload) error {
-	defer putPayload(&payload)
+// transition transitions the Session to the given status, then queues a call to its status handler (if applicable).
+//
+// The status handler is called by notify() once the Session is unlocked.
+func (s *Session) transition(status int, reason string, code int, err error) {
+	from := atomic.SwapInt32(&s.status, int32(status))
 
-	// https://discord.com/developers/docs/topics/opcodes-and-status-codes#gateway-gateway-opcodes
-	switch payload.Op {
-	// run the bot's event handlers.
-	case FlagGatewayOpcodeDispatch:
-		atomic.StoreInt64(&s.Seq, *payload.SequenceNumber)
-		go bot.handle(*payload.EventName, payload.Data)
+	switch status {
+	case SessionStatusConnecting:
+		atomic.AddInt32(&s.attempts, 1)
 
-	// send an Opcode 1 Heartbeat to the Discord Gateway.
-	case FlagGatewayOpcodeHeartbeat:
-		s.Lock()
-		atomic.AddInt32(&s.manager.pulses, 1)
-		s.Unlock()
+	case SessionStatusReady:
+		defer atomic.StoreInt32(&s.attempts, 0)
+	}
 
-		s.manager.Go(func() error {
-			if err := s.respond(payload.Data); err != nil {
-				return fmt.Errorf("respond: %w", err)
-			}
+	LogSession(Logger.Info(), s.ID).Msgf("session is %s: %s", SessionStatuses[status], reason)
 
-			return nil
-		})
+	if s.statusHandler == nil {
+		return
+	}
 
-	// handle the successful acknowledgement of the client's last heartbeat.
-	case FlagGatewayOpcodeHeartbeatACK:
-		s.Lock()
-		atomic.AddUint32(&s.heartbeat.acks, 1)
-		s.Unlock()
+	handler := s.statusHandler
+	transition := &SessionTransition{
+		Err:     err,
+		Reason:  reason,
+		From:    int(from),
+		To:      status,
+		Code:    code,
+		Attempt: int(atomic.LoadInt32(&s.attempts)),
+	}
 
-	// occurs when the Discord Gateway is shutting down the connection, while signalling the client to reconnect.
-	case FlagGatewayOpcodeReconnect:
-		s.reconnect("reconnecting session due to Opcode 7 Reconnect")
+	s.transitions.Lock()
+	s.transitions.queue = append(s.transitions.queue, func() { handler(s, transition) })
+	s.transitions.Unlock()
+}
 
-		return nil
+// notify calls the Session's status handler with its queued transitions (in order).
+//
+// notify is called without holding the Session's lock, such that a status handler can use the Session.
+// When another goroutine is delivering transitions, it delivers the queued transitions instead.
+func (s *Session) notify() {
+	s.transitions.Lock()
+	defer s.transitions.Unlock()
 
-	// in the context of onPayload, an Invalid Session occurs when an active session is invalidated.
-	case FlagGatewayOpcodeInvalidSession:
-		// Remove the session from the session manager.
-		s.client_manager.Gateway.Store(s.ID, nil)
+	if s.transitions.delivering {
+		return
+	}
 
-		// wait for Discord to close the session, then complete a fresh connect.
-		<-time.NewTimer(invalidSessionWaitTime).C
+	s.transitions.delivering = true
+	defer func() { s.transitions.delivering = false }()
 
-		s.Lock()
-		defer s.Unlock()
+	for len(s.transitions.queue) != 0 {
+		queue := s.transitions.queue
+		s.transitions.queue = nil
 
-		if err := s.initial(bot, 0); err != nil {
-			return err
+		s.transitions.Unlock()
+
+		for _, call := range queue {
+			call()
 		}
-	}
 
-	return nil
+		s.transitions.Lock()
+	}
 }
 
-// signal represents a manager Context Signal.
-type signal string
+// fail transitions the Session to SessionStatusDisconnected or SessionStatusFailed
+// (when the error does NOT allow the Session to reconnect) using the given error,
+// then returns the error.
+func (s *Session) fail(err error) error {
+	code := 0
+	status := SessionStatusDisconnected
 
-// manager Context Signals.
-const (
-	// keySignal represents the Context key for a manager's signals.
-	keySignal = signal("signal")
+	// a Session error contains the error that occurred during the session.
+	cause := err
+	if sessionErr := new(ErrorSession); errors.As(err, sessionErr) {
+		cause = sessionErr.Err
+	}
 
-	// keyReason represents the Context key for a manager's reason for disconnection.
-	keyReason = signal("reason")
+	// a Disconnect error contains the error that prompted the disconnection (i.e., a close frame).
+	if disconnectErr := new(ErrorDisconnect); errors.As(cause, disconnectErr) && disconnectErr.Action != nil {
+		cause = disconnectErr.Action
+	}
 
-	// signalDisconnect indicates that a disconnection was called purposefully.
-	signalDisconnect = 1
+	gatewayCloseErr := new(ErrorGatewayClose)
+	closeErr := new(websocket.CloseError)
+	switch {
+	case errors.As(cause, gatewayCloseErr):
+		code = gatewayCloseErr.Code.Code
+		status = SessionStatusFailed
+
+	case errors.As(cause, closeErr):
+		code = int(closeErr.Code)
+
+		// surface a Gateway Close Event Code that doesn't allow reconnection as a terminal error.
+		if closeCode, ok := GatewayCloseEventCodes[code]; ok && !closeCode.Reconnect {
+			err = ErrorGatewayClose{
+				Err:       err,
+				Code:      closeCode,
+				SessionID: s.ID,
+			}
 
-	// signalReconnect signals the manager to reconnect upon a successful disconnection.
-	signalReconnect = 2
-)
+			status = SessionStatusFailed
+		}
+	}
 
-// manager represents a manager of a Session's goroutines.
-type manager struct {
-	signal context.Context
-	cancel context.CancelFunc
-	err    chan error
-	*errgroup.Group
-	routines sync.WaitGroup
-	pulses   int32
+	s.transition(status, err.Error(), code, err)
+
+	return err
 }
 
-// decrementPulses safely decrements the pulses counter of a Session manager.
-func (s *Session) decrementPulses() {
-	s.Lock()
-	defer s.Unlock()
+// SessionState represents a serializable snapshot of the state required to resume a Session.
+//
+// https://discord.com/developers/docs/topics/gateway#resuming
+type SessionState struct {
+	// Shard represents the [shard_id, num_shards] for the Session.
+	Shard *[2]int `json:"shard,omitempty"`
 
-	atomic.AddInt32(&s.manager.pulses, -1)
-}
+	// ID represents the session ID of the Session.
+	ID string `json:"id"`
 
-// logClose safely logs the close of a Session's goroutine.
-func (s *Session) logClose(routine string) {
-	LogSession(Logger.Info(), s.ID).Msgf("closed %s routine", routine)
+	// Endpoint represents the endpoint that is used to reconnect to the Gateway.
+	Endpoint string `json:"endpoint"`
+
+	// Seq represents the last sequence number received by the client.
+	Seq int64 `json:"seq"`
 }
 
-// reconnect spawns a goroutine for reconnection which prompts the manager
-// to reconnect upon a disconnection.
-func (s *Session) reconnect(reason string) {
-	s.manager.Go(func() error {
-		s.Lock()
-		defer s.logClose("reconnect")
-		defer s.Unlock()
+// canResume determines whether the state contains the fields required to resume a Session.
+func (state *SessionState) canResume() bool {
+	return state != nil && state.ID != "" && state.Endpoint != "" && state.Seq != 0
+}
 
-		LogSession(Logger.Info(), s.ID).Msg(reason)
+// SessionStore represents an interface for the persistence of Session State.
+//
+// SessionStore allows a bot to resume its sessions across process restarts,
+// instead of identifying a new session (which uses the Identify Rate Limit).
+type SessionStore interface {
+	// Save saves the state of a Session.
+	Save(state *SessionState) error
 
-		s.manager.signal = context.WithValue(s.manager.signal, keySignal, signalReconnect)
-		if err := s.disconnect(FlagClientCloseEventCodeReconnect); err != nil {
-			return fmt.Errorf("reconnect: %w", err)
-		}
+	// Load loads the state of the Session with the given shard.
+	//
+	// Load returns nil (without an error) when no state is stored.
+	Load(shard *[2]int) (*SessionState, error)
 
-		return nil
-	})
+	// Delete deletes the state of the Session with the given shard.
+	Delete(shard *[2]int) error
 }
 
-// manage manages a Session's goroutines.
-func (s *Session) manage() {
-	s.manager.routines.Done()
-	defer func() {
-		s.Lock()
-		s.logClose("manager")
-		s.Unlock()
-	}()
+// State returns a snapshot of the Session's current state.
+func (s *Session) State() *SessionState {
+	state := &SessionState{
+		Shard:    nil,
+		ID:       s.ID,
+		Endpoint: s.Endpoint,
+		Seq:      atomic.LoadInt64(&s.Seq),
+	}
 
-	// wait until all of a Session's goroutines are closed.
-	err := s.manager.Wait()
+	if s.Shard != nil {
+		shard := *s.Shard
+		state.Shard = &shard
+	}
+
+	return state
+}
+
+// Restore sets the Session's state to the given state.
+//
+// Restore must be called prior to Connect() in order to resume a session.
+func (s *Session) Restore(state *SessionState) {
 	s.Lock()
 	defer s.Unlock()
 
-	// log the reason for disconnection (if applicable).
-	if reason := s.manager.signal.Value(keyReason); reason != nil {
-		LogSession(Logger.Info(), s.ID).Msgf("%v", reason)
-	}
+	s.restore(state)
+}
 
-	// when a signal is provided, it indicates that the disconnection was purposeful.
-	signal := s.manager.signal.Value(keySignal)
-	switch signal {
-	case signalDisconnect:
-		LogSession(Logger.Info(), s.ID).Msg("successfully disconnected")
+// restore sets the Session's state to the given state.
+func (s *Session) restore(state *SessionState) {
+	s.ID = state.ID
+	atomic.StoreInt64(&s.Seq, state.Seq)
+	s.Endpoint = state.Endpoint
 
-		s.manager.err <- nil
+	if state.Shard != nil {
+		shard := *state.Shard
+		s.Shard = &shard
+	}
+}
 
+// load restores the Session's state from its SessionStore (if applicable).
+func (s *Session) load() {
+	if s.store == nil || s.canReconnect() {
 		return
+	}
 
-	case signalReconnect:
-		LogSession(Logger.Info(), s.ID).Msg("successfully disconnected (while reconnecting)")
-
-		// allow Discord to close the session.
-		<-time.After(time.Second)
+	state, err := s.store.Load(s.Shard)
+	if err != nil {
+		LogSession(Logger.Error(), s.ID).Err(fmt.Errorf("sessionstore: load: %w", err)).Msg("")
 
-		s.manager.err <- nil
+		return
+	}
 
+	if !state.canResume() {
 		return
 	}
 
-	// when an error caused goroutines to close, manage the state of disconnection.
-	if err != nil {
-		disconnectErr := new(ErrorDisconnect)
-		closeErr := new(websocket.CloseError)
-		switch {
-		// when an error occurs from a purposeful disconnection.
-		case errors.As(err, disconnectErr):
-			s.manager.err <- err
+	s.restore(state)
 
-		// when an error occurs from a WebSocket Close Error.
-		case errors.As(err, closeErr):
-			s.manager.err <- s.handleGatewayCloseError(closeErr)
+	LogSession(Logger.Info(), s.ID).Msg("restored session state")
+}
 
-		default:
-			if cErr := s.Conn.Close(websocket.StatusCode(FlagClientCloseEventCodeAway), ""); cErr != nil {
-				s.manager.err <- ErrorDisconnect{
-					Action:     err,
-					Err:        cErr,
-					Connection: ErrConnectionSession,
-				}
+// snapshot returns the state of the Session that is saved to its SessionStore
+// (or nil when the state is NOT saved).
+func (s *Session) snapshot() *SessionState {
+	if s.store == nil || !s.canReconnect() {
+		return nil
+	}
 
-				return
-			}
+	return s.State()
+}
 
-			s.manager.err <- err
-		}
+// save saves the Session's state to its SessionStore (if applicable).
+func (s *Session) save() {
+	s.persist(s.snapshot())
+}
 
+// persist saves a snapshot of the Session's state to its SessionStore (if applicable).
+//
+// persist does NOT require the Session to be locked, such that the SessionStore's I/O
+// does NOT block the Session.
+func (s *Session) persist(state *SessionState) {
+	if state == nil {
 		return
 	}
 
-	s.manager.err <- nil
+	if err := s.store.Save(state); err != nil {
+		LogSession(Logger.Error(), state.ID).Err(fmt.Errorf("sessionstore: save: %w", err)).Msg("")
+	}
 }
 
-// handleGatewayCloseError handles a WebSocket CloseError.
-func (s *Session) handleGatewayCloseError(closeErr *websocket.CloseError) error {
-	code, ok := GatewayCloseEventCodes[int(closeErr.Code)]
-	switch ok {
-	// Gateway Close Event Code is known.
-	case true:
-		LogSession(Logger.Info(), s.ID).
-			Msgf("received Gateway Close Event Code %d %s: %s",
-				code.Code, code.Description, code.Explanation,
-			)
+// forget deletes the Session's state from its SessionStore (if applicable).
+func (s *Session) forget() {
+	if s.store == nil {
+		return
+	}
 
-		if code.Reconnect {
-			s.reconnect(fmt.Sprintf("reconnecting due to Gateway Close Event Code %d", code.Code))
+	if err := s.store.Delete(s.Shard); err != nil {
+		LogSession(Logger.Error(), s.ID).Err(fmt.Errorf("sessionstore: delete: %w", err)).Msg("")
+	}
+}
 
-			return nil
-		}
+// sessionStateKey returns the key used to store the state of a Session with the given shard.
+func sessionStateKey(shard *[2]int) string {
+	if shard == nil {
+		return "0"
+	}
 
-		return closeErr
+	return strconv.Itoa(shard[0]) + "/" + strconv.Itoa(shard[1])
+}
 
-	// Gateway Close Event Code is unknown.
-	default:
+const (
+	// filemodeSessionStore represents the file mode of a FileSessionStore file.
+	filemodeSessionStore = 0o600
+)
 
-		// when another goroutine calls disconnect(),
-		// s.Conn.Close is called before s.cancel which will result in
-		// a CloseError with the close code that Disgo uses to reconnect.
-		if closeErr.Code == websocket.StatusCode(FlagClientCloseEventCodeReconnect) {
-			return nil
-		}
+// FileSessionStore represents a SessionStore which stores Session State in a JSON file.
+type FileSessionStore struct {
+	// Path represents the path of the file.
+	Path string
 
-		LogSession(Logger.Info(), s.ID).
-			Msgf("received unknown Gateway Close Event Code %d with reason %q",
-				closeErr.Code, closeErr.Reason,
-			)
+	// mu protects the file from concurrent reads and writes.
+	mu sync.Mutex
+}
 
-		return closeErr
+// NewFileSessionStore creates a new FileSessionStore using the file at the given path.
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{ //nolint:exhaustruct
+		Path: path,
 	}
 }
 
-const (
-	// SignalNone indicates that Wait() was called on an already disconnected session.
-	SignalNone = 0
+// Save saves the state of a Session to the file.
+func (fs *FileSessionStore) Save(state *SessionState) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
 
-	// SignalDisconnect indicates that a disconnection was called purposefully.
-	SignalDisconnect = signalDisconnect
+	states, err := fs.read()
+	if err != nil {
+		return err
+	}
 
-	// SignalReconnect indicates that a disconnection was called purposefully in order to reconnect.
-	SignalReconnect = signalReconnect
+	states[sessionStateKey(state.Shard)] = state
 
-	// SignalError indicates that a disconnection occurred as an error.
-	SignalError = 3
+	return fs.write(states)
+}
 
-	// SignalDisconnectError indicates that a disconnection was called purposefully (for any reason),
-	// but the Session experienced an error while disconnecting.
-	SignalDisconnectError = 4
+// Load loads the state of the Session with the given shard from the file.
+func (fs *FileSessionStore) Load(shard *[2]int) (*SessionState, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
 
-	// SignalUndefined indicates that a disconnection occurred in an undefined manner.
-	//
-	// This signal should NEVER be returned: If it is, report it.
-	SignalUndefined = 5
-)
+	states, err := fs.read()
+	if err != nil {
+		return nil, err
+	}
 
-// Wait blocks until the calling Session has disconnected, then returns the reason
-// (disgo.SignalReason) for disconnecting and the disconnection error (if it exists).
-//
-// If Wait() is called on a Session that isn't connected, it will return immediately
-// with code SignalNone.
-//
-// It's NOT recommended to modify a Session after it has disconnected,
-// since it will be cleared and placed into a memory pool shortly after.
-func (s *Session) Wait() (int, error) {
-	if !s.isConnected() {
-		return SignalNone, nil
+	return states[sessionStateKey(shard)], nil
+}
+
+// Delete deletes the state of the Session with the given shard from the file.
+func (fs *FileSessionStore) Delete(shard *[2]int) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	states, err := fs.read()
+	if err != nil {
+		return err
 	}
 
-	// NOTE: Wait() is equivalent to the s.manage() s.manager.Wait() handling logic,
-	// but without the management of the disconnection state,
-	// and without the usage of a channel that tells another goroutine to unblock.
-	//
-	// wait until all of a Session's goroutines are closed.
-	err := s.manager.Wait()
-	s.Lock()
-	defer s.Unlock()
+	key := sessionStateKey(shard)
+	if _, ok := states[key]; !ok {
+		return nil
+	}
 
-	// when a signal is provided, it indicates that the disconnection was purposeful.
-	signal := s.manager.signal.Value(keySignal)
-	switch signal {
-	case signalDisconnect:
-		return SignalDisconnect, nil
+	delete(states, key)
+
+	return fs.write(states)
+}
+
+// read reads the Session States from the file.
+func (fs *FileSessionStore) read() (map[string]*SessionState, error) {
+	states := make(map[string]*SessionState)
+
+	data, err := os.ReadFile(fs.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return states, nil
+		}
+
+		return nil, fmt.Errorf("error reading session store file: %w", err)
+	}
+
+	if len(data) == 0 {
+		return states, nil
+	}
+
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, fmt.Errorf(errUnmarshal, states, err)
+	}
+
+	return states, nil
+}
 
-	case signalReconnect:
-		return SignalReconnect, nil
+// write writes the Session States to the file.
+//
+// The file is replaced atomically to prevent a partial write from corrupting the stored state.
+func (fs *FileSessionStore) write(states map[string]*SessionState) error {
+	data, err := json.Marshal(states)
+	if err != nil {
+		return fmt.Errorf("error marshalling session states: %w", err)
 	}
 
-	// when an error caused goroutines to close.
+	tmp, err := os.CreateTemp(filepath.Dir(fs.Path), filepath.Base(fs.Path)+".*.tmp")
 	if err != nil {
-		disconnectErr := new(ErrorDisconnect)
-		closeErr := new(websocket.CloseError)
-		switch {
-		// when an error occurs from a purposeful disconnection.
-		case errors.As(err, disconnectErr):
-			if signal != nil {
-				if signalValue, ok := signal.(int); ok {
-					return signalValue, err //nolint:wrapcheck
-				}
-			}
+		return fmt.Errorf("error creating session store file: %w", err)
+	}
 
-			return SignalDisconnectError, err //nolint:wrapcheck
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
 
-		// when an error occurs from a WebSocket Close Error.
-		case errors.As(err, closeErr):
-			return SignalError, s.handleGatewayCloseError(closeErr)
-		}
+		return fmt.Errorf("error writing session store file: %w", err)
+	}
 
-		return SignalError, err //nolint:wrapcheck
+	if err := tmp.Chmod(filemodeSessionStore); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("error writing session store file: %w", err)
 	}
 
-	return SignalUndefined, nil
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("error writing session store file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fs.Path); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("error replacing session store file: %w", err)
+	}
+
+	return nil
 }
 
 // ShardManager represents an interface for Shard Management.
@@ -18874,6 +24476,10 @@ type ShardManager interface {
 	// GetSessions gets the connected sessions of the bot (in order of connection).
 	GetSessions() []*Session
 
+	// Latency returns the average heartbeat latency of the shard manager's sessions
+	// (that have measured their latency).
+	Latency() time.Duration
+
 	// Ready is called when a Session receives a ready event.
 	//
 	// Called from the session.go initial() function (at L#304 in /wrapper/session.go).
@@ -18989,3 +24595,505 @@ func (c *RequestGuildMembers) SendEvents(bot *Client, sm ShardManager) error {
 
 	return nil
 }
+
+// GuildShard returns the shard_id of the shard that receives the events of the given guild
+// when a bot uses the given num_shards.
+//
+// https://discord.com/developers/docs/topics/gateway#sharding-sharding-formula
+func GuildShard(guildID string, shards int) (int, error) {
+	id, err := ParseSnowflake(guildID)
+	if err != nil {
+		return 0, fmt.Errorf("guild ID: %w", err)
+	}
+
+	return id.Shard(shards), nil
+}
+
+// GuildSession returns the bot's connected session that receives the events of the given guild.
+//
+// The sessions of the bot's ShardManager are used when it's set.
+// An ErrorShard is returned when the shard of the guild is NOT connected in this process,
+// while an ErrorNoSession is returned when the bot has no connected session.
+func (bot *Client) GuildSession(guildID string) (*Session, error) {
+	var sessions []*Session
+	if bot.Config.Gateway.ShardManager != nil {
+		sessions = bot.Config.Gateway.ShardManager.GetSessions()
+	} else {
+		bot.Sessions.Gateway.Range(func(_, value any) bool {
+			if s, ok := value.(*Session); ok && s != nil {
+				sessions = append(sessions, s)
+			}
+
+			return true
+		})
+	}
+
+	if len(sessions) == 0 {
+		return nil, ErrorNoSession{GuildID: guildID}
+	}
+
+	routeErr := ErrorShard{GuildID: guildID, Shard: 0, Shards: 0}
+
+	for _, s := range sessions {
+		s.RLock()
+		shard := s.Shard
+		s.RUnlock()
+
+		// a session without a shard receives the events of every guild.
+		if shard == nil {
+			return s, nil
+		}
+
+		id, err := GuildShard(guildID, shard[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if id == shard[0] {
+			return s, nil
+		}
+
+		routeErr.Shard, routeErr.Shards = id, shard[1]
+	}
+
+	return nil, routeErr
+}
+
+// SendEventGuild sends an Opcode 4 UpdateVoiceState event to the Discord Gateway
+// using the bot's session for the guild.
+func (c *VoiceStateUpdate) SendEventGuild(bot *Client) error {
+	if c.VoiceState == nil || c.GuildID == nil {
+		return errors.New("voice state update: guild ID is required to route the send event")
+	}
+
+	session, err := bot.GuildSession(*c.GuildID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendEvent(bot, session)
+}
+
+// SendEventGuild sends an Opcode 8 RequestGuildMembers event to the Discord Gateway
+// using the bot's session for the guild.
+func (c *RequestGuildMembers) SendEventGuild(bot *Client) error {
+	session, err := bot.GuildSession(c.GuildID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendEvent(bot, session)
+}
+
+// Snowflake represents a Discord API Snowflake, which is a unique ID that contains the time it was created at.
+//
+// https://discord.com/developers/docs/reference#snowflakes
+type Snowflake uint64
+
+// Snowflake Structure
+// https://discord.com/developers/docs/reference#snowflakes-snowflake-id-format-structure-left-to-right
+const (
+	// DiscordEpoch represents the first millisecond of 2015 (in Unix milliseconds),
+	// which is the epoch of a Snowflake's timestamp.
+	DiscordEpoch = 1420070400000
+
+	snowflakeTimestampShift = 22
+	snowflakeWorkerShift    = 17
+	snowflakeProcessShift   = 12
+	snowflakeWorkerMask     = 0x3E0000
+	snowflakeProcessMask    = 0x1F000
+	snowflakeIncrementMask  = 0xFFF
+)
+
+// ParseSnowflake parses a Snowflake from a string.
+func ParseSnowflake(id string) (Snowflake, error) {
+	s, err := strconv.ParseUint(id, base10, bit64)
+	if err != nil {
+		return 0, fmt.Errorf("snowflake %q: %w", id, err)
+	}
+
+	return Snowflake(s), nil
+}
+
+// SnowflakeFromTime returns the first Snowflake that can be created at the given time,
+// which is used to paginate by time (i.e., using the before or after parameter of a request).
+func SnowflakeFromTime(t time.Time) Snowflake {
+	ms := t.UnixMilli() - DiscordEpoch
+	if ms < 0 {
+		return 0
+	}
+
+	return Snowflake(ms) << snowflakeTimestampShift
+}
+
+// String returns the string representation of a Snowflake.
+func (s Snowflake) String() string {
+	return strconv.FormatUint(uint64(s), base10)
+}
+
+// Timestamp returns the time a Snowflake was created at (in Unix milliseconds).
+func (s Snowflake) Timestamp() int64 {
+	return int64(s>>snowflakeTimestampShift) + DiscordEpoch
+}
+
+// Time returns the time a Snowflake was created at.
+func (s Snowflake) Time() time.Time {
+	return time.UnixMilli(s.Timestamp())
+}
+
+// WorkerID returns the internal worker ID of a Snowflake.
+func (s Snowflake) WorkerID() uint8 {
+	return uint8((s & snowflakeWorkerMask) >> snowflakeWorkerShift)
+}
+
+// ProcessID returns the internal process ID of a Snowflake.
+func (s Snowflake) ProcessID() uint8 {
+	return uint8((s & snowflakeProcessMask) >> snowflakeProcessShift)
+}
+
+// Increment returns the increment of a Snowflake, which is incremented
+// for every ID that is generated on its process.
+func (s Snowflake) Increment() uint16 {
+	return uint16(s & snowflakeIncrementMask)
+}
+
+// Compare returns -1 when a Snowflake is created before another, 1 when it's created after,
+// and 0 when both Snowflakes are equal.
+func (s Snowflake) Compare(other Snowflake) int {
+	switch {
+	case s < other:
+		return -1
+	case s > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Shard returns the shard_id of a guild (with the Snowflake as its ID) using the given number of shards.
+//
+// https://discord.com/developers/docs/topics/gateway#sharding-sharding-formula
+func (s Snowflake) Shard(shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+
+	return int((s >> snowflakeTimestampShift) % Snowflake(shards))
+}
+
+// CompareSnowflakes compares the Snowflakes of two IDs (see Snowflake.Compare).
+func CompareSnowflakes(a, b string) (int, error) {
+	x, err := ParseSnowflake(a)
+	if err != nil {
+		return 0, err
+	}
+
+	y, err := ParseSnowflake(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return x.Compare(y), nil
+}
+
+// MarshalJSON marshals a Snowflake into a JSON string.
+//
+// Use IntegerSnowflake to marshal a Snowflake into a JSON integer.
+func (s Snowflake) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + s.String() + `"`), nil
+}
+
+// UnmarshalJSON unmarshals a Snowflake from a JSON string or integer.
+func (s *Snowflake) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	id, err := ParseSnowflake(string(bytes.Trim(b, `"`)))
+	if err != nil {
+		return fmt.Errorf(errUnmarshal, s, err)
+	}
+
+	*s = id
+
+	return nil
+}
+
+// IntegerSnowflake represents a Snowflake that is marshalled into a JSON integer (instead of a JSON string),
+// which is used when a JSON integer is expected.
+//
+// A JSON integer loses the precision of a Snowflake when it's parsed as a 64-bit float (i.e., in JavaScript).
+type IntegerSnowflake Snowflake
+
+// MarshalJSON marshals an IntegerSnowflake into a JSON integer.
+func (s IntegerSnowflake) MarshalJSON() ([]byte, error) {
+	return []byte(Snowflake(s).String()), nil
+}
+
+// UnmarshalJSON unmarshals an IntegerSnowflake from a JSON string or integer.
+func (s *IntegerSnowflake) UnmarshalJSON(b []byte) error {
+	return (*Snowflake)(s).UnmarshalJSON(b)
+}
+
+// event represents the type of an event that an event handler handles.
+type event[T any] interface {
+	*T
+
+	// eventname returns the name of the event.
+	eventname() string
+}
+
+// Subscription represents an event handler that is added to a bot using On, Once, or Listen.
+type Subscription struct {
+	// bot represents the bot that the event handler is added to.
+	bot *Client
+
+	// listener represents the function that passes an event to the event handler
+	// before the event is dispatched (when the Subscription is added using Once or Listen).
+	listener func(d *dispatch)
+
+	// event represents the name of the event that the event handler handles.
+	event string
+
+	// cancelled indicates whether the Subscription is cancelled (when cancelled == 1).
+	cancelled uint32
+}
+
+// On adds an event handler for an event to the bot, then returns the Subscription
+// that is used to remove the event handler.
+//
+// In contrast to Handle, the event is determined by the event handler's type at compile time.
+//
+//	sub := disgo.On(bot, func(m *disgo.MessageCreate) { ... })
+func On[T any, E event[T]](bot *Client, handler func(*T)) *Subscription {
+	var e E
+
+	sub := &Subscription{
+		bot:       bot,
+		listener:  nil,
+		event:     e.eventname(),
+		cancelled: 0,
+	}
+
+	sub.add(func(event *T) {
+		if atomic.LoadUint32(&sub.cancelled) == 1 {
+			return
+		}
+
+		handler(event)
+	})
+
+	return sub
+}
+
+// Once adds an event handler for an event to the bot that is removed once it's called,
+// then returns the Subscription that is used to remove the event handler beforehand.
+//
+// The event handler is called on its own goroutine as soon as the event is received
+// (before the event is dispatched), such that an event handler can wait for it in every Dispatch Mode.
+func Once[T any, E event[T]](bot *Client, handler func(*T)) *Subscription {
+	var e E
+
+	sub := &Subscription{
+		bot:       bot,
+		listener:  nil,
+		event:     e.eventname(),
+		cancelled: 0,
+	}
+
+	sub.listener = func(d *dispatch) {
+		event, ok := decode[T](d)
+		if !ok {
+			return
+		}
+
+		// ensure that the event handler is only called once
+		// when multiple events are received concurrently.
+		if !atomic.CompareAndSwapUint32(&sub.cancelled, 0, 1) {
+			return
+		}
+
+		sub.remove()
+
+		go invoke(d, handler, event)
+	}
+
+	sub.listen()
+
+	return sub
+}
+
+// Listen adds an event handler for an event to the bot that is called as soon as the event is received
+// (before the event is dispatched), then returns the Subscription that is used to remove the event handler.
+//
+// In contrast to On, the event handler is NOT queued behind other event handlers by a Dispatcher,
+// such that an event handler can wait for the events it receives (i.e., Await) in every Dispatch Mode.
+// So, the event handler is called on the goroutine of the Session that receives the event
+// (in the order that events are received) and must NOT block.
+//
+//	sub := disgo.Listen(bot, func(m *disgo.MessageCreate) { received <- m })
+func Listen[T any, E event[T]](bot *Client, handler func(*T)) *Subscription {
+	var e E
+
+	sub := &Subscription{
+		bot:       bot,
+		listener:  nil,
+		event:     e.eventname(),
+		cancelled: 0,
+	}
+
+	sub.listener = func(d *dispatch) {
+		if atomic.LoadUint32(&sub.cancelled) == 1 {
+			return
+		}
+
+		event, ok := decode[T](d)
+		if !ok {
+			return
+		}
+
+		invoke(d, handler, event)
+	}
+
+	sub.listen()
+
+	return sub
+}
+
+// Event returns the name of the event that the Subscription's event handler handles.
+func (sub *Subscription) Event() string {
+	return sub.event
+}
+
+// Cancel removes the Subscription's event handler from the bot.
+//
+// The event handler is NOT called once Cancel returns, even when an event is being dispatched.
+func (sub *Subscription) Cancel() {
+	if !atomic.CompareAndSwapUint32(&sub.cancelled, 0, 1) {
+		return
+	}
+
+	sub.remove()
+}
+
+// add adds the Subscription's event handler to the bot.
+func (sub *Subscription) add(function interface{}) {
+	sub.bot.Handlers.mu.Lock()
+	defer sub.bot.Handlers.mu.Unlock()
+
+	// the event handler is always added since its type is determined by its event.
+	sub.bot.add(sub.event, function, sub) //nolint:errcheck
+}
+
+// listen adds the Subscription's listener to the bot.
+func (sub *Subscription) listen() {
+	sub.bot.Handlers.mu.Lock()
+	defer sub.bot.Handlers.mu.Unlock()
+
+	sub.bot.intend(sub.event)
+
+	if sub.bot.Handlers.listeners == nil {
+		sub.bot.Handlers.listeners = make(map[string][]*Subscription)
+	}
+
+	sub.bot.Handlers.listeners[sub.event] = append(sub.bot.Handlers.listeners[sub.event], sub)
+
+	LogEventHandler(Logger.Info(), sub.bot.ApplicationID, sub.event).Msg("added event listener")
+}
+
+// remove removes the Subscription's event handler from the bot.
+func (sub *Subscription) remove() {
+	sub.bot.Handlers.mu.Lock()
+	defer sub.bot.Handlers.mu.Unlock()
+
+	if sub.listener != nil {
+		listeners := sub.bot.Handlers.listeners[sub.event]
+		for index, listener := range listeners {
+			if listener == sub {
+				// the listeners are copied, such that the listeners that are being called are NOT modified.
+				sub.bot.Handlers.listeners[sub.event] = append(listeners[:index:index], listeners[index+1:]...)
+
+				LogEventHandler(Logger.Info(), sub.bot.ApplicationID, sub.event).Msg("removed event listener")
+
+				return
+			}
+		}
+
+		return
+	}
+
+	for index, subscription := range sub.bot.Handlers.subscriptions[sub.event] {
+		if subscription == sub {
+			sub.bot.remove(sub.event, index) //nolint:errcheck
+
+			return
+		}
+	}
+}
+
+// track tracks the Subscription of the event handler at the given index (if applicable).
+//
+// The subscriptions of an event are stored in the same order as its event handlers,
+// such that a Subscription's event handler can be removed while other event handlers are added or removed.
+func (h *Handlers) track(eventname string, index int, sub *Subscription) {
+	if sub == nil {
+		return
+	}
+
+	if h.subscriptions == nil {
+		h.subscriptions = make(map[string][]*Subscription)
+	}
+
+	subscriptions := h.subscriptions[eventname]
+	for len(subscriptions) <= index {
+		subscriptions = append(subscriptions, nil)
+	}
+
+	subscriptions[index] = sub
+	h.subscriptions[eventname] = subscriptions
+}
+
+// untrack stops tracking the Subscription of the event handler at the given index (if applicable).
+func (h *Handlers) untrack(eventname string, index int) {
+	subscriptions := h.subscriptions[eventname]
+	if index < 0 || len(subscriptions) <= index {
+		return
+	}
+
+	h.subscriptions[eventname] = append(subscriptions[:index], subscriptions[index+1:]...)
+}
+
+// listen passes an event to the listeners of the event (see Listen) before the event is dispatched.
+func (bot *Client) listen(d *dispatch) {
+	bot.Handlers.mu.RLock()
+	listeners := bot.Handlers.listeners[d.eventname]
+	raw := bot.Handlers.listeners[FlagGatewayEventNameRaw]
+	bot.Handlers.mu.RUnlock()
+
+	for _, sub := range listeners {
+		sub.listener(d)
+	}
+
+	for _, sub := range raw {
+		sub.listener(d)
+	}
+}
+
+// decode decodes the event of a dispatch for a listener.
+func decode[T any](d *dispatch) (*T, bool) {
+	event := new(T)
+
+	if raw, ok := any(event).(*RawEvent); ok {
+		*raw = *d.raw()
+
+		return event, true
+	}
+
+	if err := json.Unmarshal(d.data, event); err != nil {
+		d.error(ErrorEvent{ClientID: d.bot.ApplicationID, Event: d.eventname, Err: err, Action: ErrorEventActionUnmarshal})
+
+		return nil, false
+	}
+
+	return event, true
+}
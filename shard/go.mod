@@ -5,6 +5,7 @@ go 1.20
 require (
 	github.com/rs/zerolog v1.28.0
 	github.com/switchupcb/disgo v1.10.1-0.20230704072044-28d8319961f3
+	github.com/switchupcb/websocket v1.8.8
 )
 
 require (
@@ -15,7 +16,6 @@ require (
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.16 // indirect
 	github.com/rs/xid v1.4.0 // indirect
-	github.com/switchupcb/websocket v1.8.8 // indirect
 	github.com/valyala/bytebufferpool v1.0.0 // indirect
 	github.com/valyala/fasthttp v1.43.0 // indirect
 	golang.org/x/sync v0.1.0 // indirect
@@ -0,0 +1,222 @@
+package shard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/switchupcb/disgo"
+)
+
+const (
+	// handoffNetwork represents the network used to hand off sessions between processes.
+	handoffNetwork = "unix"
+)
+
+// ErrorHandoff represents an error that occurs when the sessions of a shard manager
+// are only partially handed off to another process.
+type ErrorHandoff struct {
+	// Err represents the error that stopped the handoff.
+	Err error
+
+	// Unsent represents the states of the sessions that were suspended, but NOT sent to the other process.
+	//
+	// These sessions are NOT running in either process, so they must be resumed (i.e., using Resume).
+	Unsent []*disgo.SessionState
+}
+
+func (e ErrorHandoff) Error() string {
+	return fmt.Sprintf("handoff: %v (%d unsent sessions)", e.Err, len(e.Unsent))
+}
+
+func (e ErrorHandoff) Unwrap() error {
+	return e.Err
+}
+
+// ErrorTakeover represents an error that occurs when the sessions that are handed off to a shard manager
+// are only partially resumed.
+type ErrorTakeover struct {
+	// Err represents the errors that occurred while the sessions were resumed.
+	Err error
+
+	// Unresumed represents the states of the sessions that were handed off, but NOT resumed.
+	//
+	// These sessions are NOT running in either process, so they must be resumed (i.e., using Resume).
+	Unresumed []*disgo.SessionState
+}
+
+func (e ErrorTakeover) Error() string {
+	return fmt.Sprintf("takeover: %v (%d unresumed sessions)", e.Err, len(e.Unresumed))
+}
+
+func (e ErrorTakeover) Unwrap() error {
+	return e.Err
+}
+
+// Handoff hands off the sessions of the shard manager to another process
+// (during a rolling deploy) without invalidating the sessions.
+//
+// Handoff listens on the Unix socket at the given address until a process calls Takeover.
+// Then, each session is suspended (in order) and its state (session ID, sequence, and resume URL)
+// is sent to the other process, which resumes the session.
+//
+// The shard manager has no sessions upon a successful handoff. Otherwise, only the sessions
+// that are handed off are removed from the shard manager, and an ErrorHandoff is returned
+// with the states of the suspended sessions that must be resumed.
+func (sm *InstanceShardManager) Handoff(ctx context.Context, address string) error {
+	// remove a socket that remains from a previous handoff.
+	if err := os.Remove(address); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf(errShardManager, fmt.Errorf("handoff: %w", err))
+	}
+
+	var lc net.ListenConfig
+
+	listener, err := lc.Listen(ctx, handoffNetwork, address)
+	if err != nil {
+		return fmt.Errorf(errShardManager, fmt.Errorf("handoff: %w", err))
+	}
+
+	defer listener.Close()
+
+	// stop waiting for a connection when the context is cancelled.
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			listener.Close()
+		case <-stop:
+		}
+	}()
+
+	disgo.Logger.Info().Str(LogCtxShardManager, address).Msg("waiting for session handoff")
+
+	conn, err := listener.Accept()
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf(errShardManager, fmt.Errorf("handoff: %w", ctx.Err()))
+		}
+
+		return fmt.Errorf(errShardManager, fmt.Errorf("handoff: %w", err))
+	}
+
+	defer conn.Close()
+
+	// set the Gateway Endpoint to a value that requires it to be fetched again upon reconnection.
+	sm.gatewayEndpoint = ""
+
+	// send each session's state as soon as it's suspended,
+	// which allows the other process to resume a shard while the next shard is suspended.
+	encoder := json.NewEncoder(conn)
+	for i, session := range sm.Sessions {
+		state, err := session.Suspend()
+		if err != nil {
+			// the sessions that are NOT suspended remain in the shard manager.
+			sm.Sessions = sm.Sessions[i:]
+
+			return fmt.Errorf(errShardManager, ErrorHandoff{Err: err, Unsent: nil})
+		}
+
+		if err := encoder.Encode(state); err != nil {
+			sm.Sessions = sm.Sessions[i+1:]
+
+			return fmt.Errorf(errShardManager, ErrorHandoff{
+				Err:    fmt.Errorf("shard %v: %w", state.Shard, err),
+				Unsent: []*disgo.SessionState{state},
+			})
+		}
+
+		disgo.Logger.Info().Str(LogCtxShardManager, address).Msgf("handed off shard %v", state.Shard)
+	}
+
+	sm.Sessions = nil
+
+	return nil
+}
+
+// Resume resumes sessions (i.e., the unsent sessions of an ErrorHandoff) using the given states,
+// then adds the sessions to the shard manager.
+func (sm *InstanceShardManager) Resume(bot *disgo.Client, states ...*disgo.SessionState) error {
+	for _, state := range states {
+		if err := sm.resume(bot, state); err != nil {
+			return fmt.Errorf(errShardManager, fmt.Errorf("resume: %w", err))
+		}
+	}
+
+	return nil
+}
+
+// resume resumes a session using the given state, then adds the session to the shard manager.
+func (sm *InstanceShardManager) resume(bot *disgo.Client, state *disgo.SessionState) error {
+	if state.Shard != nil {
+		sm.Shards = state.Shard[1]
+	}
+
+	// resume the session using its resume gateway URL.
+	session := disgo.NewSession()
+	session.Restore(state)
+
+	if err := session.Connect(bot); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	sm.Sessions = append(sm.Sessions, session)
+
+	return nil
+}
+
+// Takeover resumes the sessions that another process hands off using Handoff.
+//
+// Takeover connects to the Unix socket at the given address, then resumes each session
+// (in the order that it's received) until every session is handed off.
+//
+// A session that can NOT be resumed does NOT stop the takeover. Instead, an ErrorTakeover is returned
+// with the states of the sessions that must be resumed once every other session is handed off.
+func (sm *InstanceShardManager) Takeover(bot *disgo.Client, address string) error {
+	conn, err := net.Dial(handoffNetwork, address)
+	if err != nil {
+		return fmt.Errorf(errShardManager, fmt.Errorf("takeover: %w", err))
+	}
+
+	defer conn.Close()
+
+	sm.Sessions = nil
+
+	var (
+		errs      []error
+		unresumed []*disgo.SessionState
+	)
+
+	decoder := json.NewDecoder(conn)
+	for {
+		state := new(disgo.SessionState)
+		if err := decoder.Decode(state); err != nil {
+			if !errors.Is(err, io.EOF) {
+				// the remaining sessions can NOT be decoded.
+				errs = append(errs, err)
+			}
+
+			break
+		}
+
+		if err := sm.resume(bot, state); err != nil {
+			errs = append(errs, fmt.Errorf("shard %v: %w", state.Shard, err))
+			unresumed = append(unresumed, state)
+
+			continue
+		}
+
+		disgo.Logger.Info().Str(LogCtxShardManager, address).Msgf("took over shard %v", state.Shard)
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf(errShardManager, ErrorTakeover{Err: errors.Join(errs...), Unresumed: unresumed})
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package shard
+
+import (
+	"time"
+)
+
+// Latency returns the average heartbeat latency of the shard manager's sessions
+// (that have measured their latency).
+func (sm *InstanceShardManager) Latency() time.Duration {
+	var total time.Duration
+	var sessions time.Duration
+
+	for _, session := range sm.Sessions {
+		if latency := session.Latency(); latency != 0 {
+			total += latency
+			sessions++
+		}
+	}
+
+	if sessions == 0 {
+		return 0
+	}
+
+	return total / sessions
+}
+
+// Latencies returns the heartbeat latency of each session in the shard manager (by shard_id).
+func (sm *InstanceShardManager) Latencies() map[int]time.Duration {
+	latencies := make(map[int]time.Duration, len(sm.Sessions))
+	for i, session := range sm.Sessions {
+		shardID := i
+		if session.Shard != nil {
+			shardID = session.Shard[0]
+		}
+
+		latencies[shardID] = session.Latency()
+	}
+
+	return latencies
+}
@@ -0,0 +1,271 @@
+package shard_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/switchupcb/disgo"
+	. "github.com/switchupcb/disgo/shard"
+	"github.com/switchupcb/websocket"
+)
+
+// gateway returns the endpoint of a test Discord Gateway that resumes every session.
+func gateway(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		defer conn.Close(websocket.StatusNormalClosure, "")
+
+		ctx := context.Background()
+		if err := conn.Write(ctx, websocket.MessageText, []byte(`{"op":10,"d":{"heartbeat_interval":45000}}`)); err != nil {
+			return
+		}
+
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				return
+			}
+
+			var payload struct {
+				Data struct {
+					Seq int64 `json:"seq"`
+				} `json:"d"`
+				Op int `json:"op"`
+			}
+
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return
+			}
+
+			var response string
+
+			switch payload.Op {
+			case disgo.FlagGatewayOpcodeResume:
+				response = `{"op":0,"t":"RESUMED","s":` + strconv.FormatInt(payload.Data.Seq, 10) + `,"d":{}}`
+			case disgo.FlagGatewayOpcodeHeartbeat:
+				response = `{"op":11}`
+			default:
+				continue
+			}
+
+			if err := conn.Write(ctx, websocket.MessageText, []byte(response)); err != nil {
+				return
+			}
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// client returns a new client for testing.
+func client() *disgo.Client {
+	return &disgo.Client{ //nolint:exhaustruct
+		Authentication: disgo.BotToken("token"),
+		Config:         disgo.DefaultConfig(),
+		Handlers:       new(disgo.Handlers),
+		Sessions:       disgo.NewSessionManager(),
+	}
+}
+
+// takeover takes over the sessions that are handed off at the given address.
+func takeover(t *testing.T, sm *InstanceShardManager, bot *disgo.Client, address string) {
+	t.Helper()
+
+	// wait for the handoff socket.
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		if _, err := os.Stat(address); err == nil {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("handoff socket %q was NOT created", address)
+		}
+	}
+
+	if err := sm.Takeover(bot, address); err != nil {
+		t.Fatalf("takeover: %v", err)
+	}
+
+	t.Cleanup(func() {
+		for _, session := range sm.Sessions {
+			_ = session.Disconnect()
+		}
+	})
+}
+
+// TestHandoff tests Handoff() and Takeover() of the Shard Manager over a Unix socket.
+func TestHandoff(t *testing.T) {
+	endpoint := gateway(t)
+
+	states := []*disgo.SessionState{
+		{Shard: &[2]int{0, 2}, ID: "a", Endpoint: endpoint, Seq: 5},
+		{Shard: &[2]int{1, 2}, ID: "b", Endpoint: endpoint, Seq: 7},
+	}
+
+	old := new(InstanceShardManager)
+	if err := old.Resume(client(), states...); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+
+	address := filepath.Join(t.TempDir(), "disgo.sock")
+
+	handoff := make(chan error, 1)
+	go func() {
+		handoff <- old.Handoff(context.Background(), address)
+	}()
+
+	sm := new(InstanceShardManager)
+	takeover(t, sm, client(), address)
+
+	if err := <-handoff; err != nil {
+		t.Fatalf("handoff: %v", err)
+	}
+
+	if len(old.Sessions) != 0 {
+		t.Errorf("expected no sessions after a handoff but got %d", len(old.Sessions))
+	}
+
+	if len(sm.Sessions) != len(states) {
+		t.Fatalf("expected %d sessions after a takeover but got %d", len(states), len(sm.Sessions))
+	}
+
+	for i, session := range sm.Sessions {
+		session.RLock()
+		state := session.State()
+		session.RUnlock()
+
+		if state.ID != states[i].ID || state.Seq != states[i].Seq || *state.Shard != *states[i].Shard {
+			t.Errorf("shard %d: expected state %v but got %v", i, *states[i], *state)
+		}
+	}
+}
+
+// TestHandoffPartial tests that a partial Handoff() only removes the sessions that are handed off.
+func TestHandoffPartial(t *testing.T) {
+	endpoint := gateway(t)
+
+	old := new(InstanceShardManager)
+	if err := old.Resume(client(), &disgo.SessionState{Shard: &[2]int{0, 2}, ID: "a", Endpoint: endpoint, Seq: 5}); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+
+	// a disconnected session can NOT be suspended.
+	disconnected := disgo.NewSession()
+	disconnected.Restore(&disgo.SessionState{Shard: &[2]int{1, 2}, ID: "b", Endpoint: endpoint, Seq: 7})
+	old.Sessions = append(old.Sessions, disconnected)
+
+	address := filepath.Join(t.TempDir(), "disgo.sock")
+
+	handoff := make(chan error, 1)
+	go func() {
+		handoff <- old.Handoff(context.Background(), address)
+	}()
+
+	sm := new(InstanceShardManager)
+	takeover(t, sm, client(), address)
+
+	var handoffErr ErrorHandoff
+	if err := <-handoff; !errors.As(err, &handoffErr) {
+		t.Fatalf("expected ErrorHandoff but got %v", err)
+	}
+
+	if len(handoffErr.Unsent) != 0 {
+		t.Errorf("expected no unsent sessions but got %d", len(handoffErr.Unsent))
+	}
+
+	if len(old.Sessions) != 1 || old.Sessions[0] != disconnected {
+		t.Errorf("expected the session that was NOT handed off to remain but got %v", old.Sessions)
+	}
+
+	if len(sm.Sessions) != 1 {
+		t.Errorf("expected 1 session after a takeover but got %d", len(sm.Sessions))
+	}
+}
+
+// TestTakeoverPartial tests that Takeover() resumes every other session when a session can NOT be resumed.
+func TestTakeoverPartial(t *testing.T) {
+	endpoint := gateway(t)
+
+	// the second session's endpoint refuses connections.
+	states := []*disgo.SessionState{
+		{Shard: &[2]int{0, 3}, ID: "a", Endpoint: endpoint, Seq: 5},
+		{Shard: &[2]int{1, 3}, ID: "b", Endpoint: "ws://127.0.0.1:1", Seq: 7},
+		{Shard: &[2]int{2, 3}, ID: "c", Endpoint: endpoint, Seq: 9},
+	}
+
+	address := filepath.Join(t.TempDir(), "disgo.sock")
+
+	listener, err := net.Listen("unix", address)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	defer listener.Close()
+
+	// hand off the sessions.
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		encoder := json.NewEncoder(conn)
+		for _, state := range states {
+			if err := encoder.Encode(state); err != nil {
+				return
+			}
+		}
+	}()
+
+	sm := new(InstanceShardManager)
+	err = sm.Takeover(client(), address)
+
+	t.Cleanup(func() {
+		for _, session := range sm.Sessions {
+			_ = session.Disconnect()
+		}
+	})
+
+	var takeoverErr ErrorTakeover
+	if !errors.As(err, &takeoverErr) {
+		t.Fatalf("expected ErrorTakeover but got %v", err)
+	}
+
+	if len(takeoverErr.Unresumed) != 1 || takeoverErr.Unresumed[0].ID != "b" {
+		t.Errorf("expected the unresumed session %q but got %v", "b", takeoverErr.Unresumed)
+	}
+
+	if len(sm.Sessions) != 2 {
+		t.Fatalf("expected 2 sessions after a takeover but got %d", len(sm.Sessions))
+	}
+
+	for i, id := range []string{"a", "c"} {
+		sm.Sessions[i].RLock()
+		state := sm.Sessions[i].State()
+		sm.Sessions[i].RUnlock()
+
+		if state.ID != id {
+			t.Errorf("session %d: expected %q but got %q", i, id, state.ID)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package shard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/switchupcb/disgo"
+	. "github.com/switchupcb/disgo/shard"
+)
+
+// TestLatency tests the aggregate heartbeat latency of the Shard Manager's sessions.
+func TestLatency(t *testing.T) {
+	endpoint := gateway(t)
+
+	sm := new(InstanceShardManager)
+	if err := sm.Resume(client(), &disgo.SessionState{Shard: &[2]int{0, 2}, ID: "a", Endpoint: endpoint, Seq: 5}); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+
+	t.Cleanup(func() {
+		for _, session := range sm.Sessions {
+			_ = session.Disconnect()
+		}
+	})
+
+	// a session without a measured latency is NOT included in the average latency.
+	disconnected := disgo.NewSession()
+	disconnected.Restore(&disgo.SessionState{Shard: &[2]int{1, 2}, ID: "b", Endpoint: endpoint, Seq: 7})
+	sm.Sessions = append(sm.Sessions, disconnected)
+
+	// the aggregate latency is provided by every ShardManager.
+	var manager disgo.ShardManager = sm
+
+	// wait for the connected session to measure its latency.
+	for deadline := time.Now().Add(time.Second); sm.Sessions[0].Latency() == 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("the session did NOT measure its latency")
+		}
+	}
+
+	if latency, expected := manager.Latency(), sm.Sessions[0].Latency(); latency != expected {
+		t.Errorf("expected latency %v but got %v", expected, latency)
+	}
+
+	latencies := sm.Latencies()
+	if len(latencies) != 2 || latencies[0] == 0 || latencies[1] != 0 {
+		t.Errorf("expected a latency for shard 0 (only) but got %v", latencies)
+	}
+
+	if latency := new(InstanceShardManager).Latency(); latency != 0 {
+		t.Errorf("expected no latency without sessions but got %v", latency)
+	}
+}
@@ -0,0 +1,415 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Autocomplete Limits
+// https://discord.com/developers/docs/interactions/application-commands#application-command-object-application-command-option-choice-structure
+const (
+	maxAutocompleteChoices    = 25
+	maxAutocompleteChoiceName = 100
+)
+
+// AutocompleteHandler represents a handler for the focused option of an autocomplete interaction,
+// which returns the choices of the option.
+type AutocompleteHandler func(bot *disgo.Client, request *AutocompleteRequest) ([]*disgo.ApplicationCommandOptionChoice, error)
+
+// AutocompleteRequest represents an autocomplete interaction that is routed to an AutocompleteHandler.
+type AutocompleteRequest struct {
+	// Interaction represents the interaction.
+	Interaction *disgo.Interaction
+
+	// Focused represents the option that the user is typing.
+	Focused *disgo.ApplicationCommandInteractionDataOption
+
+	// Path represents the command path of the command.
+	Path string
+
+	// Input represents the value of the focused option.
+	Input string
+
+	// Options represents the options of the command's subcommand (or command).
+	Options []*disgo.ApplicationCommandInteractionDataOption
+}
+
+// AutocompleteRouter routes autocomplete interactions to handlers by command path and focused option name.
+//
+// The choices returned by a handler are limited to the 25 choices (with names of 100 characters)
+// that Discord accepts.
+type AutocompleteRouter struct {
+	// ErrorHandler handles the errors returned by the router's handlers.
+	//
+	// Errors are logged when the ErrorHandler is nil.
+	ErrorHandler func(bot *disgo.Client, interaction *disgo.Interaction, err error)
+
+	// handlers represents a map of route keys to handlers.
+	handlers map[string]AutocompleteHandler
+
+	// cache represents a map of request keys to the cached choices of a user's input.
+	cache map[string]autocompleteEntry
+
+	// debounced represents a map of user route keys to the timer of the latest interaction (to debounce).
+	debounced map[string]*time.Timer
+
+	// Debounce represents the amount of time the router waits for a user to stop typing
+	// before an autocomplete interaction is handled.
+	//
+	// An interaction that is followed by another interaction (from the same user for the same option)
+	// during the debounce is NOT responded to, since Discord only displays the choices of the latest input.
+	// The Debounce must be less than the 3 seconds Discord waits for a response.
+	//
+	// A debounced interaction is handled by a per-user timer, such that Route returns immediately
+	// (without blocking the dispatch of other events) and the errors of the handler are handled
+	// by the ErrorHandler.
+	Debounce time.Duration
+
+	// Cache represents the amount of time the choices for a user's input are cached.
+	Cache time.Duration
+
+	mu sync.Mutex
+}
+
+// autocompleteEntry represents the cached choices of a user's input.
+type autocompleteEntry struct {
+	expires time.Time
+	choices []*disgo.ApplicationCommandOptionChoice
+}
+
+// ErrAutocompleteNotFound represents an error that occurs when no handler is routed to a focused option.
+var ErrAutocompleteNotFound = errors.New("autocomplete not found")
+
+// NewAutocompleteRouter returns a new AutocompleteRouter.
+func NewAutocompleteRouter() *AutocompleteRouter {
+	return &AutocompleteRouter{ //nolint:exhaustruct
+		handlers:  make(map[string]AutocompleteHandler),
+		cache:     make(map[string]autocompleteEntry),
+		debounced: make(map[string]*time.Timer),
+	}
+}
+
+// autocompleteKey returns the key of a command path and option name.
+func autocompleteKey(path, option string) string {
+	return strings.Join(strings.Fields(path), " ") + "\x00" + option
+}
+
+// Handle routes the given option of a command path to a handler.
+//
+//	router.Handle("music play", "song", handler)
+func (r *AutocompleteRouter) Handle(path, option string, handler AutocompleteHandler) {
+	r.mu.Lock()
+	r.handlers[autocompleteKey(path, option)] = handler
+	r.mu.Unlock()
+}
+
+// Route responds to an autocomplete interaction with the choices
+// of the handler of its command path and focused option.
+//
+// A debounced interaction is responded to after Route returns.
+func (r *AutocompleteRouter) Route(bot *disgo.Client, interaction *disgo.Interaction) error {
+	request := NewAutocompleteRequest(interaction)
+	if request.Focused == nil {
+		return fmt.Errorf("%w: %q has no focused option", ErrAutocompleteNotFound, request.Path)
+	}
+
+	key := autocompleteKey(request.Path, request.Focused.Name)
+	userKey := InteractionUserID(interaction) + "\x00" + key
+	inputKey := userKey + "\x00" + request.Input
+
+	r.mu.Lock()
+	handler, ok := r.handlers[key]
+	r.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %q option %q", ErrAutocompleteNotFound, request.Path, request.Focused.Name)
+	}
+
+	if r.Debounce <= 0 {
+		return r.respond(bot, request, handler, inputKey)
+	}
+
+	r.debounce(userKey, func() {
+		if err := r.respond(bot, request, handler, inputKey); err != nil {
+			r.handleError(bot, interaction, err)
+		}
+	})
+
+	return nil
+}
+
+// debounce calls the given function once the debounce passes, unless another function
+// is debounced with the same user route key in the meantime.
+func (r *AutocompleteRouter) debounce(userKey string, call func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// a pending interaction from the user is superseded by the latest interaction.
+	if previous, ok := r.debounced[userKey]; ok {
+		previous.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(r.Debounce, func() {
+		r.mu.Lock()
+		latest := r.debounced[userKey] == timer
+		if latest {
+			delete(r.debounced, userKey)
+		}
+		r.mu.Unlock()
+
+		if latest {
+			call()
+		}
+	})
+
+	r.debounced[userKey] = timer
+}
+
+// respond responds to an autocomplete request with the (cached) choices of its handler.
+func (r *AutocompleteRouter) respond(bot *disgo.Client, request *AutocompleteRequest, handler AutocompleteHandler, inputKey string) error {
+	interaction := request.Interaction
+
+	choices, ok := r.cached(inputKey)
+	if !ok {
+		var err error
+		if choices, err = handler(bot, request); err != nil {
+			return err
+		}
+
+		choices = LimitChoices(choices)
+		r.store(inputKey, choices)
+	}
+
+	response := &disgo.CreateInteractionResponse{
+		InteractionID:    interaction.ID,
+		InteractionToken: interaction.Token,
+		InteractionResponse: &disgo.InteractionResponse{
+			Type: disgo.FlagInteractionCallbackTypeAPPLICATION_COMMAND_AUTOCOMPLETE_RESULT,
+			Data: &disgo.Autocomplete{Choices: choices},
+		},
+	}
+
+	if err := response.Send(bot); err != nil {
+		return fmt.Errorf("autocomplete %q option %q: %w", request.Path, request.Focused.Name, err)
+	}
+
+	return nil
+}
+
+// cached returns the cached choices of a request key.
+func (r *AutocompleteRouter) cached(key string) ([]*disgo.ApplicationCommandOptionChoice, bool) {
+	if r.Cache <= 0 {
+		return nil, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.cache[key]
+	if !ok || !time.Now().Before(entry.expires) {
+		return nil, false
+	}
+
+	return entry.choices, true
+}
+
+// store caches the choices of a request key, then removes expired entries.
+func (r *AutocompleteRouter) store(key string, choices []*disgo.ApplicationCommandOptionChoice) {
+	if r.Cache <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache[key] = autocompleteEntry{expires: now.Add(r.Cache), choices: choices}
+
+	for k, entry := range r.cache {
+		if !now.Before(entry.expires) {
+			delete(r.cache, k)
+		}
+	}
+}
+
+// Handler returns an InteractionCreate event handler that routes
+// the autocomplete interactions of the bot.
+//
+//	bot.Handle(disgo.FlagGatewayEventNameInteractionCreate, router.Handler(bot))
+func (r *AutocompleteRouter) Handler(bot *disgo.Client) func(*disgo.InteractionCreate) {
+	return func(i *disgo.InteractionCreate) {
+		if i.Interaction.Type != disgo.FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE {
+			return
+		}
+
+		if err := r.Route(bot, i.Interaction); err != nil {
+			r.handleError(bot, i.Interaction, err)
+		}
+	}
+}
+
+// handleError handles an error returned by the router's handlers.
+func (r *AutocompleteRouter) handleError(bot *disgo.Client, interaction *disgo.Interaction, err error) {
+	if r.ErrorHandler != nil {
+		r.ErrorHandler(bot, interaction, err)
+
+		return
+	}
+
+	disgo.Logger.Error().Str(disgo.LogCtxClient, bot.ApplicationID).Err(err).Msg("")
+}
+
+// NewAutocompleteRequest returns the AutocompleteRequest of an autocomplete interaction.
+func NewAutocompleteRequest(interaction *disgo.Interaction) *AutocompleteRequest {
+	path, options := CommandPath(interaction.ApplicationCommand())
+
+	request := &AutocompleteRequest{
+		Interaction: interaction,
+		Focused:     nil,
+		Path:        path,
+		Input:       "",
+		Options:     options,
+	}
+
+	for _, option := range options {
+		if option.Focused != nil && *option.Focused {
+			request.Focused = option
+
+			if option.Value != nil {
+				request.Input = option.Value.String()
+			}
+
+			break
+		}
+	}
+
+	return request
+}
+
+// LimitChoices returns the first 25 choices with names that are truncated to 100 characters.
+func LimitChoices(choices []*disgo.ApplicationCommandOptionChoice) []*disgo.ApplicationCommandOptionChoice {
+	if len(choices) > maxAutocompleteChoices {
+		choices = choices[:maxAutocompleteChoices]
+	}
+
+	limited := make([]*disgo.ApplicationCommandOptionChoice, len(choices))
+	for i, choice := range choices {
+		limited[i] = choice
+
+		if utf8.RuneCountInString(choice.Name) > maxAutocompleteChoiceName {
+			truncated := *choice
+			truncated.Name = string([]rune(choice.Name)[:maxAutocompleteChoiceName])
+			limited[i] = &truncated
+		}
+	}
+
+	return limited
+}
+
+// CandidateSource represents a source of the candidate choices for an autocomplete request.
+type CandidateSource func(bot *disgo.Client, request *AutocompleteRequest) ([]*disgo.ApplicationCommandOptionChoice, error)
+
+// StaticCandidates returns a CandidateSource of the given choices.
+func StaticCandidates(choices ...*disgo.ApplicationCommandOptionChoice) CandidateSource {
+	return func(*disgo.Client, *AutocompleteRequest) ([]*disgo.ApplicationCommandOptionChoice, error) {
+		return choices, nil
+	}
+}
+
+// MatchCandidates returns an AutocompleteHandler that matches the candidates of a source
+// against the input of the focused option (see MatchChoices).
+//
+//	router.Handle("music play", "song", tools.MatchCandidates(tools.StaticCandidates(songs...)))
+func MatchCandidates(source CandidateSource) AutocompleteHandler {
+	return func(bot *disgo.Client, request *AutocompleteRequest) ([]*disgo.ApplicationCommandOptionChoice, error) {
+		candidates, err := source(bot, request)
+		if err != nil {
+			return nil, err
+		}
+
+		return MatchChoices(request.Input, candidates), nil
+	}
+}
+
+// Choice Match Ranks
+const (
+	matchNone = iota
+	matchFuzzy
+	matchSubstring
+	matchWordPrefix
+	matchPrefix
+	matchExact
+)
+
+// MatchChoices returns the choices with names that match the given input (case insensitive),
+// in order of relevance: An exact match, a prefix, a prefix of a word, a substring,
+// then a fuzzy match (of the input's characters in order).
+//
+// Every choice is returned (in order) when the input is empty.
+func MatchChoices(input string, choices []*disgo.ApplicationCommandOptionChoice) []*disgo.ApplicationCommandOptionChoice {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return choices
+	}
+
+	ranks := make(map[*disgo.ApplicationCommandOptionChoice]int, len(choices))
+	matched := make([]*disgo.ApplicationCommandOptionChoice, 0, len(choices))
+
+	for _, choice := range choices {
+		if rank := matchRank(input, strings.ToLower(choice.Name)); rank != matchNone {
+			ranks[choice] = rank
+			matched = append(matched, choice)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return ranks[matched[i]] > ranks[matched[j]]
+	})
+
+	return matched
+}
+
+// matchRank returns the rank of a (lowercase) name's match to a (lowercase) input.
+func matchRank(input, name string) int {
+	switch {
+	case name == input:
+		return matchExact
+
+	case strings.HasPrefix(name, input):
+		return matchPrefix
+
+	case strings.Contains(name, " "+input):
+		return matchWordPrefix
+
+	case strings.Contains(name, input):
+		return matchSubstring
+	}
+
+	// match the characters of the input in order.
+	remaining := input
+	for _, r := range name {
+		if len(remaining) == 0 {
+			break
+		}
+
+		next, size := utf8.DecodeRuneInString(remaining)
+		if r == next {
+			remaining = remaining[size:]
+		}
+	}
+
+	if len(remaining) == 0 {
+		return matchFuzzy
+	}
+
+	return matchNone
+}
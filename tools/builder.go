@@ -0,0 +1,361 @@
+package tools
+
+import (
+	"time"
+
+	"github.com/switchupcb/disgo"
+)
+
+// MessageBuilder represents a builder for a message.
+//
+//	message := tools.NewMessage().
+//		Content("Hello!").
+//		Embed(tools.NewEmbed().Title("Title").Field("Name", "Value", true).Build()).
+//		Row(tools.NewButton(disgo.FlagButtonStylePRIMARY, "Click", "click"))
+//
+//	if err := message.Validate(); err != nil {
+//		return err
+//	}
+//
+//	sent, err := message.Build(channelID).Send(bot)
+type MessageBuilder struct {
+	message *disgo.CreateMessage
+}
+
+// NewMessage returns a new MessageBuilder.
+func NewMessage() *MessageBuilder {
+	return &MessageBuilder{message: new(disgo.CreateMessage)}
+}
+
+// Content sets the content of the message.
+func (b *MessageBuilder) Content(content string) *MessageBuilder {
+	b.message.Content = disgo.Pointer(content)
+
+	return b
+}
+
+// TTS sets whether the message is a text-to-speech message.
+func (b *MessageBuilder) TTS(tts bool) *MessageBuilder {
+	b.message.TTS = disgo.Pointer(tts)
+
+	return b
+}
+
+// Embed adds embeds to the message.
+func (b *MessageBuilder) Embed(embeds ...*disgo.Embed) *MessageBuilder {
+	b.message.Embeds = append(b.message.Embeds, embeds...)
+
+	return b
+}
+
+// Row adds an action row with the given components to the message.
+func (b *MessageBuilder) Row(components ...disgo.Component) *MessageBuilder {
+	b.message.Components = append(b.message.Components, NewActionRow(components...))
+
+	return b
+}
+
+// AllowedMentions sets the allowed mentions of the message.
+func (b *MessageBuilder) AllowedMentions(mentions *disgo.AllowedMentions) *MessageBuilder {
+	b.message.AllowedMentions = mentions
+
+	return b
+}
+
+// Reply sets the message that the message replies to.
+func (b *MessageBuilder) Reply(messageID string) *MessageBuilder {
+	b.message.MessageReference = &disgo.MessageReference{ //nolint:exhaustruct
+		MessageID: disgo.Pointer(messageID),
+	}
+
+	return b
+}
+
+// Flags sets the flags of the message.
+func (b *MessageBuilder) Flags(flags disgo.BitFlag) *MessageBuilder {
+	b.message.Flags = disgo.Pointer(flags)
+
+	return b
+}
+
+// File adds files to the message.
+func (b *MessageBuilder) File(files ...*disgo.File) *MessageBuilder {
+	b.message.Files = append(b.message.Files, files...)
+
+	return b
+}
+
+// Validate validates the message against Discord limits (see ValidateMessage).
+func (b *MessageBuilder) Validate() error {
+	return ValidateMessage(b.message)
+}
+
+// Build returns a CreateMessage request for the message in the given channel.
+//
+// The request is a copy of the message, such that the builder can be modified (or reused)
+// without modifying the request.
+func (b *MessageBuilder) Build(channelID string) *disgo.CreateMessage {
+	message := *b.message
+	message.ChannelID = channelID
+	message.Embeds = copyEmbeds(b.message.Embeds)
+	message.Components = copyComponents(b.message.Components)
+	message.StickerIDS = append([]*string(nil), b.message.StickerIDS...)
+	message.Files = append([]*disgo.File(nil), b.message.Files...)
+	message.Attachments = append([]*disgo.Attachment(nil), b.message.Attachments...)
+
+	return &message
+}
+
+// Messages returns a copy of the message as the data of an interaction response.
+//
+// The data of an interaction response does NOT contain files, so the files of the message
+// are NOT included: Use Files to send them with a request (i.e., CreateFollowupMessage).
+func (b *MessageBuilder) Messages() *disgo.Messages {
+	return &disgo.Messages{
+		TTS:             b.message.TTS,
+		Content:         b.message.Content,
+		Embeds:          copyEmbeds(b.message.Embeds),
+		AllowedMentions: b.message.AllowedMentions,
+		Flags:           b.message.Flags,
+		Components:      copyComponents(b.message.Components),
+		Attachments:     append([]*disgo.Attachment(nil), b.message.Attachments...),
+	}
+}
+
+// Files returns the files of the message.
+func (b *MessageBuilder) Files() []*disgo.File {
+	return append([]*disgo.File(nil), b.message.Files...)
+}
+
+// copyEmbeds returns copies of the given embeds (and their fields).
+func copyEmbeds(embeds []*disgo.Embed) []*disgo.Embed {
+	if embeds == nil {
+		return nil
+	}
+
+	copied := make([]*disgo.Embed, len(embeds))
+	for i, embed := range embeds {
+		if embed != nil {
+			copied[i] = copyEmbed(embed)
+		}
+	}
+
+	return copied
+}
+
+// copyEmbed returns a copy of the given embed (and its fields).
+func copyEmbed(embed *disgo.Embed) *disgo.Embed {
+	copied := *embed
+	copied.Fields = append([]*disgo.EmbedField(nil), embed.Fields...)
+
+	return &copied
+}
+
+// copyComponents returns copies of the given components (and the components of their action rows).
+func copyComponents(components []disgo.Component) []disgo.Component {
+	if components == nil {
+		return nil
+	}
+
+	copied := make([]disgo.Component, len(components))
+	for i, component := range components {
+		if row, ok := component.(*disgo.ActionRow); ok && row != nil {
+			r := *row
+			r.Components = copyComponents(row.Components)
+			copied[i] = &r
+
+			continue
+		}
+
+		copied[i] = component
+	}
+
+	return copied
+}
+
+// EmbedBuilder represents a builder for an embed.
+type EmbedBuilder struct {
+	embed *disgo.Embed
+}
+
+// NewEmbed returns a new EmbedBuilder.
+func NewEmbed() *EmbedBuilder {
+	return &EmbedBuilder{embed: new(disgo.Embed)}
+}
+
+// Title sets the title of the embed.
+func (b *EmbedBuilder) Title(title string) *EmbedBuilder {
+	b.embed.Title = disgo.Pointer(title)
+
+	return b
+}
+
+// Description sets the description of the embed.
+func (b *EmbedBuilder) Description(description string) *EmbedBuilder {
+	b.embed.Description = disgo.Pointer(description)
+
+	return b
+}
+
+// URL sets the URL of the embed's title.
+func (b *EmbedBuilder) URL(url string) *EmbedBuilder {
+	b.embed.URL = disgo.Pointer(url)
+
+	return b
+}
+
+// Timestamp sets the timestamp of the embed.
+func (b *EmbedBuilder) Timestamp(timestamp time.Time) *EmbedBuilder {
+	b.embed.Timestamp = disgo.Pointer(timestamp)
+
+	return b
+}
+
+// Color sets the color of the embed (i.e., 0x5865F2).
+func (b *EmbedBuilder) Color(color int) *EmbedBuilder {
+	b.embed.Color = disgo.Pointer(color)
+
+	return b
+}
+
+// Footer sets the footer of the embed, with an icon when the iconURL is NOT empty.
+func (b *EmbedBuilder) Footer(text, iconURL string) *EmbedBuilder {
+	b.embed.Footer = &disgo.EmbedFooter{Text: text, IconURL: nil, ProxyIconURL: nil}
+	if iconURL != "" {
+		b.embed.Footer.IconURL = disgo.Pointer(iconURL)
+	}
+
+	return b
+}
+
+// Author sets the author of the embed, with a URL and icon when they are NOT empty.
+func (b *EmbedBuilder) Author(name, url, iconURL string) *EmbedBuilder {
+	b.embed.Author = &disgo.EmbedAuthor{Name: name, URL: nil, IconURL: nil, ProxyIconURL: nil}
+	if url != "" {
+		b.embed.Author.URL = disgo.Pointer(url)
+	}
+
+	if iconURL != "" {
+		b.embed.Author.IconURL = disgo.Pointer(iconURL)
+	}
+
+	return b
+}
+
+// Image sets the image of the embed.
+func (b *EmbedBuilder) Image(url string) *EmbedBuilder {
+	b.embed.Image = &disgo.EmbedImage{URL: url, ProxyURL: nil, Height: nil, Width: nil}
+
+	return b
+}
+
+// Thumbnail sets the thumbnail of the embed.
+func (b *EmbedBuilder) Thumbnail(url string) *EmbedBuilder {
+	b.embed.Thumbnail = &disgo.EmbedThumbnail{URL: url, ProxyURL: nil, Height: nil, Width: nil}
+
+	return b
+}
+
+// Field adds a field to the embed.
+func (b *EmbedBuilder) Field(name, value string, inline bool) *EmbedBuilder {
+	b.embed.Fields = append(b.embed.Fields, &disgo.EmbedField{
+		Name:   name,
+		Value:  value,
+		Inline: disgo.Pointer(inline),
+	})
+
+	return b
+}
+
+// Validate validates the embed against Discord limits (see ValidateEmbeds).
+func (b *EmbedBuilder) Validate() error {
+	return ValidateEmbeds(b.embed)
+}
+
+// Build returns a copy of the embed.
+func (b *EmbedBuilder) Build() *disgo.Embed {
+	return copyEmbed(b.embed)
+}
+
+// NewActionRow returns an action row with the given components.
+func NewActionRow(components ...disgo.Component) *disgo.ActionRow {
+	return &disgo.ActionRow{
+		Type:       disgo.FlagComponentTypeActionRow,
+		Components: components,
+	}
+}
+
+// NewButton returns a button with the given style, label, and custom_id.
+func NewButton(style disgo.Flag, label, customID string) *disgo.Button {
+	return &disgo.Button{ //nolint:exhaustruct
+		Type:     disgo.FlagComponentTypeButton,
+		Style:    style,
+		Label:    disgo.Pointer(label),
+		CustomID: disgo.Pointer(customID),
+	}
+}
+
+// NewLinkButton returns a link button with the given label and URL.
+func NewLinkButton(label, url string) *disgo.Button {
+	return &disgo.Button{ //nolint:exhaustruct
+		Type:  disgo.FlagComponentTypeButton,
+		Style: disgo.FlagButtonStyleLINK,
+		Label: disgo.Pointer(label),
+		URL:   disgo.Pointer(url),
+	}
+}
+
+// SelectMenuBuilder represents a builder for a string select menu.
+type SelectMenuBuilder struct {
+	menu *disgo.SelectMenu
+}
+
+// NewSelectMenu returns a new SelectMenuBuilder for a string select menu with the given custom_id.
+func NewSelectMenu(customID string) *SelectMenuBuilder {
+	return &SelectMenuBuilder{
+		menu: &disgo.SelectMenu{ //nolint:exhaustruct
+			Type:     disgo.FlagComponentTypeStringSelect,
+			CustomID: customID,
+		},
+	}
+}
+
+// Placeholder sets the placeholder of the select menu.
+func (b *SelectMenuBuilder) Placeholder(placeholder string) *SelectMenuBuilder {
+	b.menu.Placeholder = disgo.Pointer(placeholder)
+
+	return b
+}
+
+// Option adds an option to the select menu, with a description when it's NOT empty.
+func (b *SelectMenuBuilder) Option(label, value, description string) *SelectMenuBuilder {
+	option := disgo.SelectMenuOption{Label: label, Value: value} //nolint:exhaustruct
+	if description != "" {
+		option.Description = disgo.Pointer(description)
+	}
+
+	b.menu.Options = append(b.menu.Options, option)
+
+	return b
+}
+
+// Values sets the minimum and maximum amount of options that can be selected.
+func (b *SelectMenuBuilder) Values(min, max int) *SelectMenuBuilder {
+	b.menu.MinValues = disgo.Pointer(disgo.Flag(min))
+	b.menu.MaxValues = disgo.Pointer(disgo.Flag(max))
+
+	return b
+}
+
+// Validate validates the select menu against Discord limits (in an action row).
+func (b *SelectMenuBuilder) Validate() error {
+	return ValidateComponents([]disgo.Component{NewActionRow(b.menu)})
+}
+
+// Build returns a copy of the select menu.
+func (b *SelectMenuBuilder) Build() *disgo.SelectMenu {
+	menu := *b.menu
+	menu.Options = append([]disgo.SelectMenuOption(nil), b.menu.Options...)
+
+	return &menu
+}
@@ -0,0 +1,474 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/switchupcb/disgo"
+)
+
+// CommandHandler represents a handler for an application command interaction.
+type CommandHandler func(bot *disgo.Client, interaction *disgo.Interaction) error
+
+// CommandMiddleware represents a function that wraps a CommandHandler
+// (i.e., to check permissions or enforce cooldowns).
+type CommandMiddleware func(next CommandHandler) CommandHandler
+
+// CommandRouter routes application command interactions to handlers by command path.
+//
+// A command path is the name of a command followed by the names of its subcommand group
+// and subcommand (i.e., "command", "command sub", "command group sub").
+type CommandRouter struct {
+	// ErrorHandler handles the errors returned by the router's handlers.
+	//
+	// Errors are logged when the ErrorHandler is nil.
+	ErrorHandler func(bot *disgo.Client, interaction *disgo.Interaction, err error)
+
+	// handlers represents a map of command paths to handlers.
+	handlers map[string]CommandHandler
+
+	// chains represents a map of command paths to handlers that are wrapped by the router's middleware.
+	chains map[string]CommandHandler
+
+	// middleware represents the middleware that wraps every handler.
+	middleware []CommandMiddleware
+
+	mu sync.RWMutex
+}
+
+var (
+	// ErrCommandNotFound represents an error that occurs when no handler is routed to a command path.
+	ErrCommandNotFound = errors.New("command not found")
+
+	// ErrNotCommand represents an error that occurs when an interaction that is NOT
+	// an application command is routed.
+	ErrNotCommand = errors.New("interaction is NOT an application command")
+)
+
+// NewCommandRouter returns a new CommandRouter.
+func NewCommandRouter() *CommandRouter {
+	return &CommandRouter{ //nolint:exhaustruct
+		handlers: make(map[string]CommandHandler),
+		chains:   make(map[string]CommandHandler),
+	}
+}
+
+// Use adds middleware that wraps every handler of the router (in the order it's added).
+func (r *CommandRouter) Use(middleware ...CommandMiddleware) {
+	r.mu.Lock()
+	r.middleware = append(r.middleware, middleware...)
+
+	// the handlers are wrapped by the new middleware when they're routed.
+	r.chains = make(map[string]CommandHandler, len(r.handlers))
+	r.mu.Unlock()
+}
+
+// Handle routes the given command path to a handler, which is wrapped by the given middleware.
+//
+//	router.Handle("calculate add int", handler)
+func (r *CommandRouter) Handle(path string, handler CommandHandler, middleware ...CommandMiddleware) {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+
+	path = strings.Join(strings.Fields(path), " ")
+
+	r.mu.Lock()
+	r.handlers[path] = handler
+	delete(r.chains, path)
+	r.mu.Unlock()
+}
+
+// Route calls the handler of an application command interaction's command path.
+//
+// An ErrNotCommand is returned when the interaction is NOT an application command.
+func (r *CommandRouter) Route(bot *disgo.Client, interaction *disgo.Interaction) error {
+	if interaction.Type != disgo.FlagInteractionTypeAPPLICATION_COMMAND {
+		return fmt.Errorf("%w: interaction type %d", ErrNotCommand, interaction.Type)
+	}
+
+	data, ok := interaction.Data.(*disgo.ApplicationCommandData)
+	if !ok {
+		return fmt.Errorf("%w: interaction data %T", ErrNotCommand, interaction.Data)
+	}
+
+	path, _ := CommandPath(data)
+
+	handler, err := r.chain(path)
+	if err != nil {
+		return err
+	}
+
+	return handler(bot, interaction)
+}
+
+// chain returns the handler of a command path wrapped by the router's middleware,
+// which is built once (per handler and middleware).
+func (r *CommandRouter) chain(path string) (CommandHandler, error) {
+	r.mu.RLock()
+	handler, ok := r.chains[path]
+	r.mu.RUnlock()
+
+	if ok {
+		return handler, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if handler, ok = r.chains[path]; ok {
+		return handler, nil
+	}
+
+	if handler, ok = r.handlers[path]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrCommandNotFound, path)
+	}
+
+	for i := len(r.middleware) - 1; i >= 0; i-- {
+		handler = r.middleware[i](handler)
+	}
+
+	r.chains[path] = handler
+
+	return handler, nil
+}
+
+// Handler returns an InteractionCreate event handler that routes
+// the application command interactions of the bot.
+//
+//	bot.Handle(disgo.FlagGatewayEventNameInteractionCreate, router.Handler(bot))
+func (r *CommandRouter) Handler(bot *disgo.Client) func(*disgo.InteractionCreate) {
+	return func(i *disgo.InteractionCreate) {
+		if i.Interaction.Type != disgo.FlagInteractionTypeAPPLICATION_COMMAND {
+			return
+		}
+
+		if err := r.Route(bot, i.Interaction); err != nil {
+			if r.ErrorHandler != nil {
+				r.ErrorHandler(bot, i.Interaction, err)
+
+				return
+			}
+
+			disgo.Logger.Error().Str(disgo.LogCtxClient, bot.ApplicationID).Err(err).Msg("")
+		}
+	}
+}
+
+// CommandPath returns the command path of an application command interaction
+// and the options of its subcommand (or command).
+func CommandPath(data *disgo.ApplicationCommandData) (string, []*disgo.ApplicationCommandInteractionDataOption) {
+	path := data.Name
+	options := data.Options
+
+	for len(options) == 1 {
+		switch options[0].Type {
+		case disgo.FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP,
+			disgo.FlagApplicationCommandOptionTypeSUB_COMMAND:
+			path += " " + options[0].Name
+			options = options[0].Options
+
+			continue
+		}
+
+		break
+	}
+
+	return path, options
+}
+
+// optionTag represents the struct tag used to bind an option to a struct field.
+const optionTag = "option"
+
+var (
+	typeUser       = reflect.TypeOf((*disgo.User)(nil))
+	typeMember     = reflect.TypeOf((*disgo.GuildMember)(nil))
+	typeRole       = reflect.TypeOf((*disgo.Role)(nil))
+	typeChannel    = reflect.TypeOf((*disgo.Channel)(nil))
+	typeAttachment = reflect.TypeOf((*disgo.Attachment)(nil))
+)
+
+// BindOptions binds the options of an application command interaction's subcommand (or command)
+// to the fields of the given struct pointer using the `option` struct tag.
+//
+// A field can be a string, bool, integer, float, or a pointer to one (for optional options).
+// A user, member, role, channel, or attachment option is bound to a field of its resolved object
+// (i.e., *disgo.User) or a string field (of its ID). Fields without an option are NOT modified.
+//
+//	type options struct {
+//		User   *disgo.User `option:"user"`
+//		Reason *string     `option:"reason"`
+//	}
+//
+// An ErrNotCommand is returned when the interaction is NOT an application command (or autocomplete).
+func BindOptions(interaction *disgo.Interaction, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind options: expected a struct pointer, received %T", dst)
+	}
+
+	if interaction.Type != disgo.FlagInteractionTypeAPPLICATION_COMMAND &&
+		interaction.Type != disgo.FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE {
+		return fmt.Errorf("bind options: %w: interaction type %d", ErrNotCommand, interaction.Type)
+	}
+
+	data, ok := interaction.Data.(*disgo.ApplicationCommandData)
+	if !ok {
+		return fmt.Errorf("bind options: %w: interaction data %T", ErrNotCommand, interaction.Data)
+	}
+
+	_, options := CommandPath(data)
+
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+
+		name, ok := field.Tag.Lookup(optionTag)
+		if !ok || !field.IsExported() {
+			continue
+		}
+
+		for _, option := range options {
+			if option.Name != name || option.Value == nil {
+				continue
+			}
+
+			if err := bindOption(v.Field(i), option, data.Resolved); err != nil {
+				return fmt.Errorf("bind options: option %q: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// bindOption binds an option to a field.
+func bindOption(field reflect.Value, option *disgo.ApplicationCommandInteractionDataOption, resolved *disgo.ResolvedData) error {
+	value := option.Value.String()
+
+	// bind resolved objects.
+	switch field.Type() {
+	case typeUser, typeMember, typeRole, typeChannel, typeAttachment:
+		object, ok := resolve(field.Type(), value, resolved)
+		if !ok {
+			return fmt.Errorf("resolved %v %q not found", field.Type().Elem(), value)
+		}
+
+		field.Set(object)
+
+		return nil
+	}
+
+	// bind optional values.
+	if field.Kind() == reflect.Pointer {
+		ptr := reflect.New(field.Type().Elem())
+		if err := bindValue(ptr.Elem(), value); err != nil {
+			return err
+		}
+
+		field.Set(ptr)
+
+		return nil
+	}
+
+	return bindValue(field, value)
+}
+
+// resolve returns the resolved object of the given type with the given ID.
+func resolve(t reflect.Type, id string, resolved *disgo.ResolvedData) (reflect.Value, bool) {
+	if resolved == nil {
+		return reflect.Value{}, false
+	}
+
+	var object any
+	var ok bool
+
+	switch t {
+	case typeUser:
+		object, ok = resolved.Users[id]
+
+	case typeMember:
+		var member *disgo.GuildMember
+		if member, ok = resolved.Members[id]; ok {
+			// a resolved member does NOT contain its user.
+			copied := *member
+			if copied.User == nil {
+				copied.User = resolved.Users[id]
+			}
+
+			object = &copied
+		}
+
+	case typeRole:
+		object, ok = resolved.Roles[id]
+
+	case typeChannel:
+		object, ok = resolved.Channels[id]
+
+	case typeAttachment:
+		object, ok = resolved.Attachments[id]
+	}
+
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	return reflect.ValueOf(object), true
+}
+
+// bindValue binds an option value to a field of a basic kind.
+func bindValue(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		field.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		field.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		field.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		field.SetFloat(n)
+
+	default:
+		return fmt.Errorf("unsupported field type %v", field.Type())
+	}
+
+	return nil
+}
+
+// ErrorCooldown represents an error that occurs when a user uses a command during its cooldown.
+type ErrorCooldown struct {
+	// Path represents the command path of the command.
+	Path string
+
+	// UserID represents the ID of the user.
+	UserID string
+
+	// Remaining represents the remaining duration of the cooldown.
+	Remaining time.Duration
+}
+
+func (e ErrorCooldown) Error() string {
+	return fmt.Sprintf("command %q is on cooldown for user %q: %v remaining", e.Path, e.UserID, e.Remaining)
+}
+
+// Cooldown returns middleware that prevents a user from using a command
+// more than once per the given duration.
+func Cooldown(duration time.Duration) CommandMiddleware {
+	var mu sync.Mutex
+
+	uses := make(map[string]time.Time)
+
+	return func(next CommandHandler) CommandHandler {
+		return func(bot *disgo.Client, interaction *disgo.Interaction) error {
+			path, _ := CommandPath(interaction.ApplicationCommand())
+			userID := InteractionUserID(interaction)
+			key := path + "\x00" + userID
+			now := time.Now()
+
+			mu.Lock()
+			if last, ok := uses[key]; ok && now.Sub(last) < duration {
+				mu.Unlock()
+
+				return ErrorCooldown{Path: path, UserID: userID, Remaining: duration - now.Sub(last)}
+			}
+
+			uses[key] = now
+
+			// remove expired cooldowns.
+			for k, last := range uses {
+				if now.Sub(last) >= duration {
+					delete(uses, k)
+				}
+			}
+			mu.Unlock()
+
+			return next(bot, interaction)
+		}
+	}
+}
+
+// ErrorMemberPermissions represents an error that occurs when a member uses a command
+// without the permissions that it requires.
+type ErrorMemberPermissions struct {
+	// Path represents the command path of the command.
+	Path string
+
+	// Missing represents the permissions that the member is missing.
+	Missing disgo.BitFlag
+}
+
+func (e ErrorMemberPermissions) Error() string {
+	return fmt.Sprintf("command %q requires missing permissions %v", e.Path, disgo.PermissionNames(e.Missing))
+}
+
+// RequirePermissions returns middleware that prevents a member from using a command
+// without the given permissions (in the channel of the interaction).
+//
+// A command used outside of a guild is NOT checked.
+func RequirePermissions(permissions disgo.BitFlag) CommandMiddleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(bot *disgo.Client, interaction *disgo.Interaction) error {
+			if interaction.Member != nil {
+				var granted disgo.BitFlag
+				if interaction.Member.Permissions != nil {
+					var err error
+					if granted, err = disgo.ParsePermissions(*interaction.Member.Permissions); err != nil {
+						return fmt.Errorf("member %w", err)
+					}
+				}
+
+				if !disgo.HasPermissions(granted, permissions) {
+					path, _ := CommandPath(interaction.ApplicationCommand())
+
+					return ErrorMemberPermissions{Path: path, Missing: disgo.MissingPermissions(granted, permissions)}
+				}
+			}
+
+			return next(bot, interaction)
+		}
+	}
+}
+
+// InteractionUserID returns the ID of the user that invoked an interaction.
+func InteractionUserID(interaction *disgo.Interaction) string {
+	if interaction.Member != nil && interaction.Member.User != nil {
+		return interaction.Member.User.ID
+	}
+
+	if interaction.User != nil {
+		return interaction.User.ID
+	}
+
+	return ""
+}
@@ -0,0 +1,352 @@
+package tools
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/switchupcb/disgo"
+)
+
+// ComponentHandler represents a handler for a message component (or modal submit) interaction.
+type ComponentHandler func(bot *disgo.Client, request *ComponentRequest) error
+
+// ComponentRequest represents a message component (or modal submit) interaction
+// that is routed to a ComponentHandler.
+type ComponentRequest struct {
+	// Interaction represents the interaction.
+	Interaction *disgo.Interaction
+
+	// Params represents the parameters of the custom_id (by name).
+	Params map[string]string
+
+	// State represents the state of the custom_id's {state} parameter (if applicable).
+	State any
+
+	// CustomID represents the custom_id of the component (or modal).
+	CustomID string
+}
+
+// ComponentRouter routes message component and modal submit interactions to handlers by custom_id pattern.
+//
+// A pattern is a list of segments separated by a colon, where each segment is either a literal
+// or a {parameter} (i.e., "vote:{pollID}:{choice}"). The {state} parameter is reserved for the ID
+// of the state that is attached to a message using Attach.
+type ComponentRouter struct {
+	// ErrorHandler handles the errors returned by the router's handlers.
+	//
+	// Errors are logged when the ErrorHandler is nil.
+	ErrorHandler func(bot *disgo.Client, interaction *disgo.Interaction, err error)
+
+	// states represents a map of state IDs to the state attached to messages.
+	states map[string]*ComponentState
+
+	// routes represents the routes of the router (in order of addition).
+	routes []componentRoute
+
+	mu sync.RWMutex
+}
+
+// componentRoute represents a route from a custom_id pattern to a handler.
+type componentRoute struct {
+	handler  ComponentHandler
+	segments []string
+}
+
+const (
+	// ComponentStateParam represents the custom_id parameter that contains the ID of a ComponentState.
+	ComponentStateParam = "state"
+
+	// customIDSeparator represents the separator between the segments of a custom_id.
+	customIDSeparator = ":"
+
+	// componentStateIDLength represents the amount of random bytes in the ID of a ComponentState,
+	// such that the ID (hex encoded) fits in the 100 character custom_id limit with room to spare.
+	componentStateIDLength = 6
+)
+
+var (
+	// ErrComponentNotFound represents an error that occurs when no handler is routed to a custom_id.
+	ErrComponentNotFound = errors.New("component not found")
+
+	// ErrComponentExpired represents an error that occurs when the state of a custom_id has expired.
+	ErrComponentExpired = errors.New("component expired")
+)
+
+// NewComponentRouter returns a new ComponentRouter.
+func NewComponentRouter() *ComponentRouter {
+	return &ComponentRouter{ //nolint:exhaustruct
+		states: make(map[string]*ComponentState),
+	}
+}
+
+// Handle routes custom IDs that match the given pattern to a handler.
+//
+//	router.Handle("vote:{pollID}:{choice}", handler)
+func (r *ComponentRouter) Handle(pattern string, handler ComponentHandler) {
+	r.mu.Lock()
+	r.routes = append(r.routes, componentRoute{
+		handler:  handler,
+		segments: strings.Split(pattern, customIDSeparator),
+	})
+	r.mu.Unlock()
+}
+
+// Route calls the handler of a message component or modal submit interaction's custom_id.
+func (r *ComponentRouter) Route(bot *disgo.Client, interaction *disgo.Interaction) error {
+	var customID string
+
+	switch interaction.Type {
+	case disgo.FlagInteractionTypeMESSAGE_COMPONENT:
+		customID = interaction.MessageComponent().CustomID
+
+	case disgo.FlagInteractionTypeMODAL_SUBMIT:
+		customID = interaction.ModalSubmit().CustomID
+
+	default:
+		return fmt.Errorf("%w: interaction type %d", ErrComponentNotFound, interaction.Type)
+	}
+
+	request := &ComponentRequest{
+		Interaction: interaction,
+		Params:      nil,
+		State:       nil,
+		CustomID:    customID,
+	}
+
+	handler, err := r.match(request)
+	if err != nil {
+		return err
+	}
+
+	return handler(bot, request)
+}
+
+// match returns the handler of a request's custom_id, then sets the request's parameters and state.
+func (r *ComponentRouter) match(request *ComponentRequest) (ComponentHandler, error) {
+	segments := strings.Split(request.CustomID, customIDSeparator)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, route := range r.routes {
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+
+		request.Params = params
+
+		if id, ok := params[ComponentStateParam]; ok {
+			state, ok := r.states[id]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", ErrComponentExpired, request.CustomID)
+			}
+
+			request.State = state.Value
+		}
+
+		return route.handler, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrComponentNotFound, request.CustomID)
+}
+
+// match returns the parameters of the given custom_id segments when they match the route.
+func (route componentRoute) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(route.segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range route.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[segment[1:len(segment)-1]] = segments[i]
+
+			continue
+		}
+
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// Handler returns an InteractionCreate event handler that routes
+// the message component and modal submit interactions of the bot.
+//
+//	bot.Handle(disgo.FlagGatewayEventNameInteractionCreate, router.Handler(bot))
+func (r *ComponentRouter) Handler(bot *disgo.Client) func(*disgo.InteractionCreate) {
+	return func(i *disgo.InteractionCreate) {
+		if i.Interaction.Type != disgo.FlagInteractionTypeMESSAGE_COMPONENT &&
+			i.Interaction.Type != disgo.FlagInteractionTypeMODAL_SUBMIT {
+			return
+		}
+
+		if err := r.Route(bot, i.Interaction); err != nil {
+			if r.ErrorHandler != nil {
+				r.ErrorHandler(bot, i.Interaction, err)
+
+				return
+			}
+
+			disgo.Logger.Error().Str(disgo.LogCtxClient, bot.ApplicationID).Err(err).Msg("")
+		}
+	}
+}
+
+// ComponentState represents server-side state that is attached to the components
+// of an interaction's original response (message).
+type ComponentState struct {
+	// Value represents the value of the state.
+	Value any
+
+	// timer represents the timer that expires the state.
+	//
+	// timer is protected by the router's mutex.
+	timer *time.Timer
+
+	// ID represents the ID of the state, which is used as the {state} parameter of a custom_id.
+	ID string
+}
+
+// Attach attaches state to the original response of an interaction for the given TTL,
+// then returns the state (with an ID to use as the {state} parameter of a custom_id).
+//
+// Once the TTL passes, the state is removed and the components of the original response
+// (from GetOriginalInteractionResponse) are disabled using EditOriginalInteractionResponse. The TTL must be less than the 15 minute lifetime
+// of the interaction's token.
+func (r *ComponentRouter) Attach(bot *disgo.Client, interaction *disgo.Interaction, value any, ttl time.Duration) (*ComponentState, error) {
+	id := make([]byte, componentStateIDLength)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("component state: %w", err)
+	}
+
+	state := &ComponentState{ //nolint:exhaustruct
+		Value: value,
+		ID:    hex.EncodeToString(id),
+	}
+
+	// the timer is set while the state is published, such that it can be detached (or expired) immediately.
+	r.mu.Lock()
+	r.states[state.ID] = state
+	state.timer = time.AfterFunc(ttl, func() {
+		r.expire(bot, interaction, state)
+	})
+	r.mu.Unlock()
+
+	return state, nil
+}
+
+// Detach removes state (without disabling its components).
+func (r *ComponentRouter) Detach(state *ComponentState) {
+	r.mu.Lock()
+	delete(r.states, state.ID)
+	timer := state.timer
+	r.mu.Unlock()
+
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// expire removes state, then disables the components of the message it's attached to.
+func (r *ComponentRouter) expire(bot *disgo.Client, interaction *disgo.Interaction, state *ComponentState) {
+	r.mu.Lock()
+	_, attached := r.states[state.ID]
+	delete(r.states, state.ID)
+	r.mu.Unlock()
+
+	// a detached state does NOT disable its components.
+	if !attached {
+		return
+	}
+
+	if err := disable(bot, interaction); err != nil {
+		err = fmt.Errorf("component state %q: disable components: %w", state.ID, err)
+		if r.ErrorHandler != nil {
+			r.ErrorHandler(bot, interaction, err)
+
+			return
+		}
+
+		disgo.Logger.Error().Str(disgo.LogCtxClient, bot.ApplicationID).Err(err).Msg("")
+	}
+}
+
+// disable disables the components of an interaction's original response.
+func disable(bot *disgo.Client, interaction *disgo.Interaction) error {
+	original := &disgo.GetOriginalInteractionResponse{ //nolint:exhaustruct
+		InteractionToken: interaction.Token,
+	}
+
+	message, err := original.Send(bot)
+	if err != nil {
+		return err
+	}
+
+	components := DisableComponents(message.Components)
+	if len(components) == 0 {
+		return nil
+	}
+
+	request := &disgo.EditOriginalInteractionResponse{ //nolint:exhaustruct
+		ApplicationID:    interaction.ApplicationID,
+		InteractionToken: interaction.Token,
+		Components:       &components,
+	}
+
+	_, err = request.Send(bot)
+
+	return err
+}
+
+// DisableComponents returns copies of the given components (and their child components) that are disabled.
+func DisableComponents(components []disgo.Component) []disgo.Component {
+	if len(components) == 0 {
+		return nil
+	}
+
+	disabled := make([]disgo.Component, len(components))
+	for i, component := range components {
+		switch c := component.(type) {
+		case *disgo.ActionRow:
+			row := *c
+			row.Components = DisableComponents(c.Components)
+			disabled[i] = &row
+
+		case *disgo.Button:
+			button := *c
+			button.Disabled = disgo.Pointer(true)
+			disabled[i] = &button
+
+		case *disgo.SelectMenu:
+			menu := *c
+			menu.Disabled = disgo.Pointer(true)
+			disabled[i] = &menu
+
+		case disgo.ActionRow:
+			c.Components = DisableComponents(c.Components)
+			disabled[i] = &c
+
+		case disgo.Button:
+			c.Disabled = disgo.Pointer(true)
+			disabled[i] = &c
+
+		case disgo.SelectMenu:
+			c.Disabled = disgo.Pointer(true)
+			disabled[i] = &c
+
+		default:
+			disabled[i] = component
+		}
+	}
+
+	return disabled
+}
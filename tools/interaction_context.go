@@ -0,0 +1,350 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Interaction Response Limits
+// https://discord.com/developers/docs/interactions/receiving-and-responding#responding-to-an-interaction
+const (
+	// interactionAutoDefer represents the amount of time an InteractionContext waits for an initial response,
+	// before the interaction is deferred, which is less than the 3 seconds Discord waits for an initial response.
+	interactionAutoDefer = time.Millisecond * 2500
+
+	// interactionTokenLifetime represents the amount of time an interaction token is valid for.
+	interactionTokenLifetime = time.Minute * 15
+)
+
+// Interaction Response States
+const (
+	InteractionStatePending  = 0
+	InteractionStateDeferred = 1
+	InteractionStateReplied  = 2
+)
+
+// InteractionStates represents a map of interaction response states to names.
+var InteractionStates = map[int]string{
+	InteractionStatePending:  "pending",
+	InteractionStateDeferred: "deferred",
+	InteractionStateReplied:  "replied",
+}
+
+var (
+	// ErrInteractionAcknowledged represents an error that occurs when an initial response is sent
+	// to an interaction that is already acknowledged (deferred or replied to).
+	ErrInteractionAcknowledged = errors.New("interaction already acknowledged")
+
+	// ErrInteractionExpired represents an error that occurs when a response is sent
+	// to an interaction with an expired token.
+	ErrInteractionExpired = errors.New("interaction token expired")
+)
+
+// InteractionContext represents an interaction that tracks the state of its response.
+//
+// An InteractionContext defers its interaction when no initial response is sent before
+// the 3 second deadline, and sends each reply to the interaction using the endpoint
+// that corresponds to its state (i.e., a reply to a deferred interaction edits the original response,
+// while a reply to a replied interaction creates a followup message).
+type InteractionContext struct {
+	// expires represents the time that the interaction token expires.
+	expires time.Time
+
+	// Bot represents the bot that responds to the interaction.
+	Bot *disgo.Client
+
+	// Interaction represents the interaction.
+	Interaction *disgo.Interaction
+
+	// timer represents the timer that defers the interaction.
+	timer *time.Timer
+
+	// state represents the state of the interaction's response.
+	state int
+
+	// ephemeral represents whether an automatic deferral is ephemeral.
+	ephemeral bool
+
+	mu sync.Mutex
+}
+
+// NewInteractionContext returns a new InteractionContext for an interaction, which is deferred
+// (ephemerally when specified) when an initial response is NOT sent before the 3 second deadline.
+//
+// The deadline is calculated from the time the interaction is created (using its ID),
+// such that time spent before the InteractionContext is created counts against it.
+//
+// An autocomplete interaction is NOT deferred, since it can NOT be deferred.
+//
+//	ictx := tools.NewInteractionContext(bot, i.Interaction, false)
+//	defer ictx.Done()
+func NewInteractionContext(bot *disgo.Client, interaction *disgo.Interaction, ephemeral bool) *InteractionContext {
+	created := interactionCreatedAt(interaction)
+	c := &InteractionContext{ //nolint:exhaustruct
+		expires:     created.Add(interactionTokenLifetime),
+		Bot:         bot,
+		Interaction: interaction,
+		ephemeral:   ephemeral,
+	}
+
+	if interaction.Type != disgo.FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE {
+		c.timer = time.AfterFunc(time.Until(created.Add(interactionAutoDefer)), c.autoDefer)
+	}
+
+	return c
+}
+
+// interactionCreatedAt returns the time an interaction is created at using the timestamp of its ID.
+//
+// The current time is returned when the ID is NOT a snowflake.
+func interactionCreatedAt(interaction *disgo.Interaction) time.Time {
+	id, err := disgo.ParseSnowflake(interaction.ID)
+	if err != nil {
+		return time.Now()
+	}
+
+	return id.Time()
+}
+
+// State returns the state of the interaction's response.
+func (c *InteractionContext) State() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.state
+}
+
+// Acknowledged returns whether the interaction is deferred or replied to.
+func (c *InteractionContext) Acknowledged() bool {
+	return c.State() != InteractionStatePending
+}
+
+// ExpiresAt returns the time that the interaction token expires.
+func (c *InteractionContext) ExpiresAt() time.Time {
+	return c.expires
+}
+
+// Expired returns whether the interaction token is expired.
+func (c *InteractionContext) Expired() bool {
+	return !time.Now().Before(c.expires)
+}
+
+// Done stops the automatic deferral of the interaction.
+//
+// Done should be called once the handler of the interaction returns.
+func (c *InteractionContext) Done() {
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+}
+
+// autoDefer defers the interaction when an initial response is NOT sent.
+func (c *InteractionContext) autoDefer() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != InteractionStatePending || c.Expired() {
+		return
+	}
+
+	if err := c.deferResponse(c.ephemeral); err != nil {
+		disgo.Logger.Error().Str(disgo.LogCtxClient, c.Bot.ApplicationID).
+			Str("interaction", c.Interaction.ID).Err(err).Msg("automatic deferral")
+	}
+}
+
+// Defer defers the interaction (ephemerally when specified).
+//
+// A message component interaction is deferred without a loading state,
+// such that its message can be edited using a reply.
+func (c *InteractionContext) Defer(ephemeral bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(); err != nil {
+		return err
+	}
+
+	if c.state != InteractionStatePending {
+		return fmt.Errorf("%w: %q is %s", ErrInteractionAcknowledged, c.Interaction.ID, InteractionStates[c.state])
+	}
+
+	return c.deferResponse(ephemeral)
+}
+
+// deferResponse sends a deferred initial response to the interaction.
+func (c *InteractionContext) deferResponse(ephemeral bool) error {
+	response := &disgo.InteractionResponse{ //nolint:exhaustruct
+		Type: disgo.FlagInteractionCallbackTypeDEFERRED_CHANNEL_MESSAGE_WITH_SOURCE,
+	}
+
+	switch {
+	case c.Interaction.Type == disgo.FlagInteractionTypeMESSAGE_COMPONENT:
+		response.Type = disgo.FlagInteractionCallbackTypeDEFERRED_UPDATE_MESSAGE
+
+	case ephemeral:
+		response.Data = &disgo.Messages{Flags: disgo.Pointer(disgo.FlagMessageEPHEMERAL)} //nolint:exhaustruct
+	}
+
+	return c.respond(response)
+}
+
+// Respond sends an initial response to the interaction (i.e., a modal or autocomplete result).
+func (c *InteractionContext) Respond(response *disgo.InteractionResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(); err != nil {
+		return err
+	}
+
+	if c.state != InteractionStatePending {
+		return fmt.Errorf("%w: %q is %s", ErrInteractionAcknowledged, c.Interaction.ID, InteractionStates[c.state])
+	}
+
+	return c.respond(response)
+}
+
+// respond sends an initial response to the interaction, then updates the state of the interaction.
+func (c *InteractionContext) respond(response *disgo.InteractionResponse) error {
+	request := &disgo.CreateInteractionResponse{
+		InteractionID:       c.Interaction.ID,
+		InteractionToken:    c.Interaction.Token,
+		InteractionResponse: response,
+	}
+
+	if err := request.Send(c.Bot); err != nil {
+		return fmt.Errorf("interaction %q: %w", c.Interaction.ID, err)
+	}
+
+	switch response.Type {
+	case disgo.FlagInteractionCallbackTypeDEFERRED_CHANNEL_MESSAGE_WITH_SOURCE,
+		disgo.FlagInteractionCallbackTypeDEFERRED_UPDATE_MESSAGE:
+		c.state = InteractionStateDeferred
+
+	default:
+		c.state = InteractionStateReplied
+	}
+
+	return nil
+}
+
+// Reply replies to the interaction with a message using the state of the interaction's response.
+//
+// A pending interaction is replied to with an initial response (which does NOT return a message).
+// A deferred interaction is replied to by editing its original response.
+// A replied interaction is replied to with a followup message.
+//
+// The message of a message component interaction is updated (instead of replied to)
+// until the interaction is replied to.
+func (c *InteractionContext) Reply(message *disgo.Messages) (*disgo.Message, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	switch c.state {
+	case InteractionStatePending:
+		response := &disgo.InteractionResponse{
+			Type: disgo.FlagInteractionCallbackTypeCHANNEL_MESSAGE_WITH_SOURCE,
+			Data: message,
+		}
+
+		if c.Interaction.Type == disgo.FlagInteractionTypeMESSAGE_COMPONENT {
+			response.Type = disgo.FlagInteractionCallbackTypeUPDATE_MESSAGE
+		}
+
+		return nil, c.respond(response)
+
+	case InteractionStateDeferred:
+		request := &disgo.EditOriginalInteractionResponse{ //nolint:exhaustruct
+			ApplicationID:    c.Interaction.ApplicationID,
+			InteractionToken: c.Interaction.Token,
+		}
+
+		if message.Content != nil {
+			request.Content = &message.Content
+		}
+
+		if message.Embeds != nil {
+			request.Embeds = &message.Embeds
+		}
+
+		if message.Components != nil {
+			request.Components = &message.Components
+		}
+
+		if message.AllowedMentions != nil {
+			request.AllowedMentions = &message.AllowedMentions
+		}
+
+		if message.Attachments != nil {
+			request.Attachments = &message.Attachments
+		}
+
+		edited, err := request.Send(c.Bot)
+		if err != nil {
+			return nil, fmt.Errorf("interaction %q: %w", c.Interaction.ID, err)
+		}
+
+		c.state = InteractionStateReplied
+
+		return edited, nil
+
+	default:
+		return c.followup(message)
+	}
+}
+
+// Followup sends a followup message to an acknowledged interaction.
+func (c *InteractionContext) Followup(message *disgo.Messages) (*disgo.Message, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	if c.state == InteractionStatePending {
+		return nil, fmt.Errorf("interaction %q: a followup message requires an initial response", c.Interaction.ID)
+	}
+
+	return c.followup(message)
+}
+
+// followup sends a followup message to the interaction.
+func (c *InteractionContext) followup(message *disgo.Messages) (*disgo.Message, error) {
+	request := &disgo.CreateFollowupMessage{ //nolint:exhaustruct
+		ApplicationID:    c.Interaction.ApplicationID,
+		InteractionToken: c.Interaction.Token,
+		Content:          message.Content,
+		TTS:              message.TTS,
+		Embeds:           message.Embeds,
+		AllowedMentions:  message.AllowedMentions,
+		Components:       message.Components,
+		Attachments:      message.Attachments,
+		Flags:            message.Flags,
+	}
+
+	sent, err := request.Send(c.Bot)
+	if err != nil {
+		return nil, fmt.Errorf("interaction %q: %w", c.Interaction.ID, err)
+	}
+
+	return sent, nil
+}
+
+// check returns an error when the interaction token is expired.
+func (c *InteractionContext) check() error {
+	if c.Expired() {
+		return fmt.Errorf("%w: %q expired at %v", ErrInteractionExpired, c.Interaction.ID, c.expires)
+	}
+
+	return nil
+}
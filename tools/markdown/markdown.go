@@ -0,0 +1,189 @@
+// Package markdown formats, escapes, splits, and parses Discord-flavored text.
+//
+// https://discord.com/developers/docs/reference#message-formatting
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimestampStyle represents the style of a timestamp.
+type TimestampStyle string
+
+// Timestamp Styles
+// https://discord.com/developers/docs/reference#message-formatting-timestamp-styles
+const (
+	TimestampShortTime     TimestampStyle = "t" // 16:20
+	TimestampLongTime      TimestampStyle = "T" // 16:20:30
+	TimestampShortDate     TimestampStyle = "d" // 20/04/2021
+	TimestampLongDate      TimestampStyle = "D" // 20 April 2021
+	TimestampShortDateTime TimestampStyle = "f" // 20 April 2021 16:20
+	TimestampLongDateTime  TimestampStyle = "F" // Tuesday, 20 April 2021 16:20
+	TimestampRelative      TimestampStyle = "R" // 2 months ago
+)
+
+// User returns the mention of a user.
+func User(id string) string {
+	return "<@" + id + ">"
+}
+
+// Role returns the mention of a role.
+func Role(id string) string {
+	return "<@&" + id + ">"
+}
+
+// Channel returns the mention of a channel.
+func Channel(id string) string {
+	return "<#" + id + ">"
+}
+
+// Command returns the mention of an application command with the given command path
+// (i.e., "command", "command sub", "command group sub") and command ID.
+func Command(path, id string) string {
+	return "</" + strings.Join(strings.Fields(path), " ") + ":" + id + ">"
+}
+
+// Timestamp returns a timestamp that is displayed in the given style (or the default style when empty).
+func Timestamp(t time.Time, style TimestampStyle) string {
+	unix := strconv.FormatInt(t.Unix(), 10)
+	if style == "" {
+		return "<t:" + unix + ">"
+	}
+
+	return "<t:" + unix + ":" + string(style) + ">"
+}
+
+// Emoji represents a custom emoji.
+type Emoji struct {
+	// Name represents the name of the emoji.
+	Name string
+
+	// ID represents the ID of the emoji.
+	ID string
+
+	// Animated represents whether the emoji is animated.
+	Animated bool
+}
+
+// String returns the syntax of a custom emoji (i.e., <:name:id>).
+func (e Emoji) String() string {
+	if e.Animated {
+		return "<a:" + e.Name + ":" + e.ID + ">"
+	}
+
+	return "<:" + e.Name + ":" + e.ID + ">"
+}
+
+var (
+	// markdownEscaper escapes the characters that Discord uses for markdown.
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		`*`, `\*`,
+		`_`, `\_`,
+		`~`, `\~`,
+		"`", "\\`",
+		`|`, `\|`,
+		`>`, `\>`,
+		`#`, `\#`,
+		`-`, `\-`,
+		`[`, `\[`,
+		`]`, `\]`,
+	)
+
+	// mentionEscaper escapes mentions using a zero-width space, such that they do NOT mention anyone.
+	mentionEscaper = strings.NewReplacer("@", "@\u200b")
+)
+
+// Escape escapes the markdown of text, such that it's displayed as is.
+func Escape(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// EscapeMentions escapes the mentions of text (i.e., @everyone, @here, <@id>),
+// such that they are displayed without mentioning anyone.
+func EscapeMentions(text string) string {
+	return mentionEscaper.Replace(text)
+}
+
+var (
+	userMention     = regexp.MustCompile(`<@!?(\d+)>`)
+	roleMention     = regexp.MustCompile(`<@&(\d+)>`)
+	channelMention  = regexp.MustCompile(`<#(\d+)>`)
+	everyoneMention = regexp.MustCompile(`@(everyone|here)\b`)
+	customEmoji     = regexp.MustCompile(`<(a?):(\w{2,32}):(\d+)>`)
+	timestamp       = regexp.MustCompile(`<t:(-?\d+)(?::([tTdDfFR]))?>`)
+)
+
+// Mentions represents the mentions of text.
+type Mentions struct {
+	// Users represents the IDs of the mentioned users (in order of appearance).
+	Users []string
+
+	// Roles represents the IDs of the mentioned roles (in order of appearance).
+	Roles []string
+
+	// Channels represents the IDs of the mentioned channels (in order of appearance).
+	Channels []string
+
+	// Everyone represents whether @everyone or @here is mentioned.
+	Everyone bool
+}
+
+// ParseMentions returns the mentions of text (i.e., Message.Content).
+//
+// The ID of a mention that appears more than once is only returned once.
+func ParseMentions(text string) Mentions {
+	return Mentions{
+		Users:    submatches(userMention, text),
+		Roles:    submatches(roleMention, text),
+		Channels: submatches(channelMention, text),
+		Everyone: everyoneMention.MatchString(text),
+	}
+}
+
+// submatches returns the unique first submatches of a regular expression in text.
+func submatches(re *regexp.Regexp, text string) []string {
+	var ids []string
+
+	seen := make(map[string]bool)
+	for _, match := range re.FindAllStringSubmatch(text, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			ids = append(ids, match[1])
+		}
+	}
+
+	return ids
+}
+
+// ParseEmojis returns the custom emojis of text (in order of appearance).
+func ParseEmojis(text string) []Emoji {
+	matches := customEmoji.FindAllStringSubmatch(text, -1)
+
+	emojis := make([]Emoji, len(matches))
+	for i, match := range matches {
+		emojis[i] = Emoji{Name: match[2], ID: match[3], Animated: match[1] == "a"}
+	}
+
+	return emojis
+}
+
+// ParseTimestamps returns the times of the timestamps of text (in order of appearance).
+func ParseTimestamps(text string) []time.Time {
+	matches := timestamp.FindAllStringSubmatch(text, -1)
+
+	times := make([]time.Time, 0, len(matches))
+	for _, match := range matches {
+		unix, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		times = append(times, time.Unix(unix, 0))
+	}
+
+	return times
+}
@@ -0,0 +1,178 @@
+package markdown
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MessageContentLimit represents the maximum amount of characters in the content of a message.
+	MessageContentLimit = 2000
+
+	// codeFence represents the delimiter of a code block.
+	codeFence = "```"
+
+	// closingFence represents the text that closes a code block at the end of a part.
+	closingFence = "\n" + codeFence
+
+	// MinSplitLimit represents the minimum limit of a part, which fits a reopened code block,
+	// a code fence, and the fence that closes the code block.
+	MinSplitLimit = len(codeFence+"\n") + len(codeFence) + len(closingFence)
+)
+
+// Split splits content into parts that fit within the given limit of characters
+// (or MessageContentLimit when the limit is NOT positive). A limit less than MinSplitLimit
+// is raised to MinSplitLimit.
+//
+// Content is split at the end of a line when possible. A code block that is split
+// is closed at the end of a part, then reopened (with its language when it fits) in the next part.
+func Split(content string, limit int) []string {
+	if limit <= 0 {
+		limit = MessageContentLimit
+	}
+
+	if limit < MinSplitLimit {
+		limit = MinSplitLimit
+	}
+
+	if utf8.RuneCountInString(content) <= limit {
+		return []string{content}
+	}
+
+	s := &splitter{limit: limit} //nolint:exhaustruct
+
+	for _, line := range strings.SplitAfter(content, "\n") {
+		s.add(line)
+	}
+
+	s.flush(false)
+
+	return s.parts
+}
+
+// splitter represents the state of content that is split into parts.
+type splitter struct {
+	// fence represents the opening fence (with its language) of the current code block.
+	fence string
+
+	// parts represents the parts of the content.
+	parts []string
+
+	// part represents the current part.
+	part strings.Builder
+
+	// length represents the amount of characters in the current part.
+	length int
+
+	// reopened represents the amount of characters used to reopen a code block in the current part.
+	reopened int
+
+	// limit represents the maximum amount of characters in a part.
+	limit int
+}
+
+// add adds a line to the current part, then tracks the code block of the line.
+func (s *splitter) add(line string) {
+	for {
+		// reserve space to close a code block that is open after the line.
+		if utf8.RuneCountInString(line) <= s.space(s.open(line)) {
+			break
+		}
+
+		// start a new part for the line.
+		if s.length > s.reopened {
+			s.flush(true)
+
+			continue
+		}
+
+		// split a line that can NOT fit in an empty part (without splitting a code fence).
+		runes := []rune(line)
+
+		split := s.space(true)
+		if start := fenceStart(runes, split); start > 0 {
+			split = start
+		}
+
+		s.writeLine(string(runes[:split]))
+		s.flush(true)
+
+		line = string(runes[split:])
+	}
+
+	s.writeLine(line)
+}
+
+// space returns the amount of characters that fit in the current part,
+// with space reserved to close a code block when specified.
+func (s *splitter) space(closing bool) int {
+	space := s.limit - s.length
+	if closing {
+		space -= len(closingFence)
+	}
+
+	return space
+}
+
+// open determines whether a code block is open after the given text is written.
+func (s *splitter) open(text string) bool {
+	return (s.fence != "") != (strings.Count(text, codeFence)%2 == 1)
+}
+
+// writeLine writes a line (or a fragment of a line) to the current part, then tracks its code block.
+func (s *splitter) writeLine(text string) {
+	s.write(text)
+
+	// text with an odd amount of code fences opens (or closes) a code block.
+	if strings.Count(text, codeFence)%2 == 1 {
+		if s.fence != "" {
+			s.fence = ""
+		} else {
+			s.fence = strings.TrimSpace(text[strings.LastIndex(text, codeFence):])
+		}
+	}
+}
+
+// fenceStart returns the index of the code fence that is split at the given index (or -1).
+func fenceStart(runes []rune, index int) int {
+	for start := index - len(codeFence) + 1; start < index; start++ {
+		if start >= 0 && start+len(codeFence) <= len(runes) && string(runes[start:start+len(codeFence)]) == codeFence {
+			return start
+		}
+	}
+
+	return -1
+}
+
+// write writes text to the current part.
+func (s *splitter) write(text string) {
+	s.part.WriteString(text)
+	s.length += utf8.RuneCountInString(text)
+}
+
+// flush adds the current part to the parts (closing its code block),
+// then starts a new part (reopening the code block when specified).
+func (s *splitter) flush(reopen bool) {
+	part := strings.TrimSuffix(s.part.String(), "\n")
+	if s.fence != "" {
+		part += closingFence
+	}
+
+	if strings.TrimSpace(part) != "" {
+		s.parts = append(s.parts, part)
+	}
+
+	s.part.Reset()
+	s.length, s.reopened = 0, 0
+
+	if reopen && s.fence != "" {
+		// a language that doesn't fit with a code fence and the closing fence is NOT reopened.
+		fence := s.fence
+		if utf8.RuneCountInString(fence+"\n")+len(codeFence)+len(closingFence) > s.limit {
+			fence = codeFence
+		}
+
+		s.write(fence + "\n")
+		s.reopened = s.length
+	}
+}
@@ -0,0 +1,339 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Modal Struct Tags
+//
+// A field of a modal struct is a text input when it has a `modal` tag (which contains its custom_id),
+// and must be a string (or *string).
+//
+//	type Feedback struct {
+//		Title string  `modal:"title" label:"Title" min:"1" max:"45" required:"true"`
+//		Body  *string `modal:"body" label:"Feedback" style:"paragraph" placeholder:"Your feedback..."`
+//	}
+const (
+	modalTagCustomID    = "modal"
+	modalTagLabel       = "label"
+	modalTagStyle       = "style"
+	modalTagMin         = "min"
+	modalTagMax         = "max"
+	modalTagRequired    = "required"
+	modalTagPlaceholder = "placeholder"
+)
+
+// Modal Limits
+// https://discord.com/developers/docs/interactions/message-components#text-inputs
+const (
+	maxModalTextInputs = 5
+	maxModalTitle      = 45
+	maxTextInputLabel  = 45
+)
+
+// ModalFieldError represents a field of a modal submission that is NOT valid.
+type ModalFieldError struct {
+	// Field represents the name of the struct field.
+	Field string
+
+	// CustomID represents the custom_id of the text input.
+	CustomID string
+
+	// Reason represents the reason the field is NOT valid.
+	Reason string
+}
+
+// ErrorModal represents an error that occurs when the values of a modal submission are NOT valid.
+type ErrorModal struct {
+	// Fields represents the fields that are NOT valid.
+	Fields []ModalFieldError
+}
+
+func (e ErrorModal) Error() string {
+	reasons := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		reasons[i] = fmt.Sprintf("%s: %s", field.CustomID, field.Reason)
+	}
+
+	return "invalid modal submission: " + strings.Join(reasons, "; ")
+}
+
+// modalField represents a field of a modal struct.
+type modalField struct {
+	name      string
+	customID  string
+	label     string
+	style     disgo.Flag
+	min       *int
+	max       *int
+	required  bool
+	pointer   bool
+	input     *disgo.TextInput
+	reference reflect.Value
+}
+
+// modalFields returns the text input fields of a modal struct pointer.
+func modalFields(form any) ([]*modalField, error) {
+	v := reflect.ValueOf(form)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("modal: expected a struct pointer, received %T", form)
+	}
+
+	v = v.Elem()
+
+	var fields []*modalField
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+
+		customID, ok := field.Tag.Lookup(modalTagCustomID)
+		if !ok || !field.IsExported() {
+			continue
+		}
+
+		f := &modalField{ //nolint:exhaustruct
+			name:      field.Name,
+			customID:  customID,
+			label:     field.Tag.Get(modalTagLabel),
+			style:     disgo.FlagTextInputStyleShort,
+			required:  field.Tag.Get(modalTagRequired) == "true",
+			reference: v.Field(i),
+		}
+
+		switch {
+		case field.Type.Kind() == reflect.String:
+		case field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.String:
+			f.pointer = true
+		default:
+			return nil, fmt.Errorf("modal: field %s: unsupported type %v", field.Name, field.Type)
+		}
+
+		if f.label == "" {
+			f.label = field.Name
+		}
+
+		if utf8.RuneCountInString(f.label) > maxTextInputLabel {
+			return nil, fmt.Errorf("modal: field %s: label exceeds %d characters", field.Name, maxTextInputLabel)
+		}
+
+		if field.Tag.Get(modalTagStyle) == "paragraph" {
+			f.style = disgo.FlagTextInputStyleParagraph
+		}
+
+		for tag, length := range map[string]**int{modalTagMin: &f.min, modalTagMax: &f.max} {
+			if value, ok := field.Tag.Lookup(tag); ok {
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("modal: field %s: %s: %w", field.Name, tag, err)
+				}
+
+				*length = &n
+			}
+		}
+
+		f.input = &disgo.TextInput{
+			Type:      disgo.FlagComponentTypeTextInput,
+			Style:     f.style,
+			CustomID:  f.customID,
+			Label:     disgo.Pointer(f.label),
+			MinLength: f.min,
+			MaxLength: f.max,
+			Required:  disgo.Pointer(f.required),
+			Value:     nil,
+		}
+
+		if placeholder, ok := field.Tag.Lookup(modalTagPlaceholder); ok {
+			f.input.Placeholder = disgo.Pointer(placeholder)
+		}
+
+		fields = append(fields, f)
+	}
+
+	if len(fields) == 0 || len(fields) > maxModalTextInputs {
+		return nil, fmt.Errorf("modal: expected 1 to %d text input fields, received %d", maxModalTextInputs, len(fields))
+	}
+
+	return fields, nil
+}
+
+// NewModal returns a modal with a text input for each field of the given struct pointer.
+//
+// The current (non-empty) value of a field is used as the value of its text input.
+func NewModal(customID, title string, form any) (*disgo.Modal, error) {
+	if utf8.RuneCountInString(title) > maxModalTitle {
+		return nil, fmt.Errorf("modal: title exceeds %d characters", maxModalTitle)
+	}
+
+	fields, err := modalFields(form)
+	if err != nil {
+		return nil, err
+	}
+
+	modal := &disgo.Modal{
+		CustomID:   customID,
+		Title:      title,
+		Components: make([]disgo.Component, len(fields)),
+	}
+
+	for i, field := range fields {
+		value := field.reference
+		if field.pointer {
+			value = value.Elem()
+		}
+
+		if value.IsValid() && value.String() != "" {
+			field.input.Value = disgo.Pointer(value.String())
+		}
+
+		// each text input is placed in its own action row.
+		modal.Components[i] = &disgo.ActionRow{
+			Type:       disgo.FlagComponentTypeActionRow,
+			Components: []disgo.Component{field.input},
+		}
+	}
+
+	return modal, nil
+}
+
+// DecodeModal decodes the values of a modal submission into the fields of the given struct pointer,
+// then validates them using the struct tags of each field.
+//
+// An ErrorModal is returned when a value is NOT valid.
+func DecodeModal(data *disgo.ModalSubmitData, form any) error {
+	fields, err := modalFields(form)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(fields))
+	textInputValues(values, data.Components)
+
+	var invalid []ModalFieldError
+
+	for _, field := range fields {
+		value, ok := values[field.customID]
+		length := utf8.RuneCountInString(value)
+
+		var reason string
+
+		switch {
+		case field.required && value == "":
+			reason = "is required"
+
+		case value != "" && field.min != nil && length < *field.min:
+			reason = fmt.Sprintf("must be at least %d characters", *field.min)
+
+		case field.max != nil && length > *field.max:
+			reason = fmt.Sprintf("must be at most %d characters", *field.max)
+		}
+
+		if reason != "" {
+			invalid = append(invalid, ModalFieldError{Field: field.name, CustomID: field.customID, Reason: reason})
+
+			continue
+		}
+
+		if field.pointer {
+			if ok && value != "" {
+				ptr := reflect.New(field.reference.Type().Elem())
+				ptr.Elem().SetString(value)
+				field.reference.Set(ptr)
+			} else {
+				field.reference.Set(reflect.Zero(field.reference.Type()))
+			}
+
+			continue
+		}
+
+		field.reference.SetString(value)
+	}
+
+	if len(invalid) != 0 {
+		return ErrorModal{Fields: invalid}
+	}
+
+	return nil
+}
+
+// textInputValues adds the values of the text inputs in the given components to a map of custom IDs to values.
+func textInputValues(values map[string]string, components []disgo.Component) {
+	for _, component := range components {
+		switch c := component.(type) {
+		case *disgo.ActionRow:
+			textInputValues(values, c.Components)
+
+		case *disgo.TextInput:
+			if c.Value != nil {
+				values[c.CustomID] = *c.Value
+			}
+		}
+	}
+}
+
+// AwaitModal responds to an interaction with a modal that is built from the given struct pointer,
+// then waits for the same user to submit the modal (until the context is done).
+//
+// The submitted values are decoded into the struct (see DecodeModal), and the modal submit
+// interaction is returned, such that it can be responded to. The modal submit interaction
+// is returned with an ErrorModal when a submitted value is NOT valid.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+//	defer cancel()
+//
+//	submit, err := tools.AwaitModal(ctx, bot, interaction, "Feedback", &feedback)
+func AwaitModal(ctx context.Context, bot *disgo.Client, interaction *disgo.Interaction, title string, form any) (*disgo.Interaction, error) {
+	customID := "modal:" + interaction.ID
+
+	modal, err := NewModal(customID, title, form)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := InteractionUserID(interaction)
+
+	// handle the submission prior to sending the modal, such that the submission is NOT missed.
+	submitted := make(chan *disgo.Interaction, 1)
+
+	sub := disgo.Listen(bot, func(i *disgo.InteractionCreate) {
+		if i.Interaction.Type != disgo.FlagInteractionTypeMODAL_SUBMIT ||
+			i.Interaction.ModalSubmit().CustomID != customID ||
+			InteractionUserID(i.Interaction) != userID {
+			return
+		}
+
+		select {
+		case submitted <- i.Interaction:
+		default:
+		}
+	})
+
+	defer sub.Cancel()
+
+	request := &disgo.CreateInteractionResponse{
+		InteractionID:    interaction.ID,
+		InteractionToken: interaction.Token,
+		InteractionResponse: &disgo.InteractionResponse{
+			Type: disgo.FlagInteractionCallbackTypeMODAL,
+			Data: modal,
+		},
+	}
+
+	if err := request.Send(bot); err != nil {
+		return nil, fmt.Errorf("modal: %w", err)
+	}
+
+	select {
+	case submit := <-submitted:
+		return submit, DecodeModal(submit.ModalSubmit(), form)
+
+	case <-ctx.Done():
+		return nil, fmt.Errorf("modal: %w", ctx.Err())
+	}
+}
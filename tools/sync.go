@@ -0,0 +1,459 @@
+package tools
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	json "github.com/goccy/go-json"
+	"github.com/switchupcb/disgo"
+)
+
+// CommandSync synchronizes the application commands that are registered with Discord
+// to the application commands that are declared in code.
+//
+// In contrast to BulkOverwriteGlobalApplicationCommands, CommandSync only sends the
+// Create, Edit, and Delete requests required to synchronize the commands, such that
+// the ID of an unchanged (or edited) command is kept.
+type CommandSync struct {
+	// Output represents the writer that a dry run writes its plan to (os.Stdout when nil).
+	Output io.Writer
+
+	// GuildID represents the ID of the guild to synchronize the commands of.
+	//
+	// Global commands are synchronized when the GuildID is empty.
+	GuildID string
+
+	// Commands represents the application commands declared in code.
+	Commands []*disgo.ApplicationCommand
+
+	// DryRun determines whether the plan is written to the Output instead of being applied.
+	DryRun bool
+}
+
+// CommandPlan represents the changes required to synchronize application commands.
+type CommandPlan struct {
+	// GuildID represents the ID of the guild of the commands (or empty for global commands).
+	GuildID string
+
+	// Create represents the commands that must be created.
+	Create []*disgo.ApplicationCommand
+
+	// Edit represents the commands that must be edited.
+	Edit []*CommandChange
+
+	// Delete represents the commands that must be deleted.
+	Delete []*disgo.ApplicationCommand
+
+	// Unchanged represents the commands that are already synchronized.
+	Unchanged []*disgo.ApplicationCommand
+}
+
+// CommandChange represents a change to a registered application command.
+type CommandChange struct {
+	// Current represents the registered command.
+	Current *disgo.ApplicationCommand
+
+	// Desired represents the command declared in code.
+	Desired *disgo.ApplicationCommand
+
+	// Fields represents the JSON names of the fields that differ between the commands.
+	Fields []string
+}
+
+// Sync synchronizes the registered application commands to the commands declared in code,
+// then returns the plan used to synchronize them.
+func (c *CommandSync) Sync(bot *disgo.Client) (*CommandPlan, error) {
+	plan, err := PlanCommands(bot, c.GuildID, c.Commands)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.DryRun {
+		output := c.Output
+		if output == nil {
+			output = os.Stdout
+		}
+
+		if _, err := io.WriteString(output, plan.String()); err != nil {
+			return plan, fmt.Errorf("command sync: dry run: %w", err)
+		}
+
+		return plan, nil
+	}
+
+	return plan, plan.Apply(bot)
+}
+
+// PlanCommands fetches the registered global (or guild) application commands of the bot,
+// then returns the plan required to synchronize them to the given commands.
+func PlanCommands(bot *disgo.Client, guildID string, commands []*disgo.ApplicationCommand) (*CommandPlan, error) {
+	var current []*disgo.ApplicationCommand
+	var err error
+
+	if guildID == "" {
+		request := &disgo.GetGlobalApplicationCommands{WithLocalizations: disgo.Pointer(true)}
+		current, err = request.Send(bot)
+	} else {
+		request := &disgo.GetGuildApplicationCommands{GuildID: guildID, WithLocalizations: disgo.Pointer(true)}
+		current, err = request.Send(bot)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("command sync: %w", err)
+	}
+
+	plan := DiffCommands(current, commands)
+	plan.GuildID = guildID
+
+	return plan, nil
+}
+
+// DiffCommands returns the plan required to synchronize the current application commands
+// to the desired application commands.
+//
+// Commands are matched by type and name.
+func DiffCommands(current, desired []*disgo.ApplicationCommand) *CommandPlan {
+	plan := new(CommandPlan)
+
+	registered := make(map[string]*disgo.ApplicationCommand, len(current))
+	for _, command := range current {
+		registered[commandKey(command)] = command
+	}
+
+	for _, command := range desired {
+		key := commandKey(command)
+
+		existing, ok := registered[key]
+		if !ok {
+			plan.Create = append(plan.Create, command)
+
+			continue
+		}
+
+		delete(registered, key)
+
+		if fields := diffCommand(existing, command); len(fields) != 0 {
+			plan.Edit = append(plan.Edit, &CommandChange{Current: existing, Desired: command, Fields: fields})
+		} else {
+			plan.Unchanged = append(plan.Unchanged, existing)
+		}
+	}
+
+	// delete the registered commands that are NOT declared (in order of registration).
+	for _, command := range current {
+		if _, ok := registered[commandKey(command)]; ok {
+			plan.Delete = append(plan.Delete, command)
+		}
+	}
+
+	return plan
+}
+
+// Empty determines whether the plan contains no changes.
+func (p *CommandPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Edit) == 0 && len(p.Delete) == 0
+}
+
+// String returns a readable representation of the plan.
+func (p *CommandPlan) String() string {
+	var b strings.Builder
+
+	scope := "global"
+	if p.GuildID != "" {
+		scope = "guild " + p.GuildID
+	}
+
+	fmt.Fprintf(&b, "command sync (%s): %d to create, %d to edit, %d to delete, %d unchanged\n",
+		scope, len(p.Create), len(p.Edit), len(p.Delete), len(p.Unchanged))
+
+	for _, command := range p.Create {
+		fmt.Fprintf(&b, "  + create %q\n", command.Name)
+	}
+
+	for _, change := range p.Edit {
+		fmt.Fprintf(&b, "  ~ edit %q (%s): %s\n", change.Current.Name, change.Current.ID, strings.Join(change.Fields, ", "))
+	}
+
+	for _, command := range p.Delete {
+		fmt.Fprintf(&b, "  - delete %q (%s)\n", command.Name, command.ID)
+	}
+
+	return b.String()
+}
+
+// Apply sends the requests required to apply the plan.
+func (p *CommandPlan) Apply(bot *disgo.Client) error {
+	for _, command := range p.Delete {
+		if err := p.delete(bot, command); err != nil {
+			return fmt.Errorf("command sync: delete %q: %w", command.Name, err)
+		}
+	}
+
+	for _, change := range p.Edit {
+		// an edit can NOT remove every option of a command (since empty options are omitted),
+		// so the command is overwritten by creating a command with the same name.
+		if len(change.Desired.Options) == 0 && len(change.Current.Options) != 0 {
+			if err := p.create(bot, change.Desired); err != nil {
+				return fmt.Errorf("command sync: edit %q: %w", change.Current.Name, err)
+			}
+
+			continue
+		}
+
+		if err := p.edit(bot, change.Current.ID, change.Desired); err != nil {
+			return fmt.Errorf("command sync: edit %q: %w", change.Current.Name, err)
+		}
+	}
+
+	for _, command := range p.Create {
+		if err := p.create(bot, command); err != nil {
+			return fmt.Errorf("command sync: create %q: %w", command.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// create sends a request to create a command.
+func (p *CommandPlan) create(bot *disgo.Client, command *disgo.ApplicationCommand) error {
+	var err error
+
+	if p.GuildID == "" {
+		request := &disgo.CreateGlobalApplicationCommand{
+			Name:                     command.Name,
+			NameLocalizations:        command.NameLocalizations,
+			Description:              disgo.Pointer(command.Description),
+			DescriptionLocalizations: command.DescriptionLocalizations,
+			Options:                  command.Options,
+			DefaultMemberPermissions: disgo.Pointer(command.DefaultMemberPermissions),
+			DMPermission:             disgo.Pointer(command.DMPermission),
+			Type:                     command.Type,
+			NSFW:                     command.NSFW,
+		}
+
+		_, err = request.Send(bot)
+	} else {
+		request := &disgo.CreateGuildApplicationCommand{
+			GuildID:                  p.GuildID,
+			Name:                     command.Name,
+			NameLocalizations:        command.NameLocalizations,
+			Description:              disgo.Pointer(command.Description),
+			DescriptionLocalizations: command.DescriptionLocalizations,
+			Options:                  command.Options,
+			DefaultMemberPermissions: disgo.Pointer(command.DefaultMemberPermissions),
+			Type:                     command.Type,
+			NSFW:                     command.NSFW,
+		}
+
+		_, err = request.Send(bot)
+	}
+
+	return err //nolint:wrapcheck
+}
+
+// edit sends a request to edit a command.
+func (p *CommandPlan) edit(bot *disgo.Client, commandID string, command *disgo.ApplicationCommand) error {
+	var err error
+
+	if p.GuildID == "" {
+		request := &disgo.EditGlobalApplicationCommand{
+			CommandID:                commandID,
+			Name:                     disgo.Pointer(command.Name),
+			NameLocalizations:        localizations(command.NameLocalizations),
+			Description:              disgo.Pointer(command.Description),
+			DescriptionLocalizations: localizations(command.DescriptionLocalizations),
+			Options:                  command.Options,
+			DefaultMemberPermissions: disgo.Pointer(command.DefaultMemberPermissions),
+			DMPermission:             disgo.Pointer(command.DMPermission),
+			NSFW:                     disgo.Pointer(command.NSFW != nil && *command.NSFW),
+		}
+
+		_, err = request.Send(bot)
+	} else {
+		request := &disgo.EditGuildApplicationCommand{
+			GuildID:                  p.GuildID,
+			CommandID:                commandID,
+			Name:                     disgo.Pointer(command.Name),
+			NameLocalizations:        localizations(command.NameLocalizations),
+			Description:              disgo.Pointer(command.Description),
+			DescriptionLocalizations: localizations(command.DescriptionLocalizations),
+			Options:                  command.Options,
+			DefaultMemberPermissions: disgo.Pointer(command.DefaultMemberPermissions),
+			NSFW:                     disgo.Pointer(command.NSFW != nil && *command.NSFW),
+		}
+
+		_, err = request.Send(bot)
+	}
+
+	return err //nolint:wrapcheck
+}
+
+// delete sends a request to delete a command.
+func (p *CommandPlan) delete(bot *disgo.Client, command *disgo.ApplicationCommand) error {
+	if p.GuildID == "" {
+		request := &disgo.DeleteGlobalApplicationCommand{CommandID: command.ID}
+
+		return request.Send(bot) //nolint:wrapcheck
+	}
+
+	request := &disgo.DeleteGuildApplicationCommand{GuildID: p.GuildID, CommandID: command.ID}
+
+	return request.Send(bot) //nolint:wrapcheck
+}
+
+// localizations returns the given localizations, or an empty map (which removes
+// the localizations of a command) when there are none.
+func localizations(l *map[string]string) *map[string]string {
+	if l == nil {
+		return &map[string]string{}
+	}
+
+	return l
+}
+
+// commandKey returns the key used to match application commands.
+func commandKey(command *disgo.ApplicationCommand) string {
+	return fmt.Sprintf("%d:%s", commandType(command), command.Name)
+}
+
+// commandType returns the type of an application command (which is CHAT_INPUT by default).
+func commandType(command *disgo.ApplicationCommand) disgo.Flag {
+	if command.Type == nil {
+		return disgo.FlagApplicationCommandTypeCHAT_INPUT
+	}
+
+	return *command.Type
+}
+
+// diffCommand returns the JSON names of the fields that differ between two application commands.
+func diffCommand(current, desired *disgo.ApplicationCommand) []string {
+	var fields []string
+
+	if current.Description != desired.Description {
+		fields = append(fields, "description")
+	}
+
+	if !equalLocalizations(current.NameLocalizations, desired.NameLocalizations) {
+		fields = append(fields, "name_localizations")
+	}
+
+	if !equalLocalizations(current.DescriptionLocalizations, desired.DescriptionLocalizations) {
+		fields = append(fields, "description_localizations")
+	}
+
+	if !equalJSON(normalizeOptions(current.Options), normalizeOptions(desired.Options)) {
+		fields = append(fields, "options")
+	}
+
+	if valueOr(current.DefaultMemberPermissions, "") != valueOr(desired.DefaultMemberPermissions, "") {
+		fields = append(fields, "default_member_permissions")
+	}
+
+	// dm_permission is only applicable to global commands (and is true by default).
+	if current.GuildID == nil && valueOr(current.DMPermission, true) != valueOr(desired.DMPermission, true) {
+		fields = append(fields, "dm_permission")
+	}
+
+	if valueOr(current.NSFW, false) != valueOr(desired.NSFW, false) {
+		fields = append(fields, "nsfw")
+	}
+
+	return fields
+}
+
+// valueOr returns the value of a pointer, or the default value when the pointer is nil.
+func valueOr[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+
+	return *v
+}
+
+// equalLocalizations determines whether two localizations are equal (where nil equals empty).
+func equalLocalizations(a, b *map[string]string) bool {
+	var x, y map[string]string
+	if a != nil {
+		x = *a
+	}
+
+	if b != nil {
+		y = *b
+	}
+
+	if len(x) != len(y) {
+		return false
+	}
+
+	for locale, value := range x {
+		if other, ok := y[locale]; !ok || other != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalJSON determines whether two values have the same JSON representation.
+func equalJSON(a, b any) bool {
+	x, errA := json.Marshal(a)
+	y, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && string(x) == string(y)
+}
+
+// normalizeOptions returns copies of the given options with default values removed,
+// such that options returned by Discord are comparable to options declared in code.
+func normalizeOptions(options []*disgo.ApplicationCommandOption) []*disgo.ApplicationCommandOption {
+	if len(options) == 0 {
+		return nil
+	}
+
+	normalized := make([]*disgo.ApplicationCommandOption, len(options))
+	for i, option := range options {
+		o := *option
+
+		if o.Required != nil && !*o.Required {
+			o.Required = nil
+		}
+
+		if o.Autocomplete != nil && !*o.Autocomplete {
+			o.Autocomplete = nil
+		}
+
+		if o.NameLocalizations != nil && len(*o.NameLocalizations) == 0 {
+			o.NameLocalizations = nil
+		}
+
+		if o.DescriptionLocalizations != nil && len(*o.DescriptionLocalizations) == 0 {
+			o.DescriptionLocalizations = nil
+		}
+
+		if len(o.ChannelTypes) == 0 {
+			o.ChannelTypes = nil
+		}
+
+		if len(o.Choices) == 0 {
+			o.Choices = nil
+		} else {
+			choices := make([]*disgo.ApplicationCommandOptionChoice, len(o.Choices))
+			for j, choice := range o.Choices {
+				c := *choice
+				if c.NameLocalizations != nil && len(*c.NameLocalizations) == 0 {
+					c.NameLocalizations = nil
+				}
+
+				choices[j] = &c
+			}
+
+			o.Choices = choices
+		}
+
+		o.Options = normalizeOptions(o.Options)
+		normalized[i] = &o
+	}
+
+	return normalized
+}
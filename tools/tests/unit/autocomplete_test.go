@@ -0,0 +1,120 @@
+package tools_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgo/tools"
+)
+
+// TestMatchChoices tests the order and limits of matched autocomplete choices.
+func TestMatchChoices(t *testing.T) {
+	choice := func(name string) *ApplicationCommandOptionChoice {
+		return &ApplicationCommandOptionChoice{Name: name, Value: Value(name)} //nolint:exhaustruct
+	}
+
+	choices := []*ApplicationCommandOptionChoice{
+		choice("Never Gonna Give You Up"),
+		choice("Give It Away"),
+		choice("Forgive"),
+		choice("Gravity"),
+		choice("give"),
+		choice("Hello"),
+	}
+
+	got := tools.MatchChoices("give", choices)
+	names := make([]string, len(got))
+	for i, c := range got {
+		names[i] = c.Name
+	}
+
+	// exact, prefix, word prefix, substring.
+	if want := "give,Give It Away,Never Gonna Give You Up,Forgive"; strings.Join(names, ",") != want {
+		t.Fatalf("(%v): got %v, wanted %v", "MatchChoices", names, want)
+	}
+
+	if got := tools.MatchChoices("grvt", choices); len(got) != 1 || got[0].Name != "Gravity" {
+		t.Fatalf("(%v): got %v, wanted %v", "fuzzy", got, "Gravity")
+	}
+
+	many := make([]*ApplicationCommandOptionChoice, 30)
+	for i := range many {
+		many[i] = choice(strings.Repeat("a", 120))
+	}
+
+	limited := tools.LimitChoices(many)
+	if len(limited) != 25 || len(limited[0].Name) != 100 || len(many[0].Name) != 120 {
+		t.Fatalf("(%v): got %d choices with name length %d", "LimitChoices", len(limited), len(limited[0].Name))
+	}
+}
+
+// TestAutocompleteRequest tests NewAutocompleteRequest for finding the focused option of a subcommand.
+func TestAutocompleteRequest(t *testing.T) {
+	interaction := &Interaction{ //nolint:exhaustruct
+		Type: FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE,
+		Data: &ApplicationCommandData{ //nolint:exhaustruct
+			Name: "music",
+			Options: []*ApplicationCommandInteractionDataOption{{ //nolint:exhaustruct
+				Name: "play",
+				Type: FlagApplicationCommandOptionTypeSUB_COMMAND,
+				Options: []*ApplicationCommandInteractionDataOption{
+					{Name: "volume", Type: FlagApplicationCommandOptionTypeINTEGER, Value: Pointer(Value("5"))},                        //nolint:exhaustruct
+					{Name: "song", Type: FlagApplicationCommandOptionTypeSTRING, Value: Pointer(Value("nev")), Focused: Pointer(true)}, //nolint:exhaustruct
+				},
+			}},
+		},
+	}
+
+	request := tools.NewAutocompleteRequest(interaction)
+	if request.Path != "music play" || request.Focused == nil || request.Focused.Name != "song" || request.Input != "nev" {
+		t.Fatalf("(%v): got %+v", "NewAutocompleteRequest", request)
+	}
+}
+
+// TestAutocompleteRouterDebounce tests that a debounced AutocompleteRouter only responds
+// to the latest input of a user without blocking the caller of Route.
+func TestAutocompleteRouterDebounce(t *testing.T) {
+	bot, requests := mockClient(func(method, path string) string { return "" })
+
+	router := tools.NewAutocompleteRouter()
+	router.Debounce = time.Millisecond * 100
+	router.Handle("music", "song", func(bot *Client, request *tools.AutocompleteRequest) ([]*ApplicationCommandOptionChoice, error) {
+		return []*ApplicationCommandOptionChoice{{Name: request.Input, Value: Value(request.Input)}}, nil //nolint:exhaustruct
+	})
+
+	interaction := func(id, input string) *Interaction {
+		return &Interaction{ //nolint:exhaustruct
+			ID:    id,
+			Type:  FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE,
+			Token: "token",
+			User:  &User{ID: "1"}, //nolint:exhaustruct
+			Data: &ApplicationCommandData{ //nolint:exhaustruct
+				Name: "music",
+				Options: []*ApplicationCommandInteractionDataOption{
+					{Name: "song", Type: FlagApplicationCommandOptionTypeSTRING, Value: Pointer(Value(input)), Focused: Pointer(true)}, //nolint:exhaustruct
+				},
+			},
+		}
+	}
+
+	start := time.Now()
+	for i, input := range []string{"n", "ne", "nev"} {
+		if err := router.Route(bot, interaction(strconv.Itoa(i), input)); err != nil {
+			t.Fatalf("(%v): got %v, wanted %v", "Route", err, nil)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed >= router.Debounce {
+		t.Fatalf("(%v): got %v, wanted %v", "Route", elapsed, "no blocking")
+	}
+
+	time.Sleep(router.Debounce * 3)
+
+	sent := requests()
+	if len(sent) != 1 || !strings.HasSuffix(sent[0].path, "/interactions/2/token/callback") || !strings.Contains(sent[0].body, `"name":"nev"`) {
+		t.Fatalf("(%v): got %v, wanted %v", "Debounce", sent, "a response to the latest input")
+	}
+}
@@ -0,0 +1,68 @@
+package tools_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgo/tools"
+)
+
+// TestMessageBuilder tests the message builders and the validation of Discord limits.
+func TestMessageBuilder(t *testing.T) {
+	embed := tools.NewEmbed().Title("Title").Description("Description").Field("Name", "Value", true).Footer("Footer", "")
+
+	message := tools.NewMessage().
+		Content("Hello!").
+		Embed(embed.Build()).
+		Row(tools.NewButton(FlagButtonStylePRIMARY, "Click", "click"), tools.NewLinkButton("Link", "https://discord.com")).
+		Row(tools.NewSelectMenu("menu").Option("A", "a", "").Values(1, 1).Build())
+
+	if err := message.Validate(); err != nil {
+		t.Fatalf("(%v): unexpected error %v", "Validate", err)
+	}
+
+	request := message.Build("1")
+	if request.ChannelID != "1" || *request.Content != "Hello!" || len(request.Embeds) != 1 || len(request.Components) != 2 {
+		t.Fatalf("(%v): got %+v", "Build", request)
+	}
+
+	// a built message is NOT modified by the builder.
+	message.Embed(embed.Field("Other", "Value", false).Build()).Row(tools.NewButton(FlagButtonStylePRIMARY, "Other", "other")).
+		File(&File{Name: "file.txt", ContentType: "text/plain", Data: []byte("a")})
+	if len(request.Embeds) != 1 || len(request.Embeds[0].Fields) != 1 || len(request.Components) != 2 || len(request.Files) != 0 {
+		t.Fatalf("(%v): got %+v", "Build (copy)", request)
+	}
+
+	// the files of a message are NOT included in the data of an interaction response.
+	if data := message.Messages(); len(data.Embeds) != 2 || len(data.Components) != 3 || len(message.Files()) != 1 {
+		t.Fatalf("(%v): got (%+v, %v)", "Messages", data, message.Files())
+	}
+
+	// a hand-built message is validated with the JSON path of each violation.
+	invalid := &CreateMessage{ //nolint:exhaustruct
+		Content: Pointer(strings.Repeat("a", 2001)),
+		Embeds: []*Embed{
+			{Fields: make([]*EmbedField, 26)},                                      //nolint:exhaustruct
+			{Description: Pointer(strings.Repeat("a", 4000))},                      //nolint:exhaustruct
+			{Fields: []*EmbedField{{Name: "a", Value: strings.Repeat("a", 2001)}}}, //nolint:exhaustruct
+		},
+		Components: []Component{
+			&ActionRow{Components: []Component{&Button{Style: FlagButtonStylePRIMARY, Label: Pointer(strings.Repeat("a", 81)), CustomID: Pointer("a")}}}, //nolint:exhaustruct
+		},
+	}
+
+	want := []string{"content", "embeds[0].fields", "embeds[2].fields[0].value", "embeds", "components[0].components[0].label"}
+
+	var limitErr tools.ErrorLimits
+	if err := tools.ValidateMessage(invalid); !errors.As(err, &limitErr) || len(limitErr.Violations) != len(want) {
+		t.Fatalf("(%v): got %v, wanted %d violations", "ValidateMessage", err, len(want))
+	}
+
+	for i, violation := range limitErr.Violations {
+		if violation.Path != want[i] {
+			t.Fatalf("(%v): got path %q, wanted %q", "ValidateMessage", violation.Path, want[i])
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package tools_test
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/valyala/fasthttp"
+)
+
+// request represents an HTTP request that is sent by a mock client.
+type request struct {
+	method string
+	path   string
+	body   string
+}
+
+// mockClient returns a client which records its HTTP requests without sending them,
+// then responds to each request with the JSON body that is returned by the given function
+// (or no content when the body is empty).
+func mockClient(respond func(method, path string) string) (*Client, func() []request) {
+	var (
+		mu       sync.Mutex
+		requests []request
+	)
+
+	bot := &Client{ApplicationID: "1", Authentication: BotToken("token"), Config: DefaultConfig()} //nolint:exhaustruct
+	bot.Config.Request.Client.ConfigureClient = func(hc *fasthttp.HostClient) error {
+		hc.Transport = func(req *fasthttp.Request, response *fasthttp.Response) error {
+			method, path := string(req.Header.Method()), string(req.URI().Path())
+
+			mu.Lock()
+			requests = append(requests, request{method: method, path: path, body: string(req.Body())})
+			mu.Unlock()
+
+			// the Date header is parsed from a raw response (since it can NOT be set).
+			date := "Date: " + time.Now().UTC().Format(time.RFC1123) + "\r\n"
+
+			raw := "HTTP/1.1 204 No Content\r\n" + date + "\r\n"
+			if body := respond(method, path); body != "" {
+				raw = "HTTP/1.1 200 OK\r\n" + date + "Content-Type: application/json\r\n" +
+					"Content-Length: " + strconv.Itoa(len(body)) + "\r\n\r\n" + body
+			}
+
+			return response.Read(bufio.NewReader(strings.NewReader(raw))) //nolint:wrapcheck
+		}
+
+		return nil
+	}
+
+	return bot, func() []request {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]request(nil), requests...)
+	}
+}
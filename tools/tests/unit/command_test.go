@@ -0,0 +1,146 @@
+package tools_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgo/tools"
+)
+
+// TestCommandRouter tests the CommandRouter for routing subcommand paths and binding options.
+func TestCommandRouter(t *testing.T) {
+	interaction := &Interaction{ //nolint:exhaustruct
+		Type: FlagInteractionTypeAPPLICATION_COMMAND,
+		User: &User{ID: "user"}, //nolint:exhaustruct
+		Data: &ApplicationCommandData{ //nolint:exhaustruct
+			Name: "calculate",
+			Options: []*ApplicationCommandInteractionDataOption{
+				{
+					Name: "add",
+					Type: FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP,
+					Options: []*ApplicationCommandInteractionDataOption{
+						{
+							Name: "int",
+							Type: FlagApplicationCommandOptionTypeSUB_COMMAND,
+							Options: []*ApplicationCommandInteractionDataOption{
+								{Name: "addend", Type: FlagApplicationCommandOptionTypeINTEGER, Value: Pointer(Value("1"))},
+								{Name: "target", Type: FlagApplicationCommandOptionTypeUSER, Value: Pointer(Value("user"))},
+							},
+						},
+					},
+				},
+			},
+			Resolved: &ResolvedData{ //nolint:exhaustruct
+				Users: map[string]*User{"user": {ID: "user", Username: "disgo"}}, //nolint:exhaustruct
+			},
+		},
+	}
+
+	type options struct {
+		Addend  int     `option:"addend"`
+		Summand *int    `option:"summand"`
+		Target  *User   `option:"target"`
+		ID      string  `option:"target"`
+		Ignored float64 `option:"ignored"`
+	}
+
+	var called int
+
+	router := tools.NewCommandRouter()
+	router.Handle("calculate add int", func(bot *Client, i *Interaction) error {
+		called++
+
+		var opts options
+		if err := tools.BindOptions(i, &opts); err != nil {
+			return err
+		}
+
+		if opts.Addend != 1 || opts.Summand != nil || opts.Target == nil || opts.Target.Username != "disgo" || opts.ID != "user" {
+			t.Fatalf("(%v): got %+v", "BindOptions", opts)
+		}
+
+		return nil
+	}, tools.Cooldown(time.Minute))
+
+	if err := router.Route(nil, interaction); err != nil || called != 1 {
+		t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", "Route", called, err, 1, nil)
+	}
+
+	// the second call is on cooldown.
+	var cooldownErr tools.ErrorCooldown
+	if err := router.Route(nil, interaction); !errors.As(err, &cooldownErr) || called != 1 {
+		t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", "Cooldown", called, err, 1, "ErrorCooldown")
+	}
+
+	interaction.ApplicationCommand().Name = "unknown"
+	if err := router.Route(nil, interaction); !errors.Is(err, tools.ErrCommandNotFound) {
+		t.Fatalf("(%v): got %v, wanted %v", "NotFound", err, tools.ErrCommandNotFound)
+	}
+
+	// options are NOT bound from an interaction without application command data.
+	var opts options
+
+	ping := &Interaction{Type: FlagInteractionTypePING} //nolint:exhaustruct
+	if err := tools.BindOptions(ping, &opts); !errors.Is(err, tools.ErrNotCommand) {
+		t.Fatalf("(%v): got %v, wanted %v", "BindOptions PING", err, tools.ErrNotCommand)
+	}
+}
+
+// TestCommandRouterMiddleware tests the middleware of a CommandRouter and the interactions that it routes.
+func TestCommandRouterMiddleware(t *testing.T) {
+	interaction := &Interaction{ //nolint:exhaustruct
+		Type:   FlagInteractionTypeAPPLICATION_COMMAND,
+		Member: &GuildMember{User: &User{ID: "user"}, Permissions: Pointer("2048")}, //nolint:exhaustruct
+		Data:   &ApplicationCommandData{Name: "ban"},                                //nolint:exhaustruct
+	}
+
+	var wrapped int
+
+	// count the amount of times the middleware wraps a handler.
+	count := func(next tools.CommandHandler) tools.CommandHandler {
+		wrapped++
+
+		return next
+	}
+
+	router := tools.NewCommandRouter()
+	router.Use(count)
+	router.Handle("ban", func(bot *Client, i *Interaction) error { return nil },
+		tools.RequirePermissions(FlagBitwisePermissionSEND_MESSAGES|FlagBitwisePermissionBAN_MEMBERS),
+	)
+
+	// the member is missing the BAN_MEMBERS permission.
+	var permErr tools.ErrorMemberPermissions
+	if err := router.Route(nil, interaction); !errors.As(err, &permErr) || permErr.Missing != FlagBitwisePermissionBAN_MEMBERS {
+		t.Fatalf("(%v): got %v, wanted %v", "RequirePermissions", err, tools.ErrorMemberPermissions{Path: "ban", Missing: FlagBitwisePermissionBAN_MEMBERS})
+	}
+
+	// the ADMINISTRATOR permission grants every permission.
+	interaction.Member.Permissions = Pointer("8")
+	if err := router.Route(nil, interaction); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Administrator", err, nil)
+	}
+
+	// the middleware chain is built once.
+	if wrapped != 1 {
+		t.Fatalf("(%v): got %d, wanted %d", "Chain", wrapped, 1)
+	}
+
+	// the middleware chain is rebuilt when middleware is added.
+	router.Use(count)
+	if err := router.Route(nil, interaction); err != nil || wrapped != 3 {
+		t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", "Use", wrapped, err, 3, nil)
+	}
+
+	// an interaction that is NOT an application command is NOT routed.
+	component := &Interaction{ //nolint:exhaustruct
+		Type: FlagInteractionTypeMESSAGE_COMPONENT,
+		Data: &MessageComponentData{CustomID: "ban"}, //nolint:exhaustruct
+	}
+
+	if err := router.Route(nil, component); !errors.Is(err, tools.ErrNotCommand) {
+		t.Fatalf("(%v): got %v, wanted %v", "NotCommand", err, tools.ErrNotCommand)
+	}
+}
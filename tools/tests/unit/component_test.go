@@ -0,0 +1,119 @@
+package tools_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgo/tools"
+	"github.com/valyala/fasthttp"
+)
+
+// TestComponentRouter tests the ComponentRouter for routing custom IDs with parameters and state.
+func TestComponentRouter(t *testing.T) {
+	router := tools.NewComponentRouter()
+
+	var request *tools.ComponentRequest
+	router.Handle("vote:{pollID}:{choice}", func(bot *Client, r *tools.ComponentRequest) error {
+		request = r
+
+		return nil
+	})
+
+	router.Handle("poll:{state}", func(bot *Client, r *tools.ComponentRequest) error {
+		request = r
+
+		return nil
+	})
+
+	interaction := func(customID string) *Interaction {
+		return &Interaction{ //nolint:exhaustruct
+			Type: FlagInteractionTypeMESSAGE_COMPONENT,
+			Data: &MessageComponentData{CustomID: customID}, //nolint:exhaustruct
+		}
+	}
+
+	if err := router.Route(nil, interaction("vote:42:yes")); err != nil ||
+		request.Params["pollID"] != "42" || request.Params["choice"] != "yes" {
+		t.Fatalf("(%v): got (%v, %v)", "Params", request, err)
+	}
+
+	if err := router.Route(nil, interaction("vote:42")); !errors.Is(err, tools.ErrComponentNotFound) {
+		t.Fatalf("(%v): got %v, wanted %v", "NotFound", err, tools.ErrComponentNotFound)
+	}
+
+	if err := router.Route(nil, interaction("poll:unknown")); !errors.Is(err, tools.ErrComponentExpired) {
+		t.Fatalf("(%v): got %v, wanted %v", "Expired", err, tools.ErrComponentExpired)
+	}
+
+	// disable the components of a message.
+	components := tools.DisableComponents([]Component{
+		&ActionRow{Components: []Component{&Button{CustomID: Pointer("vote:42:yes")}}}, //nolint:exhaustruct
+	})
+
+	if button, ok := components[0].(*ActionRow).Components[0].(*Button); !ok || button.Disabled == nil || !*button.Disabled {
+		t.Fatalf("(%v): got %v, wanted a disabled button", "DisableComponents", components)
+	}
+}
+
+// TestComponentState tests the expiry of state that is attached to an interaction's original response.
+func TestComponentState(t *testing.T) {
+	original := `{"id":"2","channel_id":"3","components":[{"type":1,"components":[{"type":2,"style":1,"custom_id":"poll:state"}]}]}`
+	bot, requests := mockClient(func(method, path string) string {
+		return original
+	})
+
+	router := tools.NewComponentRouter()
+	interaction := &Interaction{ID: "1", ApplicationID: "1", Token: "token"} //nolint:exhaustruct
+
+	// a state that is detached immediately does NOT disable its components.
+	detached, err := router.Attach(bot, interaction, nil, 0)
+	if err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Attach", err, nil)
+	}
+
+	router.Detach(detached)
+
+	// an expired state disables the components of the original response.
+	state, err := router.Attach(bot, interaction, "value", time.Millisecond*10)
+	if err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Attach", err, nil)
+	}
+
+	customID := "poll:" + state.ID
+	route := &Interaction{ //nolint:exhaustruct
+		Type: FlagInteractionTypeMESSAGE_COMPONENT,
+		Data: &MessageComponentData{CustomID: customID}, //nolint:exhaustruct
+	}
+
+	router.Handle("poll:{state}", func(bot *Client, r *tools.ComponentRequest) error {
+		if r.State != "value" {
+			t.Errorf("(%v): got %v, wanted %v", "State", r.State, "value")
+		}
+
+		return nil
+	})
+
+	if err := router.Route(bot, route); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Route", err, nil)
+	}
+
+	for deadline := time.Now().Add(time.Second * 5); len(requests()) < 2; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("(%v): got %v, wanted %v", "Expire", requests(), "2 requests")
+		}
+	}
+
+	sent := requests()
+	if len(sent) != 2 ||
+		sent[0].method != fasthttp.MethodGet || !strings.HasSuffix(sent[0].path, "/webhooks/1/token/messages/@original") ||
+		sent[1].method != fasthttp.MethodPatch || !strings.Contains(sent[1].body, `"disabled":true`) {
+		t.Fatalf("(%v): got %v, wanted %v", "Expire", sent, "a disabled original response")
+	}
+
+	if err := router.Route(bot, route); !errors.Is(err, tools.ErrComponentExpired) {
+		t.Fatalf("(%v): got %v, wanted %v", "Expired", err, tools.ErrComponentExpired)
+	}
+}
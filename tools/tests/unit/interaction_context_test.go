@@ -0,0 +1,155 @@
+package tools_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgo/tools"
+	"github.com/valyala/fasthttp"
+)
+
+// TestInteractionContext tests the response state and token expiry of an InteractionContext.
+func TestInteractionContext(t *testing.T) {
+	bot := new(Client)
+
+	// a snowflake from 2015 represents an interaction with an expired token.
+	expired := tools.NewInteractionContext(bot, &Interaction{ID: "175928847299117063", Type: FlagInteractionTypeAPPLICATION_COMMAND}, false) //nolint:exhaustruct
+	defer expired.Done()
+
+	if !expired.Expired() || expired.State() != tools.InteractionStatePending {
+		t.Fatalf("(%v): got (%v, %v)", "Expired", expired.Expired(), expired.State())
+	}
+
+	if _, err := expired.Reply(&Messages{Content: Pointer("Hello")}); !errors.Is(err, tools.ErrInteractionExpired) { //nolint:exhaustruct
+		t.Fatalf("(%v): got %v, wanted %v", "Reply", err, tools.ErrInteractionExpired)
+	}
+
+	if err := expired.Defer(true); !errors.Is(err, tools.ErrInteractionExpired) {
+		t.Fatalf("(%v): got %v, wanted %v", "Defer", err, tools.ErrInteractionExpired)
+	}
+
+	// an interaction without a snowflake ID is created upon receipt.
+	current := tools.NewInteractionContext(bot, &Interaction{ID: "", Type: FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE}, false) //nolint:exhaustruct
+	defer current.Done()
+
+	if current.Expired() || current.Acknowledged() || time.Until(current.ExpiresAt()) <= time.Minute*14 {
+		t.Fatalf("(%v): got (%v, %v)", "ExpiresAt", current.Expired(), current.ExpiresAt())
+	}
+
+	if _, err := current.Followup(&Messages{Content: Pointer("Hello")}); err == nil { //nolint:exhaustruct
+		t.Fatalf("(%v): expected an error for a pending interaction", "Followup")
+	}
+}
+
+// TestInteractionContextAutoDefer tests the automatic deferral of an interaction
+// using the time the interaction is created at.
+func TestInteractionContextAutoDefer(t *testing.T) {
+	bot, requests := mockClient(func(method, path string) string { return "" })
+
+	// an interaction that is created 2 seconds ago is deferred in 500 milliseconds.
+	id := SnowflakeFromTime(time.Now().Add(-time.Second * 2)).String()
+	ictx := tools.NewInteractionContext(bot, &Interaction{ID: id, Type: FlagInteractionTypeAPPLICATION_COMMAND, Token: "token"}, true) //nolint:exhaustruct
+	defer ictx.Done()
+
+	for deadline := time.Now().Add(time.Second * 2); !ictx.Acknowledged(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("(%v): got %v, wanted %v", "AutoDefer", ictx.State(), tools.InteractionStateDeferred)
+		}
+	}
+
+	sent := requests()
+	if len(sent) != 1 || !strings.HasSuffix(sent[0].path, "/interactions/"+id+"/token/callback") ||
+		!strings.Contains(sent[0].body, `"type":5`) || !strings.Contains(sent[0].body, `"flags":64`) {
+		t.Fatalf("(%v): got %v, wanted %v", "AutoDefer", sent, "an ephemeral deferral")
+	}
+
+	// an interaction that is replied to before the deadline is NOT deferred.
+	bot, requests = mockClient(func(method, path string) string { return "" })
+
+	id = SnowflakeFromTime(time.Now().Add(-time.Second * 2)).String()
+	replied := tools.NewInteractionContext(bot, &Interaction{ID: id, Type: FlagInteractionTypeAPPLICATION_COMMAND}, false) //nolint:exhaustruct
+	defer replied.Done()
+
+	if _, err := replied.Reply(&Messages{Content: Pointer("Hello")}); err != nil { //nolint:exhaustruct
+		t.Fatalf("(%v): got %v, wanted %v", "Reply", err, nil)
+	}
+
+	time.Sleep(time.Second)
+
+	if sent := requests(); len(sent) != 1 || replied.State() != tools.InteractionStateReplied {
+		t.Fatalf("(%v): got (%v, %v), wanted %v", "Replied", sent, replied.State(), "no deferral")
+	}
+}
+
+// TestInteractionContextReply tests the endpoint that is used to reply to an interaction in each state.
+func TestInteractionContextReply(t *testing.T) {
+	bot, requests := mockClient(func(method, path string) string {
+		if method == fasthttp.MethodPost && strings.HasSuffix(path, "/callback") {
+			return ""
+		}
+
+		return `{"id":"2","channel_id":"3"}`
+	})
+
+	interaction := &Interaction{ID: SnowflakeFromTime(time.Now()).String(), ApplicationID: "1", Type: FlagInteractionTypeAPPLICATION_COMMAND, Token: "token"} //nolint:exhaustruct
+	ictx := tools.NewInteractionContext(bot, interaction, false)
+	defer ictx.Done()
+
+	tests := []struct {
+		name   string
+		reply  func() error
+		method string
+		path   string
+		state  int
+	}{
+		{
+			name:   "Defer",
+			reply:  func() error { return ictx.Defer(false) },
+			method: fasthttp.MethodPost,
+			path:   "/interactions/" + interaction.ID + "/token/callback",
+			state:  tools.InteractionStateDeferred,
+		},
+		{
+			name: "Reply (Deferred)",
+			reply: func() error {
+				_, err := ictx.Reply(&Messages{Content: Pointer("Hello")}) //nolint:exhaustruct
+				return err
+			},
+			method: fasthttp.MethodPatch,
+			path:   "/webhooks/1/token/messages/@original",
+			state:  tools.InteractionStateReplied,
+		},
+		{
+			name: "Reply (Replied)",
+			reply: func() error {
+				_, err := ictx.Reply(&Messages{Content: Pointer("Hello")}) //nolint:exhaustruct
+				return err
+			},
+			method: fasthttp.MethodPost,
+			path:   "/webhooks/1/token",
+			state:  tools.InteractionStateReplied,
+		},
+	}
+
+	for i, test := range tests {
+		if err := test.reply(); err != nil {
+			t.Fatalf("(%v): got %v, wanted %v", test.name, err, nil)
+		}
+
+		sent := requests()
+		if len(sent) != i+1 || sent[i].method != test.method || !strings.HasSuffix(sent[i].path, test.path) {
+			t.Fatalf("(%v): got %v, wanted %v %v", test.name, sent, test.method, test.path)
+		}
+
+		if state := ictx.State(); state != test.state {
+			t.Fatalf("(%v): got %v, wanted %v", test.name, tools.InteractionStates[state], tools.InteractionStates[test.state])
+		}
+	}
+
+	if err := ictx.Defer(false); !errors.Is(err, tools.ErrInteractionAcknowledged) {
+		t.Fatalf("(%v): got %v, wanted %v", "Acknowledged", err, tools.ErrInteractionAcknowledged)
+	}
+}
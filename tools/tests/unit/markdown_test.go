@@ -0,0 +1,104 @@
+package tools_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/switchupcb/disgo/tools/markdown"
+)
+
+// TestMarkdown tests the formatting and parsing of Discord-flavored text.
+func TestMarkdown(t *testing.T) {
+	emoji := markdown.Emoji{Name: "disgo", ID: "3", Animated: true}
+	at := time.Unix(1618953630, 0)
+
+	content := markdown.User("1") + " " + markdown.Role("2") + " " + markdown.Channel("4") + " " + emoji.String() + " " +
+		markdown.Timestamp(at, markdown.TimestampRelative) + " " + markdown.Command("music  play", "5") + " <@!1> @here"
+
+	if want := "<@1> <@&2> <#4> <a:disgo:3> <t:1618953630:R> </music play:5> <@!1> @here"; content != want {
+		t.Fatalf("(%v): got %q, wanted %q", "format", content, want)
+	}
+
+	mentions := markdown.ParseMentions(content)
+	if len(mentions.Users) != 1 || mentions.Users[0] != "1" || mentions.Roles[0] != "2" || mentions.Channels[0] != "4" || !mentions.Everyone {
+		t.Fatalf("(%v): got %+v", "ParseMentions", mentions)
+	}
+
+	if emojis := markdown.ParseEmojis(content); len(emojis) != 1 || emojis[0] != emoji {
+		t.Fatalf("(%v): got %+v", "ParseEmojis", emojis)
+	}
+
+	if times := markdown.ParseTimestamps(content); len(times) != 1 || !times[0].Equal(at) {
+		t.Fatalf("(%v): got %v", "ParseTimestamps", times)
+	}
+
+	if escaped := markdown.Escape("**bold** _it_"); escaped != `\*\*bold\*\* \_it\_` {
+		t.Fatalf("(%v): got %q", "Escape", escaped)
+	}
+
+	if mentions := markdown.ParseMentions(markdown.EscapeMentions(content)); len(mentions.Users) != 0 || mentions.Everyone {
+		t.Fatalf("(%v): got %+v", "EscapeMentions", mentions)
+	}
+}
+
+// TestSplit tests that split content fits within the limit without breaking code blocks.
+func TestSplit(t *testing.T) {
+	content := "intro\n```go\n" + strings.Repeat("fmt.Println(\"disgo\")\n", 20) + "```\noutro " + strings.Repeat("a", 150)
+
+	parts := markdown.Split(content, 100)
+	if len(parts) < 3 {
+		t.Fatalf("(%v): got %d parts", "Split", len(parts))
+	}
+
+	for i, part := range parts {
+		if utf8.RuneCountInString(part) > 100 {
+			t.Fatalf("(%v): part %d exceeds the limit: %d", "Split", i, utf8.RuneCountInString(part))
+		}
+
+		if strings.Count(part, "```")%2 != 0 {
+			t.Fatalf("(%v): part %d breaks a code block:\n%s", "Split", i, part)
+		}
+
+		if i > 1 && strings.Contains(part, "Println") && !strings.HasPrefix(part, "```go\n") {
+			t.Fatalf("(%v): part %d does NOT reopen the code block:\n%s", "Split", i, part)
+		}
+	}
+
+	if got := strings.ReplaceAll(strings.Join(parts, ""), "```\n```go\n", ""); strings.Count(got, "Println") != 20 {
+		t.Fatalf("(%v): content is lost: %q", "Split", got)
+	}
+}
+
+// TestSplitLimits tests that split content fits within small limits and long lines without breaking code blocks.
+func TestSplitLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		limit   int
+	}{
+		{name: "Long Line Fence", content: strings.Repeat("a", 150) + "```go\ncode\n" + strings.Repeat("b", 150) + "\n```\noutro", limit: 100},
+		{name: "Long Line Fences", content: strings.Repeat("a", 95) + "```x``` " + strings.Repeat("b", 120) + "```" + strings.Repeat("c", 50) + "```", limit: 100},
+		{name: "Below Minimum", content: "```go\n" + strings.Repeat("code\n", 10) + "```", limit: 3},
+		{name: "Long Language", content: "```" + strings.Repeat("l", 20) + "\n" + strings.Repeat("code\n", 10) + "```", limit: 16},
+	}
+
+	for _, test := range tests {
+		limit := test.limit
+		if limit < markdown.MinSplitLimit {
+			limit = markdown.MinSplitLimit
+		}
+
+		parts := markdown.Split(test.content, test.limit)
+		for i, part := range parts {
+			if utf8.RuneCountInString(part) > limit {
+				t.Fatalf("(%v): part %d exceeds the limit %d: %q", test.name, i, limit, part)
+			}
+
+			if strings.Count(part, "```")%2 != 0 {
+				t.Fatalf("(%v): part %d breaks a code block: %q", test.name, i, part)
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package tools_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgo/tools"
+)
+
+// TestModal tests NewModal and DecodeModal for building a modal from a struct and decoding its submission.
+func TestModal(t *testing.T) {
+	type feedback struct {
+		Title string  `modal:"title" label:"Title" min:"3" max:"10" required:"true"`
+		Body  *string `modal:"body" label:"Feedback" style:"paragraph"`
+	}
+
+	form := feedback{Title: "Bug"} //nolint:exhaustruct
+
+	modal, err := tools.NewModal("feedback", "Feedback", &form)
+	if err != nil || len(modal.Components) != 2 {
+		t.Fatalf("(%v): got (%v, %v)", "NewModal", modal, err)
+	}
+
+	input := modal.Components[0].(*ActionRow).Components[0].(*TextInput) //nolint:forcetypeassert
+	if input.CustomID != "title" || *input.Value != "Bug" || *input.MaxLength != 10 || !*input.Required {
+		t.Fatalf("(%v): got %+v", "TextInput", input)
+	}
+
+	submit := func(title, body string) *ModalSubmitData {
+		return &ModalSubmitData{
+			CustomID: "feedback",
+			Components: []Component{
+				&ActionRow{Components: []Component{&TextInput{CustomID: "title", Value: Pointer(title)}}}, //nolint:exhaustruct
+				&ActionRow{Components: []Component{&TextInput{CustomID: "body", Value: Pointer(body)}}},   //nolint:exhaustruct
+			},
+		}
+	}
+
+	if err := tools.DecodeModal(submit("Crash", "It crashed."), &form); err != nil ||
+		form.Title != "Crash" || form.Body == nil || *form.Body != "It crashed." {
+		t.Fatalf("(%v): got (%+v, %v)", "DecodeModal", form, err)
+	}
+
+	var modalErr tools.ErrorModal
+	if err := tools.DecodeModal(submit("A", ""), &form); !errors.As(err, &modalErr) ||
+		len(modalErr.Fields) != 1 || modalErr.Fields[0].CustomID != "title" {
+		t.Fatalf("(%v): got %v, wanted %v", "Validation", err, "ErrorModal")
+	}
+}
+
+// TestAwaitModal tests that AwaitModal only returns the modal submission of the interaction's user.
+func TestAwaitModal(t *testing.T) {
+	type feedback struct {
+		Title string `modal:"title" label:"Title" min:"3" required:"true"`
+	}
+
+	// submission returns a modal submit interaction from the given user.
+	submission := func(userID, customID, title string) json.RawMessage {
+		return json.RawMessage(`{"id":"200","application_id":"1","type":5,"token":"submit",` +
+			`"member":{"user":{"id":"` + userID + `"}},"data":{"custom_id":"` + customID + `","components":[` +
+			`{"type":1,"components":[{"type":4,"custom_id":"title","value":"` + title + `"}]}]}}`)
+	}
+
+	tests := []struct {
+		name    string
+		title   string
+		submits []json.RawMessage
+		invalid bool
+	}{
+		{
+			name: "Submit",
+			submits: []json.RawMessage{
+				submission("2", "modal:100", "Other User"),
+				submission("1", "modal:101", "Other Modal"),
+				submission("1", "modal:100", "Crash"),
+			},
+			title:   "Crash",
+			invalid: false,
+		},
+		{
+			name:    "Invalid",
+			submits: []json.RawMessage{submission("1", "modal:100", "A")},
+			title:   "",
+			invalid: true,
+		},
+		{
+			name: "Cancel",
+			submits: []json.RawMessage{
+				submission("2", "modal:100", "Other User"),
+				submission("1", "modal:101", "Other Modal"),
+			},
+			title:   "",
+			invalid: false,
+		},
+	}
+
+	for _, test := range tests {
+		bot, requests := mockClient(func(method, path string) string { return "" })
+		bot.Handlers = new(Handlers)
+
+		interaction := &Interaction{ //nolint:exhaustruct
+			ID:     "100",
+			Type:   FlagInteractionTypeAPPLICATION_COMMAND,
+			Token:  "token",
+			Member: &GuildMember{User: &User{ID: "1"}}, //nolint:exhaustruct
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var (
+			form   feedback
+			submit *Interaction
+			err    error
+		)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			submit, err = tools.AwaitModal(ctx, bot, interaction, "Feedback", &form)
+		}()
+
+		// wait for the modal to be sent.
+		for deadline := time.Now().Add(time.Second); len(requests()) == 0; {
+			if time.Now().After(deadline) {
+				t.Fatalf("(%v): the modal was NOT sent", test.name)
+			}
+
+			time.Sleep(time.Millisecond)
+		}
+
+		for _, data := range test.submits {
+			if err := bot.Dispatch(FlagGatewayEventNameInteractionCreate, data); err != nil {
+				t.Fatalf("(%v): got %v, wanted %v", test.name, err, nil)
+			}
+		}
+
+		if test.title == "" && !test.invalid {
+			// the ignored submissions are handled concurrently.
+			time.Sleep(time.Millisecond * 50)
+			cancel()
+		}
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatalf("(%v): AwaitModal did NOT return", test.name)
+		}
+
+		cancel()
+
+		var modalErr tools.ErrorModal
+
+		switch {
+		case test.invalid:
+			if submit == nil || submit.ID != "200" || !errors.As(err, &modalErr) {
+				t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", test.name, submit, err, "submission", "ErrorModal")
+			}
+
+		case test.title != "":
+			if err != nil || submit == nil || submit.ID != "200" || form.Title != test.title {
+				t.Fatalf("(%v): got (%+v, %v), wanted %v", test.name, form, err, test.title)
+			}
+
+		default:
+			if submit != nil || !errors.Is(err, context.Canceled) {
+				t.Fatalf("(%v): got (%v, %v), wanted %v", test.name, submit, err, context.Canceled)
+			}
+		}
+
+		if len(bot.Handlers.InteractionCreate) != 0 {
+			t.Fatalf("(%v): got %d event handlers, wanted %d", test.name, len(bot.Handlers.InteractionCreate), 0)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package tools_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgo/tools"
+	"github.com/valyala/fasthttp"
+)
+
+// TestDiffCommands tests DiffCommands for the changes required to synchronize application commands.
+func TestDiffCommands(t *testing.T) {
+	current := []*ApplicationCommand{
+		{ID: "1", Name: "ping", Description: "Ping the bot.", Options: []*ApplicationCommandOption{ //nolint:exhaustruct
+			{Name: "ephemeral", Type: FlagApplicationCommandOptionTypeBOOLEAN, Description: "Hide the reply.", Required: Pointer(false)}, //nolint:exhaustruct
+		}},
+		{ID: "2", Name: "echo", Description: "Echo a message."},                                                   //nolint:exhaustruct
+		{ID: "3", Name: "unused", Description: "An unused command."},                                              //nolint:exhaustruct
+		{ID: "4", Name: "echo", Description: "Echo a message.", Type: Pointer(FlagApplicationCommandTypeMESSAGE)}, //nolint:exhaustruct
+	}
+
+	desired := []*ApplicationCommand{
+		{Name: "ping", Description: "Ping the bot.", Options: []*ApplicationCommandOption{ //nolint:exhaustruct
+			{Name: "ephemeral", Type: FlagApplicationCommandOptionTypeBOOLEAN, Description: "Hide the reply."}, //nolint:exhaustruct
+		}},
+		{Name: "echo", Description: "Repeat a message.", NameLocalizations: &map[string]string{"fr": "echo"}}, //nolint:exhaustruct
+		{Name: "new", Description: "A new command."},                                                          //nolint:exhaustruct
+		{Name: "echo", Description: "Echo a message.", Type: Pointer(FlagApplicationCommandTypeMESSAGE)},      //nolint:exhaustruct
+	}
+
+	plan := tools.DiffCommands(current, desired)
+
+	if len(plan.Create) != 1 || plan.Create[0].Name != "new" {
+		t.Fatalf("(%v): got %v, wanted %v", "Create", plan.Create, "new")
+	}
+
+	if len(plan.Edit) != 1 || plan.Edit[0].Current.ID != "2" ||
+		strings.Join(plan.Edit[0].Fields, ",") != "description,name_localizations" {
+		t.Fatalf("(%v): got %v", "Edit", plan.String())
+	}
+
+	if len(plan.Delete) != 1 || plan.Delete[0].ID != "3" {
+		t.Fatalf("(%v): got %v, wanted %v", "Delete", plan.Delete, "3")
+	}
+
+	if len(plan.Unchanged) != 2 || plan.Empty() {
+		t.Fatalf("(%v): got %v", "Unchanged", plan.String())
+	}
+}
+
+// TestCommandSync tests that CommandSync only sends the requests required to synchronize
+// the global (or guild) application commands, and that a dry run sends no changes.
+func TestCommandSync(t *testing.T) {
+	registered := `[` +
+		`{"id":"1","type":1,"name":"ping","description":"Ping the bot."},` +
+		`{"id":"2","type":1,"name":"echo","description":"Echo a message."},` +
+		`{"id":"3","type":1,"name":"unused","description":"An unused command."}` +
+		`]`
+
+	desired := []*ApplicationCommand{
+		{Name: "ping", Description: "Ping the bot."},     //nolint:exhaustruct
+		{Name: "echo", Description: "Repeat a message."}, //nolint:exhaustruct
+		{Name: "new", Description: "A new command."},     //nolint:exhaustruct
+	}
+
+	tests := []struct {
+		name     string
+		guildID  string
+		requests []string
+		dryRun   bool
+	}{
+		{
+			name:    "Global",
+			guildID: "",
+			dryRun:  false,
+			requests: []string{
+				"GET /api/v10/applications/1/commands",
+				"DELETE /api/v10/applications/1/commands/3",
+				"PATCH /api/v10/applications/1/commands/2",
+				"POST /api/v10/applications/1/commands",
+			},
+		},
+		{
+			name:    "Guild",
+			guildID: "10",
+			dryRun:  false,
+			requests: []string{
+				"GET /api/v10/applications/1/guilds/10/commands",
+				"DELETE /api/v10/applications/1/guilds/10/commands/3",
+				"PATCH /api/v10/applications/1/guilds/10/commands/2",
+				"POST /api/v10/applications/1/guilds/10/commands",
+			},
+		},
+		{
+			name:     "Dry Run",
+			guildID:  "",
+			dryRun:   true,
+			requests: []string{"GET /api/v10/applications/1/commands"},
+		},
+	}
+
+	for _, test := range tests {
+		bot, requests := mockClient(func(method, path string) string {
+			switch method {
+			case fasthttp.MethodGet:
+				return registered
+			case fasthttp.MethodDelete:
+				return ""
+			default:
+				return `{"id":"4","type":1,"name":"command","description":"A command."}`
+			}
+		})
+
+		var output strings.Builder
+
+		sync := &tools.CommandSync{Output: &output, GuildID: test.guildID, Commands: desired, DryRun: test.dryRun}
+
+		plan, err := sync.Sync(bot)
+		if err != nil {
+			t.Fatalf("(%v): got %v, wanted %v", test.name, err, nil)
+		}
+
+		sent := requests()
+		got := make([]string, len(sent))
+		for i, request := range sent {
+			got[i] = request.method + " " + request.path
+		}
+
+		if strings.Join(got, "\n") != strings.Join(test.requests, "\n") {
+			t.Fatalf("(%v): got %v, wanted %v", test.name, got, test.requests)
+		}
+
+		if test.dryRun != (output.String() == plan.String()) {
+			t.Fatalf("(%v): got output %q, wanted the plan (dry run %v)", test.name, output.String(), test.dryRun)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Message Limits
+// https://discord.com/developers/docs/resources/channel#create-message-jsonform-params
+const (
+	maxMessageContent = 2000
+	maxMessageEmbeds  = 10
+)
+
+// Embed Limits
+// https://discord.com/developers/docs/resources/channel#embed-object-embed-limits
+const (
+	maxEmbedCharacters  = 6000
+	maxEmbedTitle       = 256
+	maxEmbedDescription = 4096
+	maxEmbedFields      = 25
+	maxEmbedFieldName   = 256
+	maxEmbedFieldValue  = 1024
+	maxEmbedFooterText  = 2048
+	maxEmbedAuthorName  = 256
+)
+
+// Component Limits
+// https://discord.com/developers/docs/interactions/message-components
+const (
+	maxActionRows            = 5
+	maxActionRowButtons      = 5
+	maxButtonLabel           = 80
+	maxCustomID              = 100
+	maxSelectMenuOptions     = 25
+	maxSelectMenuOption      = 100
+	maxSelectMenuPlaceholder = 150
+)
+
+// LimitViolation represents a value that exceeds a Discord limit.
+type LimitViolation struct {
+	// Path represents the JSON path of the value (i.e., "embeds[0].fields[2].value").
+	Path string
+
+	// Reason represents the reason the value is NOT valid.
+	Reason string
+}
+
+// ErrorLimits represents an error that occurs when the values of a request exceed Discord limits.
+type ErrorLimits struct {
+	// Violations represents the values that exceed a limit.
+	Violations []LimitViolation
+}
+
+func (e ErrorLimits) Error() string {
+	reasons := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		reasons[i] = fmt.Sprintf("%s: %s", violation.Path, violation.Reason)
+	}
+
+	return "exceeds Discord limits: " + strings.Join(reasons, "; ")
+}
+
+// validator represents a validator that collects the limit violations of values.
+type validator struct {
+	violations []LimitViolation
+}
+
+// violate adds a limit violation to the validator.
+func (v *validator) violate(path, format string, args ...any) {
+	v.violations = append(v.violations, LimitViolation{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+// length adds a limit violation when the length of a string exceeds a maximum, then returns the length.
+func (v *validator) length(path, value string, max int) int {
+	length := utf8.RuneCountInString(value)
+	if length > max {
+		v.violate(path, "exceeds %d characters (%d)", max, length)
+	}
+
+	return length
+}
+
+// err returns the error of the validator's violations (or nil).
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	return ErrorLimits{Violations: v.violations}
+}
+
+// path returns the path of an element in a list.
+func path(prefix, name string, index int) string {
+	if prefix == "" {
+		return fmt.Sprintf("%s[%d]", name, index)
+	}
+
+	return fmt.Sprintf("%s.%s[%d]", prefix, name, index)
+}
+
+// ValidateMessage validates the content, embeds, and components of a message against Discord limits.
+//
+// An ErrorLimits is returned with every limit violation.
+func ValidateMessage(message *disgo.CreateMessage) error {
+	v := new(validator)
+	v.message(message.Content, message.Embeds, message.Components)
+
+	return v.err()
+}
+
+// ValidateEmbeds validates embeds (of a message) against Discord limits.
+//
+// An ErrorLimits is returned with every limit violation.
+func ValidateEmbeds(embeds ...*disgo.Embed) error {
+	v := new(validator)
+	v.embeds(embeds)
+
+	return v.err()
+}
+
+// ValidateComponents validates the components of a message against Discord limits.
+//
+// An ErrorLimits is returned with every limit violation.
+func ValidateComponents(components []disgo.Component) error {
+	v := new(validator)
+	v.components(components)
+
+	return v.err()
+}
+
+// message validates the content, embeds, and components of a message.
+func (v *validator) message(content *string, embeds []*disgo.Embed, components []disgo.Component) {
+	if content != nil {
+		v.length("content", *content, maxMessageContent)
+	}
+
+	v.embeds(embeds)
+	v.components(components)
+}
+
+// embeds validates the embeds of a message.
+func (v *validator) embeds(embeds []*disgo.Embed) {
+	if len(embeds) > maxMessageEmbeds {
+		v.violate("embeds", "exceeds %d embeds (%d)", maxMessageEmbeds, len(embeds))
+	}
+
+	// the characters of every embed in a message are limited.
+	characters := 0
+	for i, embed := range embeds {
+		if embed != nil {
+			characters += v.embed(path("", "embeds", i), embed)
+		}
+	}
+
+	if characters > maxEmbedCharacters {
+		v.violate("embeds", "exceeds %d characters (%d)", maxEmbedCharacters, characters)
+	}
+}
+
+// embed validates an embed, then returns the amount of characters in the embed.
+func (v *validator) embed(prefix string, embed *disgo.Embed) int {
+	characters := 0
+
+	if embed.Title != nil {
+		characters += v.length(prefix+".title", *embed.Title, maxEmbedTitle)
+	}
+
+	if embed.Description != nil {
+		characters += v.length(prefix+".description", *embed.Description, maxEmbedDescription)
+	}
+
+	if embed.Footer != nil {
+		characters += v.length(prefix+".footer.text", embed.Footer.Text, maxEmbedFooterText)
+	}
+
+	if embed.Author != nil {
+		characters += v.length(prefix+".author.name", embed.Author.Name, maxEmbedAuthorName)
+	}
+
+	if len(embed.Fields) > maxEmbedFields {
+		v.violate(prefix+".fields", "exceeds %d fields (%d)", maxEmbedFields, len(embed.Fields))
+	}
+
+	for i, field := range embed.Fields {
+		if field == nil {
+			continue
+		}
+
+		fieldPath := path(prefix, "fields", i)
+		characters += v.length(fieldPath+".name", field.Name, maxEmbedFieldName)
+		characters += v.length(fieldPath+".value", field.Value, maxEmbedFieldValue)
+	}
+
+	return characters
+}
+
+// components validates the components of a message.
+func (v *validator) components(components []disgo.Component) {
+	if len(components) > maxActionRows {
+		v.violate("components", "exceeds %d action rows (%d)", maxActionRows, len(components))
+	}
+
+	for i, component := range components {
+		rowPath := path("", "components", i)
+
+		var row *disgo.ActionRow
+
+		switch c := component.(type) {
+		case *disgo.ActionRow:
+			row = c
+
+		case disgo.ActionRow:
+			row = &c
+
+		default:
+			v.violate(rowPath, "expected an action row, received %T", component)
+
+			continue
+		}
+
+		v.actionRow(rowPath, row)
+	}
+}
+
+// actionRow validates the components of an action row.
+func (v *validator) actionRow(prefix string, row *disgo.ActionRow) {
+	if len(row.Components) == 0 {
+		v.violate(prefix+".components", "expected at least 1 component")
+	}
+
+	buttons, menus := 0, 0
+
+	for i, component := range row.Components {
+		componentPath := path(prefix, "components", i)
+
+		switch c := component.(type) {
+		case *disgo.Button:
+			buttons++
+			v.button(componentPath, c)
+
+		case disgo.Button:
+			buttons++
+			v.button(componentPath, &c)
+
+		case *disgo.SelectMenu:
+			menus++
+			v.selectMenu(componentPath, c)
+
+		case disgo.SelectMenu:
+			menus++
+			v.selectMenu(componentPath, &c)
+
+		default:
+			v.violate(componentPath, "unsupported message component %T", component)
+		}
+	}
+
+	switch {
+	case menus != 0 && len(row.Components) != 1:
+		v.violate(prefix+".components", "a select menu must be the only component of an action row")
+
+	case buttons > maxActionRowButtons:
+		v.violate(prefix+".components", "exceeds %d buttons (%d)", maxActionRowButtons, buttons)
+	}
+}
+
+// button validates a button.
+func (v *validator) button(prefix string, button *disgo.Button) {
+	if button.Label != nil {
+		v.length(prefix+".label", *button.Label, maxButtonLabel)
+	}
+
+	if button.Label == nil && button.Emoji == nil {
+		v.violate(prefix, "expected a label or emoji")
+	}
+
+	if button.Style == disgo.FlagButtonStyleLINK {
+		if button.URL == nil || button.CustomID != nil {
+			v.violate(prefix, "a link button must have a url (and no custom_id)")
+		}
+
+		return
+	}
+
+	if button.CustomID == nil || button.URL != nil {
+		v.violate(prefix, "a button must have a custom_id (and no url)")
+
+		return
+	}
+
+	v.length(prefix+".custom_id", *button.CustomID, maxCustomID)
+}
+
+// selectMenu validates a select menu.
+func (v *validator) selectMenu(prefix string, menu *disgo.SelectMenu) {
+	v.length(prefix+".custom_id", menu.CustomID, maxCustomID)
+
+	if menu.Placeholder != nil {
+		v.length(prefix+".placeholder", *menu.Placeholder, maxSelectMenuPlaceholder)
+	}
+
+	if len(menu.Options) > maxSelectMenuOptions {
+		v.violate(prefix+".options", "exceeds %d options (%d)", maxSelectMenuOptions, len(menu.Options))
+	}
+
+	for i, option := range menu.Options {
+		optionPath := path(prefix, "options", i)
+		v.length(optionPath+".label", option.Label, maxSelectMenuOption)
+		v.length(optionPath+".value", option.Value, maxSelectMenuOption)
+
+		if option.Description != nil {
+			v.length(optionPath+".description", *option.Description, maxSelectMenuOption)
+		}
+	}
+
+	if menu.MinValues != nil && int(*menu.MinValues) > maxSelectMenuOptions {
+		v.violate(prefix+".min_values", "exceeds %d", maxSelectMenuOptions)
+	}
+
+	if menu.MaxValues != nil && int(*menu.MaxValues) > maxSelectMenuOptions {
+		v.violate(prefix+".max_values", "exceeds %d", maxSelectMenuOptions)
+	}
+}
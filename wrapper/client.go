@@ -29,6 +29,17 @@ type Client struct {
 	// Sessions contains sessions a bot uses to interact with the Discord Gateway.
 	Sessions *SessionManager
 
+	// Dispatcher dispatches the events that a bot receives to its event handlers.
+	//
+	// Each event handler is called on its own goroutine when the Dispatcher is nil.
+	Dispatcher *Dispatcher
+
+	// ErrorHandler handles the errors that occur while the bot handles events
+	// or maintains its sessions (i.e., event handler panics and session errors).
+	//
+	// The ErrorHandler is called in addition to the Logger, and must NOT block.
+	ErrorHandler func(err error, ctx *ErrorContext)
+
 	ApplicationID string
 }
 
@@ -111,6 +122,25 @@ type Request struct {
 	// RateLimiter represents an object that provides rate limit functionality.
 	RateLimiter RateLimiter
 
+	// Preflight represents the state that is used to check the permissions of a request
+	// before it's sent (see RoutePermissions).
+	//
+	// Requests are NOT checked when Preflight is nil (default).
+	Preflight PermissionState
+
+	// PreflightUserID represents the ID of the bot's user, which is used to find the bot's member
+	// in the Preflight state (i.e., Ready.User.ID or the User from GetCurrentUser).
+	//
+	// Requests are NOT checked when PreflightUserID is empty (default).
+	PreflightUserID string
+
+	// AllowedMentions represents the allowed mentions that are applied to a request
+	// which creates (or edits) a message without allowed mentions (see DefaultAllowedMentions).
+	//
+	// Set AllowedMentions to nil to send requests without allowed mentions.
+	// https://discord.com/developers/docs/resources/channel#allowed-mentions-object
+	AllowedMentions *AllowedMentions
+
 	// Client is used to send requests.
 	//
 	// Use Client to set a custom User-Agent in the HTTP Request Header.
@@ -166,11 +196,12 @@ func DefaultRequest() Request {
 	)
 
 	return Request{
-		RateLimiter: ratelimiter,
-		Client:      client,
-		Timeout:     defaultRequestTimeout,
-		Retries:     1,
-		RetryShared: true,
+		RateLimiter:     ratelimiter,
+		AllowedMentions: DefaultAllowedMentions(),
+		Client:          client,
+		Timeout:         defaultRequestTimeout,
+		Retries:         1,
+		RetryShared:     true,
 	}
 }
 
@@ -201,6 +232,25 @@ type Gateway struct {
 	//
 	// https://discord.com/developers/docs/topics/gateway#update-presence
 	GatewayPresenceUpdate *GatewayPresenceUpdate
+
+	// SessionStore represents an object that persists the state of a bot's sessions.
+	//
+	// When SessionStore is set, a session that connects without a session ID will attempt
+	// to resume the stored session (rather than identifying a new session).
+	SessionStore SessionStore
+
+	// SessionStatusHandler handles each transition of a bot's sessions from one status to another
+	// (i.e., SessionStatusConnecting to SessionStatusIdentifying).
+	//
+	// The SessionStatusHandler is called (in order) after the Session is unlocked,
+	// so it can call the Session's methods.
+	SessionStatusHandler func(s *Session, transition *SessionTransition)
+
+	// HeartbeatLatencyThreshold represents the maximum latency that a session's heartbeat
+	// may average before the session is reconnected (i.e., due to a zombie connection).
+	//
+	// The latency of a session's heartbeat is not checked when HeartbeatLatencyThreshold is 0.
+	HeartbeatLatencyThreshold time.Duration
 }
 
 const (
@@ -248,11 +298,14 @@ func DefaultGateway() Gateway {
 	}
 
 	return Gateway{
-		Intents:               0,
-		IntentSet:             intentSet,
-		GatewayPresenceUpdate: new(GatewayPresenceUpdate),
-		RateLimiter:           ratelimiter,
-		ShardManager:          nil,
+		Intents:                   0,
+		IntentSet:                 intentSet,
+		GatewayPresenceUpdate:     new(GatewayPresenceUpdate),
+		RateLimiter:               ratelimiter,
+		ShardManager:              nil,
+		SessionStore:              nil,
+		SessionStatusHandler:      nil,
+		HeartbeatLatencyThreshold: 0,
 	}
 }
 
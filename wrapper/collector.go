@@ -0,0 +1,80 @@
+package wrapper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Await waits for the next event that matches the predicate, then returns it.
+//
+// A nil predicate matches every event. The event handler that Await adds to the bot
+// is removed once Await returns (i.e., when an event is received or the context is done).
+//
+//	message, err := disgo.Await(ctx, bot, func(m *disgo.MessageCreate) bool {
+//		return m.ChannelID == channelID
+//	})
+func Await[T any, E event[T]](ctx context.Context, bot *Client, predicate func(*T) bool) (*T, error) {
+	events, err := Collect[T, E](ctx, bot, 1, predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	return events[0], nil
+}
+
+// Collect collects events that match the predicate until n events are collected,
+// then returns the collected events (in the order they are handled).
+//
+// When n <= 0, events are collected until the context is done (i.e., by a timeout or cancellation),
+// then the collected events are returned without an error. Otherwise, the collected events are returned
+// with the context's error when the context is done before n events are collected.
+//
+// A nil predicate matches every event. The predicate may be called concurrently
+// as soon as an event is received, so it must NOT block.
+// The event handler that Collect adds to the bot (see Listen) is removed once Collect returns.
+func Collect[T any, E event[T]](ctx context.Context, bot *Client, n int, predicate func(*T) bool) ([]*T, error) {
+	var mu sync.Mutex
+
+	var events []*T
+	if n > 0 {
+		events = make([]*T, 0, n)
+	}
+
+	collected := make(chan struct{})
+
+	sub := Listen[T, E](bot, func(event *T) {
+		if predicate != nil && !predicate(event) {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if n > 0 && len(events) == n {
+			return
+		}
+
+		events = append(events, event)
+
+		if len(events) == n {
+			close(collected)
+		}
+	})
+
+	select {
+	case <-collected:
+	case <-ctx.Done():
+	}
+
+	sub.Cancel()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if n <= 0 || len(events) == n {
+		return events, nil
+	}
+
+	return events, fmt.Errorf("collect %q: %w", sub.Event(), ctx.Err())
+}
@@ -4293,8 +4293,8 @@ type ChannelMention struct {
 // https://discord.com/developers/docs/resources/channel#allowed-mentions-object-allowed-mentions-structure
 type AllowedMentions struct {
 	Parse       []*string `json:"parse"`
-	Roles       []*string `json:"roles"`
-	Users       []*string `json:"users"`
+	Roles       []*string `json:"roles,omitempty"`
+	Users       []*string `json:"users,omitempty"`
 	RepliedUser bool      `json:"replied_user"`
 }
 
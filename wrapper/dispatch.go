@@ -0,0 +1,468 @@
+package wrapper
+
+import (
+	"fmt"
+	"hash/fnv"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// DispatchMode represents the mode that a Dispatcher uses to dispatch events.
+type DispatchMode int
+
+// Dispatch Modes
+const (
+	// DispatchModeConcurrent dispatches each event handler on its own goroutine.
+	//
+	// This is the default mode (when the Client.Dispatcher is nil).
+	DispatchModeConcurrent DispatchMode = 0
+
+	// DispatchModeOrdered dispatches events one at a time (in the order they are received).
+	//
+	// The event handlers of an event are called in the order they were added.
+	DispatchModeOrdered DispatchMode = 1
+
+	// DispatchModeKeyed dispatches events using a pool of workers, such that
+	// events with the same key (i.e., Guild ID) are dispatched in the order they are received.
+	DispatchModeKeyed DispatchMode = 2
+)
+
+const (
+	// defaultDispatchQueueSize represents the default amount of events a dispatch worker can queue.
+	defaultDispatchQueueSize = 1024
+)
+
+// Dispatcher represents an object that dispatches events to a bot's event handlers.
+//
+// A Dispatcher with workers (DispatchModeOrdered or DispatchModeKeyed) calls event handlers
+// on its workers' goroutines. An event is passed to the bot's listeners (i.e., Await, Collect, and Once)
+// before it's queued, such that an event handler can wait for another event without a deadlock.
+type Dispatcher struct {
+	// Key returns the key of an event in DispatchModeKeyed.
+	//
+	// Events with the same key are dispatched in the order they are received.
+	Key func(eventname string, data json.RawMessage) string
+
+	// queues represents the bounded queue of each dispatch worker.
+	queues []chan *dispatch
+
+	// workers is used to wait for the dispatch workers to finish when the Dispatcher is closed.
+	workers sync.WaitGroup
+
+	// metrics represents the metrics of the Dispatcher.
+	metrics DispatcherMetrics
+
+	// Mode represents the Dispatch Mode of the Dispatcher.
+	Mode DispatchMode
+
+	// mu protects the queues from being closed while an event is queued.
+	mu sync.RWMutex
+
+	// closed represents whether the Dispatcher is closed.
+	closed bool
+}
+
+// dispatch represents an event that is dispatched to a bot's event handlers.
+type dispatch struct {
+	// bot represents the bot that received the event.
+	bot *Client
+
+	// shard represents the [shard_id, num_shards] of the Session that received the event.
+	shard *[2]int
+
+	// session represents the ID of the Session that received the event.
+	session string
+
+	// eventname represents the name of the event.
+	eventname string
+
+	// data represents the raw payload data of the event.
+	data json.RawMessage
+
+	// seq represents the sequence number of the event.
+	seq int64
+
+	// async determines whether the event handlers are called on their own goroutines.
+	async bool
+
+	// replayed indicates whether the event is replayed (upon resuming a Session).
+	replayed bool
+}
+
+// newDispatch returns the dispatch of an event that a Session receives.
+func newDispatch(bot *Client, s *Session, eventname string, seq int64, data json.RawMessage) *dispatch {
+	d := &dispatch{
+		bot:       bot,
+		shard:     nil,
+		session:   "",
+		eventname: eventname,
+		data:      data,
+		seq:       seq,
+		async:     true,
+		replayed:  false,
+	}
+
+	if s != nil {
+		d.session = s.ID
+
+		if s.Shard != nil {
+			shard := *s.Shard
+			d.shard = &shard
+		}
+	}
+
+	return d
+}
+
+// DispatcherMetrics represents the metrics of a Dispatcher.
+type DispatcherMetrics struct {
+	// Queued represents the amount of events that are queued (and not dispatched).
+	Queued int64
+
+	// Dispatched represents the amount of events that have been dispatched.
+	Dispatched int64
+
+	// Blocked represents the amount of times an event was queued while its queue was full.
+	//
+	// A full queue blocks the Session that received the event (backpressure)
+	// until the queue has space for the event.
+	Blocked int64
+
+	// BlockedTime represents the total amount of time that Sessions were blocked by full queues.
+	BlockedTime time.Duration
+
+	// Dropped represents the amount of events that were dropped, since they were received
+	// after the Dispatcher was closed.
+	Dropped int64
+}
+
+// NewDispatcher creates a new Dispatcher with the given Dispatch Mode.
+//
+// workers represents the amount of workers used in DispatchModeKeyed,
+// while size represents the maximum amount of events a worker can queue.
+func NewDispatcher(mode DispatchMode, workers, size int) *Dispatcher {
+	d := &Dispatcher{ //nolint:exhaustruct
+		Key:  DispatchKeyGuild,
+		Mode: mode,
+	}
+
+	switch mode {
+	case DispatchModeOrdered:
+		workers = 1
+
+	case DispatchModeKeyed:
+		if workers <= 0 {
+			workers = 1
+		}
+
+	default:
+		return d
+	}
+
+	if size <= 0 {
+		size = defaultDispatchQueueSize
+	}
+
+	d.queues = make([]chan *dispatch, workers)
+	for i := range d.queues {
+		d.queues[i] = make(chan *dispatch, size)
+
+		d.workers.Add(1)
+		go d.work(d.queues[i])
+	}
+
+	return d
+}
+
+// Metrics returns a snapshot of the Dispatcher's metrics.
+func (d *Dispatcher) Metrics() DispatcherMetrics {
+	return DispatcherMetrics{
+		Queued:      atomic.LoadInt64(&d.metrics.Queued),
+		Dispatched:  atomic.LoadInt64(&d.metrics.Dispatched),
+		Blocked:     atomic.LoadInt64(&d.metrics.Blocked),
+		BlockedTime: time.Duration(atomic.LoadInt64((*int64)(&d.metrics.BlockedTime))),
+		Dropped:     atomic.LoadInt64(&d.metrics.Dropped),
+	}
+}
+
+// Close stops the Dispatcher after every queued event is dispatched.
+//
+// Events that are dispatched to the Dispatcher once Close is called are dropped.
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+
+	if d.closed {
+		d.mu.Unlock()
+
+		return
+	}
+
+	d.closed = true
+
+	for _, queue := range d.queues {
+		close(queue)
+	}
+
+	d.mu.Unlock()
+
+	d.workers.Wait()
+}
+
+// dispatch dispatches an event to the bot's event handlers, then returns whether the event is dropped.
+func (d *Dispatcher) dispatch(event *dispatch) bool {
+	// prevent the queues from being closed while the event is queued.
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		atomic.AddInt64(&d.metrics.Dropped, 1)
+
+		LogEventHandler(Logger.Error(), event.bot.ApplicationID, event.eventname).Msg("dropped event after the dispatcher was closed")
+
+		return true
+	}
+
+	// pass the event to the listeners prior to queueing it,
+	// such that the event handlers that wait for the event are NOT deadlocked.
+	event.bot.listen(event)
+
+	if len(d.queues) == 0 {
+		atomic.AddInt64(&d.metrics.Dispatched, 1)
+
+		go event.bot.handle(event)
+
+		return false
+	}
+
+	queue := d.queues[0]
+	if len(d.queues) > 1 {
+		queue = d.queues[d.worker(event.eventname, event.data)]
+	}
+
+	// call the event handlers on the worker's goroutine.
+	event.async = false
+
+	atomic.AddInt64(&d.metrics.Queued, 1)
+
+	select {
+	case queue <- event:
+	default:
+		// apply backpressure until the queue has space for the event.
+		atomic.AddInt64(&d.metrics.Blocked, 1)
+
+		blocked := time.Now()
+		queue <- event
+		atomic.AddInt64((*int64)(&d.metrics.BlockedTime), int64(time.Since(blocked)))
+	}
+
+	return false
+}
+
+// worker returns the index of the worker that dispatches the given event.
+func (d *Dispatcher) worker(eventname string, data json.RawMessage) int {
+	key := DispatchKeyGuild
+	if d.Key != nil {
+		key = d.Key
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(key(eventname, data))) //nolint:errcheck
+
+	return int(hash.Sum32() % uint32(len(d.queues)))
+}
+
+// work dispatches the events from a queue in order.
+func (d *Dispatcher) work(queue chan *dispatch) {
+	defer d.workers.Done()
+
+	for event := range queue {
+		event.bot.handle(event)
+
+		atomic.AddInt64(&d.metrics.Queued, -1)
+		atomic.AddInt64(&d.metrics.Dispatched, 1)
+	}
+}
+
+// Dispatch dispatches an event that is received from a Gateway proxy (instead of a Session)
+// to the bot's event handlers using the bot's Dispatcher.
+//
+// An ErrorEvent is returned when the event is unknown to Disgo or the bot's Dispatcher is closed.
+func (bot *Client) Dispatch(eventname string, data json.RawMessage) error {
+	if !isEvent(eventname) {
+		return ErrorEvent{
+			ClientID: bot.ApplicationID,
+			Event:    eventname,
+			Err:      fmt.Errorf("%s", errDispatchUnknownEvent),
+			Action:   ErrorEventActionDispatch,
+		}
+	}
+
+	if bot.dispatch(newDispatch(bot, nil, eventname, 0, data)) {
+		return ErrorEvent{
+			ClientID: bot.ApplicationID,
+			Event:    eventname,
+			Err:      fmt.Errorf("%s", errDispatchClosed),
+			Action:   ErrorEventActionDispatch,
+		}
+	}
+
+	return nil
+}
+
+// dispatch dispatches an event that a Session receives to the bot's event handlers
+// using the bot's Dispatcher, then returns whether the event is dropped.
+func (bot *Client) dispatch(event *dispatch) bool {
+	if bot.Dispatcher == nil {
+		bot.listen(event)
+
+		go bot.handle(event)
+
+		return false
+	}
+
+	return bot.Dispatcher.dispatch(event)
+}
+
+// replay dispatches the events that a Session replays to the bot's event handlers in order.
+//
+// A Dispatcher with workers dispatches replayed events before the events that the Session receives
+// after resuming. Otherwise, replayed events are NOT ordered with respect to live events.
+func (bot *Client) replay(events []*dispatch) {
+	if len(events) == 0 {
+		return
+	}
+
+	// a Dispatcher with workers dispatches the events in order.
+	if bot.Dispatcher != nil && len(bot.Dispatcher.queues) != 0 {
+		for _, event := range events {
+			bot.Dispatcher.dispatch(event)
+		}
+
+		return
+	}
+
+	for _, event := range events {
+		bot.listen(event)
+	}
+
+	// otherwise, the events are handled one at a time on a separate goroutine,
+	// which runs concurrently with the events that the Session receives after resuming.
+	go func() {
+		for _, event := range events {
+			event.async = false
+			bot.handle(event)
+		}
+	}()
+}
+
+// call calls an event handler with the given event.
+//
+// The event handler is called on its own goroutine when the dispatch is async.
+func call[T any](d *dispatch, handler func(*T), event *T) {
+	if d.async {
+		go invoke(d, handler, event)
+
+		return
+	}
+
+	invoke(d, handler, event)
+}
+
+// invoke calls an event handler with the given event, then recovers from a panic
+// that occurs in the event handler, such that the panic does NOT crash the bot.
+func invoke[T any](d *dispatch, handler func(*T), event *T) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.error(ErrorPanic{
+				Value:    r,
+				ClientID: d.bot.ApplicationID,
+				Event:    d.eventname,
+				Stack:    debug.Stack(),
+			})
+		}
+	}()
+
+	handler(event)
+}
+
+// error logs an error that occurs while an event is handled, then reports it to the bot's ErrorHandler.
+func (d *dispatch) error(err error) {
+	LogEventHandler(Logger.Error(), d.bot.ApplicationID, d.eventname).Err(err).Msg("")
+
+	d.bot.report(err, d.context())
+}
+
+// context returns the ErrorContext of an error that occurs while an event is handled.
+func (d *dispatch) context() *ErrorContext {
+	return &ErrorContext{
+		Shard:     d.shard,
+		SessionID: d.session,
+		Event:     d.eventname,
+		Payload:   d.data,
+	}
+}
+
+// report reports an error to the bot's ErrorHandler (if applicable).
+func (bot *Client) report(err error, ctx *ErrorContext) {
+	if bot.ErrorHandler == nil {
+		return
+	}
+
+	bot.ErrorHandler(err, ctx)
+}
+
+// dispatchKey represents the fields of an event that are used to determine its dispatch key.
+type dispatchKey struct {
+	ID        string `json:"id"`
+	GuildID   string `json:"guild_id"`
+	ChannelID string `json:"channel_id"`
+}
+
+// DispatchKeyGuild returns the Guild ID of an event (or the Channel ID of an event without a Guild ID).
+func DispatchKeyGuild(eventname string, data json.RawMessage) string {
+	var key dispatchKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return ""
+	}
+
+	switch eventname {
+	case FlagGatewayEventNameGuildCreate,
+		FlagGatewayEventNameGuildUpdate,
+		FlagGatewayEventNameGuildDelete:
+		return key.ID
+	}
+
+	if key.GuildID != "" {
+		return key.GuildID
+	}
+
+	return key.ChannelID
+}
+
+// DispatchKeyChannel returns the Channel ID of an event (or the Guild ID of an event without a Channel ID).
+func DispatchKeyChannel(eventname string, data json.RawMessage) string {
+	var key dispatchKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return ""
+	}
+
+	switch eventname {
+	case FlagGatewayEventNameChannelCreate,
+		FlagGatewayEventNameChannelUpdate,
+		FlagGatewayEventNameChannelDelete,
+		FlagGatewayEventNameThreadCreate,
+		FlagGatewayEventNameThreadUpdate,
+		FlagGatewayEventNameThreadDelete:
+		return key.ID
+	}
+
+	if key.ChannelID != "" {
+		return key.ChannelID
+	}
+
+	return key.GuildID
+}
@@ -2,6 +2,8 @@ package wrapper
 
 import (
 	"fmt"
+
+	json "github.com/goccy/go-json"
 )
 
 // Send Request Error Messages.
@@ -37,6 +39,10 @@ func (e ErrorRequest) Error() string {
 		e.ClientID, e.CorrelationID, e.RouteID, e.ResourceID, e.Endpoint, e.Err).Error()
 }
 
+func (e ErrorRequest) Unwrap() error {
+	return e.Err
+}
+
 // Status Code Error Messages.
 const (
 	errStatusCodeKnown   = "status code %d: %v"
@@ -96,6 +102,13 @@ const (
 	ErrorEventActionMarshal   = "marshalling"
 	ErrorEventActionRead      = "reading"
 	ErrorEventActionWrite     = "writing"
+	ErrorEventActionDispatch  = "dispatching"
+)
+
+// Event Dispatch Error Messages.
+const (
+	errDispatchUnknownEvent = "event is unknown"
+	errDispatchClosed       = "dispatcher is closed"
 )
 
 // ErrorEvent represents a WebSocket error that occurs when an attempt to {action} an event fails.
@@ -111,11 +124,12 @@ type ErrorEvent struct {
 
 	// Action represents the action that prompted the error.
 	//
-	// ErrorEventAction's can be one of four values:
+	// ErrorEventAction's can be one of five values:
 	// ErrorEventActionUnmarshal: an error occurred while unmarshalling the Event from a JSON.
 	// ErrorEventActionMarshal:   an error occurred while marshalling the Event to a JSON.
 	// ErrorEventActionRead:      an error occurred while reading the Event from a Websocket Connection.
 	// ErrorEventActionWrite:     an error occurred while writing the Event to a Websocket Connection.
+	// ErrorEventActionDispatch:  an error occurred while dispatching the Event (see Client.Dispatch).
 	Action string
 }
 
@@ -124,6 +138,44 @@ func (e ErrorEvent) Error() string {
 		e.ClientID, e.Event, e.Action, e.Err).Error()
 }
 
+// ErrorPanic represents an Event Handler error that occurs when an event handler panics.
+type ErrorPanic struct {
+	// Value represents the value that the event handler panicked with.
+	Value any
+
+	// ClientID represents the Application ID of the event handler owner.
+	ClientID string
+
+	// Event represents the name of the event involved in this error.
+	Event string
+
+	// Stack represents the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+func (e ErrorPanic) Error() string {
+	return fmt.Errorf("EVENT HANDLER PANIC: client %q: event %q: panic: %v\n%s",
+		e.ClientID, e.Event, e.Value, e.Stack).Error()
+}
+
+// ErrorContext represents the context of an error that is sent to a Client's ErrorHandler.
+//
+// The Event and Payload of an ErrorContext are empty for the errors that are NOT caused by an event
+// (i.e., an ErrorSession or ErrorDisconnect that disconnects a Session).
+type ErrorContext struct {
+	// Shard represents the [shard_id, num_shards] of the Session involved in the error (if applicable).
+	Shard *[2]int
+
+	// SessionID represents the ID of the Session involved in the error (if applicable).
+	SessionID string
+
+	// Event represents the name of the event involved in the error (if applicable).
+	Event string
+
+	// Payload represents the raw payload data of the event involved in the error (if applicable).
+	Payload json.RawMessage
+}
+
 // Discord Gateway Error Messages
 const (
 	errNoSessionManager = `The client must contain a non-nil SessionManager to connect to the Discord Gateway.
@@ -157,6 +209,87 @@ func (e ErrorSession) Error() string {
 	return fmt.Errorf("SESSION ERROR: session %q: error: %w", e.SessionID, e.Err).Error()
 }
 
+// ErrorGatewayClose represents a WebSocket Session error that occurs when the Discord Gateway
+// closes a Session with a Gateway Close Event Code that does NOT allow the Session to reconnect.
+//
+// An ErrorGatewayClose is terminal: The Session must NOT be reconnected
+// until the cause of the error (i.e., an invalid token or intents) is fixed.
+type ErrorGatewayClose struct {
+	// Err represents the error that occurred.
+	Err error
+
+	// Code represents the Gateway Close Event Code of the disconnection.
+	Code *GatewayCloseEventCode
+
+	// SessionID represents the ID of the Session.
+	SessionID string
+}
+
+func (e ErrorGatewayClose) Error() string {
+	return fmt.Errorf("SESSION CLOSED: session %q: Gateway Close Event Code %d %s: %s: %w",
+		e.SessionID, e.Code.Code, e.Code.Description, e.Code.Explanation, e.Err).Error()
+}
+
+func (e ErrorGatewayClose) Unwrap() error {
+	return e.Err
+}
+
+// ErrorSequence represents a WebSocket Session error that occurs when a Session receives
+// an event with an unexpected sequence number (i.e., a gap or duplicate).
+type ErrorSequence struct {
+	// SessionID represents the ID of the Session.
+	SessionID string
+
+	// Event represents the name of the event involved in this error.
+	Event string
+
+	// Expected represents the sequence number that the Session expected.
+	Expected int64
+
+	// Received represents the sequence number that the Session received.
+	Received int64
+}
+
+func (e ErrorSequence) Error() string {
+	kind := "gap"
+	if e.Received < e.Expected {
+		kind = "duplicate"
+	}
+
+	return fmt.Errorf("SEQUENCE ERROR: session %q: event %q: %s: expected sequence %d, received %d",
+		e.SessionID, e.Event, kind, e.Expected, e.Received).Error()
+}
+
+// ErrorShard represents a routing error that occurs when a send event for a guild
+// can NOT be sent because the shard of the guild is NOT connected in this process
+// (i.e., when the shard is managed by another process).
+type ErrorShard struct {
+	// GuildID represents the ID of the guild involved in this error.
+	GuildID string
+
+	// Shard represents the shard_id of the guild.
+	Shard int
+
+	// Shards represents the num_shards used to calculate the shard_id of the guild.
+	Shards int
+}
+
+func (e ErrorShard) Error() string {
+	return fmt.Errorf("SHARD ERROR: guild %q: shard [%d, %d] is NOT connected in this process",
+		e.GuildID, e.Shard, e.Shards).Error()
+}
+
+// ErrorNoSession represents a routing error that occurs when a send event for a guild
+// can NOT be sent because the bot has no connected session.
+type ErrorNoSession struct {
+	// GuildID represents the ID of the guild involved in this error.
+	GuildID string
+}
+
+func (e ErrorNoSession) Error() string {
+	return fmt.Errorf("SHARD ERROR: guild %q: no session is connected", e.GuildID).Error()
+}
+
 const (
 	ErrConnectionSession = "Discord Gateway"
 )
@@ -181,3 +314,33 @@ func (e ErrorDisconnect) Error() string {
 		e.Connection, e.Err, e.Action,
 	).Error() //lint:ignore ST1005 readability
 }
+
+// ErrorPermissions represents a pre-flight error that occurs when a request is NOT sent
+// because the bot is missing the permissions (or role hierarchy) that the request requires.
+type ErrorPermissions struct {
+	// Route represents the route of the request (see RouteIDs).
+	Route string
+
+	// GuildID represents the ID of the guild involved in this error.
+	GuildID string
+
+	// ChannelID represents the ID of the channel involved in this error (if applicable).
+	ChannelID string
+
+	// Missing represents the permissions that the bot is missing.
+	Missing BitFlag
+
+	// Hierarchy represents whether the request targets a member or role
+	// that is NOT lower than the highest role of the bot.
+	Hierarchy bool
+}
+
+func (e ErrorPermissions) Error() string {
+	if e.Hierarchy {
+		return fmt.Errorf("PERMISSIONS ERROR: route %q: guild %q: the target member (or role) is NOT lower than the bot's highest role",
+			e.Route, e.GuildID).Error()
+	}
+
+	return fmt.Errorf("PERMISSIONS ERROR: route %q: guild %q: channel %q: missing permissions %v",
+		e.Route, e.GuildID, e.ChannelID, PermissionNames(e.Missing)).Error()
+}
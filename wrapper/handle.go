@@ -76,6 +76,9 @@ type Handlers struct {
 	TypingStart                         []func(*TypingStart)
 	VoiceStateUpdate                    []func(*VoiceStateUpdate)
 	WebhooksUpdate                      []func(*WebhooksUpdate)
+	Raw                                 []func(*RawEvent)
+	subscriptions                       map[string][]*Subscription
+	listeners                           map[string][]*Subscription
 	mu                                  sync.RWMutex
 }
 
@@ -84,10 +87,28 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	bot.Handlers.mu.Lock()
 	defer bot.Handlers.mu.Unlock()
 
+	return bot.add(eventname, function, nil)
+}
+
+// add adds an event handler (with an optional subscription) for the given event to the bot.
+//
+// The bot's Handlers must be locked when add is called.
+func (bot *Client) add(eventname string, function interface{}, sub *Subscription) error {
+	bot.intend(eventname)
+
 	switch eventname {
+	case FlagGatewayEventNameRaw:
+		if f, ok := function.(func(*RawEvent)); ok {
+			bot.Handlers.Raw = append(bot.Handlers.Raw, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Raw)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
 	case FlagGatewayEventNameHello:
 		if f, ok := function.(func(*Hello)); ok {
 			bot.Handlers.Hello = append(bot.Handlers.Hello, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Hello)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -95,6 +116,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameReady:
 		if f, ok := function.(func(*Ready)); ok {
 			bot.Handlers.Ready = append(bot.Handlers.Ready, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Ready)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -102,6 +124,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameResumed:
 		if f, ok := function.(func(*Resumed)); ok {
 			bot.Handlers.Resumed = append(bot.Handlers.Resumed, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Resumed)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -109,6 +132,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameReconnect:
 		if f, ok := function.(func(*Reconnect)); ok {
 			bot.Handlers.Reconnect = append(bot.Handlers.Reconnect, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.Reconnect)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -116,6 +140,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameInvalidSession:
 		if f, ok := function.(func(*InvalidSession)); ok {
 			bot.Handlers.InvalidSession = append(bot.Handlers.InvalidSession, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InvalidSession)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -123,54 +148,39 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
 		if f, ok := function.(func(*ApplicationCommandPermissionsUpdate)); ok {
 			bot.Handlers.ApplicationCommandPermissionsUpdate = append(bot.Handlers.ApplicationCommandPermissionsUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ApplicationCommandPermissionsUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
-		}
-
 		if f, ok := function.(func(*AutoModerationRuleCreate)); ok {
 			bot.Handlers.AutoModerationRuleCreate = append(bot.Handlers.AutoModerationRuleCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationRuleCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
-		}
-
 		if f, ok := function.(func(*AutoModerationRuleUpdate)); ok {
 			bot.Handlers.AutoModerationRuleUpdate = append(bot.Handlers.AutoModerationRuleUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationRuleUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
-		}
-
 		if f, ok := function.(func(*AutoModerationRuleDelete)); ok {
 			bot.Handlers.AutoModerationRuleDelete = append(bot.Handlers.AutoModerationRuleDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationRuleDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameAutoModerationActionExecution:
-		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] {
-			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] = true
-			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_EXECUTION
-		}
-
 		if f, ok := function.(func(*AutoModerationActionExecution)); ok {
 			bot.Handlers.AutoModerationActionExecution = append(bot.Handlers.AutoModerationActionExecution, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.AutoModerationActionExecution)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -178,6 +188,7 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameInteractionCreate:
 		if f, ok := function.(func(*InteractionCreate)); ok {
 			bot.Handlers.InteractionCreate = append(bot.Handlers.InteractionCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InteractionCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -185,23 +196,15 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameVoiceServerUpdate:
 		if f, ok := function.(func(*VoiceServerUpdate)); ok {
 			bot.Handlers.VoiceServerUpdate = append(bot.Handlers.VoiceServerUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.VoiceServerUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMembersChunk:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
-		}
-
 		if f, ok := function.(func(*GuildMembersChunk)); ok {
 			bot.Handlers.GuildMembersChunk = append(bot.Handlers.GuildMembersChunk, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMembersChunk)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
@@ -209,503 +212,721 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 	case FlagGatewayEventNameUserUpdate:
 		if f, ok := function.(func(*UserUpdate)); ok {
 			bot.Handlers.UserUpdate = append(bot.Handlers.UserUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.UserUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelCreate)); ok {
 			bot.Handlers.ChannelCreate = append(bot.Handlers.ChannelCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelUpdate)); ok {
 			bot.Handlers.ChannelUpdate = append(bot.Handlers.ChannelUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelDelete)); ok {
 			bot.Handlers.ChannelDelete = append(bot.Handlers.ChannelDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameChannelPinsUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ChannelPinsUpdate)); ok {
 			bot.Handlers.ChannelPinsUpdate = append(bot.Handlers.ChannelPinsUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ChannelPinsUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadCreate)); ok {
 			bot.Handlers.ThreadCreate = append(bot.Handlers.ThreadCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadUpdate)); ok {
 			bot.Handlers.ThreadUpdate = append(bot.Handlers.ThreadUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadDelete)); ok {
 			bot.Handlers.ThreadDelete = append(bot.Handlers.ThreadDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadListSync:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadListSync)); ok {
 			bot.Handlers.ThreadListSync = append(bot.Handlers.ThreadListSync, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadListSync)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadMemberUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*ThreadMemberUpdate)); ok {
 			bot.Handlers.ThreadMemberUpdate = append(bot.Handlers.ThreadMemberUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadMemberUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameThreadMembersUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*ThreadMembersUpdate)); ok {
 			bot.Handlers.ThreadMembersUpdate = append(bot.Handlers.ThreadMembersUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.ThreadMembersUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildCreate)); ok {
 			bot.Handlers.GuildCreate = append(bot.Handlers.GuildCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildUpdate)); ok {
 			bot.Handlers.GuildUpdate = append(bot.Handlers.GuildUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildDelete)); ok {
 			bot.Handlers.GuildDelete = append(bot.Handlers.GuildDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildAuditLogEntryCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
-		}
-
 		if f, ok := function.(func(*GuildAuditLogEntryCreate)); ok {
 			bot.Handlers.GuildAuditLogEntryCreate = append(bot.Handlers.GuildAuditLogEntryCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildAuditLogEntryCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildBanAdd:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
-		}
-
 		if f, ok := function.(func(*GuildBanAdd)); ok {
 			bot.Handlers.GuildBanAdd = append(bot.Handlers.GuildBanAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildBanAdd)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildBanRemove:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
-		}
-
 		if f, ok := function.(func(*GuildBanRemove)); ok {
 			bot.Handlers.GuildBanRemove = append(bot.Handlers.GuildBanRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildBanRemove)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildEmojisUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
-		}
-
 		if f, ok := function.(func(*GuildEmojisUpdate)); ok {
 			bot.Handlers.GuildEmojisUpdate = append(bot.Handlers.GuildEmojisUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildEmojisUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildStickersUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
-		}
-
 		if f, ok := function.(func(*GuildStickersUpdate)); ok {
 			bot.Handlers.GuildStickersUpdate = append(bot.Handlers.GuildStickersUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildStickersUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildIntegrationsUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*GuildIntegrationsUpdate)); ok {
 			bot.Handlers.GuildIntegrationsUpdate = append(bot.Handlers.GuildIntegrationsUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildIntegrationsUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMemberAdd:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*GuildMemberAdd)); ok {
 			bot.Handlers.GuildMemberAdd = append(bot.Handlers.GuildMemberAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMemberAdd)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMemberRemove:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*GuildMemberRemove)); ok {
 			bot.Handlers.GuildMemberRemove = append(bot.Handlers.GuildMemberRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMemberRemove)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildMemberUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
-		}
-
 		if f, ok := function.(func(*GuildMemberUpdate)); ok {
 			bot.Handlers.GuildMemberUpdate = append(bot.Handlers.GuildMemberUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildMemberUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildRoleCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildRoleCreate)); ok {
 			bot.Handlers.GuildRoleCreate = append(bot.Handlers.GuildRoleCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildRoleCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildRoleUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildRoleUpdate)); ok {
 			bot.Handlers.GuildRoleUpdate = append(bot.Handlers.GuildRoleUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildRoleUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildRoleDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILDS
-		}
-
 		if f, ok := function.(func(*GuildRoleDelete)); ok {
 			bot.Handlers.GuildRoleDelete = append(bot.Handlers.GuildRoleDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildRoleDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventCreate)); ok {
 			bot.Handlers.GuildScheduledEventCreate = append(bot.Handlers.GuildScheduledEventCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventUpdate)); ok {
 			bot.Handlers.GuildScheduledEventUpdate = append(bot.Handlers.GuildScheduledEventUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventDelete)); ok {
 			bot.Handlers.GuildScheduledEventDelete = append(bot.Handlers.GuildScheduledEventDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUserAdd:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventUserAdd)); ok {
 			bot.Handlers.GuildScheduledEventUserAdd = append(bot.Handlers.GuildScheduledEventUserAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventUserAdd)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUserRemove:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
-		}
-
 		if f, ok := function.(func(*GuildScheduledEventUserRemove)); ok {
 			bot.Handlers.GuildScheduledEventUserRemove = append(bot.Handlers.GuildScheduledEventUserRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.GuildScheduledEventUserRemove)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameIntegrationCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*IntegrationCreate)); ok {
 			bot.Handlers.IntegrationCreate = append(bot.Handlers.IntegrationCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.IntegrationCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameIntegrationUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*IntegrationUpdate)); ok {
 			bot.Handlers.IntegrationUpdate = append(bot.Handlers.IntegrationUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.IntegrationUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameIntegrationDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
-		}
-
 		if f, ok := function.(func(*IntegrationDelete)); ok {
 			bot.Handlers.IntegrationDelete = append(bot.Handlers.IntegrationDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.IntegrationDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameInviteCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
-		}
-
 		if f, ok := function.(func(*InviteCreate)); ok {
 			bot.Handlers.InviteCreate = append(bot.Handlers.InviteCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InviteCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameInviteDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
-		}
-
 		if f, ok := function.(func(*InviteDelete)); ok {
 			bot.Handlers.InviteDelete = append(bot.Handlers.InviteDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.InviteDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageCreate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageCreate)); ok {
 			bot.Handlers.MessageCreate = append(bot.Handlers.MessageCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageCreate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageUpdate:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageUpdate)); ok {
 			bot.Handlers.MessageUpdate = append(bot.Handlers.MessageUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageUpdate)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageDelete:
-		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
-		}
-
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageDelete)); ok {
 			bot.Handlers.MessageDelete = append(bot.Handlers.MessageDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageDelete)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
 	case FlagGatewayEventNameMessageDeleteBulk:
-		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
-			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
-		}
-
 		if f, ok := function.(func(*MessageDeleteBulk)); ok {
 			bot.Handlers.MessageDeleteBulk = append(bot.Handlers.MessageDeleteBulk, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageDeleteBulk)-1, sub)
 			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
 			return nil
 		}
 
+	case FlagGatewayEventNameMessageReactionAdd:
+		if f, ok := function.(func(*MessageReactionAdd)); ok {
+			bot.Handlers.MessageReactionAdd = append(bot.Handlers.MessageReactionAdd, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionAdd)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameMessageReactionRemove:
+		if f, ok := function.(func(*MessageReactionRemove)); ok {
+			bot.Handlers.MessageReactionRemove = append(bot.Handlers.MessageReactionRemove, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionRemove)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameMessageReactionRemoveAll:
+		if f, ok := function.(func(*MessageReactionRemoveAll)); ok {
+			bot.Handlers.MessageReactionRemoveAll = append(bot.Handlers.MessageReactionRemoveAll, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionRemoveAll)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameMessageReactionRemoveEmoji:
+		if f, ok := function.(func(*MessageReactionRemoveEmoji)); ok {
+			bot.Handlers.MessageReactionRemoveEmoji = append(bot.Handlers.MessageReactionRemoveEmoji, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.MessageReactionRemoveEmoji)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNamePresenceUpdate:
+		if f, ok := function.(func(*PresenceUpdate)); ok {
+			bot.Handlers.PresenceUpdate = append(bot.Handlers.PresenceUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.PresenceUpdate)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameStageInstanceCreate:
+		if f, ok := function.(func(*StageInstanceCreate)); ok {
+			bot.Handlers.StageInstanceCreate = append(bot.Handlers.StageInstanceCreate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.StageInstanceCreate)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameStageInstanceDelete:
+		if f, ok := function.(func(*StageInstanceDelete)); ok {
+			bot.Handlers.StageInstanceDelete = append(bot.Handlers.StageInstanceDelete, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.StageInstanceDelete)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameStageInstanceUpdate:
+		if f, ok := function.(func(*StageInstanceUpdate)); ok {
+			bot.Handlers.StageInstanceUpdate = append(bot.Handlers.StageInstanceUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.StageInstanceUpdate)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameTypingStart:
+		if f, ok := function.(func(*TypingStart)); ok {
+			bot.Handlers.TypingStart = append(bot.Handlers.TypingStart, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.TypingStart)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameVoiceStateUpdate:
+		if f, ok := function.(func(*VoiceStateUpdate)); ok {
+			bot.Handlers.VoiceStateUpdate = append(bot.Handlers.VoiceStateUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.VoiceStateUpdate)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+
+	case FlagGatewayEventNameWebhooksUpdate:
+		if f, ok := function.(func(*WebhooksUpdate)); ok {
+			bot.Handlers.WebhooksUpdate = append(bot.Handlers.WebhooksUpdate, f)
+			bot.Handlers.track(eventname, len(bot.Handlers.WebhooksUpdate)-1, sub)
+			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
+			return nil
+		}
+	}
+
+	err := ErrorEventHandler{
+		ClientID: bot.ApplicationID,
+		Event:    eventname,
+		Err:      fmt.Errorf("%s", errHandleNotRemoved),
+	}
+	LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+
+	return err
+}
+
+// intend adds the intents that are required to receive the given event to the bot.
+//
+// The bot's Handlers must be locked when intend is called.
+func (bot *Client) intend(eventname string) {
+	switch eventname {
+	case FlagGatewayEventNameAutoModerationRuleCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
+		}
+
+	case FlagGatewayEventNameAutoModerationRuleUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
+		}
+
+	case FlagGatewayEventNameAutoModerationRuleDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_CONFIGURATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_CONFIGURATION
+		}
+
+	case FlagGatewayEventNameAutoModerationActionExecution:
+		if !bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] {
+			bot.Config.Gateway.IntentSet[FlagIntentAUTO_MODERATION_EXECUTION] = true
+			bot.Config.Gateway.Intents |= FlagIntentAUTO_MODERATION_EXECUTION
+		}
+
+	case FlagGatewayEventNameGuildMembersChunk:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
+		}
+
+	case FlagGatewayEventNameChannelCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameChannelUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameChannelDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameChannelPinsUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameThreadCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameThreadUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameThreadDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameThreadListSync:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameThreadMemberUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameThreadMembersUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
+
+	case FlagGatewayEventNameGuildCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameGuildUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameGuildDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameGuildAuditLogEntryCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
+		}
+
+	case FlagGatewayEventNameGuildBanAdd:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
+		}
+
+	case FlagGatewayEventNameGuildBanRemove:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MODERATION] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MODERATION
+		}
+
+	case FlagGatewayEventNameGuildEmojisUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
+		}
+
+	case FlagGatewayEventNameGuildStickersUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_EMOJIS_AND_STICKERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_EMOJIS_AND_STICKERS
+		}
+
+	case FlagGatewayEventNameGuildIntegrationsUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
+		}
+
+	case FlagGatewayEventNameGuildMemberAdd:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
+
+	case FlagGatewayEventNameGuildMemberRemove:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
+
+	case FlagGatewayEventNameGuildMemberUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MEMBERS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MEMBERS
+		}
+
+	case FlagGatewayEventNameGuildRoleCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameGuildRoleUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameGuildRoleDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILDS
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventUserAdd:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
+
+	case FlagGatewayEventNameGuildScheduledEventUserRemove:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_SCHEDULED_EVENTS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_SCHEDULED_EVENTS
+		}
+
+	case FlagGatewayEventNameIntegrationCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
+		}
+
+	case FlagGatewayEventNameIntegrationUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
+		}
+
+	case FlagGatewayEventNameIntegrationDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INTEGRATIONS] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INTEGRATIONS
+		}
+
+	case FlagGatewayEventNameInviteCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
+		}
+
+	case FlagGatewayEventNameInviteDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_INVITES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_INVITES
+		}
+
+	case FlagGatewayEventNameMessageCreate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
+	case FlagGatewayEventNameMessageUpdate:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
+	case FlagGatewayEventNameMessageDelete:
+		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentDIRECT_MESSAGES
+		}
+
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
+	case FlagGatewayEventNameMessageDeleteBulk:
+		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] {
+			bot.Config.Gateway.IntentSet[FlagIntentGUILD_MESSAGES] = true
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGES
+		}
+
 	case FlagGatewayEventNameMessageReactionAdd:
 		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
 			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
@@ -717,12 +938,6 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
 		}
 
-		if f, ok := function.(func(*MessageReactionAdd)); ok {
-			bot.Handlers.MessageReactionAdd = append(bot.Handlers.MessageReactionAdd, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameMessageReactionRemove:
 		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
 			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
@@ -734,12 +949,6 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
 		}
 
-		if f, ok := function.(func(*MessageReactionRemove)); ok {
-			bot.Handlers.MessageReactionRemove = append(bot.Handlers.MessageReactionRemove, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameMessageReactionRemoveAll:
 		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
 			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
@@ -751,12 +960,6 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
 		}
 
-		if f, ok := function.(func(*MessageReactionRemoveAll)); ok {
-			bot.Handlers.MessageReactionRemoveAll = append(bot.Handlers.MessageReactionRemoveAll, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameMessageReactionRemoveEmoji:
 		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] {
 			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_REACTIONS] = true
@@ -768,22 +971,10 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
 		}
 
-		if f, ok := function.(func(*MessageReactionRemoveEmoji)); ok {
-			bot.Handlers.MessageReactionRemoveEmoji = append(bot.Handlers.MessageReactionRemoveEmoji, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNamePresenceUpdate:
 		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] {
 			bot.Config.Gateway.IntentSet[FlagIntentGUILD_PRESENCES] = true
-			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
-		}
-
-		if f, ok := function.(func(*PresenceUpdate)); ok {
-			bot.Handlers.PresenceUpdate = append(bot.Handlers.PresenceUpdate, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
+			bot.Config.Gateway.Intents |= FlagIntentGUILD_PRESENCES
 		}
 
 	case FlagGatewayEventNameStageInstanceCreate:
@@ -792,36 +983,18 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		if f, ok := function.(func(*StageInstanceCreate)); ok {
-			bot.Handlers.StageInstanceCreate = append(bot.Handlers.StageInstanceCreate, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameStageInstanceDelete:
 		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
 			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
 			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		if f, ok := function.(func(*StageInstanceDelete)); ok {
-			bot.Handlers.StageInstanceDelete = append(bot.Handlers.StageInstanceDelete, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameStageInstanceUpdate:
 		if !bot.Config.Gateway.IntentSet[FlagIntentGUILDS] {
 			bot.Config.Gateway.IntentSet[FlagIntentGUILDS] = true
 			bot.Config.Gateway.Intents |= FlagIntentGUILDS
 		}
 
-		if f, ok := function.(func(*StageInstanceUpdate)); ok {
-			bot.Handlers.StageInstanceUpdate = append(bot.Handlers.StageInstanceUpdate, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameTypingStart:
 		if !bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_TYPING] {
 			bot.Config.Gateway.IntentSet[FlagIntentDIRECT_MESSAGE_TYPING] = true
@@ -833,45 +1006,18 @@ func (bot *Client) Handle(eventname string, function interface{}) error {
 			bot.Config.Gateway.Intents |= FlagIntentGUILD_MESSAGE_REACTIONS
 		}
 
-		if f, ok := function.(func(*TypingStart)); ok {
-			bot.Handlers.TypingStart = append(bot.Handlers.TypingStart, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameVoiceStateUpdate:
 		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_VOICE_STATES] {
 			bot.Config.Gateway.IntentSet[FlagIntentGUILD_VOICE_STATES] = true
 			bot.Config.Gateway.Intents |= FlagIntentGUILD_VOICE_STATES
 		}
 
-		if f, ok := function.(func(*VoiceStateUpdate)); ok {
-			bot.Handlers.VoiceStateUpdate = append(bot.Handlers.VoiceStateUpdate, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-
 	case FlagGatewayEventNameWebhooksUpdate:
 		if !bot.Config.Gateway.IntentSet[FlagIntentGUILD_WEBHOOKS] {
 			bot.Config.Gateway.IntentSet[FlagIntentGUILD_WEBHOOKS] = true
 			bot.Config.Gateway.Intents |= FlagIntentGUILD_WEBHOOKS
 		}
-
-		if f, ok := function.(func(*WebhooksUpdate)); ok {
-			bot.Handlers.WebhooksUpdate = append(bot.Handlers.WebhooksUpdate, f)
-			LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("added event handler")
-			return nil
-		}
-	}
-
-	err := ErrorEventHandler{
-		ClientID: bot.ApplicationID,
-		Event:    eventname,
-		Err:      fmt.Errorf("%s", errHandleNotRemoved),
 	}
-	LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
-
-	return err
 }
 
 // Remove removes the event handler at the given index from the bot.
@@ -880,7 +1026,27 @@ func (bot *Client) Remove(eventname string, index int) error {
 	bot.Handlers.mu.Lock()
 	defer bot.Handlers.mu.Unlock()
 
+	return bot.remove(eventname, index)
+}
+
+// remove removes the event handler at the given index from the bot.
+//
+// The bot's Handlers must be locked when remove is called.
+func (bot *Client) remove(eventname string, index int) error {
 	switch eventname {
+	case FlagGatewayEventNameRaw:
+		if len(bot.Handlers.Raw) <= index {
+			err := ErrorEventHandler{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      fmt.Errorf(errRemoveInvalidIndex, index),
+			}
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+			return err
+		}
+
+		bot.Handlers.Raw = append(bot.Handlers.Raw[:index:index], bot.Handlers.Raw[index+1:]...)
+
 	case FlagGatewayEventNameHello:
 		if len(bot.Handlers.Hello) <= index {
 			err := ErrorEventHandler{
@@ -892,7 +1058,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.Hello = append(bot.Handlers.Hello[:index], bot.Handlers.Hello[index+1:]...)
+		bot.Handlers.Hello = append(bot.Handlers.Hello[:index:index], bot.Handlers.Hello[index+1:]...)
 
 	case FlagGatewayEventNameReady:
 		if len(bot.Handlers.Ready) <= index {
@@ -905,7 +1071,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.Ready = append(bot.Handlers.Ready[:index], bot.Handlers.Ready[index+1:]...)
+		bot.Handlers.Ready = append(bot.Handlers.Ready[:index:index], bot.Handlers.Ready[index+1:]...)
 
 	case FlagGatewayEventNameResumed:
 		if len(bot.Handlers.Resumed) <= index {
@@ -918,7 +1084,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.Resumed = append(bot.Handlers.Resumed[:index], bot.Handlers.Resumed[index+1:]...)
+		bot.Handlers.Resumed = append(bot.Handlers.Resumed[:index:index], bot.Handlers.Resumed[index+1:]...)
 
 	case FlagGatewayEventNameReconnect:
 		if len(bot.Handlers.Reconnect) <= index {
@@ -931,7 +1097,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.Reconnect = append(bot.Handlers.Reconnect[:index], bot.Handlers.Reconnect[index+1:]...)
+		bot.Handlers.Reconnect = append(bot.Handlers.Reconnect[:index:index], bot.Handlers.Reconnect[index+1:]...)
 
 	case FlagGatewayEventNameInvalidSession:
 		if len(bot.Handlers.InvalidSession) <= index {
@@ -944,7 +1110,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.InvalidSession = append(bot.Handlers.InvalidSession[:index], bot.Handlers.InvalidSession[index+1:]...)
+		bot.Handlers.InvalidSession = append(bot.Handlers.InvalidSession[:index:index], bot.Handlers.InvalidSession[index+1:]...)
 
 	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
 		if len(bot.Handlers.ApplicationCommandPermissionsUpdate) <= index {
@@ -957,7 +1123,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ApplicationCommandPermissionsUpdate = append(bot.Handlers.ApplicationCommandPermissionsUpdate[:index], bot.Handlers.ApplicationCommandPermissionsUpdate[index+1:]...)
+		bot.Handlers.ApplicationCommandPermissionsUpdate = append(bot.Handlers.ApplicationCommandPermissionsUpdate[:index:index], bot.Handlers.ApplicationCommandPermissionsUpdate[index+1:]...)
 
 	case FlagGatewayEventNameAutoModerationRuleCreate:
 		if len(bot.Handlers.AutoModerationRuleCreate) <= index {
@@ -970,7 +1136,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.AutoModerationRuleCreate = append(bot.Handlers.AutoModerationRuleCreate[:index], bot.Handlers.AutoModerationRuleCreate[index+1:]...)
+		bot.Handlers.AutoModerationRuleCreate = append(bot.Handlers.AutoModerationRuleCreate[:index:index], bot.Handlers.AutoModerationRuleCreate[index+1:]...)
 
 	case FlagGatewayEventNameAutoModerationRuleUpdate:
 		if len(bot.Handlers.AutoModerationRuleUpdate) <= index {
@@ -983,7 +1149,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.AutoModerationRuleUpdate = append(bot.Handlers.AutoModerationRuleUpdate[:index], bot.Handlers.AutoModerationRuleUpdate[index+1:]...)
+		bot.Handlers.AutoModerationRuleUpdate = append(bot.Handlers.AutoModerationRuleUpdate[:index:index], bot.Handlers.AutoModerationRuleUpdate[index+1:]...)
 
 	case FlagGatewayEventNameAutoModerationRuleDelete:
 		if len(bot.Handlers.AutoModerationRuleDelete) <= index {
@@ -996,7 +1162,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.AutoModerationRuleDelete = append(bot.Handlers.AutoModerationRuleDelete[:index], bot.Handlers.AutoModerationRuleDelete[index+1:]...)
+		bot.Handlers.AutoModerationRuleDelete = append(bot.Handlers.AutoModerationRuleDelete[:index:index], bot.Handlers.AutoModerationRuleDelete[index+1:]...)
 
 	case FlagGatewayEventNameAutoModerationActionExecution:
 		if len(bot.Handlers.AutoModerationActionExecution) <= index {
@@ -1009,7 +1175,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.AutoModerationActionExecution = append(bot.Handlers.AutoModerationActionExecution[:index], bot.Handlers.AutoModerationActionExecution[index+1:]...)
+		bot.Handlers.AutoModerationActionExecution = append(bot.Handlers.AutoModerationActionExecution[:index:index], bot.Handlers.AutoModerationActionExecution[index+1:]...)
 
 	case FlagGatewayEventNameInteractionCreate:
 		if len(bot.Handlers.InteractionCreate) <= index {
@@ -1022,7 +1188,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.InteractionCreate = append(bot.Handlers.InteractionCreate[:index], bot.Handlers.InteractionCreate[index+1:]...)
+		bot.Handlers.InteractionCreate = append(bot.Handlers.InteractionCreate[:index:index], bot.Handlers.InteractionCreate[index+1:]...)
 
 	case FlagGatewayEventNameVoiceServerUpdate:
 		if len(bot.Handlers.VoiceServerUpdate) <= index {
@@ -1035,7 +1201,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.VoiceServerUpdate = append(bot.Handlers.VoiceServerUpdate[:index], bot.Handlers.VoiceServerUpdate[index+1:]...)
+		bot.Handlers.VoiceServerUpdate = append(bot.Handlers.VoiceServerUpdate[:index:index], bot.Handlers.VoiceServerUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildMembersChunk:
 		if len(bot.Handlers.GuildMembersChunk) <= index {
@@ -1048,7 +1214,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildMembersChunk = append(bot.Handlers.GuildMembersChunk[:index], bot.Handlers.GuildMembersChunk[index+1:]...)
+		bot.Handlers.GuildMembersChunk = append(bot.Handlers.GuildMembersChunk[:index:index], bot.Handlers.GuildMembersChunk[index+1:]...)
 
 	case FlagGatewayEventNameUserUpdate:
 		if len(bot.Handlers.UserUpdate) <= index {
@@ -1061,7 +1227,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.UserUpdate = append(bot.Handlers.UserUpdate[:index], bot.Handlers.UserUpdate[index+1:]...)
+		bot.Handlers.UserUpdate = append(bot.Handlers.UserUpdate[:index:index], bot.Handlers.UserUpdate[index+1:]...)
 
 	case FlagGatewayEventNameChannelCreate:
 		if len(bot.Handlers.ChannelCreate) <= index {
@@ -1074,7 +1240,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ChannelCreate = append(bot.Handlers.ChannelCreate[:index], bot.Handlers.ChannelCreate[index+1:]...)
+		bot.Handlers.ChannelCreate = append(bot.Handlers.ChannelCreate[:index:index], bot.Handlers.ChannelCreate[index+1:]...)
 
 	case FlagGatewayEventNameChannelUpdate:
 		if len(bot.Handlers.ChannelUpdate) <= index {
@@ -1087,7 +1253,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ChannelUpdate = append(bot.Handlers.ChannelUpdate[:index], bot.Handlers.ChannelUpdate[index+1:]...)
+		bot.Handlers.ChannelUpdate = append(bot.Handlers.ChannelUpdate[:index:index], bot.Handlers.ChannelUpdate[index+1:]...)
 
 	case FlagGatewayEventNameChannelDelete:
 		if len(bot.Handlers.ChannelDelete) <= index {
@@ -1100,7 +1266,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ChannelDelete = append(bot.Handlers.ChannelDelete[:index], bot.Handlers.ChannelDelete[index+1:]...)
+		bot.Handlers.ChannelDelete = append(bot.Handlers.ChannelDelete[:index:index], bot.Handlers.ChannelDelete[index+1:]...)
 
 	case FlagGatewayEventNameChannelPinsUpdate:
 		if len(bot.Handlers.ChannelPinsUpdate) <= index {
@@ -1113,7 +1279,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ChannelPinsUpdate = append(bot.Handlers.ChannelPinsUpdate[:index], bot.Handlers.ChannelPinsUpdate[index+1:]...)
+		bot.Handlers.ChannelPinsUpdate = append(bot.Handlers.ChannelPinsUpdate[:index:index], bot.Handlers.ChannelPinsUpdate[index+1:]...)
 
 	case FlagGatewayEventNameThreadCreate:
 		if len(bot.Handlers.ThreadCreate) <= index {
@@ -1126,7 +1292,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadCreate = append(bot.Handlers.ThreadCreate[:index], bot.Handlers.ThreadCreate[index+1:]...)
+		bot.Handlers.ThreadCreate = append(bot.Handlers.ThreadCreate[:index:index], bot.Handlers.ThreadCreate[index+1:]...)
 
 	case FlagGatewayEventNameThreadUpdate:
 		if len(bot.Handlers.ThreadUpdate) <= index {
@@ -1139,7 +1305,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadUpdate = append(bot.Handlers.ThreadUpdate[:index], bot.Handlers.ThreadUpdate[index+1:]...)
+		bot.Handlers.ThreadUpdate = append(bot.Handlers.ThreadUpdate[:index:index], bot.Handlers.ThreadUpdate[index+1:]...)
 
 	case FlagGatewayEventNameThreadDelete:
 		if len(bot.Handlers.ThreadDelete) <= index {
@@ -1152,7 +1318,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadDelete = append(bot.Handlers.ThreadDelete[:index], bot.Handlers.ThreadDelete[index+1:]...)
+		bot.Handlers.ThreadDelete = append(bot.Handlers.ThreadDelete[:index:index], bot.Handlers.ThreadDelete[index+1:]...)
 
 	case FlagGatewayEventNameThreadListSync:
 		if len(bot.Handlers.ThreadListSync) <= index {
@@ -1165,7 +1331,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadListSync = append(bot.Handlers.ThreadListSync[:index], bot.Handlers.ThreadListSync[index+1:]...)
+		bot.Handlers.ThreadListSync = append(bot.Handlers.ThreadListSync[:index:index], bot.Handlers.ThreadListSync[index+1:]...)
 
 	case FlagGatewayEventNameThreadMemberUpdate:
 		if len(bot.Handlers.ThreadMemberUpdate) <= index {
@@ -1178,7 +1344,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadMemberUpdate = append(bot.Handlers.ThreadMemberUpdate[:index], bot.Handlers.ThreadMemberUpdate[index+1:]...)
+		bot.Handlers.ThreadMemberUpdate = append(bot.Handlers.ThreadMemberUpdate[:index:index], bot.Handlers.ThreadMemberUpdate[index+1:]...)
 
 	case FlagGatewayEventNameThreadMembersUpdate:
 		if len(bot.Handlers.ThreadMembersUpdate) <= index {
@@ -1191,7 +1357,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.ThreadMembersUpdate = append(bot.Handlers.ThreadMembersUpdate[:index], bot.Handlers.ThreadMembersUpdate[index+1:]...)
+		bot.Handlers.ThreadMembersUpdate = append(bot.Handlers.ThreadMembersUpdate[:index:index], bot.Handlers.ThreadMembersUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildCreate:
 		if len(bot.Handlers.GuildCreate) <= index {
@@ -1204,7 +1370,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildCreate = append(bot.Handlers.GuildCreate[:index], bot.Handlers.GuildCreate[index+1:]...)
+		bot.Handlers.GuildCreate = append(bot.Handlers.GuildCreate[:index:index], bot.Handlers.GuildCreate[index+1:]...)
 
 	case FlagGatewayEventNameGuildUpdate:
 		if len(bot.Handlers.GuildUpdate) <= index {
@@ -1217,7 +1383,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildUpdate = append(bot.Handlers.GuildUpdate[:index], bot.Handlers.GuildUpdate[index+1:]...)
+		bot.Handlers.GuildUpdate = append(bot.Handlers.GuildUpdate[:index:index], bot.Handlers.GuildUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildDelete:
 		if len(bot.Handlers.GuildDelete) <= index {
@@ -1230,7 +1396,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildDelete = append(bot.Handlers.GuildDelete[:index], bot.Handlers.GuildDelete[index+1:]...)
+		bot.Handlers.GuildDelete = append(bot.Handlers.GuildDelete[:index:index], bot.Handlers.GuildDelete[index+1:]...)
 
 	case FlagGatewayEventNameGuildAuditLogEntryCreate:
 		if len(bot.Handlers.GuildAuditLogEntryCreate) <= index {
@@ -1243,7 +1409,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildAuditLogEntryCreate = append(bot.Handlers.GuildAuditLogEntryCreate[:index], bot.Handlers.GuildAuditLogEntryCreate[index+1:]...)
+		bot.Handlers.GuildAuditLogEntryCreate = append(bot.Handlers.GuildAuditLogEntryCreate[:index:index], bot.Handlers.GuildAuditLogEntryCreate[index+1:]...)
 
 	case FlagGatewayEventNameGuildBanAdd:
 		if len(bot.Handlers.GuildBanAdd) <= index {
@@ -1256,7 +1422,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildBanAdd = append(bot.Handlers.GuildBanAdd[:index], bot.Handlers.GuildBanAdd[index+1:]...)
+		bot.Handlers.GuildBanAdd = append(bot.Handlers.GuildBanAdd[:index:index], bot.Handlers.GuildBanAdd[index+1:]...)
 
 	case FlagGatewayEventNameGuildBanRemove:
 		if len(bot.Handlers.GuildBanRemove) <= index {
@@ -1269,7 +1435,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildBanRemove = append(bot.Handlers.GuildBanRemove[:index], bot.Handlers.GuildBanRemove[index+1:]...)
+		bot.Handlers.GuildBanRemove = append(bot.Handlers.GuildBanRemove[:index:index], bot.Handlers.GuildBanRemove[index+1:]...)
 
 	case FlagGatewayEventNameGuildEmojisUpdate:
 		if len(bot.Handlers.GuildEmojisUpdate) <= index {
@@ -1282,7 +1448,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildEmojisUpdate = append(bot.Handlers.GuildEmojisUpdate[:index], bot.Handlers.GuildEmojisUpdate[index+1:]...)
+		bot.Handlers.GuildEmojisUpdate = append(bot.Handlers.GuildEmojisUpdate[:index:index], bot.Handlers.GuildEmojisUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildStickersUpdate:
 		if len(bot.Handlers.GuildStickersUpdate) <= index {
@@ -1295,7 +1461,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildStickersUpdate = append(bot.Handlers.GuildStickersUpdate[:index], bot.Handlers.GuildStickersUpdate[index+1:]...)
+		bot.Handlers.GuildStickersUpdate = append(bot.Handlers.GuildStickersUpdate[:index:index], bot.Handlers.GuildStickersUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildIntegrationsUpdate:
 		if len(bot.Handlers.GuildIntegrationsUpdate) <= index {
@@ -1308,7 +1474,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildIntegrationsUpdate = append(bot.Handlers.GuildIntegrationsUpdate[:index], bot.Handlers.GuildIntegrationsUpdate[index+1:]...)
+		bot.Handlers.GuildIntegrationsUpdate = append(bot.Handlers.GuildIntegrationsUpdate[:index:index], bot.Handlers.GuildIntegrationsUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildMemberAdd:
 		if len(bot.Handlers.GuildMemberAdd) <= index {
@@ -1321,7 +1487,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildMemberAdd = append(bot.Handlers.GuildMemberAdd[:index], bot.Handlers.GuildMemberAdd[index+1:]...)
+		bot.Handlers.GuildMemberAdd = append(bot.Handlers.GuildMemberAdd[:index:index], bot.Handlers.GuildMemberAdd[index+1:]...)
 
 	case FlagGatewayEventNameGuildMemberRemove:
 		if len(bot.Handlers.GuildMemberRemove) <= index {
@@ -1334,7 +1500,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildMemberRemove = append(bot.Handlers.GuildMemberRemove[:index], bot.Handlers.GuildMemberRemove[index+1:]...)
+		bot.Handlers.GuildMemberRemove = append(bot.Handlers.GuildMemberRemove[:index:index], bot.Handlers.GuildMemberRemove[index+1:]...)
 
 	case FlagGatewayEventNameGuildMemberUpdate:
 		if len(bot.Handlers.GuildMemberUpdate) <= index {
@@ -1347,7 +1513,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildMemberUpdate = append(bot.Handlers.GuildMemberUpdate[:index], bot.Handlers.GuildMemberUpdate[index+1:]...)
+		bot.Handlers.GuildMemberUpdate = append(bot.Handlers.GuildMemberUpdate[:index:index], bot.Handlers.GuildMemberUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildRoleCreate:
 		if len(bot.Handlers.GuildRoleCreate) <= index {
@@ -1360,7 +1526,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildRoleCreate = append(bot.Handlers.GuildRoleCreate[:index], bot.Handlers.GuildRoleCreate[index+1:]...)
+		bot.Handlers.GuildRoleCreate = append(bot.Handlers.GuildRoleCreate[:index:index], bot.Handlers.GuildRoleCreate[index+1:]...)
 
 	case FlagGatewayEventNameGuildRoleUpdate:
 		if len(bot.Handlers.GuildRoleUpdate) <= index {
@@ -1373,7 +1539,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildRoleUpdate = append(bot.Handlers.GuildRoleUpdate[:index], bot.Handlers.GuildRoleUpdate[index+1:]...)
+		bot.Handlers.GuildRoleUpdate = append(bot.Handlers.GuildRoleUpdate[:index:index], bot.Handlers.GuildRoleUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildRoleDelete:
 		if len(bot.Handlers.GuildRoleDelete) <= index {
@@ -1386,7 +1552,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildRoleDelete = append(bot.Handlers.GuildRoleDelete[:index], bot.Handlers.GuildRoleDelete[index+1:]...)
+		bot.Handlers.GuildRoleDelete = append(bot.Handlers.GuildRoleDelete[:index:index], bot.Handlers.GuildRoleDelete[index+1:]...)
 
 	case FlagGatewayEventNameGuildScheduledEventCreate:
 		if len(bot.Handlers.GuildScheduledEventCreate) <= index {
@@ -1399,7 +1565,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventCreate = append(bot.Handlers.GuildScheduledEventCreate[:index], bot.Handlers.GuildScheduledEventCreate[index+1:]...)
+		bot.Handlers.GuildScheduledEventCreate = append(bot.Handlers.GuildScheduledEventCreate[:index:index], bot.Handlers.GuildScheduledEventCreate[index+1:]...)
 
 	case FlagGatewayEventNameGuildScheduledEventUpdate:
 		if len(bot.Handlers.GuildScheduledEventUpdate) <= index {
@@ -1412,7 +1578,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventUpdate = append(bot.Handlers.GuildScheduledEventUpdate[:index], bot.Handlers.GuildScheduledEventUpdate[index+1:]...)
+		bot.Handlers.GuildScheduledEventUpdate = append(bot.Handlers.GuildScheduledEventUpdate[:index:index], bot.Handlers.GuildScheduledEventUpdate[index+1:]...)
 
 	case FlagGatewayEventNameGuildScheduledEventDelete:
 		if len(bot.Handlers.GuildScheduledEventDelete) <= index {
@@ -1425,7 +1591,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventDelete = append(bot.Handlers.GuildScheduledEventDelete[:index], bot.Handlers.GuildScheduledEventDelete[index+1:]...)
+		bot.Handlers.GuildScheduledEventDelete = append(bot.Handlers.GuildScheduledEventDelete[:index:index], bot.Handlers.GuildScheduledEventDelete[index+1:]...)
 
 	case FlagGatewayEventNameGuildScheduledEventUserAdd:
 		if len(bot.Handlers.GuildScheduledEventUserAdd) <= index {
@@ -1438,7 +1604,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventUserAdd = append(bot.Handlers.GuildScheduledEventUserAdd[:index], bot.Handlers.GuildScheduledEventUserAdd[index+1:]...)
+		bot.Handlers.GuildScheduledEventUserAdd = append(bot.Handlers.GuildScheduledEventUserAdd[:index:index], bot.Handlers.GuildScheduledEventUserAdd[index+1:]...)
 
 	case FlagGatewayEventNameGuildScheduledEventUserRemove:
 		if len(bot.Handlers.GuildScheduledEventUserRemove) <= index {
@@ -1451,7 +1617,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.GuildScheduledEventUserRemove = append(bot.Handlers.GuildScheduledEventUserRemove[:index], bot.Handlers.GuildScheduledEventUserRemove[index+1:]...)
+		bot.Handlers.GuildScheduledEventUserRemove = append(bot.Handlers.GuildScheduledEventUserRemove[:index:index], bot.Handlers.GuildScheduledEventUserRemove[index+1:]...)
 
 	case FlagGatewayEventNameIntegrationCreate:
 		if len(bot.Handlers.IntegrationCreate) <= index {
@@ -1464,7 +1630,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.IntegrationCreate = append(bot.Handlers.IntegrationCreate[:index], bot.Handlers.IntegrationCreate[index+1:]...)
+		bot.Handlers.IntegrationCreate = append(bot.Handlers.IntegrationCreate[:index:index], bot.Handlers.IntegrationCreate[index+1:]...)
 
 	case FlagGatewayEventNameIntegrationUpdate:
 		if len(bot.Handlers.IntegrationUpdate) <= index {
@@ -1477,7 +1643,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.IntegrationUpdate = append(bot.Handlers.IntegrationUpdate[:index], bot.Handlers.IntegrationUpdate[index+1:]...)
+		bot.Handlers.IntegrationUpdate = append(bot.Handlers.IntegrationUpdate[:index:index], bot.Handlers.IntegrationUpdate[index+1:]...)
 
 	case FlagGatewayEventNameIntegrationDelete:
 		if len(bot.Handlers.IntegrationDelete) <= index {
@@ -1490,7 +1656,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.IntegrationDelete = append(bot.Handlers.IntegrationDelete[:index], bot.Handlers.IntegrationDelete[index+1:]...)
+		bot.Handlers.IntegrationDelete = append(bot.Handlers.IntegrationDelete[:index:index], bot.Handlers.IntegrationDelete[index+1:]...)
 
 	case FlagGatewayEventNameInviteCreate:
 		if len(bot.Handlers.InviteCreate) <= index {
@@ -1503,7 +1669,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.InviteCreate = append(bot.Handlers.InviteCreate[:index], bot.Handlers.InviteCreate[index+1:]...)
+		bot.Handlers.InviteCreate = append(bot.Handlers.InviteCreate[:index:index], bot.Handlers.InviteCreate[index+1:]...)
 
 	case FlagGatewayEventNameInviteDelete:
 		if len(bot.Handlers.InviteDelete) <= index {
@@ -1516,7 +1682,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.InviteDelete = append(bot.Handlers.InviteDelete[:index], bot.Handlers.InviteDelete[index+1:]...)
+		bot.Handlers.InviteDelete = append(bot.Handlers.InviteDelete[:index:index], bot.Handlers.InviteDelete[index+1:]...)
 
 	case FlagGatewayEventNameMessageCreate:
 		if len(bot.Handlers.MessageCreate) <= index {
@@ -1529,7 +1695,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageCreate = append(bot.Handlers.MessageCreate[:index], bot.Handlers.MessageCreate[index+1:]...)
+		bot.Handlers.MessageCreate = append(bot.Handlers.MessageCreate[:index:index], bot.Handlers.MessageCreate[index+1:]...)
 
 	case FlagGatewayEventNameMessageUpdate:
 		if len(bot.Handlers.MessageUpdate) <= index {
@@ -1542,7 +1708,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageUpdate = append(bot.Handlers.MessageUpdate[:index], bot.Handlers.MessageUpdate[index+1:]...)
+		bot.Handlers.MessageUpdate = append(bot.Handlers.MessageUpdate[:index:index], bot.Handlers.MessageUpdate[index+1:]...)
 
 	case FlagGatewayEventNameMessageDelete:
 		if len(bot.Handlers.MessageDelete) <= index {
@@ -1555,7 +1721,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageDelete = append(bot.Handlers.MessageDelete[:index], bot.Handlers.MessageDelete[index+1:]...)
+		bot.Handlers.MessageDelete = append(bot.Handlers.MessageDelete[:index:index], bot.Handlers.MessageDelete[index+1:]...)
 
 	case FlagGatewayEventNameMessageDeleteBulk:
 		if len(bot.Handlers.MessageDeleteBulk) <= index {
@@ -1568,7 +1734,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageDeleteBulk = append(bot.Handlers.MessageDeleteBulk[:index], bot.Handlers.MessageDeleteBulk[index+1:]...)
+		bot.Handlers.MessageDeleteBulk = append(bot.Handlers.MessageDeleteBulk[:index:index], bot.Handlers.MessageDeleteBulk[index+1:]...)
 
 	case FlagGatewayEventNameMessageReactionAdd:
 		if len(bot.Handlers.MessageReactionAdd) <= index {
@@ -1581,7 +1747,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionAdd = append(bot.Handlers.MessageReactionAdd[:index], bot.Handlers.MessageReactionAdd[index+1:]...)
+		bot.Handlers.MessageReactionAdd = append(bot.Handlers.MessageReactionAdd[:index:index], bot.Handlers.MessageReactionAdd[index+1:]...)
 
 	case FlagGatewayEventNameMessageReactionRemove:
 		if len(bot.Handlers.MessageReactionRemove) <= index {
@@ -1594,7 +1760,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionRemove = append(bot.Handlers.MessageReactionRemove[:index], bot.Handlers.MessageReactionRemove[index+1:]...)
+		bot.Handlers.MessageReactionRemove = append(bot.Handlers.MessageReactionRemove[:index:index], bot.Handlers.MessageReactionRemove[index+1:]...)
 
 	case FlagGatewayEventNameMessageReactionRemoveAll:
 		if len(bot.Handlers.MessageReactionRemoveAll) <= index {
@@ -1607,7 +1773,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionRemoveAll = append(bot.Handlers.MessageReactionRemoveAll[:index], bot.Handlers.MessageReactionRemoveAll[index+1:]...)
+		bot.Handlers.MessageReactionRemoveAll = append(bot.Handlers.MessageReactionRemoveAll[:index:index], bot.Handlers.MessageReactionRemoveAll[index+1:]...)
 
 	case FlagGatewayEventNameMessageReactionRemoveEmoji:
 		if len(bot.Handlers.MessageReactionRemoveEmoji) <= index {
@@ -1620,7 +1786,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.MessageReactionRemoveEmoji = append(bot.Handlers.MessageReactionRemoveEmoji[:index], bot.Handlers.MessageReactionRemoveEmoji[index+1:]...)
+		bot.Handlers.MessageReactionRemoveEmoji = append(bot.Handlers.MessageReactionRemoveEmoji[:index:index], bot.Handlers.MessageReactionRemoveEmoji[index+1:]...)
 
 	case FlagGatewayEventNamePresenceUpdate:
 		if len(bot.Handlers.PresenceUpdate) <= index {
@@ -1633,7 +1799,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.PresenceUpdate = append(bot.Handlers.PresenceUpdate[:index], bot.Handlers.PresenceUpdate[index+1:]...)
+		bot.Handlers.PresenceUpdate = append(bot.Handlers.PresenceUpdate[:index:index], bot.Handlers.PresenceUpdate[index+1:]...)
 
 	case FlagGatewayEventNameStageInstanceCreate:
 		if len(bot.Handlers.StageInstanceCreate) <= index {
@@ -1646,7 +1812,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.StageInstanceCreate = append(bot.Handlers.StageInstanceCreate[:index], bot.Handlers.StageInstanceCreate[index+1:]...)
+		bot.Handlers.StageInstanceCreate = append(bot.Handlers.StageInstanceCreate[:index:index], bot.Handlers.StageInstanceCreate[index+1:]...)
 
 	case FlagGatewayEventNameStageInstanceDelete:
 		if len(bot.Handlers.StageInstanceDelete) <= index {
@@ -1659,7 +1825,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.StageInstanceDelete = append(bot.Handlers.StageInstanceDelete[:index], bot.Handlers.StageInstanceDelete[index+1:]...)
+		bot.Handlers.StageInstanceDelete = append(bot.Handlers.StageInstanceDelete[:index:index], bot.Handlers.StageInstanceDelete[index+1:]...)
 
 	case FlagGatewayEventNameStageInstanceUpdate:
 		if len(bot.Handlers.StageInstanceUpdate) <= index {
@@ -1672,7 +1838,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.StageInstanceUpdate = append(bot.Handlers.StageInstanceUpdate[:index], bot.Handlers.StageInstanceUpdate[index+1:]...)
+		bot.Handlers.StageInstanceUpdate = append(bot.Handlers.StageInstanceUpdate[:index:index], bot.Handlers.StageInstanceUpdate[index+1:]...)
 
 	case FlagGatewayEventNameTypingStart:
 		if len(bot.Handlers.TypingStart) <= index {
@@ -1685,7 +1851,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.TypingStart = append(bot.Handlers.TypingStart[:index], bot.Handlers.TypingStart[index+1:]...)
+		bot.Handlers.TypingStart = append(bot.Handlers.TypingStart[:index:index], bot.Handlers.TypingStart[index+1:]...)
 
 	case FlagGatewayEventNameVoiceStateUpdate:
 		if len(bot.Handlers.VoiceStateUpdate) <= index {
@@ -1698,7 +1864,7 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.VoiceStateUpdate = append(bot.Handlers.VoiceStateUpdate[:index], bot.Handlers.VoiceStateUpdate[index+1:]...)
+		bot.Handlers.VoiceStateUpdate = append(bot.Handlers.VoiceStateUpdate[:index:index], bot.Handlers.VoiceStateUpdate[index+1:]...)
 
 	case FlagGatewayEventNameWebhooksUpdate:
 		if len(bot.Handlers.WebhooksUpdate) <= index {
@@ -1711,850 +1877,1501 @@ func (bot *Client) Remove(eventname string, index int) error {
 			return err
 		}
 
-		bot.Handlers.WebhooksUpdate = append(bot.Handlers.WebhooksUpdate[:index], bot.Handlers.WebhooksUpdate[index+1:]...)
+		bot.Handlers.WebhooksUpdate = append(bot.Handlers.WebhooksUpdate[:index:index], bot.Handlers.WebhooksUpdate[index+1:]...)
 	}
 
+	bot.Handlers.untrack(eventname, index)
 	LogEventHandler(Logger.Info(), bot.ApplicationID, eventname).Msg("removed event handler")
 
 	return nil
 }
 
 // handle handles an event using its name and data.
-func (bot *Client) handle(eventname string, data json.RawMessage) {
-	bot.Handlers.mu.RLock()
-	defer bot.Handlers.mu.RUnlock()
+//
+// The event handlers are called on their own goroutines when the dispatch is async.
+func (bot *Client) handle(d *dispatch) {
+	bot.raw(d)
 
-	switch eventname {
+	switch d.eventname {
 	case FlagGatewayEventNameHello:
-		if len(bot.Handlers.Hello) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Hello
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(Hello)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameHello, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameHello, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.Hello {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameReady:
-		if len(bot.Handlers.Ready) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Ready
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(Ready)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReady, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReady, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.Ready {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameResumed:
-		if len(bot.Handlers.Resumed) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Resumed
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(Resumed)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameResumed, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameResumed, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.Resumed {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameReconnect:
-		if len(bot.Handlers.Reconnect) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.Reconnect
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(Reconnect)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReconnect, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameReconnect, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.Reconnect {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameInvalidSession:
-		if len(bot.Handlers.InvalidSession) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InvalidSession
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(InvalidSession)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInvalidSession, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInvalidSession, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.InvalidSession {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameApplicationCommandPermissionsUpdate:
-		if len(bot.Handlers.ApplicationCommandPermissionsUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ApplicationCommandPermissionsUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ApplicationCommandPermissionsUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameApplicationCommandPermissionsUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameApplicationCommandPermissionsUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ApplicationCommandPermissionsUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleCreate:
-		if len(bot.Handlers.AutoModerationRuleCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationRuleCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(AutoModerationRuleCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.AutoModerationRuleCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleUpdate:
-		if len(bot.Handlers.AutoModerationRuleUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationRuleUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(AutoModerationRuleUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.AutoModerationRuleUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameAutoModerationRuleDelete:
-		if len(bot.Handlers.AutoModerationRuleDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationRuleDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(AutoModerationRuleDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationRuleDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.AutoModerationRuleDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameAutoModerationActionExecution:
-		if len(bot.Handlers.AutoModerationActionExecution) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.AutoModerationActionExecution
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(AutoModerationActionExecution)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationActionExecution, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameAutoModerationActionExecution, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.AutoModerationActionExecution {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameInteractionCreate:
-		if len(bot.Handlers.InteractionCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InteractionCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(InteractionCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInteractionCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInteractionCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.InteractionCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameVoiceServerUpdate:
-		if len(bot.Handlers.VoiceServerUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.VoiceServerUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(VoiceServerUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceServerUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceServerUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.VoiceServerUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildMembersChunk:
-		if len(bot.Handlers.GuildMembersChunk) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMembersChunk
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildMembersChunk)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMembersChunk, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMembersChunk, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildMembersChunk {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameUserUpdate:
-		if len(bot.Handlers.UserUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.UserUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(UserUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameUserUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameUserUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.UserUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameChannelCreate:
-		if len(bot.Handlers.ChannelCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ChannelCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ChannelCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameChannelUpdate:
-		if len(bot.Handlers.ChannelUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ChannelUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ChannelUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameChannelDelete:
-		if len(bot.Handlers.ChannelDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ChannelDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ChannelDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameChannelPinsUpdate:
-		if len(bot.Handlers.ChannelPinsUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ChannelPinsUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ChannelPinsUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelPinsUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameChannelPinsUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ChannelPinsUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameThreadCreate:
-		if len(bot.Handlers.ThreadCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ThreadCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameThreadUpdate:
-		if len(bot.Handlers.ThreadUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ThreadUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameThreadDelete:
-		if len(bot.Handlers.ThreadDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ThreadDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameThreadListSync:
-		if len(bot.Handlers.ThreadListSync) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadListSync
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ThreadListSync)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadListSync, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadListSync, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadListSync {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameThreadMemberUpdate:
-		if len(bot.Handlers.ThreadMemberUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadMemberUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ThreadMemberUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadMemberUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameThreadMembersUpdate:
-		if len(bot.Handlers.ThreadMembersUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.ThreadMembersUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(ThreadMembersUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMembersUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameThreadMembersUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.ThreadMembersUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildCreate:
-		if len(bot.Handlers.GuildCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildUpdate:
-		if len(bot.Handlers.GuildUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildDelete:
-		if len(bot.Handlers.GuildDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildAuditLogEntryCreate:
-		if len(bot.Handlers.GuildAuditLogEntryCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildAuditLogEntryCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildAuditLogEntryCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildAuditLogEntryCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildAuditLogEntryCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildAuditLogEntryCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildBanAdd:
-		if len(bot.Handlers.GuildBanAdd) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildBanAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildBanAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanAdd, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildBanAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildBanRemove:
-		if len(bot.Handlers.GuildBanRemove) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildBanRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildBanRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildBanRemove, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildBanRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildEmojisUpdate:
-		if len(bot.Handlers.GuildEmojisUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildEmojisUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildEmojisUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildEmojisUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildEmojisUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildEmojisUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildStickersUpdate:
-		if len(bot.Handlers.GuildStickersUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildStickersUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildStickersUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildStickersUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildStickersUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildStickersUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildIntegrationsUpdate:
-		if len(bot.Handlers.GuildIntegrationsUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildIntegrationsUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildIntegrationsUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildIntegrationsUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildIntegrationsUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildIntegrationsUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildMemberAdd:
-		if len(bot.Handlers.GuildMemberAdd) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMemberAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildMemberAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberAdd, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildMemberAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildMemberRemove:
-		if len(bot.Handlers.GuildMemberRemove) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMemberRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildMemberRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberRemove, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildMemberRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildMemberUpdate:
-		if len(bot.Handlers.GuildMemberUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildMemberUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildMemberUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildMemberUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildMemberUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildRoleCreate:
-		if len(bot.Handlers.GuildRoleCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildRoleCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildRoleCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildRoleCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildRoleUpdate:
-		if len(bot.Handlers.GuildRoleUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildRoleUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildRoleUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildRoleUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildRoleDelete:
-		if len(bot.Handlers.GuildRoleDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildRoleDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildRoleDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildRoleDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildRoleDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventCreate:
-		if len(bot.Handlers.GuildScheduledEventCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildScheduledEventCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUpdate:
-		if len(bot.Handlers.GuildScheduledEventUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildScheduledEventUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventDelete:
-		if len(bot.Handlers.GuildScheduledEventDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildScheduledEventDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUserAdd:
-		if len(bot.Handlers.GuildScheduledEventUserAdd) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventUserAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildScheduledEventUserAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserAdd, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventUserAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameGuildScheduledEventUserRemove:
-		if len(bot.Handlers.GuildScheduledEventUserRemove) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.GuildScheduledEventUserRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(GuildScheduledEventUserRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameGuildScheduledEventUserRemove, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.GuildScheduledEventUserRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameIntegrationCreate:
-		if len(bot.Handlers.IntegrationCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.IntegrationCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(IntegrationCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.IntegrationCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameIntegrationUpdate:
-		if len(bot.Handlers.IntegrationUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.IntegrationUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(IntegrationUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.IntegrationUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameIntegrationDelete:
-		if len(bot.Handlers.IntegrationDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.IntegrationDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(IntegrationDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameIntegrationDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.IntegrationDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameInviteCreate:
-		if len(bot.Handlers.InviteCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InviteCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(InviteCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.InviteCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameInviteDelete:
-		if len(bot.Handlers.InviteDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.InviteDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(InviteDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameInviteDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.InviteDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageCreate:
-		if len(bot.Handlers.MessageCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageUpdate:
-		if len(bot.Handlers.MessageUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageDelete:
-		if len(bot.Handlers.MessageDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageDeleteBulk:
-		if len(bot.Handlers.MessageDeleteBulk) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageDeleteBulk
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageDeleteBulk)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDeleteBulk, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageDeleteBulk, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageDeleteBulk {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionAdd:
-		if len(bot.Handlers.MessageReactionAdd) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionAdd
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionAdd)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionAdd, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionAdd, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionAdd {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionRemove:
-		if len(bot.Handlers.MessageReactionRemove) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionRemove
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionRemove)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemove, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemove, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionRemove {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionRemoveAll:
-		if len(bot.Handlers.MessageReactionRemoveAll) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionRemoveAll
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionRemoveAll)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveAll, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveAll, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionRemoveAll {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameMessageReactionRemoveEmoji:
-		if len(bot.Handlers.MessageReactionRemoveEmoji) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.MessageReactionRemoveEmoji
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(MessageReactionRemoveEmoji)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveEmoji, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameMessageReactionRemoveEmoji, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.MessageReactionRemoveEmoji {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNamePresenceUpdate:
-		if len(bot.Handlers.PresenceUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.PresenceUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(PresenceUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNamePresenceUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNamePresenceUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.PresenceUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameStageInstanceCreate:
-		if len(bot.Handlers.StageInstanceCreate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.StageInstanceCreate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(StageInstanceCreate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceCreate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceCreate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.StageInstanceCreate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameStageInstanceDelete:
-		if len(bot.Handlers.StageInstanceDelete) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.StageInstanceDelete
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(StageInstanceDelete)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceDelete, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceDelete, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.StageInstanceDelete {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameStageInstanceUpdate:
-		if len(bot.Handlers.StageInstanceUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.StageInstanceUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(StageInstanceUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameStageInstanceUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.StageInstanceUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameTypingStart:
-		if len(bot.Handlers.TypingStart) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.TypingStart
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(TypingStart)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameTypingStart, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameTypingStart, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.TypingStart {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameVoiceStateUpdate:
-		if len(bot.Handlers.VoiceStateUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.VoiceStateUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(VoiceStateUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceStateUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameVoiceStateUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.VoiceStateUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 
 	case FlagGatewayEventNameWebhooksUpdate:
-		if len(bot.Handlers.WebhooksUpdate) != 0 {
+		bot.Handlers.mu.RLock()
+		handlers := bot.Handlers.WebhooksUpdate
+		bot.Handlers.mu.RUnlock()
+
+		if len(handlers) != 0 {
 			event := new(WebhooksUpdate)
-			if err := json.Unmarshal(data, event); err != nil {
-				LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameWebhooksUpdate, Err: err, Action: ErrorEventActionUnmarshal}).Msg("")
+			if err := json.Unmarshal(d.data, event); err != nil {
+				d.error(ErrorEvent{ClientID: bot.ApplicationID, Event: FlagGatewayEventNameWebhooksUpdate, Err: err, Action: ErrorEventActionUnmarshal})
 				return
 			}
 
-			for _, handler := range bot.Handlers.WebhooksUpdate {
-				go handler(event)
+			for _, handler := range handlers {
+				call(d, handler, event)
 			}
 		}
 	}
 }
+
+// isEvent returns whether an event with the given name is handled by Disgo.
+func isEvent(eventname string) bool {
+	switch eventname {
+	case FlagGatewayEventNameHello,
+		FlagGatewayEventNameReady,
+		FlagGatewayEventNameResumed,
+		FlagGatewayEventNameReconnect,
+		FlagGatewayEventNameInvalidSession,
+		FlagGatewayEventNameApplicationCommandPermissionsUpdate,
+		FlagGatewayEventNameAutoModerationRuleCreate,
+		FlagGatewayEventNameAutoModerationRuleUpdate,
+		FlagGatewayEventNameAutoModerationRuleDelete,
+		FlagGatewayEventNameAutoModerationActionExecution,
+		FlagGatewayEventNameInteractionCreate,
+		FlagGatewayEventNameVoiceServerUpdate,
+		FlagGatewayEventNameGuildMembersChunk,
+		FlagGatewayEventNameUserUpdate,
+		FlagGatewayEventNameChannelCreate,
+		FlagGatewayEventNameChannelUpdate,
+		FlagGatewayEventNameChannelDelete,
+		FlagGatewayEventNameChannelPinsUpdate,
+		FlagGatewayEventNameThreadCreate,
+		FlagGatewayEventNameThreadUpdate,
+		FlagGatewayEventNameThreadDelete,
+		FlagGatewayEventNameThreadListSync,
+		FlagGatewayEventNameThreadMemberUpdate,
+		FlagGatewayEventNameThreadMembersUpdate,
+		FlagGatewayEventNameGuildCreate,
+		FlagGatewayEventNameGuildUpdate,
+		FlagGatewayEventNameGuildDelete,
+		FlagGatewayEventNameGuildAuditLogEntryCreate,
+		FlagGatewayEventNameGuildBanAdd,
+		FlagGatewayEventNameGuildBanRemove,
+		FlagGatewayEventNameGuildEmojisUpdate,
+		FlagGatewayEventNameGuildStickersUpdate,
+		FlagGatewayEventNameGuildIntegrationsUpdate,
+		FlagGatewayEventNameGuildMemberAdd,
+		FlagGatewayEventNameGuildMemberRemove,
+		FlagGatewayEventNameGuildMemberUpdate,
+		FlagGatewayEventNameGuildRoleCreate,
+		FlagGatewayEventNameGuildRoleUpdate,
+		FlagGatewayEventNameGuildRoleDelete,
+		FlagGatewayEventNameGuildScheduledEventCreate,
+		FlagGatewayEventNameGuildScheduledEventUpdate,
+		FlagGatewayEventNameGuildScheduledEventDelete,
+		FlagGatewayEventNameGuildScheduledEventUserAdd,
+		FlagGatewayEventNameGuildScheduledEventUserRemove,
+		FlagGatewayEventNameIntegrationCreate,
+		FlagGatewayEventNameIntegrationUpdate,
+		FlagGatewayEventNameIntegrationDelete,
+		FlagGatewayEventNameInviteCreate,
+		FlagGatewayEventNameInviteDelete,
+		FlagGatewayEventNameMessageCreate,
+		FlagGatewayEventNameMessageUpdate,
+		FlagGatewayEventNameMessageDelete,
+		FlagGatewayEventNameMessageDeleteBulk,
+		FlagGatewayEventNameMessageReactionAdd,
+		FlagGatewayEventNameMessageReactionRemove,
+		FlagGatewayEventNameMessageReactionRemoveAll,
+		FlagGatewayEventNameMessageReactionRemoveEmoji,
+		FlagGatewayEventNamePresenceUpdate,
+		FlagGatewayEventNameStageInstanceCreate,
+		FlagGatewayEventNameStageInstanceDelete,
+		FlagGatewayEventNameStageInstanceUpdate,
+		FlagGatewayEventNameTypingStart,
+		FlagGatewayEventNameVoiceStateUpdate,
+		FlagGatewayEventNameWebhooksUpdate:
+		return true
+	}
+
+	return false
+}
+
+// eventname returns the name of the Hello event.
+func (*Hello) eventname() string {
+	return FlagGatewayEventNameHello
+}
+
+// eventname returns the name of the Ready event.
+func (*Ready) eventname() string {
+	return FlagGatewayEventNameReady
+}
+
+// eventname returns the name of the Resumed event.
+func (*Resumed) eventname() string {
+	return FlagGatewayEventNameResumed
+}
+
+// eventname returns the name of the Reconnect event.
+func (*Reconnect) eventname() string {
+	return FlagGatewayEventNameReconnect
+}
+
+// eventname returns the name of the InvalidSession event.
+func (*InvalidSession) eventname() string {
+	return FlagGatewayEventNameInvalidSession
+}
+
+// eventname returns the name of the ApplicationCommandPermissionsUpdate event.
+func (*ApplicationCommandPermissionsUpdate) eventname() string {
+	return FlagGatewayEventNameApplicationCommandPermissionsUpdate
+}
+
+// eventname returns the name of the AutoModerationRuleCreate event.
+func (*AutoModerationRuleCreate) eventname() string {
+	return FlagGatewayEventNameAutoModerationRuleCreate
+}
+
+// eventname returns the name of the AutoModerationRuleUpdate event.
+func (*AutoModerationRuleUpdate) eventname() string {
+	return FlagGatewayEventNameAutoModerationRuleUpdate
+}
+
+// eventname returns the name of the AutoModerationRuleDelete event.
+func (*AutoModerationRuleDelete) eventname() string {
+	return FlagGatewayEventNameAutoModerationRuleDelete
+}
+
+// eventname returns the name of the AutoModerationActionExecution event.
+func (*AutoModerationActionExecution) eventname() string {
+	return FlagGatewayEventNameAutoModerationActionExecution
+}
+
+// eventname returns the name of the InteractionCreate event.
+func (*InteractionCreate) eventname() string {
+	return FlagGatewayEventNameInteractionCreate
+}
+
+// eventname returns the name of the VoiceServerUpdate event.
+func (*VoiceServerUpdate) eventname() string {
+	return FlagGatewayEventNameVoiceServerUpdate
+}
+
+// eventname returns the name of the GuildMembersChunk event.
+func (*GuildMembersChunk) eventname() string {
+	return FlagGatewayEventNameGuildMembersChunk
+}
+
+// eventname returns the name of the UserUpdate event.
+func (*UserUpdate) eventname() string {
+	return FlagGatewayEventNameUserUpdate
+}
+
+// eventname returns the name of the ChannelCreate event.
+func (*ChannelCreate) eventname() string {
+	return FlagGatewayEventNameChannelCreate
+}
+
+// eventname returns the name of the ChannelUpdate event.
+func (*ChannelUpdate) eventname() string {
+	return FlagGatewayEventNameChannelUpdate
+}
+
+// eventname returns the name of the ChannelDelete event.
+func (*ChannelDelete) eventname() string {
+	return FlagGatewayEventNameChannelDelete
+}
+
+// eventname returns the name of the ChannelPinsUpdate event.
+func (*ChannelPinsUpdate) eventname() string {
+	return FlagGatewayEventNameChannelPinsUpdate
+}
+
+// eventname returns the name of the ThreadCreate event.
+func (*ThreadCreate) eventname() string {
+	return FlagGatewayEventNameThreadCreate
+}
+
+// eventname returns the name of the ThreadUpdate event.
+func (*ThreadUpdate) eventname() string {
+	return FlagGatewayEventNameThreadUpdate
+}
+
+// eventname returns the name of the ThreadDelete event.
+func (*ThreadDelete) eventname() string {
+	return FlagGatewayEventNameThreadDelete
+}
+
+// eventname returns the name of the ThreadListSync event.
+func (*ThreadListSync) eventname() string {
+	return FlagGatewayEventNameThreadListSync
+}
+
+// eventname returns the name of the ThreadMemberUpdate event.
+func (*ThreadMemberUpdate) eventname() string {
+	return FlagGatewayEventNameThreadMemberUpdate
+}
+
+// eventname returns the name of the ThreadMembersUpdate event.
+func (*ThreadMembersUpdate) eventname() string {
+	return FlagGatewayEventNameThreadMembersUpdate
+}
+
+// eventname returns the name of the GuildCreate event.
+func (*GuildCreate) eventname() string {
+	return FlagGatewayEventNameGuildCreate
+}
+
+// eventname returns the name of the GuildUpdate event.
+func (*GuildUpdate) eventname() string {
+	return FlagGatewayEventNameGuildUpdate
+}
+
+// eventname returns the name of the GuildDelete event.
+func (*GuildDelete) eventname() string {
+	return FlagGatewayEventNameGuildDelete
+}
+
+// eventname returns the name of the GuildAuditLogEntryCreate event.
+func (*GuildAuditLogEntryCreate) eventname() string {
+	return FlagGatewayEventNameGuildAuditLogEntryCreate
+}
+
+// eventname returns the name of the GuildBanAdd event.
+func (*GuildBanAdd) eventname() string {
+	return FlagGatewayEventNameGuildBanAdd
+}
+
+// eventname returns the name of the GuildBanRemove event.
+func (*GuildBanRemove) eventname() string {
+	return FlagGatewayEventNameGuildBanRemove
+}
+
+// eventname returns the name of the GuildEmojisUpdate event.
+func (*GuildEmojisUpdate) eventname() string {
+	return FlagGatewayEventNameGuildEmojisUpdate
+}
+
+// eventname returns the name of the GuildStickersUpdate event.
+func (*GuildStickersUpdate) eventname() string {
+	return FlagGatewayEventNameGuildStickersUpdate
+}
+
+// eventname returns the name of the GuildIntegrationsUpdate event.
+func (*GuildIntegrationsUpdate) eventname() string {
+	return FlagGatewayEventNameGuildIntegrationsUpdate
+}
+
+// eventname returns the name of the GuildMemberAdd event.
+func (*GuildMemberAdd) eventname() string {
+	return FlagGatewayEventNameGuildMemberAdd
+}
+
+// eventname returns the name of the GuildMemberRemove event.
+func (*GuildMemberRemove) eventname() string {
+	return FlagGatewayEventNameGuildMemberRemove
+}
+
+// eventname returns the name of the GuildMemberUpdate event.
+func (*GuildMemberUpdate) eventname() string {
+	return FlagGatewayEventNameGuildMemberUpdate
+}
+
+// eventname returns the name of the GuildRoleCreate event.
+func (*GuildRoleCreate) eventname() string {
+	return FlagGatewayEventNameGuildRoleCreate
+}
+
+// eventname returns the name of the GuildRoleUpdate event.
+func (*GuildRoleUpdate) eventname() string {
+	return FlagGatewayEventNameGuildRoleUpdate
+}
+
+// eventname returns the name of the GuildRoleDelete event.
+func (*GuildRoleDelete) eventname() string {
+	return FlagGatewayEventNameGuildRoleDelete
+}
+
+// eventname returns the name of the GuildScheduledEventCreate event.
+func (*GuildScheduledEventCreate) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventCreate
+}
+
+// eventname returns the name of the GuildScheduledEventUpdate event.
+func (*GuildScheduledEventUpdate) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventUpdate
+}
+
+// eventname returns the name of the GuildScheduledEventDelete event.
+func (*GuildScheduledEventDelete) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventDelete
+}
+
+// eventname returns the name of the GuildScheduledEventUserAdd event.
+func (*GuildScheduledEventUserAdd) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventUserAdd
+}
+
+// eventname returns the name of the GuildScheduledEventUserRemove event.
+func (*GuildScheduledEventUserRemove) eventname() string {
+	return FlagGatewayEventNameGuildScheduledEventUserRemove
+}
+
+// eventname returns the name of the IntegrationCreate event.
+func (*IntegrationCreate) eventname() string {
+	return FlagGatewayEventNameIntegrationCreate
+}
+
+// eventname returns the name of the IntegrationUpdate event.
+func (*IntegrationUpdate) eventname() string {
+	return FlagGatewayEventNameIntegrationUpdate
+}
+
+// eventname returns the name of the IntegrationDelete event.
+func (*IntegrationDelete) eventname() string {
+	return FlagGatewayEventNameIntegrationDelete
+}
+
+// eventname returns the name of the InviteCreate event.
+func (*InviteCreate) eventname() string {
+	return FlagGatewayEventNameInviteCreate
+}
+
+// eventname returns the name of the InviteDelete event.
+func (*InviteDelete) eventname() string {
+	return FlagGatewayEventNameInviteDelete
+}
+
+// eventname returns the name of the MessageCreate event.
+func (*MessageCreate) eventname() string {
+	return FlagGatewayEventNameMessageCreate
+}
+
+// eventname returns the name of the MessageUpdate event.
+func (*MessageUpdate) eventname() string {
+	return FlagGatewayEventNameMessageUpdate
+}
+
+// eventname returns the name of the MessageDelete event.
+func (*MessageDelete) eventname() string {
+	return FlagGatewayEventNameMessageDelete
+}
+
+// eventname returns the name of the MessageDeleteBulk event.
+func (*MessageDeleteBulk) eventname() string {
+	return FlagGatewayEventNameMessageDeleteBulk
+}
+
+// eventname returns the name of the MessageReactionAdd event.
+func (*MessageReactionAdd) eventname() string {
+	return FlagGatewayEventNameMessageReactionAdd
+}
+
+// eventname returns the name of the MessageReactionRemove event.
+func (*MessageReactionRemove) eventname() string {
+	return FlagGatewayEventNameMessageReactionRemove
+}
+
+// eventname returns the name of the MessageReactionRemoveAll event.
+func (*MessageReactionRemoveAll) eventname() string {
+	return FlagGatewayEventNameMessageReactionRemoveAll
+}
+
+// eventname returns the name of the MessageReactionRemoveEmoji event.
+func (*MessageReactionRemoveEmoji) eventname() string {
+	return FlagGatewayEventNameMessageReactionRemoveEmoji
+}
+
+// eventname returns the name of the PresenceUpdate event.
+func (*PresenceUpdate) eventname() string {
+	return FlagGatewayEventNamePresenceUpdate
+}
+
+// eventname returns the name of the StageInstanceCreate event.
+func (*StageInstanceCreate) eventname() string {
+	return FlagGatewayEventNameStageInstanceCreate
+}
+
+// eventname returns the name of the StageInstanceDelete event.
+func (*StageInstanceDelete) eventname() string {
+	return FlagGatewayEventNameStageInstanceDelete
+}
+
+// eventname returns the name of the StageInstanceUpdate event.
+func (*StageInstanceUpdate) eventname() string {
+	return FlagGatewayEventNameStageInstanceUpdate
+}
+
+// eventname returns the name of the TypingStart event.
+func (*TypingStart) eventname() string {
+	return FlagGatewayEventNameTypingStart
+}
+
+// eventname returns the name of the VoiceStateUpdate event.
+func (*VoiceStateUpdate) eventname() string {
+	return FlagGatewayEventNameVoiceStateUpdate
+}
+
+// eventname returns the name of the WebhooksUpdate event.
+func (*WebhooksUpdate) eventname() string {
+	return FlagGatewayEventNameWebhooksUpdate
+}
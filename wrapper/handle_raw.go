@@ -0,0 +1,151 @@
+package wrapper
+
+import (
+	json "github.com/goccy/go-json"
+)
+
+// FlagGatewayEventNameRaw represents the name used to handle every event as a RawEvent.
+//
+// A RawEvent is NOT sent by Discord, so an event with this name is never dispatched.
+const FlagGatewayEventNameRaw = "*"
+
+// RawEvent represents an event that is dispatched to a bot's raw event handlers
+// (including events that are unknown to Disgo).
+type RawEvent struct {
+	// Shard represents the [shard_id, num_shards] of the Session that received the event.
+	Shard *[2]int
+
+	// Name represents the name of the event (i.e., MESSAGE_CREATE).
+	Name string
+
+	// SessionID represents the ID of the Session that received the event.
+	SessionID string
+
+	// Data represents the raw payload data of the event.
+	Data json.RawMessage
+
+	// Seq represents the sequence number of the event.
+	Seq int64
+
+	// Replayed indicates whether the event is replayed, which occurs when Discord
+	// resends the events that a Session missed while it was resuming.
+	Replayed bool
+}
+
+// DispatchInfo represents information about the dispatch of an event to an event handler (see OnDispatch).
+type DispatchInfo struct {
+	// Seq represents the sequence number of the event.
+	Seq int64
+
+	// Replayed indicates whether the event is replayed, which occurs when Discord
+	// resends the events that a Session missed while it was resuming.
+	Replayed bool
+}
+
+// eventname returns the name used to handle raw events.
+func (*RawEvent) eventname() string {
+	return FlagGatewayEventNameRaw
+}
+
+// raw dispatches an event to the bot's raw event handlers.
+func (bot *Client) raw(d *dispatch) {
+	bot.Handlers.mu.RLock()
+	handlers := bot.Handlers.Raw
+	bot.Handlers.mu.RUnlock()
+
+	if len(handlers) == 0 {
+		return
+	}
+
+	event := d.raw()
+	for _, handler := range handlers {
+		call(d, handler, event)
+	}
+}
+
+// raw returns the RawEvent of a dispatch.
+func (d *dispatch) raw() *RawEvent {
+	return &RawEvent{
+		Shard:     d.shard,
+		Name:      d.eventname,
+		SessionID: d.session,
+		Data:      d.data,
+		Seq:       d.seq,
+		Replayed:  d.replayed,
+	}
+}
+
+// OnCustom adds an event handler for a custom event (i.e., an event that is unknown to Disgo) to the bot,
+// then returns the Subscription that is used to remove the event handler.
+//
+// The data of each event with the given name is decoded into a new T using the given decoder
+// (or json.Unmarshal when the decoder is nil), then passed to the event handler.
+//
+//	sub := disgo.OnCustom(bot, "NEW_EVENT", nil, func(e *NewEvent) { ... })
+func OnCustom[T any](bot *Client, eventname string, decoder func(data json.RawMessage, event *T) error, handler func(*T)) *Subscription {
+	return onRaw(bot, eventname, decoder, func(event *T, _ *RawEvent) {
+		handler(event)
+	})
+}
+
+// OnDispatch adds an event handler for an event to the bot, which receives the DispatchInfo
+// of each event (i.e., whether the event is replayed), then returns the Subscription
+// that is used to remove the event handler.
+//
+//	sub := disgo.OnDispatch(bot, func(m *disgo.MessageCreate, info *disgo.DispatchInfo) {
+//		if info.Replayed { ... }
+//	})
+func OnDispatch[T any, E event[T]](bot *Client, handler func(*T, *DispatchInfo)) *Subscription {
+	var e E
+
+	eventname := e.eventname()
+
+	// the event handler is a raw event handler, so the intents of its event are added explicitly.
+	bot.Handlers.mu.Lock()
+	bot.intend(eventname)
+	bot.Handlers.mu.Unlock()
+
+	return onRaw(bot, eventname, nil, func(event *T, raw *RawEvent) {
+		handler(event, &DispatchInfo{Seq: raw.Seq, Replayed: raw.Replayed})
+	})
+}
+
+// onRaw adds a raw event handler to the bot, which decodes the data of each event with the given name
+// into a new T using the given decoder (or json.Unmarshal when the decoder is nil), then passes it
+// to the event handler (with the RawEvent).
+func onRaw[T any](bot *Client, eventname string, decoder func(data json.RawMessage, event *T) error, handler func(*T, *RawEvent)) *Subscription {
+	if decoder == nil {
+		decoder = func(data json.RawMessage, event *T) error {
+			return json.Unmarshal(data, event) //nolint:wrapcheck
+		}
+	}
+
+	return On(bot, func(raw *RawEvent) {
+		if raw.Name != eventname {
+			return
+		}
+
+		event := new(T)
+		if err := decoder(raw.Data, event); err != nil {
+			err = ErrorEvent{
+				ClientID: bot.ApplicationID,
+				Event:    eventname,
+				Err:      err,
+				Action:   ErrorEventActionUnmarshal,
+			}
+
+			LogEventHandler(Logger.Error(), bot.ApplicationID, eventname).Err(err).Msg("")
+
+			bot.report(err, &ErrorContext{
+				Shard:     raw.Shard,
+				SessionID: raw.SessionID,
+				Event:     eventname,
+				Payload:   raw.Data,
+			})
+
+			return
+		}
+
+		handler(event, raw)
+	})
+}
@@ -0,0 +1,278 @@
+package wrapper
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// Interaction Endpoint Headers
+// https://discord.com/developers/docs/interactions/receiving-and-responding#security-and-authorization
+const (
+	headerSignature = "X-Signature-Ed25519"
+	headerTimestamp = "X-Signature-Timestamp"
+)
+
+const (
+	// defaultInteractionReplyTimeout represents the default amount of time an InteractionServer waits
+	// for an inline reply, which is less than the 3 seconds Discord waits for an initial response.
+	defaultInteractionReplyTimeout = time.Millisecond * 2500
+
+	// defaultInteractionTimestampTolerance represents the default maximum difference between
+	// the time an InteractionServer receives an interaction and its signature timestamp.
+	defaultInteractionTimestampTolerance = time.Second * 30
+
+	// maxInteractionBodySize represents the maximum size of an interaction's HTTP request body.
+	maxInteractionBodySize = 1 << 20
+)
+
+// InteractionServer represents an HTTP handler that receives interactions from Discord
+// using an Interactions Endpoint URL (instead of the Discord Gateway).
+//
+// Each interaction (that is NOT a PING) is dispatched to the bot's InteractionCreate event handlers.
+//
+// https://discord.com/developers/docs/interactions/receiving-and-responding#receiving-an-interaction
+type InteractionServer struct {
+	// pending represents a map of interaction IDs to channels that receive inline replies.
+	pending sync.Map
+
+	// Bot represents the bot that handles the interactions.
+	Bot *Client
+
+	// PublicKey represents the public key of the application, which verifies
+	// that each interaction is sent by Discord.
+	PublicKey ed25519.PublicKey
+
+	// Timeout represents the amount of time the server waits for an inline reply to an interaction,
+	// before the interaction is deferred (in the HTTP response).
+	Timeout time.Duration
+
+	// Tolerance represents the maximum difference between the time the server receives an interaction
+	// and its signature timestamp, such that a request which is replayed later is rejected.
+	Tolerance time.Duration
+}
+
+// NewInteractionServer returns a new InteractionServer using the given (hex encoded) public key
+// of the bot's application.
+func NewInteractionServer(bot *Client, publicKey string) (*InteractionServer, error) {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("interaction server: public key: %w", err)
+	}
+
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("interaction server: public key: invalid length %d", len(key))
+	}
+
+	return &InteractionServer{ //nolint:exhaustruct
+		Bot:       bot,
+		PublicKey: key,
+		Timeout:   defaultInteractionReplyTimeout,
+		Tolerance: defaultInteractionTimestampTolerance,
+	}, nil
+}
+
+// ErrorInteractionReply represents an error that occurs when an interaction can NOT be replied to
+// in the HTTP response of an InteractionServer.
+type ErrorInteractionReply struct {
+	// InteractionID represents the ID of the interaction.
+	InteractionID string
+}
+
+func (e ErrorInteractionReply) Error() string {
+	return fmt.Sprintf("interaction %q can NOT be replied to inline: it was already replied to or deferred", e.InteractionID)
+}
+
+// Reply replies to an interaction (received by the server) in the HTTP response of the interaction.
+//
+// An ErrorInteractionReply is returned when the interaction was already replied to or deferred,
+// such that the reply must be sent using CreateFollowupMessage or EditOriginalInteractionResponse.
+func (s *InteractionServer) Reply(interaction *Interaction, response *InteractionResponse) error {
+	value, ok := s.pending.LoadAndDelete(interaction.ID)
+	if !ok {
+		return ErrorInteractionReply{InteractionID: interaction.ID}
+	}
+
+	reply, _ := value.(chan *InteractionResponse)
+	reply <- response
+
+	return nil
+}
+
+// ServeHTTP verifies and responds to an interaction that Discord sends to the Interactions Endpoint URL.
+func (s *InteractionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxInteractionBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	timestamp := r.Header.Get(headerTimestamp)
+	if !s.Verify(r.Header.Get(headerSignature), timestamp, body) {
+		http.Error(w, "invalid request signature", http.StatusUnauthorized)
+
+		return
+	}
+
+	if !s.fresh(timestamp, time.Now()) {
+		http.Error(w, "invalid request timestamp", http.StatusUnauthorized)
+
+		return
+	}
+
+	interaction := new(Interaction)
+	if err := json.Unmarshal(body, interaction); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	// respond to a PING (which Discord uses to validate the Interactions Endpoint URL).
+	if interaction.Type == FlagInteractionTypePING {
+		s.respond(w, &InteractionResponse{Type: FlagInteractionCallbackTypePONG}) //nolint:exhaustruct
+
+		return
+	}
+
+	// an interaction is deferred immediately when the bot has no event handler to reply to it.
+	if !s.handled() {
+		s.respond(w, deferral(interaction))
+
+		return
+	}
+
+	reply := make(chan *InteractionResponse, 1)
+	s.pending.Store(interaction.ID, reply)
+
+	s.Bot.dispatch(newDispatch(s.Bot, nil, FlagGatewayEventNameInteractionCreate, 0, body))
+
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = defaultInteractionReplyTimeout
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case response := <-reply:
+		s.respond(w, response)
+
+	case <-timer.C:
+		s.deadline(w, interaction, reply)
+
+	case <-r.Context().Done():
+		s.pending.Delete(interaction.ID)
+	}
+}
+
+// deadline defers an interaction that is NOT replied to before the server's timeout.
+func (s *InteractionServer) deadline(w http.ResponseWriter, interaction *Interaction, reply chan *InteractionResponse) {
+	// a reply may be sent while the interaction is deferred.
+	if _, ok := s.pending.LoadAndDelete(interaction.ID); !ok {
+		s.respond(w, <-reply)
+
+		return
+	}
+
+	LogEventHandler(Logger.Info(), s.Bot.ApplicationID, FlagGatewayEventNameInteractionCreate).
+		Str("interaction", interaction.ID).Msg("deferred interaction")
+
+	s.respond(w, deferral(interaction))
+}
+
+// deferral returns the response that defers an interaction.
+func deferral(interaction *Interaction) *InteractionResponse {
+	response := &InteractionResponse{ //nolint:exhaustruct
+		Type: FlagInteractionCallbackTypeDEFERRED_CHANNEL_MESSAGE_WITH_SOURCE,
+	}
+
+	switch interaction.Type {
+	case FlagInteractionTypeMESSAGE_COMPONENT:
+		response.Type = FlagInteractionCallbackTypeDEFERRED_UPDATE_MESSAGE
+
+	// an autocomplete interaction can NOT be deferred.
+	case FlagInteractionTypeAPPLICATION_COMMAND_AUTOCOMPLETE:
+		response.Type = FlagInteractionCallbackTypeAPPLICATION_COMMAND_AUTOCOMPLETE_RESULT
+		response.Data = &Autocomplete{Choices: []*ApplicationCommandOptionChoice{}}
+	}
+
+	return response
+}
+
+// handled returns whether the bot has an event handler that receives interactions.
+func (s *InteractionServer) handled() bool {
+	s.Bot.Handlers.mu.RLock()
+	defer s.Bot.Handlers.mu.RUnlock()
+
+	return len(s.Bot.Handlers.InteractionCreate) != 0 || len(s.Bot.Handlers.Raw) != 0
+}
+
+// fresh returns whether the signature timestamp (in Unix seconds) of an interaction's HTTP request
+// is within the server's tolerance of the given time.
+func (s *InteractionServer) fresh(timestamp string, now time.Time) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	tolerance := s.Tolerance
+	if tolerance <= 0 {
+		tolerance = defaultInteractionTimestampTolerance
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+
+	return age <= tolerance && age >= -tolerance
+}
+
+// respond writes an interaction response to the HTTP response.
+func (s *InteractionServer) respond(w http.ResponseWriter, response *InteractionResponse) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		LogEventHandler(Logger.Error(), s.Bot.ApplicationID, FlagGatewayEventNameInteractionCreate).
+			Err(fmt.Errorf(errSendMarshal, err)).Msg("")
+
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(body); err != nil {
+		LogEventHandler(Logger.Error(), s.Bot.ApplicationID, FlagGatewayEventNameInteractionCreate).Err(err).Msg("")
+	}
+}
+
+// Verify verifies the Ed25519 signature of an interaction's HTTP request
+// using the server's public key.
+//
+// https://discord.com/developers/docs/interactions/receiving-and-responding#security-and-authorization
+func (s *InteractionServer) Verify(signature, timestamp string, body []byte) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil || len(sig) != ed25519.SignatureSize || timestamp == "" {
+		return false
+	}
+
+	message := make([]byte, 0, len(timestamp)+len(body))
+	message = append(message, timestamp...)
+	message = append(message, body...)
+
+	return ed25519.Verify(s.PublicKey, message, sig)
+}
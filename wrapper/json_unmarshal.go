@@ -26,23 +26,24 @@ Includes: CreateMessage, Message
 **/
 
 func (v *Nonce) UnmarshalJSON(b []byte) error {
-	var x interface{}
-
-	if err := json.Unmarshal(b, &x); err != nil {
-		return fmt.Errorf(errUnmarshal, x, err)
-	}
+	// a nonce is a string or an integer (which is kept as is to prevent a loss of precision).
+	if len(b) != 0 && b[0] == '"' {
+		var x string
+		if err := json.Unmarshal(b, &x); err != nil {
+			return fmt.Errorf(errUnmarshal, v, err)
+		}
 
-	switch xValue := x.(type) {
-	case string:
-		*v = Nonce(xValue)
+		*v = Nonce(x)
 
-	case int:
-		*v = Nonce(strconv.Itoa(xValue))
+		return nil
+	}
 
-	default:
-		return fmt.Errorf(errUnmarshal, v, fmt.Errorf("value is type %T", x))
+	if _, err := strconv.ParseInt(string(b), base10, bit64); err != nil {
+		return fmt.Errorf(errUnmarshal, v, fmt.Errorf("value is NOT an integer: %w", err))
 	}
 
+	*v = Nonce(b)
+
 	return nil
 }
 
@@ -131,6 +132,18 @@ func unmarshalComponents(b []byte) ([]Component, error) {
 		}
 	}
 
+	// unmarshal the JSON (components) into the underlying structs of the components.
+	raw := make([]json.RawMessage, len(components))
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf(errUnmarshal, raw, err)
+	}
+
+	for i := range components {
+		if err := json.Unmarshal(raw[i], components[i]); err != nil {
+			return nil, fmt.Errorf(errUnmarshal, components[i], err)
+		}
+	}
+
 	return components, nil
 }
 
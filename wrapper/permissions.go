@@ -0,0 +1,268 @@
+package wrapper
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"time"
+)
+
+// PermissionsAll represents every permission.
+const PermissionsAll = ^BitFlag(0)
+
+// Implicit Permissions
+// https://discord.com/developers/docs/topics/permissions#implicit-permissions
+const (
+	// permissionsTimeout represents the permissions of a member that is timed out.
+	permissionsTimeout = FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY
+
+	// permissionsSendMessages represents the permissions that are lost without SEND_MESSAGES.
+	permissionsSendMessages = FlagBitwisePermissionMENTION_EVERYONE | FlagBitwisePermissionSEND_TTS_MESSAGES |
+		FlagBitwisePermissionATTACH_FILES | FlagBitwisePermissionEMBED_LINKS
+)
+
+// PermissionFlagNames represents a map of permission flags to names.
+var PermissionFlagNames = map[BitFlag]string{
+	FlagBitwisePermissionCREATE_INSTANT_INVITE:               "CREATE_INSTANT_INVITE",
+	FlagBitwisePermissionKICK_MEMBERS:                        "KICK_MEMBERS",
+	FlagBitwisePermissionBAN_MEMBERS:                         "BAN_MEMBERS",
+	FlagBitwisePermissionADMINISTRATOR:                       "ADMINISTRATOR",
+	FlagBitwisePermissionMANAGE_CHANNELS:                     "MANAGE_CHANNELS",
+	FlagBitwisePermissionMANAGE_GUILD:                        "MANAGE_GUILD",
+	FlagBitwisePermissionADD_REACTIONS:                       "ADD_REACTIONS",
+	FlagBitwisePermissionVIEW_AUDIT_LOG:                      "VIEW_AUDIT_LOG",
+	FlagBitwisePermissionPRIORITY_SPEAKER:                    "PRIORITY_SPEAKER",
+	FlagBitwisePermissionSTREAM:                              "STREAM",
+	FlagBitwisePermissionVIEW_CHANNEL:                        "VIEW_CHANNEL",
+	FlagBitwisePermissionSEND_MESSAGES:                       "SEND_MESSAGES",
+	FlagBitwisePermissionSEND_TTS_MESSAGES:                   "SEND_TTS_MESSAGES",
+	FlagBitwisePermissionMANAGE_MESSAGES:                     "MANAGE_MESSAGES",
+	FlagBitwisePermissionEMBED_LINKS:                         "EMBED_LINKS",
+	FlagBitwisePermissionATTACH_FILES:                        "ATTACH_FILES",
+	FlagBitwisePermissionREAD_MESSAGE_HISTORY:                "READ_MESSAGE_HISTORY",
+	FlagBitwisePermissionMENTION_EVERYONE:                    "MENTION_EVERYONE",
+	FlagBitwisePermissionUSE_EXTERNAL_EMOJIS:                 "USE_EXTERNAL_EMOJIS",
+	FlagBitwisePermissionVIEW_GUILD_INSIGHTS:                 "VIEW_GUILD_INSIGHTS",
+	FlagBitwisePermissionCONNECT:                             "CONNECT",
+	FlagBitwisePermissionSPEAK:                               "SPEAK",
+	FlagBitwisePermissionMUTE_MEMBERS:                        "MUTE_MEMBERS",
+	FlagBitwisePermissionDEAFEN_MEMBERS:                      "DEAFEN_MEMBERS",
+	FlagBitwisePermissionMOVE_MEMBERS:                        "MOVE_MEMBERS",
+	FlagBitwisePermissionUSE_VAD:                             "USE_VAD",
+	FlagBitwisePermissionCHANGE_NICKNAME:                     "CHANGE_NICKNAME",
+	FlagBitwisePermissionMANAGE_NICKNAMES:                    "MANAGE_NICKNAMES",
+	FlagBitwisePermissionMANAGE_ROLES:                        "MANAGE_ROLES",
+	FlagBitwisePermissionMANAGE_WEBHOOKS:                     "MANAGE_WEBHOOKS",
+	FlagBitwisePermissionMANAGE_GUILD_EXPRESSIONS:            "MANAGE_GUILD_EXPRESSIONS",
+	FlagBitwisePermissionUSE_APPLICATION_COMMANDS:            "USE_APPLICATION_COMMANDS",
+	FlagBitwisePermissionREQUEST_TO_SPEAK:                    "REQUEST_TO_SPEAK",
+	FlagBitwisePermissionMANAGE_EVENTS:                       "MANAGE_EVENTS",
+	FlagBitwisePermissionMANAGE_THREADS:                      "MANAGE_THREADS",
+	FlagBitwisePermissionCREATE_PUBLIC_THREADS:               "CREATE_PUBLIC_THREADS",
+	FlagBitwisePermissionCREATE_PRIVATE_THREADS:              "CREATE_PRIVATE_THREADS",
+	FlagBitwisePermissionUSE_EXTERNAL_STICKERS:               "USE_EXTERNAL_STICKERS",
+	FlagBitwisePermissionSEND_MESSAGES_IN_THREADS:            "SEND_MESSAGES_IN_THREADS",
+	FlagBitwisePermissionUSE_EMBEDDED_ACTIVITIES:             "USE_EMBEDDED_ACTIVITIES",
+	FlagBitwisePermissionMODERATE_MEMBERS:                    "MODERATE_MEMBERS",
+	FlagBitwisePermissionVIEW_CREATOR_MONETIZATION_ANALYTICS: "VIEW_CREATOR_MONETIZATION_ANALYTICS",
+	FlagBitwisePermissionUSE_SOUNDBOARD:                      "USE_SOUNDBOARD",
+	FlagBitwisePermissionUSE_EXTERNAL_SOUNDS:                 "USE_EXTERNAL_SOUNDS",
+	FlagBitwisePermissionSEND_VOICE_MESSAGES:                 "SEND_VOICE_MESSAGES",
+}
+
+// ParsePermissions parses a permission string (i.e., Role.Permissions) into a BitFlag.
+func ParsePermissions(permissions string) (BitFlag, error) {
+	if permissions == "" {
+		return 0, nil
+	}
+
+	flags, err := strconv.ParseUint(permissions, base10, bit64)
+	if err != nil {
+		return 0, fmt.Errorf("permissions: %w", err)
+	}
+
+	return BitFlag(flags), nil
+}
+
+// HasPermissions returns whether the granted permissions contain the required permissions.
+func HasPermissions(granted, required BitFlag) bool {
+	return MissingPermissions(granted, required) == 0
+}
+
+// MissingPermissions returns the required permissions that are NOT granted.
+//
+// The ADMINISTRATOR permission grants every permission.
+func MissingPermissions(granted, required BitFlag) BitFlag {
+	if granted&FlagBitwisePermissionADMINISTRATOR != 0 {
+		return 0
+	}
+
+	return required &^ granted
+}
+
+// PermissionNames returns the names of the given permissions (in order of their bits).
+func PermissionNames(permissions BitFlag) []string {
+	names := make([]string, 0, bits.OnesCount64(uint64(permissions)))
+
+	for permissions != 0 {
+		flag := BitFlag(1) << bits.TrailingZeros64(uint64(permissions))
+		permissions &^= flag
+
+		name, ok := PermissionFlagNames[flag]
+		if !ok {
+			name = strconv.FormatUint(uint64(flag), 10)
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// BasePermissions returns the guild-level permissions of a member,
+// which are computed from the @everyone role and the roles of the member.
+//
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func BasePermissions(guild *Guild, member *GuildMember) (BitFlag, error) {
+	if member.User == nil {
+		return 0, fmt.Errorf("permissions: member of guild %q has no user", guild.ID)
+	}
+
+	if member.User.ID == guild.OwnerID {
+		return PermissionsAll, nil
+	}
+
+	roles := make(map[string]*Role, len(guild.Roles))
+	for _, role := range guild.Roles {
+		if role != nil {
+			roles[role.ID] = role
+		}
+	}
+
+	var permissions BitFlag
+
+	// the ID of the @everyone role is the ID of the guild.
+	if everyone, ok := roles[guild.ID]; ok {
+		flags, err := ParsePermissions(everyone.Permissions)
+		if err != nil {
+			return 0, fmt.Errorf("role %q: %w", everyone.ID, err)
+		}
+
+		permissions = flags
+	}
+
+	for _, id := range member.Roles {
+		if id == nil {
+			continue
+		}
+
+		role, ok := roles[*id]
+		if !ok {
+			continue
+		}
+
+		flags, err := ParsePermissions(role.Permissions)
+		if err != nil {
+			return 0, fmt.Errorf("role %q: %w", role.ID, err)
+		}
+
+		permissions |= flags
+	}
+
+	if permissions&FlagBitwisePermissionADMINISTRATOR != 0 {
+		return PermissionsAll, nil
+	}
+
+	if timedOut(member) {
+		permissions &= permissionsTimeout
+	}
+
+	return permissions, nil
+}
+
+// ChannelPermissions returns the permissions of a member in a channel, which are computed from
+// the base permissions of the member and the permission overwrites of the channel
+// (for the @everyone role, then the roles of the member, then the member).
+//
+// The permissions of a thread must be computed using its parent channel.
+//
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func ChannelPermissions(guild *Guild, member *GuildMember, channel *Channel) (BitFlag, error) {
+	permissions, err := BasePermissions(guild, member)
+	if err != nil {
+		return 0, err
+	}
+
+	if permissions == PermissionsAll {
+		return PermissionsAll, nil
+	}
+
+	memberRoles := make(map[string]bool, len(member.Roles))
+	for _, id := range member.Roles {
+		if id != nil {
+			memberRoles[*id] = true
+		}
+	}
+
+	var everyone, roles, user [2]BitFlag // allow, deny
+
+	for _, overwrite := range channel.PermissionOverwrites {
+		var target *[2]BitFlag
+
+		switch {
+		case overwrite == nil:
+			continue
+
+		case overwrite.Type == FlagPermissionOverwriteTypeRole && overwrite.ID == guild.ID:
+			target = &everyone
+
+		case overwrite.Type == FlagPermissionOverwriteTypeRole && memberRoles[overwrite.ID]:
+			target = &roles
+
+		case overwrite.Type == FlagPermissionOverwriteTypeMember && overwrite.ID == member.User.ID:
+			target = &user
+
+		default:
+			continue
+		}
+
+		allow, err := ParsePermissions(overwrite.Allow)
+		if err != nil {
+			return 0, fmt.Errorf("overwrite %q: %w", overwrite.ID, err)
+		}
+
+		deny, err := ParsePermissions(overwrite.Deny)
+		if err != nil {
+			return 0, fmt.Errorf("overwrite %q: %w", overwrite.ID, err)
+		}
+
+		target[0] |= allow
+		target[1] |= deny
+	}
+
+	for _, overwrite := range [][2]BitFlag{everyone, roles, user} {
+		permissions &^= overwrite[1]
+		permissions |= overwrite[0]
+	}
+
+	if timedOut(member) {
+		permissions &= permissionsTimeout
+	}
+
+	// a member that can NOT view a channel has no permissions in it.
+	if permissions&FlagBitwisePermissionVIEW_CHANNEL == 0 {
+		return 0, nil
+	}
+
+	if permissions&FlagBitwisePermissionSEND_MESSAGES == 0 {
+		permissions &^= permissionsSendMessages
+	}
+
+	return permissions, nil
+}
+
+// timedOut returns whether a member is timed out.
+func timedOut(member *GuildMember) bool {
+	return member.CommunicationDisabledUntil != nil && *member.CommunicationDisabledUntil != nil &&
+		(*member.CommunicationDisabledUntil).After(time.Now())
+}
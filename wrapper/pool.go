@@ -56,6 +56,11 @@ func putSession(s *Session) {
 	s.heartbeat = nil
 	s.manager = nil
 	s.client_manager = nil
+	s.statusHandler = nil
+	s.store = nil
+	s.transitions.queue = nil
+	s.status = SessionStatusDisconnected
+	s.attempts = 0
 	s.RateLimiter = nil
 
 	spool.Put(s)
@@ -0,0 +1,128 @@
+package wrapper
+
+// DefaultAllowedMentions returns the default allowed mentions of a client (see Request.AllowedMentions),
+// which mention the users of a message (and the author of a replied message),
+// but NEVER @everyone, @here, or roles.
+//
+// The Roles and Users are NOT set, since Discord rejects allowed mentions which parse users
+// while specifying users (or parse roles while specifying roles).
+//
+// https://discord.com/developers/docs/resources/channel#allowed-mentions-object
+func DefaultAllowedMentions() *AllowedMentions {
+	return &AllowedMentions{
+		Parse:       []*string{Pointer(FlagAllowedMentionTypeUsers)},
+		Roles:       nil,
+		Users:       nil,
+		RepliedUser: true,
+	}
+}
+
+// applyAllowedMentions returns a request with the default allowed mentions of the client
+// when the request produces a message without allowed mentions.
+//
+// The given request is NOT modified: A copy is returned when the default is applied.
+func applyAllowedMentions[T any](bot *Client, r *T) *T {
+	if bot.Config == nil || bot.Config.Request.AllowedMentions == nil {
+		return r
+	}
+
+	mentions := bot.Config.Request.AllowedMentions
+
+	c := *r
+
+	switch request := any(&c).(type) {
+	case *CreateMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = mentions
+
+	case *EditMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	case *StartThreadinForumChannel:
+		if request.Message == nil || request.Message.AllowedMentions != nil {
+			return r
+		}
+
+		message := *request.Message
+		message.AllowedMentions = mentions
+		request.Message = &message
+
+	case *ExecuteWebhook:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = mentions
+
+	case *EditWebhookMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	case *CreateInteractionResponse:
+		if request.InteractionResponse == nil {
+			return r
+		}
+
+		var data Messages
+
+		switch message := request.Data.(type) {
+		case *Messages:
+			if message == nil || message.AllowedMentions != nil {
+				return r
+			}
+
+			data = *message
+
+		case Messages:
+			if message.AllowedMentions != nil {
+				return r
+			}
+
+			data = message
+
+		default:
+			return r
+		}
+
+		data.AllowedMentions = mentions
+		response := *request.InteractionResponse
+		response.Data = &data
+		request.InteractionResponse = &response
+
+	case *EditOriginalInteractionResponse:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	case *CreateFollowupMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = mentions
+
+	case *EditFollowupMessage:
+		if request.AllowedMentions != nil {
+			return r
+		}
+
+		request.AllowedMentions = &mentions
+
+	default:
+		return r
+	}
+
+	return &c
+}
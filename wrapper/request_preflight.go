@@ -0,0 +1,350 @@
+package wrapper
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// PermissionState represents an interface for the state (i.e., a cache) of guilds, members, and channels,
+// which is used to check the permissions of a request before it's sent.
+type PermissionState interface {
+	// Guild returns the guild (with its roles) with the given ID.
+	Guild(guildID string) (*Guild, bool)
+
+	// Member returns the member of a guild with the given user ID.
+	Member(guildID, userID string) (*GuildMember, bool)
+
+	// Channel returns the channel with the given ID.
+	Channel(channelID string) (*Channel, bool)
+}
+
+// RoutePermission represents the permissions that a route requires.
+type RoutePermission struct {
+	// Fields represents a map of request field names to the permissions
+	// that are required when the field is set.
+	Fields map[string]BitFlag
+
+	// HierarchyFields represents the request fields that require the role hierarchy when set,
+	// such that the role hierarchy is NOT required when none of these fields are set.
+	HierarchyFields []string
+
+	// Permissions represents the permissions that are required.
+	Permissions BitFlag
+
+	// Hierarchy represents whether the highest role of the bot must be higher than
+	// the highest role of the request's member (UserID) and the request's role (RoleID).
+	Hierarchy bool
+}
+
+// RoutePermissions represents a map of Routes (see RouteIDs) to the permissions they require.
+//
+// The fields of a route's RoutePermission are resolved (and cached) when a request of the route is first checked.
+//
+// https://discord.com/developers/docs/topics/permissions#permissions-bitwise-permission-flags
+var RoutePermissions = map[string]RoutePermission{
+	"GetGuildAuditLog":                {Permissions: FlagBitwisePermissionVIEW_AUDIT_LOG},
+	"ListAutoModerationRulesForGuild": {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"GetAutoModerationRule":           {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"CreateAutoModerationRule":        {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"ModifyAutoModerationRule":        {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"DeleteAutoModerationRule":        {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"ModifyChannelGuild":              {Permissions: FlagBitwisePermissionMANAGE_CHANNELS},
+	"GetChannelMessages":              {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY},
+	"GetChannelMessage":               {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY},
+	"CreateMessage":                   {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionSEND_MESSAGES},
+	"CreateReaction":                  {Permissions: FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionREAD_MESSAGE_HISTORY | FlagBitwisePermissionADD_REACTIONS},
+	"DeleteUserReaction":              {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"DeleteAllReactions":              {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"DeleteAllReactionsforEmoji":      {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"BulkDeleteMessages":              {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"EditChannelPermissions":          {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"DeleteChannelPermission":         {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"GetChannelInvites":               {Permissions: FlagBitwisePermissionMANAGE_CHANNELS},
+	"CreateChannelInvite":             {Permissions: FlagBitwisePermissionCREATE_INSTANT_INVITE},
+	"TriggerTypingIndicator":          {Permissions: FlagBitwisePermissionSEND_MESSAGES},
+	"PinMessage":                      {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"UnpinMessage":                    {Permissions: FlagBitwisePermissionMANAGE_MESSAGES},
+	"ModifyGuild":                     {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"CreateGuildChannel":              {Permissions: FlagBitwisePermissionMANAGE_CHANNELS},
+	"ModifyGuildMember": {
+		Fields: map[string]BitFlag{
+			"Nick":                       FlagBitwisePermissionMANAGE_NICKNAMES,
+			"Roles":                      FlagBitwisePermissionMANAGE_ROLES,
+			"Mute":                       FlagBitwisePermissionMUTE_MEMBERS,
+			"Deaf":                       FlagBitwisePermissionDEAFEN_MEMBERS,
+			"ChannelID":                  FlagBitwisePermissionMOVE_MEMBERS,
+			"CommunicationDisabledUntil": FlagBitwisePermissionMODERATE_MEMBERS,
+		},
+		HierarchyFields: []string{"Nick", "Roles", "CommunicationDisabledUntil"},
+		Hierarchy:       true,
+	},
+	"AddGuildMemberRole":       {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"RemoveGuildMemberRole":    {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"RemoveGuildMember":        {Permissions: FlagBitwisePermissionKICK_MEMBERS, Hierarchy: true},
+	"GetGuildBans":             {Permissions: FlagBitwisePermissionBAN_MEMBERS},
+	"GetGuildBan":              {Permissions: FlagBitwisePermissionBAN_MEMBERS},
+	"CreateGuildBan":           {Permissions: FlagBitwisePermissionBAN_MEMBERS, Hierarchy: true},
+	"RemoveGuildBan":           {Permissions: FlagBitwisePermissionBAN_MEMBERS},
+	"CreateGuildRole":          {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"ModifyGuildRolePositions": {Permissions: FlagBitwisePermissionMANAGE_ROLES},
+	"ModifyGuildRole":          {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"DeleteGuildRole":          {Permissions: FlagBitwisePermissionMANAGE_ROLES, Hierarchy: true},
+	"GetGuildPruneCount":       {Permissions: FlagBitwisePermissionKICK_MEMBERS},
+	"BeginGuildPrune":          {Permissions: FlagBitwisePermissionKICK_MEMBERS},
+	"GetGuildInvites":          {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"GetGuildIntegrations":     {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"DeleteGuildIntegration":   {Permissions: FlagBitwisePermissionMANAGE_GUILD},
+	"CreateWebhook":            {Permissions: FlagBitwisePermissionMANAGE_WEBHOOKS},
+	"GetChannelWebhooks":       {Permissions: FlagBitwisePermissionMANAGE_WEBHOOKS},
+	"GetGuildWebhooks":         {Permissions: FlagBitwisePermissionMANAGE_WEBHOOKS},
+}
+
+// Preflight checks whether the bot has the permissions (and role hierarchy) that a request requires
+// using the bot's PermissionState (Config.Request.Preflight), such that a request that would fail with
+// a Missing Permissions error (50013) is NOT sent.
+//
+// An ErrorPermissions is returned when a permission is missing. A request is NOT checked when
+// the bot has no PermissionState (or PreflightUserID), its route has no RoutePermission,
+// or its state is NOT available.
+func Preflight(bot *Client, request any) error {
+	state := bot.Config.Request.Preflight
+	if state == nil || bot.Config.Request.PreflightUserID == "" {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(request))
+
+	route := v.Type().Name()
+
+	permission, ok := RoutePermissions[route]
+	if !ok {
+		return nil
+	}
+
+	fields := routeFieldsOf(v.Type(), permission)
+
+	required := permission.Permissions
+	for _, field := range fields.permissions {
+		if isSet(v, field.index) {
+			required |= field.permissions
+		}
+	}
+
+	guildID := stringField(v, fields.guildID)
+	channelID := stringField(v, fields.channelID)
+
+	var channel *Channel
+	if channelID != "" {
+		if channel, ok = state.Channel(channelID); !ok || channel.GuildID == nil {
+			return nil
+		}
+
+		guildID = *channel.GuildID
+
+		// the permissions of a thread are the permissions of its parent channel.
+		if channel.Type != nil && (*channel.Type == FlagChannelTypeANNOUNCEMENT_THREAD ||
+			*channel.Type == FlagChannelTypePUBLIC_THREAD ||
+			*channel.Type == FlagChannelTypePRIVATE_THREAD) {
+			if channel.ParentID == nil || *channel.ParentID == nil {
+				return nil
+			}
+
+			if channel, ok = state.Channel(**channel.ParentID); !ok {
+				return nil
+			}
+
+			if required&FlagBitwisePermissionSEND_MESSAGES != 0 {
+				required = required&^FlagBitwisePermissionSEND_MESSAGES | FlagBitwisePermissionSEND_MESSAGES_IN_THREADS
+			}
+		}
+	}
+
+	if guildID == "" {
+		return nil
+	}
+
+	guild, ok := state.Guild(guildID)
+	if !ok {
+		return nil
+	}
+
+	member, ok := state.Member(guildID, bot.Config.Request.PreflightUserID)
+	if !ok {
+		return nil
+	}
+
+	var granted BitFlag
+	var err error
+
+	if channel != nil {
+		granted, err = ChannelPermissions(guild, member, channel)
+	} else {
+		granted, err = BasePermissions(guild, member)
+	}
+
+	if err != nil {
+		return fmt.Errorf("preflight: %w", err)
+	}
+
+	if missing := MissingPermissions(granted, required); missing != 0 {
+		return ErrorPermissions{Route: route, GuildID: guildID, ChannelID: channelID, Missing: missing, Hierarchy: false}
+	}
+
+	if permission.Hierarchy && hierarchyRequired(fields, v) && !hierarchy(state, guild, member, fields, v) {
+		return ErrorPermissions{Route: route, GuildID: guildID, ChannelID: channelID, Missing: 0, Hierarchy: true}
+	}
+
+	return nil
+}
+
+// routeFields represents the indexes of the request fields that are used to check a route's permissions.
+type routeFields struct {
+	// permissions represents the fields that require permissions when set.
+	permissions []routeField
+
+	// hierarchy represents the indexes of the fields that require the role hierarchy when set.
+	hierarchy [][]int
+
+	// guildID, channelID, userID, and roleID represent the indexes of the request's ID fields.
+	guildID   []int
+	channelID []int
+	userID    []int
+	roleID    []int
+}
+
+// routeField represents the index of a request field that requires permissions when set.
+type routeField struct {
+	index       []int
+	permissions BitFlag
+}
+
+// routeFieldsCache represents a map of request types to their routeFields.
+var routeFieldsCache sync.Map
+
+// routeFieldsOf returns the (cached) routeFields of a request type.
+func routeFieldsOf(t reflect.Type, permission RoutePermission) *routeFields {
+	if fields, ok := routeFieldsCache.Load(t); ok {
+		return fields.(*routeFields) //nolint:forcetypeassert
+	}
+
+	fields := &routeFields{
+		permissions: make([]routeField, 0, len(permission.Fields)),
+		hierarchy:   make([][]int, 0, len(permission.HierarchyFields)),
+		guildID:     stringFieldIndex(t, "GuildID"),
+		channelID:   stringFieldIndex(t, "ChannelID"),
+		userID:      stringFieldIndex(t, "UserID"),
+		roleID:      stringFieldIndex(t, "RoleID"),
+	}
+
+	for name, permissions := range permission.Fields {
+		if field, ok := t.FieldByName(name); ok {
+			fields.permissions = append(fields.permissions, routeField{index: field.Index, permissions: permissions})
+		}
+	}
+
+	for _, name := range permission.HierarchyFields {
+		if field, ok := t.FieldByName(name); ok {
+			fields.hierarchy = append(fields.hierarchy, field.Index)
+		}
+	}
+
+	cached, _ := routeFieldsCache.LoadOrStore(t, fields)
+
+	return cached.(*routeFields) //nolint:forcetypeassert
+}
+
+// stringFieldIndex returns the index of a struct type's string field (or nil).
+func stringFieldIndex(t reflect.Type, name string) []int {
+	field, ok := t.FieldByName(name)
+	if !ok || field.Type.Kind() != reflect.String {
+		return nil
+	}
+
+	return field.Index
+}
+
+// hierarchyRequired determines whether a request requires the role hierarchy using its set fields.
+func hierarchyRequired(fields *routeFields, request reflect.Value) bool {
+	if len(fields.hierarchy) == 0 {
+		return true
+	}
+
+	for _, index := range fields.hierarchy {
+		if isSet(request, index) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hierarchy determines whether the highest role of a member is higher than
+// the highest role of the request's member (UserID) and the request's role (RoleID).
+func hierarchy(state PermissionState, guild *Guild, member *GuildMember, fields *routeFields, request reflect.Value) bool {
+	if member.User.ID == guild.OwnerID {
+		return true
+	}
+
+	position := highestRole(guild, member)
+
+	if userID := stringField(request, fields.userID); userID != "" && userID != member.User.ID {
+		if userID == guild.OwnerID {
+			return false
+		}
+
+		if target, ok := state.Member(guild.ID, userID); ok && highestRole(guild, target) >= position {
+			return false
+		}
+	}
+
+	if roleID := stringField(request, fields.roleID); roleID != "" {
+		for _, role := range guild.Roles {
+			if role != nil && role.ID == roleID && role.Position >= position {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// highestRole returns the position of the highest role of a member.
+func highestRole(guild *Guild, member *GuildMember) int {
+	roles := make(map[string]bool, len(member.Roles))
+	for _, id := range member.Roles {
+		if id != nil {
+			roles[*id] = true
+		}
+	}
+
+	var position int
+	for _, role := range guild.Roles {
+		if role != nil && roles[role.ID] && role.Position > position {
+			position = role.Position
+		}
+	}
+
+	return position
+}
+
+// stringField returns the value of a struct's string field at the given index (or an empty string).
+func stringField(v reflect.Value, index []int) string {
+	if index == nil {
+		return ""
+	}
+
+	field, err := v.FieldByIndexErr(index)
+	if err != nil {
+		return ""
+	}
+
+	return field.String()
+}
+
+// isSet determines whether a struct's field at the given index is set (non-zero).
+func isSet(v reflect.Value, index []int) bool {
+	field, err := v.FieldByIndexErr(index)
+
+	return err == nil && !field.IsZero()
+}
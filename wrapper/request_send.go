@@ -219,6 +219,17 @@ func (r *GetGlobalApplicationCommands) Send(bot *Client) ([]*ApplicationCommand,
 	}
 	endpoint := EndpointGetGlobalApplicationCommands(bot.ApplicationID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*ApplicationCommand, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -242,6 +253,17 @@ func (r *CreateGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand,
 	routeid, resourceid := RateLimitHashFuncs[3]("3")
 	endpoint := EndpointCreateGlobalApplicationCommand(bot.ApplicationID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -277,6 +299,17 @@ func (r *GetGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, er
 	routeid, resourceid := RateLimitHashFuncs[4]("4", "297ffb1f"+r.CommandID)
 	endpoint := EndpointGetGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ApplicationCommand)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -300,6 +333,17 @@ func (r *EditGlobalApplicationCommand) Send(bot *Client) (*ApplicationCommand, e
 	routeid, resourceid := RateLimitHashFuncs[5]("5", "297ffb1f"+r.CommandID)
 	endpoint := EndpointEditGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -335,6 +379,17 @@ func (r *DeleteGlobalApplicationCommand) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[6]("6", "297ffb1f"+r.CommandID)
 	endpoint := EndpointDeleteGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -357,6 +412,17 @@ func (r *BulkOverwriteGlobalApplicationCommands) Send(bot *Client) ([]*Applicati
 	routeid, resourceid := RateLimitHashFuncs[7]("7")
 	endpoint := EndpointBulkOverwriteGlobalApplicationCommands(bot.ApplicationID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -403,6 +469,17 @@ func (r *GetGuildApplicationCommands) Send(bot *Client) ([]*ApplicationCommand,
 	}
 	endpoint := EndpointGetGuildApplicationCommands(bot.ApplicationID, r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*ApplicationCommand, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -426,6 +503,17 @@ func (r *CreateGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand,
 	routeid, resourceid := RateLimitHashFuncs[9]("9", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateGuildApplicationCommand(bot.ApplicationID, r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -461,6 +549,17 @@ func (r *GetGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, err
 	routeid, resourceid := RateLimitHashFuncs[10]("10", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
 	endpoint := EndpointGetGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ApplicationCommand)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -484,6 +583,17 @@ func (r *EditGuildApplicationCommand) Send(bot *Client) (*ApplicationCommand, er
 	routeid, resourceid := RateLimitHashFuncs[11]("11", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
 	endpoint := EndpointEditGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -519,6 +629,17 @@ func (r *DeleteGuildApplicationCommand) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[12]("12", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
 	endpoint := EndpointDeleteGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -541,6 +662,17 @@ func (r *BulkOverwriteGuildApplicationCommands) Send(bot *Client) ([]*Applicatio
 	routeid, resourceid := RateLimitHashFuncs[13]("13", "45892a5d"+r.GuildID)
 	endpoint := EndpointBulkOverwriteGuildApplicationCommands(bot.ApplicationID, r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -576,6 +708,17 @@ func (r *GetGuildApplicationCommandPermissions) Send(bot *Client) (*GuildApplica
 	routeid, resourceid := RateLimitHashFuncs[14]("14", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildApplicationCommandPermissions(bot.ApplicationID, r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildApplicationCommandPermissions)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -599,6 +742,17 @@ func (r *GetApplicationCommandPermissions) Send(bot *Client) (*GuildApplicationC
 	routeid, resourceid := RateLimitHashFuncs[15]("15", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
 	endpoint := EndpointGetApplicationCommandPermissions(bot.ApplicationID, r.GuildID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildApplicationCommandPermissions)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -622,6 +776,17 @@ func (r *EditApplicationCommandPermissions) Send(bot *Client) (*GuildApplication
 	routeid, resourceid := RateLimitHashFuncs[16]("16", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
 	endpoint := EndpointEditApplicationCommandPermissions(bot.ApplicationID, r.GuildID, r.CommandID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -657,6 +822,17 @@ func (r *BatchEditApplicationCommandPermissions) Send(bot *Client) (*GuildApplic
 	routeid, resourceid := RateLimitHashFuncs[17]("17", "45892a5d"+r.GuildID)
 	endpoint := EndpointBatchEditApplicationCommandPermissions(bot.ApplicationID, r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildApplicationCommandPermissions)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, result)
 	if err != nil {
@@ -680,6 +856,19 @@ func (r *CreateInteractionResponse) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[18]("18", "beb3d0e6"+r.InteractionID, "cb69bb28"+r.InteractionToken)
 	endpoint := EndpointCreateInteractionResponse(r.InteractionID, r.InteractionToken)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -707,14 +896,14 @@ func (r *CreateInteractionResponse) Send(bot *Client) error {
 	return nil
 }
 
-// Send sends a GetOriginalInteractionResponse request to Discord and returns a error.
-func (r *GetOriginalInteractionResponse) Send(bot *Client) error {
+// Send sends a GetOriginalInteractionResponse request to Discord and returns a Message.
+func (r *GetOriginalInteractionResponse) Send(bot *Client) (*Message, error) {
 	var err error
 	xid := xid.New().String()
 	routeid, resourceid := RateLimitHashFuncs[19]("19", "cb69bb28"+r.InteractionToken)
 	query, err := EndpointQueryString(r)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -725,9 +914,21 @@ func (r *GetOriginalInteractionResponse) Send(bot *Client) error {
 	}
 	endpoint := EndpointGetOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken) + "?" + query
 
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, ContentTypeURLQueryString, nil, nil)
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	result := new(Message)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
-		return ErrorRequest{
+		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
 			RouteID:       routeid,
@@ -737,7 +938,7 @@ func (r *GetOriginalInteractionResponse) Send(bot *Client) error {
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
 // Send sends a EditOriginalInteractionResponse request to Discord and returns a Message.
@@ -758,6 +959,19 @@ func (r *EditOriginalInteractionResponse) Send(bot *Client) (*Message, error) {
 	}
 	endpoint := EndpointEditOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -808,6 +1022,17 @@ func (r *DeleteOriginalInteractionResponse) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[21]("21", "cb69bb28"+r.InteractionToken)
 	endpoint := EndpointDeleteOriginalInteractionResponse(bot.ApplicationID, r.InteractionToken)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -841,6 +1066,19 @@ func (r *CreateFollowupMessage) Send(bot *Client) (*Message, error) {
 	}
 	endpoint := EndpointCreateFollowupMessage(bot.ApplicationID, r.InteractionToken) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -902,6 +1140,17 @@ func (r *GetFollowupMessage) Send(bot *Client) (*Message, error) {
 	}
 	endpoint := EndpointGetFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Message)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -936,6 +1185,19 @@ func (r *EditFollowupMessage) Send(bot *Client) (*Message, error) {
 	}
 	endpoint := EndpointEditFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -986,6 +1248,17 @@ func (r *DeleteFollowupMessage) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[25]("25", "cb69bb28"+r.InteractionToken, "d57d6589"+r.MessageID)
 	endpoint := EndpointDeleteFollowupMessage(bot.ApplicationID, r.InteractionToken, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1008,9 +1281,7 @@ func (r *GetCurrentApplication) Send(bot *Client) (*Application, error) {
 	routeid, resourceid := RateLimitHashFuncs[26]("26")
 	endpoint := EndpointGetCurrentApplication()
 
-	result := new(Application)
-	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
-	if err != nil {
+	if err = Preflight(bot, r); err != nil {
 		return nil, ErrorRequest{
 			ClientID:      bot.ApplicationID,
 			CorrelationID: xid,
@@ -1021,7 +1292,20 @@ func (r *GetCurrentApplication) Send(bot *Client) (*Application, error) {
 		}
 	}
 
-	return result, nil
+	result := new(Application)
+	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
+	if err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
 }
 
 // Send sends a GetApplicationRoleConnectionMetadataRecords request to Discord and returns a []*ApplicationRoleConnectionMetadata.
@@ -1031,6 +1315,17 @@ func (r *GetApplicationRoleConnectionMetadataRecords) Send(bot *Client) ([]*Appl
 	routeid, resourceid := RateLimitHashFuncs[27]("27")
 	endpoint := EndpointGetApplicationRoleConnectionMetadataRecords(bot.ApplicationID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*ApplicationRoleConnectionMetadata, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -1054,6 +1349,17 @@ func (r *UpdateApplicationRoleConnectionMetadataRecords) Send(bot *Client) ([]*A
 	routeid, resourceid := RateLimitHashFuncs[28]("28")
 	endpoint := EndpointUpdateApplicationRoleConnectionMetadataRecords(bot.ApplicationID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*ApplicationRoleConnectionMetadata, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, &result)
 	if err != nil {
@@ -1088,6 +1394,17 @@ func (r *GetGuildAuditLog) Send(bot *Client) (*AuditLog, error) {
 	}
 	endpoint := EndpointGetGuildAuditLog(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(AuditLog)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -1111,6 +1428,17 @@ func (r *ListAutoModerationRulesForGuild) Send(bot *Client) ([]*AutoModerationAc
 	routeid, resourceid := RateLimitHashFuncs[30]("30", "45892a5d"+r.GuildID)
 	endpoint := EndpointListAutoModerationRulesForGuild(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*AutoModerationAction, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -1134,6 +1462,17 @@ func (r *GetAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error) {
 	routeid, resourceid := RateLimitHashFuncs[31]("31", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
 	endpoint := EndpointGetAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(AutoModerationRule)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -1157,6 +1496,17 @@ func (r *CreateAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error
 	routeid, resourceid := RateLimitHashFuncs[32]("32", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateAutoModerationRule(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1192,6 +1542,17 @@ func (r *ModifyAutoModerationRule) Send(bot *Client) (*AutoModerationRule, error
 	routeid, resourceid := RateLimitHashFuncs[33]("33", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
 	endpoint := EndpointModifyAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1227,6 +1588,17 @@ func (r *DeleteAutoModerationRule) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[34]("34", "45892a5d"+r.GuildID, "1b7efe5d"+r.AutoModerationRuleID)
 	endpoint := EndpointDeleteAutoModerationRule(r.GuildID, r.AutoModerationRuleID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1249,6 +1621,17 @@ func (r *GetChannel) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[35]("35", "e5416649"+r.ChannelID)
 	endpoint := EndpointGetChannel(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Channel)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -1272,6 +1655,17 @@ func (r *ModifyChannel) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[36]("36", "e5416649"+r.ChannelID)
 	endpoint := EndpointModifyChannel(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Channel)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, nil, nil, result)
 	if err != nil {
@@ -1295,6 +1689,17 @@ func (r *ModifyChannelGroupDM) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[37]("37", "e5416649"+r.ChannelID)
 	endpoint := EndpointModifyChannelGroupDM(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1330,6 +1735,17 @@ func (r *ModifyChannelGuild) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[38]("38", "e5416649"+r.ChannelID)
 	endpoint := EndpointModifyChannelGuild(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1365,6 +1781,17 @@ func (r *ModifyChannelThread) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[39]("39", "e5416649"+r.ChannelID)
 	endpoint := EndpointModifyChannelThread(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1400,6 +1827,17 @@ func (r *DeleteCloseChannel) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[40]("40", "e5416649"+r.ChannelID)
 	endpoint := EndpointDeleteCloseChannel(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Channel)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
 	if err != nil {
@@ -1434,6 +1872,17 @@ func (r *GetChannelMessages) Send(bot *Client) ([]*Message, error) {
 	}
 	endpoint := EndpointGetChannelMessages(r.ChannelID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Message, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -1457,6 +1906,17 @@ func (r *GetChannelMessage) Send(bot *Client) (*Message, error) {
 	routeid, resourceid := RateLimitHashFuncs[42]("42", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointGetChannelMessage(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Message)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -1480,6 +1940,19 @@ func (r *CreateMessage) Send(bot *Client) (*Message, error) {
 	routeid, resourceid := RateLimitHashFuncs[43]("43", "e5416649"+r.ChannelID)
 	endpoint := EndpointCreateMessage(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1530,6 +2003,17 @@ func (r *CrosspostMessage) Send(bot *Client) (*Message, error) {
 	routeid, resourceid := RateLimitHashFuncs[44]("44", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointCrosspostMessage(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Message)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, nil, nil, result)
 	if err != nil {
@@ -1553,6 +2037,17 @@ func (r *CreateReaction) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[45]("45", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
 	endpoint := EndpointCreateReaction(r.ChannelID, r.MessageID, r.Emoji)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1575,6 +2070,17 @@ func (r *DeleteOwnReaction) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[46]("46", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
 	endpoint := EndpointDeleteOwnReaction(r.ChannelID, r.MessageID, r.Emoji)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1597,6 +2103,17 @@ func (r *DeleteUserReaction) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[47]("47", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji, "209c92df"+r.UserID)
 	endpoint := EndpointDeleteUserReaction(r.ChannelID, r.MessageID, r.Emoji, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1630,6 +2147,17 @@ func (r *GetReactions) Send(bot *Client) ([]*User, error) {
 	}
 	endpoint := EndpointGetReactions(r.ChannelID, r.MessageID, r.Emoji) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*User, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -1653,6 +2181,17 @@ func (r *DeleteAllReactions) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[49]("49", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointDeleteAllReactions(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1675,6 +2214,17 @@ func (r *DeleteAllReactionsforEmoji) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[50]("50", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID, "033ebcdd"+r.Emoji)
 	endpoint := EndpointDeleteAllReactionsforEmoji(r.ChannelID, r.MessageID, r.Emoji)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1697,6 +2247,19 @@ func (r *EditMessage) Send(bot *Client) (*Message, error) {
 	routeid, resourceid := RateLimitHashFuncs[51]("51", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointEditMessage(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1747,6 +2310,17 @@ func (r *DeleteMessage) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[52]("52", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointDeleteMessage(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1769,6 +2343,17 @@ func (r *BulkDeleteMessages) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[53]("53", "e5416649"+r.ChannelID)
 	endpoint := EndpointBulkDeleteMessages(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -1803,6 +2388,17 @@ func (r *EditChannelPermissions) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[54]("54", "e5416649"+r.ChannelID, "9167175f"+r.OverwriteID)
 	endpoint := EndpointEditChannelPermissions(r.ChannelID, r.OverwriteID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -1837,6 +2433,17 @@ func (r *GetChannelInvites) Send(bot *Client) ([]*Invite, error) {
 	routeid, resourceid := RateLimitHashFuncs[55]("55", "e5416649"+r.ChannelID)
 	endpoint := EndpointGetChannelInvites(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Invite, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -1860,6 +2467,17 @@ func (r *CreateChannelInvite) Send(bot *Client) (*Invite, error) {
 	routeid, resourceid := RateLimitHashFuncs[56]("56", "e5416649"+r.ChannelID)
 	endpoint := EndpointCreateChannelInvite(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1895,6 +2513,17 @@ func (r *DeleteChannelPermission) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[57]("57", "e5416649"+r.ChannelID, "9167175f"+r.OverwriteID)
 	endpoint := EndpointDeleteChannelPermission(r.ChannelID, r.OverwriteID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1917,6 +2546,17 @@ func (r *FollowAnnouncementChannel) Send(bot *Client) (*FollowedChannel, error)
 	routeid, resourceid := RateLimitHashFuncs[58]("58", "e5416649"+r.ChannelID)
 	endpoint := EndpointFollowAnnouncementChannel(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -1952,6 +2592,17 @@ func (r *TriggerTypingIndicator) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[59]("59", "e5416649"+r.ChannelID)
 	endpoint := EndpointTriggerTypingIndicator(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -1974,6 +2625,17 @@ func (r *GetPinnedMessages) Send(bot *Client) ([]*Message, error) {
 	routeid, resourceid := RateLimitHashFuncs[60]("60", "e5416649"+r.ChannelID)
 	endpoint := EndpointGetPinnedMessages(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Message, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -1997,6 +2659,17 @@ func (r *PinMessage) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[61]("61", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointPinMessage(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2019,6 +2692,17 @@ func (r *UnpinMessage) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[62]("62", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointUnpinMessage(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2041,6 +2725,17 @@ func (r *GroupDMAddRecipient) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[63]("63", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
 	endpoint := EndpointGroupDMAddRecipient(r.ChannelID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -2075,6 +2770,17 @@ func (r *GroupDMRemoveRecipient) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[64]("64", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
 	endpoint := EndpointGroupDMRemoveRecipient(r.ChannelID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2097,6 +2803,17 @@ func (r *StartThreadfromMessage) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[65]("65", "e5416649"+r.ChannelID, "d57d6589"+r.MessageID)
 	endpoint := EndpointStartThreadfromMessage(r.ChannelID, r.MessageID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2132,6 +2849,17 @@ func (r *StartThreadwithoutMessage) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[66]("66", "e5416649"+r.ChannelID)
 	endpoint := EndpointStartThreadwithoutMessage(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2167,6 +2895,19 @@ func (r *StartThreadinForumChannel) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[67]("67", "e5416649"+r.ChannelID)
 	endpoint := EndpointStartThreadinForumChannel(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2202,6 +2943,17 @@ func (r *JoinThread) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[68]("68", "e5416649"+r.ChannelID)
 	endpoint := EndpointJoinThread(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2224,6 +2976,17 @@ func (r *AddThreadMember) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[69]("69", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
 	endpoint := EndpointAddThreadMember(r.ChannelID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2246,6 +3009,17 @@ func (r *LeaveThread) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[70]("70", "e5416649"+r.ChannelID)
 	endpoint := EndpointLeaveThread(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2268,6 +3042,17 @@ func (r *RemoveThreadMember) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[71]("71", "e5416649"+r.ChannelID, "209c92df"+r.UserID)
 	endpoint := EndpointRemoveThreadMember(r.ChannelID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2301,6 +3086,17 @@ func (r *GetThreadMember) Send(bot *Client) (*ThreadMember, error) {
 	}
 	endpoint := EndpointGetThreadMember(r.ChannelID, r.UserID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ThreadMember)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -2335,6 +3131,17 @@ func (r *ListThreadMembers) Send(bot *Client) ([]*ThreadMember, error) {
 	}
 	endpoint := EndpointListThreadMembers(r.ChannelID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*ThreadMember, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -2369,6 +3176,17 @@ func (r *ListPublicArchivedThreads) Send(bot *Client) (*ListPublicArchivedThread
 	}
 	endpoint := EndpointListPublicArchivedThreads(r.ChannelID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ListPublicArchivedThreadsResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -2403,6 +3221,17 @@ func (r *ListPrivateArchivedThreads) Send(bot *Client) (*ListPrivateArchivedThre
 	}
 	endpoint := EndpointListPrivateArchivedThreads(r.ChannelID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ListPrivateArchivedThreadsResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -2437,6 +3266,17 @@ func (r *ListJoinedPrivateArchivedThreads) Send(bot *Client) (*ListJoinedPrivate
 	}
 	endpoint := EndpointListJoinedPrivateArchivedThreads(r.ChannelID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ListJoinedPrivateArchivedThreadsResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -2460,6 +3300,17 @@ func (r *ListGuildEmojis) Send(bot *Client) ([]*Emoji, error) {
 	routeid, resourceid := RateLimitHashFuncs[77]("77", "45892a5d"+r.GuildID)
 	endpoint := EndpointListGuildEmojis(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Emoji, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -2483,6 +3334,17 @@ func (r *GetGuildEmoji) Send(bot *Client) (*Emoji, error) {
 	routeid, resourceid := RateLimitHashFuncs[78]("78", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
 	endpoint := EndpointGetGuildEmoji(r.GuildID, r.EmojiID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Emoji)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -2506,6 +3368,17 @@ func (r *CreateGuildEmoji) Send(bot *Client) (*Emoji, error) {
 	routeid, resourceid := RateLimitHashFuncs[79]("79", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateGuildEmoji(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2541,6 +3414,17 @@ func (r *ModifyGuildEmoji) Send(bot *Client) (*Emoji, error) {
 	routeid, resourceid := RateLimitHashFuncs[80]("80", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
 	endpoint := EndpointModifyGuildEmoji(r.GuildID, r.EmojiID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2576,6 +3460,17 @@ func (r *DeleteGuildEmoji) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[81]("81", "45892a5d"+r.GuildID, "67c175a8"+r.EmojiID)
 	endpoint := EndpointDeleteGuildEmoji(r.GuildID, r.EmojiID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2598,6 +3493,17 @@ func (r *CreateGuild) Send(bot *Client) (*Guild, error) {
 	routeid, resourceid := RateLimitHashFuncs[82]("82")
 	endpoint := EndpointCreateGuild()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2644,6 +3550,17 @@ func (r *GetGuild) Send(bot *Client) (*Guild, error) {
 	}
 	endpoint := EndpointGetGuild(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Guild)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -2667,6 +3584,17 @@ func (r *GetGuildPreview) Send(bot *Client) (*GuildPreview, error) {
 	routeid, resourceid := RateLimitHashFuncs[84]("84", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildPreview(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildPreview)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -2690,6 +3618,17 @@ func (r *ModifyGuild) Send(bot *Client) (*Guild, error) {
 	routeid, resourceid := RateLimitHashFuncs[85]("85", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyGuild(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2725,6 +3664,17 @@ func (r *DeleteGuild) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[86]("86", "45892a5d"+r.GuildID)
 	endpoint := EndpointDeleteGuild(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -2747,6 +3697,17 @@ func (r *GetGuildChannels) Send(bot *Client) ([]*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[87]("87", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildChannels(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Channel, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -2770,6 +3731,17 @@ func (r *CreateGuildChannel) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[88]("88", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateGuildChannel(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2805,6 +3777,17 @@ func (r *ModifyGuildChannelPositions) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[89]("89", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyGuildChannelPositions(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -2839,6 +3822,17 @@ func (r *ListActiveGuildThreads) Send(bot *Client) (*ListActiveGuildThreadsRespo
 	routeid, resourceid := RateLimitHashFuncs[90]("90", "45892a5d"+r.GuildID)
 	endpoint := EndpointListActiveGuildThreads(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ListActiveGuildThreadsResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -2862,6 +3856,17 @@ func (r *GetGuildMember) Send(bot *Client) (*GuildMember, error) {
 	routeid, resourceid := RateLimitHashFuncs[91]("91", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointGetGuildMember(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildMember)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -2896,6 +3901,17 @@ func (r *ListGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
 	}
 	endpoint := EndpointListGuildMembers(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*GuildMember, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -2930,6 +3946,17 @@ func (r *SearchGuildMembers) Send(bot *Client) ([]*GuildMember, error) {
 	}
 	endpoint := EndpointSearchGuildMembers(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*GuildMember, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -2953,6 +3980,17 @@ func (r *AddGuildMember) Send(bot *Client) (*GuildMember, error) {
 	routeid, resourceid := RateLimitHashFuncs[94]("94", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointAddGuildMember(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -2988,6 +4026,17 @@ func (r *ModifyGuildMember) Send(bot *Client) (*GuildMember, error) {
 	routeid, resourceid := RateLimitHashFuncs[95]("95", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointModifyGuildMember(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3023,6 +4072,17 @@ func (r *ModifyCurrentMember) Send(bot *Client) (*GuildMember, error) {
 	routeid, resourceid := RateLimitHashFuncs[96]("96", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyCurrentMember(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3058,6 +4118,17 @@ func (r *AddGuildMemberRole) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[97]("97", "45892a5d"+r.GuildID, "209c92df"+r.UserID, "3cf7dd7c"+r.RoleID)
 	endpoint := EndpointAddGuildMemberRole(r.GuildID, r.UserID, r.RoleID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -3080,6 +4151,17 @@ func (r *RemoveGuildMemberRole) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[98]("98", "45892a5d"+r.GuildID, "209c92df"+r.UserID, "3cf7dd7c"+r.RoleID)
 	endpoint := EndpointRemoveGuildMemberRole(r.GuildID, r.UserID, r.RoleID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -3102,6 +4184,17 @@ func (r *RemoveGuildMember) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[99]("99", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointRemoveGuildMember(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -3135,6 +4228,17 @@ func (r *GetGuildBans) Send(bot *Client) ([]*Ban, error) {
 	}
 	endpoint := EndpointGetGuildBans(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Ban, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -3158,6 +4262,17 @@ func (r *GetGuildBan) Send(bot *Client) (*Ban, error) {
 	routeid, resourceid := RateLimitHashFuncs[101]("101", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointGetGuildBan(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Ban)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -3181,6 +4296,17 @@ func (r *CreateGuildBan) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[102]("102", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointCreateGuildBan(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -3215,6 +4341,17 @@ func (r *RemoveGuildBan) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[103]("103", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointRemoveGuildBan(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -3237,6 +4374,17 @@ func (r *GetGuildRoles) Send(bot *Client) ([]*Role, error) {
 	routeid, resourceid := RateLimitHashFuncs[104]("104", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildRoles(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Role, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -3260,6 +4408,17 @@ func (r *CreateGuildRole) Send(bot *Client) (*Role, error) {
 	routeid, resourceid := RateLimitHashFuncs[105]("105", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateGuildRole(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3295,6 +4454,17 @@ func (r *ModifyGuildRolePositions) Send(bot *Client) ([]*Role, error) {
 	routeid, resourceid := RateLimitHashFuncs[106]("106", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyGuildRolePositions(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3330,6 +4500,17 @@ func (r *ModifyGuildRole) Send(bot *Client) (*Role, error) {
 	routeid, resourceid := RateLimitHashFuncs[107]("107", "45892a5d"+r.GuildID, "3cf7dd7c"+r.RoleID)
 	endpoint := EndpointModifyGuildRole(r.GuildID, r.RoleID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3365,6 +4546,17 @@ func (r *DeleteGuildRole) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[108]("108", "45892a5d"+r.GuildID, "3cf7dd7c"+r.RoleID)
 	endpoint := EndpointDeleteGuildRole(r.GuildID, r.RoleID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -3387,6 +4579,17 @@ func (r *ModifyGuildMFALevel) Send(bot *Client) (*ModifyGuildMFALevelResponse, e
 	routeid, resourceid := RateLimitHashFuncs[109]("109", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyGuildMFALevel(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3433,6 +4636,17 @@ func (r *GetGuildPruneCount) Send(bot *Client) (*GetGuildPruneCountResponse, err
 	}
 	endpoint := EndpointGetGuildPruneCount(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GetGuildPruneCountResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -3456,6 +4670,17 @@ func (r *BeginGuildPrune) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[111]("111", "45892a5d"+r.GuildID)
 	endpoint := EndpointBeginGuildPrune(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -3490,6 +4715,17 @@ func (r *GetGuildVoiceRegions) Send(bot *Client) ([]*VoiceRegion, error) {
 	routeid, resourceid := RateLimitHashFuncs[112]("112", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildVoiceRegions(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*VoiceRegion, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -3513,6 +4749,17 @@ func (r *GetGuildInvites) Send(bot *Client) ([]*Invite, error) {
 	routeid, resourceid := RateLimitHashFuncs[113]("113", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildInvites(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Invite, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -3536,6 +4783,17 @@ func (r *GetGuildIntegrations) Send(bot *Client) ([]*Integration, error) {
 	routeid, resourceid := RateLimitHashFuncs[114]("114", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildIntegrations(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Integration, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -3559,6 +4817,17 @@ func (r *DeleteGuildIntegration) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[115]("115", "45892a5d"+r.GuildID, "cb4479f8"+r.IntegrationID)
 	endpoint := EndpointDeleteGuildIntegration(r.GuildID, r.IntegrationID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -3581,6 +4850,17 @@ func (r *GetGuildWidgetSettings) Send(bot *Client) (*GuildWidget, error) {
 	routeid, resourceid := RateLimitHashFuncs[116]("116", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildWidgetSettings(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildWidget)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -3604,6 +4884,17 @@ func (r *ModifyGuildWidget) Send(bot *Client) (*GuildWidget, error) {
 	routeid, resourceid := RateLimitHashFuncs[117]("117", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyGuildWidget(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildWidget)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPatch, endpoint, nil, nil, result)
 	if err != nil {
@@ -3627,6 +4918,17 @@ func (r *GetGuildWidget) Send(bot *Client) (*GuildWidget, error) {
 	routeid, resourceid := RateLimitHashFuncs[118]("118", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildWidget(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildWidget)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -3650,6 +4952,17 @@ func (r *GetGuildVanityURL) Send(bot *Client) (*Invite, error) {
 	routeid, resourceid := RateLimitHashFuncs[119]("119", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildVanityURL(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3696,6 +5009,17 @@ func (r *GetGuildWidgetImage) Send(bot *Client) (*EmbedImage, error) {
 	}
 	endpoint := EndpointGetGuildWidgetImage(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(EmbedImage)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -3719,6 +5043,17 @@ func (r *GetGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
 	routeid, resourceid := RateLimitHashFuncs[121]("121", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildWelcomeScreen(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(WelcomeScreen)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -3742,6 +5077,17 @@ func (r *ModifyGuildWelcomeScreen) Send(bot *Client) (*WelcomeScreen, error) {
 	routeid, resourceid := RateLimitHashFuncs[122]("122", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyGuildWelcomeScreen(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3777,6 +5123,17 @@ func (r *GetGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
 	routeid, resourceid := RateLimitHashFuncs[123]("123", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildOnboarding(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildOnboarding)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -3800,6 +5157,17 @@ func (r *ModifyGuildOnboarding) Send(bot *Client) (*GuildOnboarding, error) {
 	routeid, resourceid := RateLimitHashFuncs[124]("124", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyGuildOnboarding(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3835,6 +5203,17 @@ func (r *ModifyCurrentUserVoiceState) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[125]("125", "45892a5d"+r.GuildID)
 	endpoint := EndpointModifyCurrentUserVoiceState(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -3869,6 +5248,17 @@ func (r *ModifyUserVoiceState) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[126]("126", "45892a5d"+r.GuildID, "209c92df"+r.UserID)
 	endpoint := EndpointModifyUserVoiceState(r.GuildID, r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -3914,6 +5304,17 @@ func (r *ListScheduledEventsforGuild) Send(bot *Client) ([]*GuildScheduledEvent,
 	}
 	endpoint := EndpointListScheduledEventsforGuild(r.GuildID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*GuildScheduledEvent, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -3937,6 +5338,17 @@ func (r *CreateGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, err
 	routeid, resourceid := RateLimitHashFuncs[128]("128", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateGuildScheduledEvent(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -3983,6 +5395,17 @@ func (r *GetGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, error)
 	}
 	endpoint := EndpointGetGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildScheduledEvent)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -4006,6 +5429,17 @@ func (r *ModifyGuildScheduledEvent) Send(bot *Client) (*GuildScheduledEvent, err
 	routeid, resourceid := RateLimitHashFuncs[130]("130", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
 	endpoint := EndpointModifyGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4041,6 +5475,17 @@ func (r *DeleteGuildScheduledEvent) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[131]("131", "45892a5d"+r.GuildID, "522412fc"+r.GuildScheduledEventID)
 	endpoint := EndpointDeleteGuildScheduledEvent(r.GuildID, r.GuildScheduledEventID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -4074,6 +5519,17 @@ func (r *GetGuildScheduledEventUsers) Send(bot *Client) ([]*GuildScheduledEventU
 	}
 	endpoint := EndpointGetGuildScheduledEventUsers(r.GuildID, r.GuildScheduledEventID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*GuildScheduledEventUser, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, &result)
 	if err != nil {
@@ -4097,6 +5553,17 @@ func (r *GetGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	routeid, resourceid := RateLimitHashFuncs[133]("133", "61437152"+r.TemplateCode)
 	endpoint := EndpointGetGuildTemplate(r.TemplateCode)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildTemplate)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4120,6 +5587,17 @@ func (r *CreateGuildfromGuildTemplate) Send(bot *Client) ([]*GuildTemplate, erro
 	routeid, resourceid := RateLimitHashFuncs[134]("134", "61437152"+r.TemplateCode)
 	endpoint := EndpointCreateGuildfromGuildTemplate(r.TemplateCode)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4155,6 +5633,17 @@ func (r *GetGuildTemplates) Send(bot *Client) ([]*GuildTemplate, error) {
 	routeid, resourceid := RateLimitHashFuncs[135]("135", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildTemplates(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*GuildTemplate, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -4178,6 +5667,17 @@ func (r *CreateGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	routeid, resourceid := RateLimitHashFuncs[136]("136", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateGuildTemplate(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4213,6 +5713,17 @@ func (r *SyncGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	routeid, resourceid := RateLimitHashFuncs[137]("137", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
 	endpoint := EndpointSyncGuildTemplate(r.GuildID, r.TemplateCode)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildTemplate)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPut, endpoint, nil, nil, result)
 	if err != nil {
@@ -4236,6 +5747,17 @@ func (r *ModifyGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	routeid, resourceid := RateLimitHashFuncs[138]("138", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
 	endpoint := EndpointModifyGuildTemplate(r.GuildID, r.TemplateCode)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4271,6 +5793,17 @@ func (r *DeleteGuildTemplate) Send(bot *Client) (*GuildTemplate, error) {
 	routeid, resourceid := RateLimitHashFuncs[139]("139", "45892a5d"+r.GuildID, "61437152"+r.TemplateCode)
 	endpoint := EndpointDeleteGuildTemplate(r.GuildID, r.TemplateCode)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildTemplate)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
 	if err != nil {
@@ -4305,6 +5838,17 @@ func (r *GetInvite) Send(bot *Client) (*Invite, error) {
 	}
 	endpoint := EndpointGetInvite(r.InviteCode) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Invite)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -4328,6 +5872,17 @@ func (r *DeleteInvite) Send(bot *Client) (*Invite, error) {
 	routeid, resourceid := RateLimitHashFuncs[141]("141", "781d4865"+r.InviteCode)
 	endpoint := EndpointDeleteInvite(r.InviteCode)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Invite)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, result)
 	if err != nil {
@@ -4351,6 +5906,17 @@ func (r *CreateStageInstance) Send(bot *Client) (*StageInstance, error) {
 	routeid, resourceid := RateLimitHashFuncs[142]("142")
 	endpoint := EndpointCreateStageInstance()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4386,6 +5952,17 @@ func (r *GetStageInstance) Send(bot *Client) (*StageInstance, error) {
 	routeid, resourceid := RateLimitHashFuncs[143]("143", "e5416649"+r.ChannelID)
 	endpoint := EndpointGetStageInstance(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(StageInstance)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4409,6 +5986,17 @@ func (r *ModifyStageInstance) Send(bot *Client) (*StageInstance, error) {
 	routeid, resourceid := RateLimitHashFuncs[144]("144", "e5416649"+r.ChannelID)
 	endpoint := EndpointModifyStageInstance(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4444,6 +6032,17 @@ func (r *DeleteStageInstance) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[145]("145", "e5416649"+r.ChannelID)
 	endpoint := EndpointDeleteStageInstance(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -4466,6 +6065,17 @@ func (r *GetSticker) Send(bot *Client) (*Sticker, error) {
 	routeid, resourceid := RateLimitHashFuncs[146]("146", "6eeeabf1"+r.StickerID)
 	endpoint := EndpointGetSticker(r.StickerID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Sticker)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4489,6 +6099,17 @@ func (r *ListNitroStickerPacks) Send(bot *Client) (*ListNitroStickerPacksRespons
 	routeid, resourceid := RateLimitHashFuncs[147]("147")
 	endpoint := EndpointListNitroStickerPacks()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ListNitroStickerPacksResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4512,6 +6133,17 @@ func (r *ListGuildStickers) Send(bot *Client) ([]*Sticker, error) {
 	routeid, resourceid := RateLimitHashFuncs[148]("148", "45892a5d"+r.GuildID)
 	endpoint := EndpointListGuildStickers(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Sticker, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -4535,6 +6167,17 @@ func (r *GetGuildSticker) Send(bot *Client) (*Sticker, error) {
 	routeid, resourceid := RateLimitHashFuncs[149]("149", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
 	endpoint := EndpointGetGuildSticker(r.GuildID, r.StickerID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Sticker)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4558,6 +6201,17 @@ func (r *CreateGuildSticker) Send(bot *Client) (*Sticker, error) {
 	routeid, resourceid := RateLimitHashFuncs[150]("150", "45892a5d"+r.GuildID)
 	endpoint := EndpointCreateGuildSticker(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4606,6 +6260,17 @@ func (r *ModifyGuildSticker) Send(bot *Client) (*Sticker, error) {
 	routeid, resourceid := RateLimitHashFuncs[151]("151", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
 	endpoint := EndpointModifyGuildSticker(r.GuildID, r.StickerID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4641,6 +6306,17 @@ func (r *DeleteGuildSticker) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[152]("152", "45892a5d"+r.GuildID, "6eeeabf1"+r.StickerID)
 	endpoint := EndpointDeleteGuildSticker(r.GuildID, r.StickerID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -4663,6 +6339,17 @@ func (r *GetCurrentUser) Send(bot *Client) (*User, error) {
 	routeid, resourceid := RateLimitHashFuncs[153]("153")
 	endpoint := EndpointGetCurrentUser()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(User)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4686,6 +6373,17 @@ func (r *GetUser) Send(bot *Client) (*User, error) {
 	routeid, resourceid := RateLimitHashFuncs[154]("154", "209c92df"+r.UserID)
 	endpoint := EndpointGetUser(r.UserID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(User)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4709,6 +6407,17 @@ func (r *ModifyCurrentUser) Send(bot *Client) (*User, error) {
 	routeid, resourceid := RateLimitHashFuncs[155]("155")
 	endpoint := EndpointModifyCurrentUser()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4744,6 +6453,17 @@ func (r *GetCurrentUserGuilds) Send(bot *Client) ([]*Guild, error) {
 	routeid, resourceid := RateLimitHashFuncs[156]("156")
 	endpoint := EndpointGetCurrentUserGuilds()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4779,6 +6499,17 @@ func (r *GetCurrentUserGuildMember) Send(bot *Client) (*GuildMember, error) {
 	routeid, resourceid := RateLimitHashFuncs[157]("157", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetCurrentUserGuildMember(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GuildMember)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4802,6 +6533,17 @@ func (r *LeaveGuild) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[158]("158", "45892a5d"+r.GuildID)
 	endpoint := EndpointLeaveGuild(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -4824,6 +6566,17 @@ func (r *CreateDM) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[159]("159")
 	endpoint := EndpointCreateDM()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4859,6 +6612,17 @@ func (r *CreateGroupDM) Send(bot *Client) (*Channel, error) {
 	routeid, resourceid := RateLimitHashFuncs[160]("160")
 	endpoint := EndpointCreateGroupDM()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4894,6 +6658,17 @@ func (r *GetUserConnections) Send(bot *Client) ([]*Connection, error) {
 	routeid, resourceid := RateLimitHashFuncs[161]("161")
 	endpoint := EndpointGetUserConnections()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Connection, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -4917,6 +6692,17 @@ func (r *GetUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRoleCo
 	routeid, resourceid := RateLimitHashFuncs[162]("162")
 	endpoint := EndpointGetUserApplicationRoleConnection(bot.ApplicationID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(ApplicationRoleConnection)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -4940,6 +6726,17 @@ func (r *UpdateUserApplicationRoleConnection) Send(bot *Client) (*ApplicationRol
 	routeid, resourceid := RateLimitHashFuncs[163]("163")
 	endpoint := EndpointUpdateUserApplicationRoleConnection(bot.ApplicationID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -4975,6 +6772,17 @@ func (r *ListVoiceRegions) Send(bot *Client) ([]*VoiceRegion, error) {
 	routeid, resourceid := RateLimitHashFuncs[164]("164")
 	endpoint := EndpointListVoiceRegions()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*VoiceRegion, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -4998,6 +6806,17 @@ func (r *CreateWebhook) Send(bot *Client) (*Webhook, error) {
 	routeid, resourceid := RateLimitHashFuncs[165]("165", "e5416649"+r.ChannelID)
 	endpoint := EndpointCreateWebhook(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -5033,6 +6852,17 @@ func (r *GetChannelWebhooks) Send(bot *Client) ([]*Webhook, error) {
 	routeid, resourceid := RateLimitHashFuncs[166]("166", "e5416649"+r.ChannelID)
 	endpoint := EndpointGetChannelWebhooks(r.ChannelID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Webhook, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -5056,6 +6886,17 @@ func (r *GetGuildWebhooks) Send(bot *Client) ([]*Webhook, error) {
 	routeid, resourceid := RateLimitHashFuncs[167]("167", "45892a5d"+r.GuildID)
 	endpoint := EndpointGetGuildWebhooks(r.GuildID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := make([]*Webhook, 0)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, &result)
 	if err != nil {
@@ -5079,6 +6920,17 @@ func (r *GetWebhook) Send(bot *Client) (*Webhook, error) {
 	routeid, resourceid := RateLimitHashFuncs[168]("168", "6d62b21b"+r.WebhookID)
 	endpoint := EndpointGetWebhook(r.WebhookID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Webhook)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -5102,6 +6954,17 @@ func (r *GetWebhookwithToken) Send(bot *Client) (*Webhook, error) {
 	routeid, resourceid := RateLimitHashFuncs[169]("169", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
 	endpoint := EndpointGetWebhookwithToken(r.WebhookID, r.WebhookToken)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Webhook)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -5125,6 +6988,17 @@ func (r *ModifyWebhook) Send(bot *Client) (*Webhook, error) {
 	routeid, resourceid := RateLimitHashFuncs[170]("170", "6d62b21b"+r.WebhookID)
 	endpoint := EndpointModifyWebhook(r.WebhookID)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -5160,6 +7034,17 @@ func (r *ModifyWebhookwithToken) Send(bot *Client) (*Webhook, error) {
 	routeid, resourceid := RateLimitHashFuncs[171]("171", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
 	endpoint := EndpointModifyWebhookwithToken(r.WebhookID, r.WebhookToken)
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -5195,6 +7080,17 @@ func (r *DeleteWebhook) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[172]("172", "6d62b21b"+r.WebhookID)
 	endpoint := EndpointDeleteWebhook(r.WebhookID)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -5217,6 +7113,17 @@ func (r *DeleteWebhookwithToken) Send(bot *Client) error {
 	routeid, resourceid := RateLimitHashFuncs[173]("173", "6d62b21b"+r.WebhookID, "8954ac33"+r.WebhookToken)
 	endpoint := EndpointDeleteWebhookwithToken(r.WebhookID, r.WebhookToken)
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, nil, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -5250,6 +7157,19 @@ func (r *ExecuteWebhook) Send(bot *Client) error {
 	}
 	endpoint := EndpointExecuteWebhook(r.WebhookID, r.WebhookToken) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return ErrorRequest{
@@ -5310,6 +7230,17 @@ func (r *ExecuteSlackCompatibleWebhook) Send(bot *Client) error {
 	}
 	endpoint := EndpointExecuteSlackCompatibleWebhook(r.WebhookID, r.WebhookToken) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -5343,6 +7274,17 @@ func (r *ExecuteGitHubCompatibleWebhook) Send(bot *Client) error {
 	}
 	endpoint := EndpointExecuteGitHubCompatibleWebhook(r.WebhookID, r.WebhookToken) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodPost, endpoint, ContentTypeURLQueryString, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -5376,6 +7318,17 @@ func (r *GetWebhookMessage) Send(bot *Client) (*Message, error) {
 	}
 	endpoint := EndpointGetWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Message)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, ContentTypeURLQueryString, nil, result)
 	if err != nil {
@@ -5410,6 +7363,19 @@ func (r *EditWebhookMessage) Send(bot *Client) (*Message, error) {
 	}
 	endpoint := EndpointEditWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	r = applyAllowedMentions(bot, r)
+
 	body, err := json.Marshal(r)
 	if err != nil {
 		return nil, ErrorRequest{
@@ -5471,6 +7437,17 @@ func (r *DeleteWebhookMessage) Send(bot *Client) error {
 	}
 	endpoint := EndpointDeleteWebhookMessage(r.WebhookID, r.WebhookToken, r.MessageID) + "?" + query
 
+	if err = Preflight(bot, r); err != nil {
+		return ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodDelete, endpoint, ContentTypeURLQueryString, nil, nil)
 	if err != nil {
 		return ErrorRequest{
@@ -5493,6 +7470,17 @@ func (r *GetGateway) Send(bot *Client) (*GetGatewayBotResponse, error) {
 	routeid, resourceid := RateLimitHashFuncs[180]("180")
 	endpoint := EndpointGetGateway()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GetGatewayBotResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -5516,6 +7504,17 @@ func (r *GetGatewayBot) Send(bot *Client) (*GetGatewayBotResponse, error) {
 	routeid, resourceid := RateLimitHashFuncs[181]("181")
 	endpoint := EndpointGetGatewayBot()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(GetGatewayBotResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -5539,6 +7538,17 @@ func (r *GetCurrentBotApplicationInformation) Send(bot *Client) (*Application, e
 	routeid, resourceid := RateLimitHashFuncs[182]("182")
 	endpoint := EndpointGetCurrentBotApplicationInformation()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(Application)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
@@ -5562,6 +7572,17 @@ func (r *GetCurrentAuthorizationInformation) Send(bot *Client) (*CurrentAuthoriz
 	routeid, resourceid := RateLimitHashFuncs[183]("183")
 	endpoint := EndpointGetCurrentAuthorizationInformation()
 
+	if err = Preflight(bot, r); err != nil {
+		return nil, ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
 	result := new(CurrentAuthorizationInformationResponse)
 	err = SendRequest(bot, xid, routeid, resourceid, fasthttp.MethodGet, endpoint, nil, nil, result)
 	if err != nil {
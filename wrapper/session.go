@@ -55,6 +55,21 @@ type Session struct {
 	// client_manager represents the *Client Session Manager of the Session.
 	client_manager *SessionManager
 
+	// statusHandler represents the handler of the Session's status transitions.
+	statusHandler func(s *Session, transition *SessionTransition)
+
+	// store represents the SessionStore that persists the state of the Session.
+	store SessionStore
+
+	// transitions represents the transitions of the Session that are queued for its status handler.
+	transitions transitions
+
+	// status represents the status of the Session (i.e., SessionStatusReady).
+	status int32
+
+	// attempts represents the amount of times the Session attempted to connect since it was last ready.
+	attempts int32
+
 	// RateLimiter represents an object that provides rate limit functionality.
 	RateLimiter RateLimiter
 
@@ -85,11 +100,22 @@ func (s *Session) canReconnect() bool {
 // Connect connects a session to the Discord Gateway (WebSocket Connection).
 func (s *Session) Connect(bot *Client) error {
 	s.Lock()
+
+	// call the status handler once the Session is unlocked.
+	defer s.notify()
 	defer s.Unlock()
 
 	LogSession(Logger.Info(), s.ID).Str(LogCtxClient, bot.ApplicationID).Msg("connecting session")
 
-	return s.connect(bot)
+	if err := s.connect(bot); err != nil {
+		if s.connecting() {
+			return s.fail(err)
+		}
+
+		return err
+	}
+
+	return nil
 }
 
 // connect connects a session to a WebSocket Connection.
@@ -104,6 +130,13 @@ func (s *Session) connect(bot *Client) error {
 		return fmt.Errorf("session %q is already connected", s.ID)
 	}
 
+	s.statusHandler = bot.Config.Gateway.SessionStatusHandler
+	s.store = bot.Config.Gateway.SessionStore
+	s.transition(SessionStatusConnecting, "connecting", 0, nil)
+
+	// restore the state of a stored session (if applicable).
+	s.load()
+
 	var err error
 
 	// request a valid Gateway URL endpoint and response from the Discord API.
@@ -114,6 +147,11 @@ func (s *Session) connect(bot *Client) error {
 		if gatewayEndpoint, response, err = bot.Config.Gateway.ShardManager.SetLimit(bot); err != nil {
 			return fmt.Errorf("shardmanager: %w", err)
 		}
+
+		// a resumable session must reconnect using its resume gateway URL.
+		if s.canReconnect() {
+			gatewayEndpoint = s.Endpoint
+		}
 	} else {
 		if gatewayEndpoint == "" || !s.canReconnect() {
 			gateway := GetGatewayBot{}
@@ -187,7 +225,7 @@ func (s *Session) connect(bot *Client) error {
 	}
 
 	for _, handler := range bot.Handlers.Hello {
-		go handler(hello)
+		call(newDispatch(bot, s, FlagGatewayEventNameHello, 0, nil), handler, hello)
 	}
 
 	// begin sending heartbeat payloads every heartbeat_interval ms.
@@ -257,7 +295,7 @@ func (s *Session) connect(bot *Client) error {
 
 	// spawn the manager goroutine.
 	s.manager.routines.Add(1)
-	go s.manage()
+	go s.manage(bot)
 
 	// ensure that the Session's goroutines are spawned.
 	s.manager.routines.Wait()
@@ -269,6 +307,8 @@ func (s *Session) connect(bot *Client) error {
 // then handles the incoming Ready or Resumed packet that indicates a successful connection.
 func (s *Session) initial(bot *Client, attempt int) error {
 	if !s.canReconnect() {
+		s.transition(SessionStatusIdentifying, "identifying", 0, nil)
+
 		// send an Opcode 2 Identify to the Discord Gateway.
 		identify := Identify{
 			Token: bot.Authentication.Token,
@@ -288,6 +328,8 @@ func (s *Session) initial(bot *Client, attempt int) error {
 			return err
 		}
 	} else {
+		s.transition(SessionStatusResuming, "resuming", 0, nil)
+
 		// send an Opcode 6 Resume to the Discord Gateway to reconnect the session.
 		resume := Resume{
 			Token:     bot.Authentication.Token,
@@ -322,86 +364,146 @@ func (s *Session) initial(bot *Client, attempt int) error {
 
 			// Configure the session.
 			s.ID = ready.SessionID
-			atomic.StoreInt64(&s.Seq, 0)
+			atomic.StoreInt64(&s.Seq, *payload.SequenceNumber)
 			s.Endpoint = ready.ResumeGatewayURL
 
 			// Store the session in the session manager.
 			s.client_manager.Gateway.Store(s.ID, s)
 
+			// Store the state of the session.
+			s.save()
+
 			if bot.Config.Gateway.ShardManager != nil {
 				bot.Config.Gateway.ShardManager.Ready(bot, s, ready)
 			}
 
+			s.transition(SessionStatusReady, "received Ready event", 0, nil)
+
+			d := newDispatch(bot, s, FlagGatewayEventNameReady, *payload.SequenceNumber, payload.Data)
+			bot.raw(d)
+
 			for _, handler := range bot.Handlers.Ready {
-				go handler(ready)
+				call(d, handler, ready)
 			}
 
 		// When a reconnection is successful, the Discord Gateway will respond
 		// by replaying all missed events in order, finalized by a Resumed event.
 		case *payload.EventName == FlagGatewayEventNameResumed:
-			LogSession(Logger.Info(), s.ID).Msg("received Resumed event")
-
-			// Store the session in the session manager.
-			s.client_manager.Gateway.Store(s.ID, s)
-
-			for _, handler := range bot.Handlers.Resumed {
-				go handler(&Resumed{})
-			}
+			s.resumed(bot, payload)
 
 		// When a reconnection is successful, the Discord Gateway will respond
 		// by replaying all missed events in order, finalized by a Resumed event.
 		default:
-			// handle the initial payload(s) until a Resumed event is encountered.
-			go bot.handle(*payload.EventName, payload.Data)
+			// handle the replayed events (in order) until a Resumed event is encountered.
+			var replays []*dispatch
 
 			for {
-				replayed := new(GatewayPayload)
-				if err := socket.Read(s.Context, s.Conn, replayed); err != nil {
-					return fmt.Errorf("error replaying events: %w", err)
-				}
+				switch payload.Op {
+				case FlagGatewayOpcodeDispatch:
+					if *payload.EventName == FlagGatewayEventNameResumed {
+						bot.replay(replays)
+						s.resumed(bot, payload)
+
+						return nil
+					}
 
-				if replayed.Op == FlagGatewayOpcodeDispatch && *replayed.EventName == FlagGatewayEventNameResumed {
-					LogSession(Logger.Info(), s.ID).Msg("received Resumed event")
+					event := newDispatch(bot, s, *payload.EventName, *payload.SequenceNumber, payload.Data)
+					event.replayed = true
 
-					// Store the session in the session manager.
-					s.client_manager.Gateway.Store(s.ID, s)
+					if s.sequence(event) {
+						replays = append(replays, event)
+					}
 
-					for _, handler := range bot.Handlers.Resumed {
-						go handler(&Resumed{})
+				// the heartbeat goroutines are blocked while events are replayed,
+				// so an Opcode 1 Heartbeat is responded to immediately.
+				case FlagGatewayOpcodeHeartbeat:
+					heartbeat := Heartbeat{Data: atomic.LoadInt64(&s.Seq)}
+					if err := heartbeat.SendEvent(bot, s); err != nil {
+						return fmt.Errorf("error responding to heartbeat while replaying events: %w", err)
 					}
 
+					s.heartbeat.sent = time.Now()
+
+				case FlagGatewayOpcodeHeartbeatACK:
+					s.heartbeat.ack()
+
+				// the session reconnects (once it's connected) after handling the events that were replayed.
+				case FlagGatewayOpcodeReconnect:
+					bot.replay(replays)
+					s.reconnect("reconnecting session due to Opcode 7 Reconnect while replaying events")
+
 					return nil
+
+				// the session is invalidated before it resumes, so it must identify again.
+				case FlagGatewayOpcodeInvalidSession:
+					bot.replay(replays)
+
+					return s.invalidated(bot, attempt)
 				}
 
-				go bot.handle(*payload.EventName, payload.Data)
+				payload = new(GatewayPayload)
+				if err := socket.Read(s.Context, s.Conn, payload); err != nil {
+					return fmt.Errorf("error replaying events: %w", err)
+				}
 			}
 		}
 
 	// When the maximum concurrency limit has been reached while connecting, or when
 	// the session does NOT reconnect in time, the Discord Gateway send an Opcode 9 Invalid Session.
 	case FlagGatewayOpcodeInvalidSession:
-		// Remove the session from the session manager.
-		s.client_manager.Gateway.Store(s.ID, nil)
+		return s.invalidated(bot, attempt)
 
-		if attempt < 1 {
-			// wait for Discord to close the session, then complete a fresh connect.
-			<-time.NewTimer(invalidSessionWaitTime).C
+	default:
+		return fmt.Errorf("session %q received payload %d during connection which is unexpected", s.ID, payload.Op)
+	}
 
-			s.ID = ""
-			atomic.StoreInt64(&s.Seq, 0)
-			if err := s.initial(bot, attempt+1); err != nil {
-				return err
-			}
+	return nil
+}
+
+// invalidated handles an Opcode 9 Invalid Session that is received while connecting.
+func (s *Session) invalidated(bot *Client, attempt int) error {
+	// Remove the session from the session manager.
+	s.client_manager.Gateway.Store(s.ID, nil)
+
+	// Remove the state of the invalidated session.
+	s.forget()
 
-			return nil
+	if attempt < 1 {
+		// wait for Discord to close the session, then complete a fresh connect.
+		<-time.NewTimer(invalidSessionWaitTime).C
+
+		s.ID = ""
+		atomic.StoreInt64(&s.Seq, 0)
+		if err := s.initial(bot, attempt+1); err != nil {
+			return err
 		}
 
-		return fmt.Errorf("session %q couldn't connect to the Discord Gateway or has invalidated an active session", s.ID)
-	default:
-		return fmt.Errorf("session %q received payload %d during connection which is unexpected", s.ID, payload.Op)
+		return nil
 	}
 
-	return nil
+	return fmt.Errorf("session %q couldn't connect to the Discord Gateway or has invalidated an active session", s.ID)
+}
+
+// resumed handles the Resumed event that indicates a successful reconnection.
+func (s *Session) resumed(bot *Client, payload *GatewayPayload) {
+	LogSession(Logger.Info(), s.ID).Msg("received Resumed event")
+
+	d := newDispatch(bot, s, FlagGatewayEventNameResumed, *payload.SequenceNumber, payload.Data)
+	s.sequence(d)
+
+	// Store the session in the session manager.
+	s.client_manager.Gateway.Store(s.ID, s)
+
+	// Store the state of the session.
+	s.save()
+
+	s.transition(SessionStatusReady, "received Resumed event", 0, nil)
+
+	bot.raw(d)
+
+	for _, handler := range bot.Handlers.Resumed {
+		call(d, handler, &Resumed{})
+	}
 }
 
 // Disconnect disconnects a session from the Discord Gateway using the given status code.
@@ -435,6 +537,12 @@ func (s *Session) Disconnect() error {
 		return err
 	}
 
+	// Remove the state of the session, which is invalidated by a normal closure.
+	s.forget()
+
+	s.transition(SessionStatusDisconnected, "disconnected", FlagClientCloseEventCodeNormal, nil)
+	s.notify()
+
 	putSession(s)
 
 	LogSession(Logger.Info(), id).Msgf("disconnected session with code %d", FlagClientCloseEventCodeNormal)
@@ -442,6 +550,55 @@ func (s *Session) Disconnect() error {
 	return nil
 }
 
+// Suspend disconnects a session from the Discord Gateway without invalidating the session,
+// then returns the state that is required to resume the session (i.e., from another process).
+//
+// In contrast to Disconnect(), Suspend() closes the connection with a non-1000 status code,
+// so Discord keeps the session resumable.
+func (s *Session) Suspend() (*SessionState, error) {
+	s.Lock()
+
+	if !s.isConnected() {
+		s.Unlock()
+
+		return nil, fmt.Errorf("session %q is already disconnected", s.ID)
+	}
+
+	id := s.ID
+	LogSession(Logger.Info(), id).Msgf("suspending session with code %d", FlagClientCloseEventCodeReconnect)
+
+	s.manager.signal = context.WithValue(s.manager.signal, keySignal, signalDisconnect)
+
+	if err := s.disconnect(FlagClientCloseEventCodeReconnect); err != nil {
+		s.Unlock()
+
+		return nil, ErrorDisconnect{
+			Connection: ErrConnectionSession,
+			Action:     nil,
+			Err:        err,
+		}
+	}
+
+	s.Unlock()
+
+	if err := <-s.manager.err; err != nil {
+		return nil, err
+	}
+
+	s.RLock()
+	state := s.State()
+	s.RUnlock()
+
+	s.transition(SessionStatusDisconnected, "suspended", FlagClientCloseEventCodeReconnect, nil)
+	s.notify()
+
+	putSession(s)
+
+	LogSession(Logger.Info(), id).Msgf("suspended session with code %d", FlagClientCloseEventCodeReconnect)
+
+	return state, nil
+}
+
 // disconnect disconnects a session from a WebSocket Connection using the given status code.
 func (s *Session) disconnect(code int) error {
 	// cancel the context to kill the goroutines of the Session.
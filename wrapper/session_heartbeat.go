@@ -19,10 +19,88 @@ type heartbeat struct {
 	// send represents a channel of heartbeats that will be sent to the Discord Gateway.
 	send chan Heartbeat
 
+	// sent represents the time that the last Heartbeat was sent (which is zero once it's acknowledged).
+	sent time.Time
+
+	// latencies represents a rolling window of the latency between each Heartbeat and its HeartbeatACK.
+	latencies []time.Duration
+
+	// next represents the index of the next latency in the rolling window.
+	next int
+
 	// acks represents the amount of times a HeartbeatACK was received since the last Heartbeat.
 	acks uint32
 }
 
+const (
+	// heartbeatLatencyWindow represents the amount of latencies used to calculate a Session's latency.
+	heartbeatLatencyWindow = 5
+
+	// heartbeatLatencySamples represents the minimum amount of latencies required to
+	// determine whether a Session's latency is degraded.
+	heartbeatLatencySamples = 3
+)
+
+// ack acknowledges the last Heartbeat sent to the Discord Gateway
+// and records its latency.
+func (hb *heartbeat) ack() {
+	atomic.AddUint32(&hb.acks, 1)
+
+	if hb.sent.IsZero() {
+		return
+	}
+
+	latency := time.Since(hb.sent)
+	hb.sent = time.Time{}
+
+	if len(hb.latencies) < heartbeatLatencyWindow {
+		hb.latencies = append(hb.latencies, latency)
+
+		return
+	}
+
+	hb.latencies[hb.next] = latency
+	hb.next = (hb.next + 1) % heartbeatLatencyWindow
+}
+
+// latency returns the average latency of the heartbeat's rolling window.
+func (hb *heartbeat) latency() time.Duration {
+	if len(hb.latencies) == 0 {
+		return 0
+	}
+
+	var total time.Duration
+	for _, latency := range hb.latencies {
+		total += latency
+	}
+
+	return total / time.Duration(len(hb.latencies))
+}
+
+// degraded determines whether the latency of the heartbeat exceeds the given threshold.
+//
+// A heartbeat is never degraded when the threshold is 0.
+func (hb *heartbeat) degraded(threshold time.Duration) bool {
+	return threshold != 0 &&
+		len(hb.latencies) >= heartbeatLatencySamples &&
+		hb.latency() > threshold
+}
+
+// Latency returns the average round-trip latency between the last Heartbeats
+// that a Session sent to the Discord Gateway and their HeartbeatACKs.
+//
+// Latency returns 0 when the Session has not received a HeartbeatACK.
+func (s *Session) Latency() time.Duration {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.heartbeat == nil {
+		return 0
+	}
+
+	return s.heartbeat.latency()
+}
+
 // Monitor returns the current amount of HeartbeatACKs for a Session's heartbeat.
 func (s *Session) Monitor() uint32 {
 	s.Lock()
@@ -86,6 +164,9 @@ func (s *Session) beat(bot *Client) error {
 				return err
 			}
 
+			// measure the latency of the Heartbeat using its HeartbeatACK.
+			s.heartbeat.sent = time.Now()
+
 			// reset the ticker (and empty existing ticks).
 			s.heartbeat.ticker.Reset(s.heartbeat.interval)
 			for len(s.heartbeat.ticker.C) > 0 {
@@ -97,8 +178,14 @@ func (s *Session) beat(bot *Client) error {
 
 			LogSession(Logger.Info(), s.ID).Msg("sent heartbeat")
 
+			// store the state of the session (with the latest sequence number)
+			// without holding the lock during the SessionStore's I/O.
+			state := s.snapshot()
+
 			s.Unlock()
 
+			s.persist(state)
+
 		case <-s.Context.Done():
 			return nil
 		}
@@ -48,8 +48,10 @@ func (s *Session) onPayload(bot *Client, payload GatewayPayload) error {
 	switch payload.Op {
 	// run the bot's event handlers.
 	case FlagGatewayOpcodeDispatch:
-		atomic.StoreInt64(&s.Seq, *payload.SequenceNumber)
-		go bot.handle(*payload.EventName, payload.Data)
+		event := newDispatch(bot, s, *payload.EventName, *payload.SequenceNumber, payload.Data)
+		if s.sequence(event) {
+			bot.dispatch(event)
+		}
 
 	// send an Opcode 1 Heartbeat to the Discord Gateway.
 	case FlagGatewayOpcodeHeartbeat:
@@ -68,9 +70,17 @@ func (s *Session) onPayload(bot *Client, payload GatewayPayload) error {
 	// handle the successful acknowledgement of the client's last heartbeat.
 	case FlagGatewayOpcodeHeartbeatACK:
 		s.Lock()
-		atomic.AddUint32(&s.heartbeat.acks, 1)
+		s.heartbeat.ack()
+		degraded := s.heartbeat.degraded(bot.Config.Gateway.HeartbeatLatencyThreshold)
 		s.Unlock()
 
+		// a connection with degraded latency is reconnected before it becomes a zombie connection.
+		if degraded {
+			s.reconnect("attempting to reconnect session due to degraded heartbeat latency")
+
+			return nil
+		}
+
 	// occurs when the Discord Gateway is shutting down the connection, while signalling the client to reconnect.
 	case FlagGatewayOpcodeReconnect:
 		s.reconnect("reconnecting session due to Opcode 7 Reconnect")
@@ -86,6 +96,7 @@ func (s *Session) onPayload(bot *Client, payload GatewayPayload) error {
 		<-time.NewTimer(invalidSessionWaitTime).C
 
 		s.Lock()
+		defer s.notify()
 		defer s.Unlock()
 
 		if err := s.initial(bot, 0); err != nil {
@@ -95,3 +106,31 @@ func (s *Session) onPayload(bot *Client, payload GatewayPayload) error {
 
 	return nil
 }
+
+// sequence updates the Session's sequence number using the sequence number of a dispatched event,
+// then returns whether the event should be dispatched.
+//
+// An event with an unexpected sequence number (i.e., a gap or duplicate) is reported,
+// while a duplicate event (with a sequence number that was already received) is NOT dispatched.
+func (s *Session) sequence(d *dispatch) bool {
+	last := atomic.LoadInt64(&s.Seq)
+	if last != 0 && d.seq != last+1 {
+		err := ErrorSequence{
+			SessionID: s.ID,
+			Event:     d.eventname,
+			Expected:  last + 1,
+			Received:  d.seq,
+		}
+
+		LogSession(Logger.Error(), s.ID).Err(err).Msg("")
+		d.bot.report(err, d.context())
+
+		if d.seq <= last {
+			return false
+		}
+	}
+
+	atomic.StoreInt64(&s.Seq, d.seq)
+
+	return true
+}
@@ -110,10 +110,12 @@ func (s *Session) logClose(routine string) {
 func (s *Session) reconnect(reason string) {
 	s.manager.Go(func() error {
 		s.Lock()
+		defer s.notify()
 		defer s.logClose("reconnect")
 		defer s.Unlock()
 
 		LogSession(Logger.Info(), s.ID).Msg(reason)
+		s.transition(SessionStatusReconnecting, reason, FlagClientCloseEventCodeReconnect, nil)
 
 		s.manager.signal = context.WithValue(s.manager.signal, keySignal, signalReconnect)
 		if err := s.disconnect(FlagClientCloseEventCodeReconnect); err != nil {
@@ -125,7 +127,7 @@ func (s *Session) reconnect(reason string) {
 }
 
 // manage manages a Session's goroutines.
-func (s *Session) manage() {
+func (s *Session) manage(bot *Client) {
 	s.manager.routines.Done()
 	defer func() {
 		s.Lock()
@@ -136,6 +138,7 @@ func (s *Session) manage() {
 	// wait until all of a Session's goroutines are closed.
 	err := s.manager.Wait()
 	s.Lock()
+	defer s.notify()
 	defer s.Unlock()
 
 	// log the reason for disconnection (if applicable).
@@ -171,30 +174,38 @@ func (s *Session) manage() {
 		switch {
 		// when an error occurs from a purposeful disconnection.
 		case errors.As(err, disconnectErr):
-			s.manager.err <- err
 
 		// when an error occurs from a WebSocket Close Error.
 		case errors.As(err, closeErr):
-			s.manager.err <- s.handleGatewayCloseError(closeErr)
+			err = s.handleGatewayCloseError(closeErr)
 
 		default:
 			if cErr := s.Conn.Close(websocket.StatusCode(FlagClientCloseEventCodeAway), ""); cErr != nil {
-				s.manager.err <- ErrorDisconnect{
+				err = ErrorDisconnect{
 					Action:     err,
 					Err:        cErr,
 					Connection: ErrConnectionSession,
 				}
+			}
+		}
 
-				return
+		// report the error that caused the disconnection.
+		if err != nil {
+			err = s.fail(err)
+
+			// a disconnection is NOT caused by an event, so the context has no event or payload.
+			ctx := &ErrorContext{
+				Shard:     s.Shard,
+				SessionID: s.ID,
+				Event:     "",
+				Payload:   nil,
 			}
 
-			s.manager.err <- err
+			go bot.report(err, ctx)
 		}
-
-		return
 	}
 
-	s.manager.err <- nil
+	s.manager.err <- err
 }
 
 // handleGatewayCloseError handles a WebSocket CloseError.
@@ -214,7 +225,11 @@ func (s *Session) handleGatewayCloseError(closeErr *websocket.CloseError) error
 			return nil
 		}
 
-		return closeErr
+		return ErrorGatewayClose{
+			Err:       *closeErr,
+			Code:      code,
+			SessionID: s.ID,
+		}
 
 	// Gateway Close Event Code is unknown.
 	default:
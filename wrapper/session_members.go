@@ -0,0 +1,126 @@
+package wrapper
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// GuildMembers represents the guild members that are sent in response to a RequestGuildMembers event.
+type GuildMembers struct {
+	// GuildID represents the ID of the guild.
+	GuildID string
+
+	// Nonce represents the nonce that correlates the GuildMembersChunk events to the request.
+	Nonce string
+
+	// Members represents the guild members of every GuildMembersChunk (in order of chunk_index).
+	Members []*GuildMember
+
+	// Presences represents the presences of the guild members (when presences are requested).
+	Presences []*PresenceUpdate
+
+	// NotFound represents the requested user IDs that were NOT found.
+	NotFound []string
+}
+
+const (
+	// nonceLength represents the amount of random bytes used to generate a nonce.
+	//
+	// A nonce sent in a RequestGuildMembers event can be up to 32 bytes (hex encoded).
+	nonceLength = 16
+)
+
+// Request sends an Opcode 8 RequestGuildMembers event to the Discord Gateway
+// using the bot's session for the guild, then returns the guild members
+// from every GuildMembersChunk event that Discord sends in response.
+//
+// A nonce is generated when the RequestGuildMembers event has no nonce (without modifying the event).
+// The request is cancelled (with the context's error) when the context is done.
+func (c *RequestGuildMembers) Request(ctx context.Context, bot *Client) (*GuildMembers, error) {
+	session, err := bot.GuildSession(c.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("request guild members: %w", err)
+	}
+
+	// the nonce is generated for a copy of the request, such that the given request is NOT modified.
+	request := *c
+	if request.Nonce == nil {
+		nonce := make([]byte, nonceLength)
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, fmt.Errorf("request guild members: nonce: %w", err)
+		}
+
+		request.Nonce = Pointer(hex.EncodeToString(nonce))
+	}
+
+	var mu sync.Mutex
+
+	nonce := *request.Nonce
+	received := make(chan struct{})
+
+	var chunks []*GuildMembersChunk
+	var count int
+
+	// add the event handler prior to sending the request, such that no chunk is missed.
+	sub := Listen(bot, func(chunk *GuildMembersChunk) {
+		if chunk.Nonce == nil || *chunk.Nonce != nonce {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if chunks == nil {
+			// a chunk without a chunk count is the only chunk.
+			if chunk.ChunkCount <= 0 {
+				chunks = []*GuildMembersChunk{chunk}
+				close(received)
+
+				return
+			}
+
+			chunks = make([]*GuildMembersChunk, chunk.ChunkCount)
+		}
+
+		if chunk.ChunkIndex < 0 || chunk.ChunkIndex >= len(chunks) || chunks[chunk.ChunkIndex] != nil {
+			return
+		}
+
+		chunks[chunk.ChunkIndex] = chunk
+
+		if count++; count == len(chunks) {
+			close(received)
+		}
+	})
+
+	defer sub.Cancel()
+
+	if err := request.SendEvent(bot, session); err != nil {
+		return nil, fmt.Errorf("request guild members: %w", err)
+	}
+
+	select {
+	case <-received:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("request guild members: %w", ctx.Err())
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	members := &GuildMembers{ //nolint:exhaustruct
+		GuildID: c.GuildID,
+		Nonce:   nonce,
+	}
+
+	for _, chunk := range chunks {
+		members.Members = append(members.Members, chunk.Members...)
+		members.Presences = append(members.Presences, chunk.Presences...)
+		members.NotFound = append(members.NotFound, chunk.NotFound...)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,206 @@
+package wrapper
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/switchupcb/websocket"
+)
+
+// Session Statuses
+const (
+	// SessionStatusDisconnected indicates that the Session is NOT connected to the Discord Gateway.
+	SessionStatusDisconnected = 0
+
+	// SessionStatusConnecting indicates that the Session is connecting to the Discord Gateway.
+	SessionStatusConnecting = 1
+
+	// SessionStatusIdentifying indicates that the Session sent an Identify and awaits a Ready event.
+	SessionStatusIdentifying = 2
+
+	// SessionStatusResuming indicates that the Session sent a Resume and awaits a Resumed event.
+	SessionStatusResuming = 3
+
+	// SessionStatusReady indicates that the Session is connected and receiving events.
+	SessionStatusReady = 4
+
+	// SessionStatusReconnecting indicates that the Session is disconnecting in order to reconnect.
+	SessionStatusReconnecting = 5
+
+	// SessionStatusFailed indicates that the Session was disconnected with an error
+	// that does NOT allow the Session to reconnect (i.e., an invalid token or intents).
+	SessionStatusFailed = 6
+)
+
+// SessionStatuses represents a map of Session Statuses to their names.
+var SessionStatuses = map[int]string{
+	SessionStatusDisconnected: "disconnected",
+	SessionStatusConnecting:   "connecting",
+	SessionStatusIdentifying:  "identifying",
+	SessionStatusResuming:     "resuming",
+	SessionStatusReady:        "ready",
+	SessionStatusReconnecting: "reconnecting",
+	SessionStatusFailed:       "failed",
+}
+
+// SessionTransition represents a transition of a Session from one status to another.
+type SessionTransition struct {
+	// Err represents the error that caused the transition (if applicable).
+	//
+	// Err is an ErrorGatewayClose when the Session transitions to SessionStatusFailed
+	// due to a Gateway Close Event Code.
+	Err error
+
+	// Reason represents the reason for the transition.
+	Reason string
+
+	// From represents the status of the Session before the transition.
+	From int
+
+	// To represents the status of the Session after the transition.
+	To int
+
+	// Code represents the WebSocket close code of the connection (if applicable).
+	Code int
+
+	// Attempt represents the amount of times the Session attempted to connect
+	// since it was last ready.
+	Attempt int
+}
+
+// Status returns the status of the Session (i.e., SessionStatusReady).
+func (s *Session) Status() int {
+	return int(atomic.LoadInt32(&s.status))
+}
+
+// connecting determines whether the Session is connecting to the Discord Gateway.
+func (s *Session) connecting() bool {
+	switch s.Status() {
+	case SessionStatusConnecting, SessionStatusIdentifying, SessionStatusResuming:
+		return true
+	}
+
+	return false
+}
+
+// transitions represents the transitions of a Session that are queued for its status handler.
+type transitions struct {
+	// queue represents the calls to the status handler that are NOT delivered yet.
+	queue []func()
+
+	// Mutex is used to protect the queue from data races.
+	sync.Mutex
+
+	// delivering indicates whether a goroutine is delivering the queue.
+	delivering bool
+}
+
+// transition transitions the Session to the given status, then queues a call to its status handler (if applicable).
+//
+// The status handler is called by notify() once the Session is unlocked.
+func (s *Session) transition(status int, reason string, code int, err error) {
+	from := atomic.SwapInt32(&s.status, int32(status))
+
+	switch status {
+	case SessionStatusConnecting:
+		atomic.AddInt32(&s.attempts, 1)
+
+	case SessionStatusReady:
+		defer atomic.StoreInt32(&s.attempts, 0)
+	}
+
+	LogSession(Logger.Info(), s.ID).Msgf("session is %s: %s", SessionStatuses[status], reason)
+
+	if s.statusHandler == nil {
+		return
+	}
+
+	handler := s.statusHandler
+	transition := &SessionTransition{
+		Err:     err,
+		Reason:  reason,
+		From:    int(from),
+		To:      status,
+		Code:    code,
+		Attempt: int(atomic.LoadInt32(&s.attempts)),
+	}
+
+	s.transitions.Lock()
+	s.transitions.queue = append(s.transitions.queue, func() { handler(s, transition) })
+	s.transitions.Unlock()
+}
+
+// notify calls the Session's status handler with its queued transitions (in order).
+//
+// notify is called without holding the Session's lock, such that a status handler can use the Session.
+// When another goroutine is delivering transitions, it delivers the queued transitions instead.
+func (s *Session) notify() {
+	s.transitions.Lock()
+	defer s.transitions.Unlock()
+
+	if s.transitions.delivering {
+		return
+	}
+
+	s.transitions.delivering = true
+	defer func() { s.transitions.delivering = false }()
+
+	for len(s.transitions.queue) != 0 {
+		queue := s.transitions.queue
+		s.transitions.queue = nil
+
+		s.transitions.Unlock()
+
+		for _, call := range queue {
+			call()
+		}
+
+		s.transitions.Lock()
+	}
+}
+
+// fail transitions the Session to SessionStatusDisconnected or SessionStatusFailed
+// (when the error does NOT allow the Session to reconnect) using the given error,
+// then returns the error.
+func (s *Session) fail(err error) error {
+	code := 0
+	status := SessionStatusDisconnected
+
+	// a Session error contains the error that occurred during the session.
+	cause := err
+	if sessionErr := new(ErrorSession); errors.As(err, sessionErr) {
+		cause = sessionErr.Err
+	}
+
+	// a Disconnect error contains the error that prompted the disconnection (i.e., a close frame).
+	if disconnectErr := new(ErrorDisconnect); errors.As(cause, disconnectErr) && disconnectErr.Action != nil {
+		cause = disconnectErr.Action
+	}
+
+	gatewayCloseErr := new(ErrorGatewayClose)
+	closeErr := new(websocket.CloseError)
+	switch {
+	case errors.As(cause, gatewayCloseErr):
+		code = gatewayCloseErr.Code.Code
+		status = SessionStatusFailed
+
+	case errors.As(cause, closeErr):
+		code = int(closeErr.Code)
+
+		// surface a Gateway Close Event Code that doesn't allow reconnection as a terminal error.
+		if closeCode, ok := GatewayCloseEventCodes[code]; ok && !closeCode.Reconnect {
+			err = ErrorGatewayClose{
+				Err:       err,
+				Code:      closeCode,
+				SessionID: s.ID,
+			}
+
+			status = SessionStatusFailed
+		}
+	}
+
+	s.transition(status, err.Error(), code, err)
+
+	return err
+}
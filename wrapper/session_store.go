@@ -0,0 +1,297 @@
+package wrapper
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	json "github.com/goccy/go-json"
+)
+
+// SessionState represents a serializable snapshot of the state required to resume a Session.
+//
+// https://discord.com/developers/docs/topics/gateway#resuming
+type SessionState struct {
+	// Shard represents the [shard_id, num_shards] for the Session.
+	Shard *[2]int `json:"shard,omitempty"`
+
+	// ID represents the session ID of the Session.
+	ID string `json:"id"`
+
+	// Endpoint represents the endpoint that is used to reconnect to the Gateway.
+	Endpoint string `json:"endpoint"`
+
+	// Seq represents the last sequence number received by the client.
+	Seq int64 `json:"seq"`
+}
+
+// canResume determines whether the state contains the fields required to resume a Session.
+func (state *SessionState) canResume() bool {
+	return state != nil && state.ID != "" && state.Endpoint != "" && state.Seq != 0
+}
+
+// SessionStore represents an interface for the persistence of Session State.
+//
+// SessionStore allows a bot to resume its sessions across process restarts,
+// instead of identifying a new session (which uses the Identify Rate Limit).
+type SessionStore interface {
+	// Save saves the state of a Session.
+	Save(state *SessionState) error
+
+	// Load loads the state of the Session with the given shard.
+	//
+	// Load returns nil (without an error) when no state is stored.
+	Load(shard *[2]int) (*SessionState, error)
+
+	// Delete deletes the state of the Session with the given shard.
+	Delete(shard *[2]int) error
+}
+
+// State returns a snapshot of the Session's current state.
+func (s *Session) State() *SessionState {
+	state := &SessionState{
+		Shard:    nil,
+		ID:       s.ID,
+		Endpoint: s.Endpoint,
+		Seq:      atomic.LoadInt64(&s.Seq),
+	}
+
+	if s.Shard != nil {
+		shard := *s.Shard
+		state.Shard = &shard
+	}
+
+	return state
+}
+
+// Restore sets the Session's state to the given state.
+//
+// Restore must be called prior to Connect() in order to resume a session.
+func (s *Session) Restore(state *SessionState) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.restore(state)
+}
+
+// restore sets the Session's state to the given state.
+func (s *Session) restore(state *SessionState) {
+	s.ID = state.ID
+	atomic.StoreInt64(&s.Seq, state.Seq)
+	s.Endpoint = state.Endpoint
+
+	if state.Shard != nil {
+		shard := *state.Shard
+		s.Shard = &shard
+	}
+}
+
+// load restores the Session's state from its SessionStore (if applicable).
+func (s *Session) load() {
+	if s.store == nil || s.canReconnect() {
+		return
+	}
+
+	state, err := s.store.Load(s.Shard)
+	if err != nil {
+		LogSession(Logger.Error(), s.ID).Err(fmt.Errorf("sessionstore: load: %w", err)).Msg("")
+
+		return
+	}
+
+	if !state.canResume() {
+		return
+	}
+
+	s.restore(state)
+
+	LogSession(Logger.Info(), s.ID).Msg("restored session state")
+}
+
+// snapshot returns the state of the Session that is saved to its SessionStore
+// (or nil when the state is NOT saved).
+func (s *Session) snapshot() *SessionState {
+	if s.store == nil || !s.canReconnect() {
+		return nil
+	}
+
+	return s.State()
+}
+
+// save saves the Session's state to its SessionStore (if applicable).
+func (s *Session) save() {
+	s.persist(s.snapshot())
+}
+
+// persist saves a snapshot of the Session's state to its SessionStore (if applicable).
+//
+// persist does NOT require the Session to be locked, such that the SessionStore's I/O
+// does NOT block the Session.
+func (s *Session) persist(state *SessionState) {
+	if state == nil {
+		return
+	}
+
+	if err := s.store.Save(state); err != nil {
+		LogSession(Logger.Error(), state.ID).Err(fmt.Errorf("sessionstore: save: %w", err)).Msg("")
+	}
+}
+
+// forget deletes the Session's state from its SessionStore (if applicable).
+func (s *Session) forget() {
+	if s.store == nil {
+		return
+	}
+
+	if err := s.store.Delete(s.Shard); err != nil {
+		LogSession(Logger.Error(), s.ID).Err(fmt.Errorf("sessionstore: delete: %w", err)).Msg("")
+	}
+}
+
+// sessionStateKey returns the key used to store the state of a Session with the given shard.
+func sessionStateKey(shard *[2]int) string {
+	if shard == nil {
+		return "0"
+	}
+
+	return strconv.Itoa(shard[0]) + "/" + strconv.Itoa(shard[1])
+}
+
+const (
+	// filemodeSessionStore represents the file mode of a FileSessionStore file.
+	filemodeSessionStore = 0o600
+)
+
+// FileSessionStore represents a SessionStore which stores Session State in a JSON file.
+type FileSessionStore struct {
+	// Path represents the path of the file.
+	Path string
+
+	// mu protects the file from concurrent reads and writes.
+	mu sync.Mutex
+}
+
+// NewFileSessionStore creates a new FileSessionStore using the file at the given path.
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{ //nolint:exhaustruct
+		Path: path,
+	}
+}
+
+// Save saves the state of a Session to the file.
+func (fs *FileSessionStore) Save(state *SessionState) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	states, err := fs.read()
+	if err != nil {
+		return err
+	}
+
+	states[sessionStateKey(state.Shard)] = state
+
+	return fs.write(states)
+}
+
+// Load loads the state of the Session with the given shard from the file.
+func (fs *FileSessionStore) Load(shard *[2]int) (*SessionState, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	states, err := fs.read()
+	if err != nil {
+		return nil, err
+	}
+
+	return states[sessionStateKey(shard)], nil
+}
+
+// Delete deletes the state of the Session with the given shard from the file.
+func (fs *FileSessionStore) Delete(shard *[2]int) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	states, err := fs.read()
+	if err != nil {
+		return err
+	}
+
+	key := sessionStateKey(shard)
+	if _, ok := states[key]; !ok {
+		return nil
+	}
+
+	delete(states, key)
+
+	return fs.write(states)
+}
+
+// read reads the Session States from the file.
+func (fs *FileSessionStore) read() (map[string]*SessionState, error) {
+	states := make(map[string]*SessionState)
+
+	data, err := os.ReadFile(fs.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return states, nil
+		}
+
+		return nil, fmt.Errorf("error reading session store file: %w", err)
+	}
+
+	if len(data) == 0 {
+		return states, nil
+	}
+
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, fmt.Errorf(errUnmarshal, states, err)
+	}
+
+	return states, nil
+}
+
+// write writes the Session States to the file.
+//
+// The file is replaced atomically to prevent a partial write from corrupting the stored state.
+func (fs *FileSessionStore) write(states map[string]*SessionState) error {
+	data, err := json.Marshal(states)
+	if err != nil {
+		return fmt.Errorf("error marshalling session states: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fs.Path), filepath.Base(fs.Path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating session store file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("error writing session store file: %w", err)
+	}
+
+	if err := tmp.Chmod(filemodeSessionStore); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("error writing session store file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("error writing session store file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fs.Path); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("error replacing session store file: %w", err)
+	}
+
+	return nil
+}
@@ -23,6 +23,10 @@ type ShardManager interface {
 	// GetSessions gets the connected sessions of the bot (in order of connection).
 	GetSessions() []*Session
 
+	// Latency returns the average heartbeat latency of the shard manager's sessions
+	// (that have measured their latency).
+	Latency() time.Duration
+
 	// Ready is called when a Session receives a ready event.
 	//
 	// Called from the session.go initial() function (at L#304 in /wrapper/session.go).
@@ -0,0 +1,95 @@
+package wrapper
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GuildShard returns the shard_id of the shard that receives the events of the given guild
+// when a bot uses the given num_shards.
+//
+// https://discord.com/developers/docs/topics/gateway#sharding-sharding-formula
+func GuildShard(guildID string, shards int) (int, error) {
+	id, err := ParseSnowflake(guildID)
+	if err != nil {
+		return 0, fmt.Errorf("guild ID: %w", err)
+	}
+
+	return id.Shard(shards), nil
+}
+
+// GuildSession returns the bot's connected session that receives the events of the given guild.
+//
+// The sessions of the bot's ShardManager are used when it's set.
+// An ErrorShard is returned when the shard of the guild is NOT connected in this process,
+// while an ErrorNoSession is returned when the bot has no connected session.
+func (bot *Client) GuildSession(guildID string) (*Session, error) {
+	var sessions []*Session
+	if bot.Config.Gateway.ShardManager != nil {
+		sessions = bot.Config.Gateway.ShardManager.GetSessions()
+	} else {
+		bot.Sessions.Gateway.Range(func(_, value any) bool {
+			if s, ok := value.(*Session); ok && s != nil {
+				sessions = append(sessions, s)
+			}
+
+			return true
+		})
+	}
+
+	if len(sessions) == 0 {
+		return nil, ErrorNoSession{GuildID: guildID}
+	}
+
+	routeErr := ErrorShard{GuildID: guildID, Shard: 0, Shards: 0}
+
+	for _, s := range sessions {
+		s.RLock()
+		shard := s.Shard
+		s.RUnlock()
+
+		// a session without a shard receives the events of every guild.
+		if shard == nil {
+			return s, nil
+		}
+
+		id, err := GuildShard(guildID, shard[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if id == shard[0] {
+			return s, nil
+		}
+
+		routeErr.Shard, routeErr.Shards = id, shard[1]
+	}
+
+	return nil, routeErr
+}
+
+// SendEventGuild sends an Opcode 4 UpdateVoiceState event to the Discord Gateway
+// using the bot's session for the guild.
+func (c *VoiceStateUpdate) SendEventGuild(bot *Client) error {
+	if c.VoiceState == nil || c.GuildID == nil {
+		return errors.New("voice state update: guild ID is required to route the send event")
+	}
+
+	session, err := bot.GuildSession(*c.GuildID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendEvent(bot, session)
+}
+
+// SendEventGuild sends an Opcode 8 RequestGuildMembers event to the Discord Gateway
+// using the bot's session for the guild.
+func (c *RequestGuildMembers) SendEventGuild(bot *Client) error {
+	session, err := bot.GuildSession(c.GuildID)
+	if err != nil {
+		return err
+	}
+
+	return c.SendEvent(bot, session)
+}
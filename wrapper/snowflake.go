@@ -0,0 +1,158 @@
+package wrapper
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Snowflake represents a Discord API Snowflake, which is a unique ID that contains the time it was created at.
+//
+// https://discord.com/developers/docs/reference#snowflakes
+type Snowflake uint64
+
+// Snowflake Structure
+// https://discord.com/developers/docs/reference#snowflakes-snowflake-id-format-structure-left-to-right
+const (
+	// DiscordEpoch represents the first millisecond of 2015 (in Unix milliseconds),
+	// which is the epoch of a Snowflake's timestamp.
+	DiscordEpoch = 1420070400000
+
+	snowflakeTimestampShift = 22
+	snowflakeWorkerShift    = 17
+	snowflakeProcessShift   = 12
+	snowflakeWorkerMask     = 0x3E0000
+	snowflakeProcessMask    = 0x1F000
+	snowflakeIncrementMask  = 0xFFF
+)
+
+// ParseSnowflake parses a Snowflake from a string.
+func ParseSnowflake(id string) (Snowflake, error) {
+	s, err := strconv.ParseUint(id, base10, bit64)
+	if err != nil {
+		return 0, fmt.Errorf("snowflake %q: %w", id, err)
+	}
+
+	return Snowflake(s), nil
+}
+
+// SnowflakeFromTime returns the first Snowflake that can be created at the given time,
+// which is used to paginate by time (i.e., using the before or after parameter of a request).
+func SnowflakeFromTime(t time.Time) Snowflake {
+	ms := t.UnixMilli() - DiscordEpoch
+	if ms < 0 {
+		return 0
+	}
+
+	return Snowflake(ms) << snowflakeTimestampShift
+}
+
+// String returns the string representation of a Snowflake.
+func (s Snowflake) String() string {
+	return strconv.FormatUint(uint64(s), base10)
+}
+
+// Timestamp returns the time a Snowflake was created at (in Unix milliseconds).
+func (s Snowflake) Timestamp() int64 {
+	return int64(s>>snowflakeTimestampShift) + DiscordEpoch
+}
+
+// Time returns the time a Snowflake was created at.
+func (s Snowflake) Time() time.Time {
+	return time.UnixMilli(s.Timestamp())
+}
+
+// WorkerID returns the internal worker ID of a Snowflake.
+func (s Snowflake) WorkerID() uint8 {
+	return uint8((s & snowflakeWorkerMask) >> snowflakeWorkerShift)
+}
+
+// ProcessID returns the internal process ID of a Snowflake.
+func (s Snowflake) ProcessID() uint8 {
+	return uint8((s & snowflakeProcessMask) >> snowflakeProcessShift)
+}
+
+// Increment returns the increment of a Snowflake, which is incremented
+// for every ID that is generated on its process.
+func (s Snowflake) Increment() uint16 {
+	return uint16(s & snowflakeIncrementMask)
+}
+
+// Compare returns -1 when a Snowflake is created before another, 1 when it's created after,
+// and 0 when both Snowflakes are equal.
+func (s Snowflake) Compare(other Snowflake) int {
+	switch {
+	case s < other:
+		return -1
+	case s > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Shard returns the shard_id of a guild (with the Snowflake as its ID) using the given number of shards.
+//
+// https://discord.com/developers/docs/topics/gateway#sharding-sharding-formula
+func (s Snowflake) Shard(shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+
+	return int((s >> snowflakeTimestampShift) % Snowflake(shards))
+}
+
+// CompareSnowflakes compares the Snowflakes of two IDs (see Snowflake.Compare).
+func CompareSnowflakes(a, b string) (int, error) {
+	x, err := ParseSnowflake(a)
+	if err != nil {
+		return 0, err
+	}
+
+	y, err := ParseSnowflake(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return x.Compare(y), nil
+}
+
+// MarshalJSON marshals a Snowflake into a JSON string.
+//
+// Use IntegerSnowflake to marshal a Snowflake into a JSON integer.
+func (s Snowflake) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + s.String() + `"`), nil
+}
+
+// UnmarshalJSON unmarshals a Snowflake from a JSON string or integer.
+func (s *Snowflake) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	id, err := ParseSnowflake(string(bytes.Trim(b, `"`)))
+	if err != nil {
+		return fmt.Errorf(errUnmarshal, s, err)
+	}
+
+	*s = id
+
+	return nil
+}
+
+// IntegerSnowflake represents a Snowflake that is marshalled into a JSON integer (instead of a JSON string),
+// which is used when a JSON integer is expected.
+//
+// A JSON integer loses the precision of a Snowflake when it's parsed as a 64-bit float (i.e., in JavaScript).
+type IntegerSnowflake Snowflake
+
+// MarshalJSON marshals an IntegerSnowflake into a JSON integer.
+func (s IntegerSnowflake) MarshalJSON() ([]byte, error) {
+	return []byte(Snowflake(s).String()), nil
+}
+
+// UnmarshalJSON unmarshals an IntegerSnowflake from a JSON string or integer.
+func (s *IntegerSnowflake) UnmarshalJSON(b []byte) error {
+	return (*Snowflake)(s).UnmarshalJSON(b)
+}
@@ -0,0 +1,270 @@
+package wrapper
+
+import (
+	"sync/atomic"
+
+	json "github.com/goccy/go-json"
+)
+
+// event represents the type of an event that an event handler handles.
+type event[T any] interface {
+	*T
+
+	// eventname returns the name of the event.
+	eventname() string
+}
+
+// Subscription represents an event handler that is added to a bot using On, Once, or Listen.
+type Subscription struct {
+	// bot represents the bot that the event handler is added to.
+	bot *Client
+
+	// listener represents the function that passes an event to the event handler
+	// before the event is dispatched (when the Subscription is added using Once or Listen).
+	listener func(d *dispatch)
+
+	// event represents the name of the event that the event handler handles.
+	event string
+
+	// cancelled indicates whether the Subscription is cancelled (when cancelled == 1).
+	cancelled uint32
+}
+
+// On adds an event handler for an event to the bot, then returns the Subscription
+// that is used to remove the event handler.
+//
+// In contrast to Handle, the event is determined by the event handler's type at compile time.
+//
+//	sub := disgo.On(bot, func(m *disgo.MessageCreate) { ... })
+func On[T any, E event[T]](bot *Client, handler func(*T)) *Subscription {
+	var e E
+
+	sub := &Subscription{
+		bot:       bot,
+		listener:  nil,
+		event:     e.eventname(),
+		cancelled: 0,
+	}
+
+	sub.add(func(event *T) {
+		if atomic.LoadUint32(&sub.cancelled) == 1 {
+			return
+		}
+
+		handler(event)
+	})
+
+	return sub
+}
+
+// Once adds an event handler for an event to the bot that is removed once it's called,
+// then returns the Subscription that is used to remove the event handler beforehand.
+//
+// The event handler is called on its own goroutine as soon as the event is received
+// (before the event is dispatched), such that an event handler can wait for it in every Dispatch Mode.
+func Once[T any, E event[T]](bot *Client, handler func(*T)) *Subscription {
+	var e E
+
+	sub := &Subscription{
+		bot:       bot,
+		listener:  nil,
+		event:     e.eventname(),
+		cancelled: 0,
+	}
+
+	sub.listener = func(d *dispatch) {
+		event, ok := decode[T](d)
+		if !ok {
+			return
+		}
+
+		// ensure that the event handler is only called once
+		// when multiple events are received concurrently.
+		if !atomic.CompareAndSwapUint32(&sub.cancelled, 0, 1) {
+			return
+		}
+
+		sub.remove()
+
+		go invoke(d, handler, event)
+	}
+
+	sub.listen()
+
+	return sub
+}
+
+// Listen adds an event handler for an event to the bot that is called as soon as the event is received
+// (before the event is dispatched), then returns the Subscription that is used to remove the event handler.
+//
+// In contrast to On, the event handler is NOT queued behind other event handlers by a Dispatcher,
+// such that an event handler can wait for the events it receives (i.e., Await) in every Dispatch Mode.
+// So, the event handler is called on the goroutine of the Session that receives the event
+// (in the order that events are received) and must NOT block.
+//
+//	sub := disgo.Listen(bot, func(m *disgo.MessageCreate) { received <- m })
+func Listen[T any, E event[T]](bot *Client, handler func(*T)) *Subscription {
+	var e E
+
+	sub := &Subscription{
+		bot:       bot,
+		listener:  nil,
+		event:     e.eventname(),
+		cancelled: 0,
+	}
+
+	sub.listener = func(d *dispatch) {
+		if atomic.LoadUint32(&sub.cancelled) == 1 {
+			return
+		}
+
+		event, ok := decode[T](d)
+		if !ok {
+			return
+		}
+
+		invoke(d, handler, event)
+	}
+
+	sub.listen()
+
+	return sub
+}
+
+// Event returns the name of the event that the Subscription's event handler handles.
+func (sub *Subscription) Event() string {
+	return sub.event
+}
+
+// Cancel removes the Subscription's event handler from the bot.
+//
+// The event handler is NOT called once Cancel returns, even when an event is being dispatched.
+func (sub *Subscription) Cancel() {
+	if !atomic.CompareAndSwapUint32(&sub.cancelled, 0, 1) {
+		return
+	}
+
+	sub.remove()
+}
+
+// add adds the Subscription's event handler to the bot.
+func (sub *Subscription) add(function interface{}) {
+	sub.bot.Handlers.mu.Lock()
+	defer sub.bot.Handlers.mu.Unlock()
+
+	// the event handler is always added since its type is determined by its event.
+	sub.bot.add(sub.event, function, sub) //nolint:errcheck
+}
+
+// listen adds the Subscription's listener to the bot.
+func (sub *Subscription) listen() {
+	sub.bot.Handlers.mu.Lock()
+	defer sub.bot.Handlers.mu.Unlock()
+
+	sub.bot.intend(sub.event)
+
+	if sub.bot.Handlers.listeners == nil {
+		sub.bot.Handlers.listeners = make(map[string][]*Subscription)
+	}
+
+	sub.bot.Handlers.listeners[sub.event] = append(sub.bot.Handlers.listeners[sub.event], sub)
+
+	LogEventHandler(Logger.Info(), sub.bot.ApplicationID, sub.event).Msg("added event listener")
+}
+
+// remove removes the Subscription's event handler from the bot.
+func (sub *Subscription) remove() {
+	sub.bot.Handlers.mu.Lock()
+	defer sub.bot.Handlers.mu.Unlock()
+
+	if sub.listener != nil {
+		listeners := sub.bot.Handlers.listeners[sub.event]
+		for index, listener := range listeners {
+			if listener == sub {
+				// the listeners are copied, such that the listeners that are being called are NOT modified.
+				sub.bot.Handlers.listeners[sub.event] = append(listeners[:index:index], listeners[index+1:]...)
+
+				LogEventHandler(Logger.Info(), sub.bot.ApplicationID, sub.event).Msg("removed event listener")
+
+				return
+			}
+		}
+
+		return
+	}
+
+	for index, subscription := range sub.bot.Handlers.subscriptions[sub.event] {
+		if subscription == sub {
+			sub.bot.remove(sub.event, index) //nolint:errcheck
+
+			return
+		}
+	}
+}
+
+// track tracks the Subscription of the event handler at the given index (if applicable).
+//
+// The subscriptions of an event are stored in the same order as its event handlers,
+// such that a Subscription's event handler can be removed while other event handlers are added or removed.
+func (h *Handlers) track(eventname string, index int, sub *Subscription) {
+	if sub == nil {
+		return
+	}
+
+	if h.subscriptions == nil {
+		h.subscriptions = make(map[string][]*Subscription)
+	}
+
+	subscriptions := h.subscriptions[eventname]
+	for len(subscriptions) <= index {
+		subscriptions = append(subscriptions, nil)
+	}
+
+	subscriptions[index] = sub
+	h.subscriptions[eventname] = subscriptions
+}
+
+// untrack stops tracking the Subscription of the event handler at the given index (if applicable).
+func (h *Handlers) untrack(eventname string, index int) {
+	subscriptions := h.subscriptions[eventname]
+	if index < 0 || len(subscriptions) <= index {
+		return
+	}
+
+	h.subscriptions[eventname] = append(subscriptions[:index], subscriptions[index+1:]...)
+}
+
+// listen passes an event to the listeners of the event (see Listen) before the event is dispatched.
+func (bot *Client) listen(d *dispatch) {
+	bot.Handlers.mu.RLock()
+	listeners := bot.Handlers.listeners[d.eventname]
+	raw := bot.Handlers.listeners[FlagGatewayEventNameRaw]
+	bot.Handlers.mu.RUnlock()
+
+	for _, sub := range listeners {
+		sub.listener(d)
+	}
+
+	for _, sub := range raw {
+		sub.listener(d)
+	}
+}
+
+// decode decodes the event of a dispatch for a listener.
+func decode[T any](d *dispatch) (*T, bool) {
+	event := new(T)
+
+	if raw, ok := any(event).(*RawEvent); ok {
+		*raw = *d.raw()
+
+		return event, true
+	}
+
+	if err := json.Unmarshal(d.data, event); err != nil {
+		d.error(ErrorEvent{ClientID: d.bot.ApplicationID, Event: d.eventname, Err: err, Action: ErrorEventActionUnmarshal})
+
+		return nil, false
+	}
+
+	return event, true
+}
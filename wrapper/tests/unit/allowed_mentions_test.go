@@ -0,0 +1,205 @@
+package unit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/valyala/fasthttp"
+)
+
+// TestAllowedMentions tests the default allowed mentions of message requests.
+func TestAllowedMentions(t *testing.T) {
+	var body []byte
+
+	// capture the body of every request without sending it.
+	bot := &Client{ApplicationID: "1", Authentication: BotToken("token"), Config: DefaultConfig()} //nolint:exhaustruct
+	bot.Config.Request.Client.ConfigureClient = func(hc *fasthttp.HostClient) error {
+		hc.Transport = func(request *fasthttp.Request, response *fasthttp.Response) error {
+			body = append([]byte(nil), request.Body()...)
+
+			// the Date header is parsed from a raw response (since it can NOT be set).
+			raw := "HTTP/1.1 204 No Content\r\nDate: " + time.Now().UTC().Format(time.RFC1123) + "\r\n\r\n"
+
+			return response.Read(bufio.NewReader(strings.NewReader(raw))) //nolint:wrapcheck
+		}
+
+		return nil
+	}
+
+	override := &AllowedMentions{Parse: []*string{Pointer(FlagAllowedMentionTypeEveryone)}} //nolint:exhaustruct
+	data := &Messages{Content: Pointer("Hello!")}                                           //nolint:exhaustruct
+
+	tests := []struct {
+		name  string
+		send  func() error
+		unset func() bool
+		parse []string
+	}{
+		{
+			name:  "CreateMessage",
+			send:  func() error { _, err := (&CreateMessage{ChannelID: "1"}).Send(bot); return err }, //nolint:exhaustruct
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name: "CreateMessage (override)",
+			send: func() error {
+				_, err := (&CreateMessage{ChannelID: "1", AllowedMentions: override}).Send(bot) //nolint:exhaustruct
+				return err
+			},
+			parse: []string{FlagAllowedMentionTypeEveryone},
+		},
+		{
+			name:  "EditMessage",
+			send:  func() error { _, err := (&EditMessage{ChannelID: "1", MessageID: "2"}).Send(bot); return err }, //nolint:exhaustruct
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name: "StartThreadinForumChannel",
+			send: func() error {
+				_, err := (&StartThreadinForumChannel{ChannelID: "1", Message: &ForumThreadMessageParams{}}).Send(bot) //nolint:exhaustruct
+				return err
+			},
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name:  "ExecuteWebhook",
+			send:  func() error { return (&ExecuteWebhook{WebhookID: "1", WebhookToken: "token"}).Send(bot) }, //nolint:exhaustruct
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name: "EditWebhookMessage",
+			send: func() error {
+				_, err := (&EditWebhookMessage{WebhookID: "1", WebhookToken: "token", MessageID: "2"}).Send(bot) //nolint:exhaustruct
+				return err
+			},
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name: "CreateInteractionResponse",
+			send: func() error {
+				return (&CreateInteractionResponse{
+					InteractionID:    "1",
+					InteractionToken: "token",
+					InteractionResponse: &InteractionResponse{
+						Type: FlagInteractionCallbackTypeCHANNEL_MESSAGE_WITH_SOURCE,
+						Data: data,
+					},
+				}).Send(bot)
+			},
+			unset: func() bool { return data.AllowedMentions == nil },
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name: "EditOriginalInteractionResponse",
+			send: func() error {
+				_, err := (&EditOriginalInteractionResponse{ApplicationID: "1", InteractionToken: "token"}).Send(bot) //nolint:exhaustruct
+				return err
+			},
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name: "CreateFollowupMessage",
+			send: func() error {
+				_, err := (&CreateFollowupMessage{ApplicationID: "1", InteractionToken: "token"}).Send(bot) //nolint:exhaustruct
+				return err
+			},
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+		{
+			name: "EditFollowupMessage",
+			send: func() error {
+				_, err := (&EditFollowupMessage{ApplicationID: "1", InteractionToken: "token", MessageID: "2"}).Send(bot) //nolint:exhaustruct
+				return err
+			},
+			parse: []string{FlagAllowedMentionTypeUsers},
+		},
+	}
+
+	for _, test := range tests {
+		body = nil
+		if err := test.send(); err != nil {
+			t.Fatalf("(%v): unexpected error: %v", test.name, err)
+		}
+
+		var sent struct {
+			AllowedMentions *struct {
+				Parse []string `json:"parse"`
+			} `json:"allowed_mentions"`
+			Message *struct {
+				AllowedMentions *struct {
+					Parse []string `json:"parse"`
+				} `json:"allowed_mentions"`
+			} `json:"message"`
+			Data *struct {
+				AllowedMentions *struct {
+					Parse []string `json:"parse"`
+				} `json:"allowed_mentions"`
+			} `json:"data"`
+		}
+
+		if err := json.Unmarshal(body, &sent); err != nil {
+			t.Fatalf("(%v): unexpected error: %v (%s)", test.name, err, body)
+		}
+
+		mentions := sent.AllowedMentions
+		switch {
+		case sent.Message != nil:
+			mentions = sent.Message.AllowedMentions
+		case sent.Data != nil:
+			mentions = sent.Data.AllowedMentions
+		}
+
+		if mentions == nil {
+			t.Fatalf("(%v): expected allowed_mentions in %s", test.name, body)
+		}
+
+		if !reflect.DeepEqual(mentions.Parse, test.parse) {
+			t.Errorf("(%v): expected parse %v but got %v", test.name, test.parse, mentions.Parse)
+		}
+
+		if test.unset != nil && !test.unset() {
+			t.Errorf("(%v): the request was modified", test.name)
+		}
+	}
+
+	// requests are sent without allowed mentions when the client has none.
+	bot.Config.Request.AllowedMentions = nil
+
+	if _, err := (&CreateMessage{ChannelID: "1"}).Send(bot); err != nil { //nolint:exhaustruct
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(body, []byte("{}")) {
+		t.Errorf("expected a request without allowed_mentions but got %s", body)
+	}
+}
+
+// TestDefaultAllowedMentions tests that the default allowed mentions are accepted by Discord,
+// which rejects allowed mentions that parse users (or roles) while specifying users (or roles).
+func TestDefaultAllowedMentions(t *testing.T) {
+	data, err := json.Marshal(DefaultAllowedMentions())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var mentions map[string]json.RawMessage
+	if err := json.Unmarshal(data, &mentions); err != nil {
+		t.Fatalf("unexpected error: %v (%s)", err, data)
+	}
+
+	var parse []string
+	if err := json.Unmarshal(mentions["parse"], &parse); err != nil {
+		t.Fatalf("unexpected error: %v (%s)", err, data)
+	}
+
+	for _, mention := range parse {
+		if _, ok := mentions[mention]; ok {
+			t.Errorf("expected allowed mentions that parse %q without a %q key but got %s", mention, mention, data)
+		}
+	}
+}
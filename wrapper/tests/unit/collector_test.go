@@ -0,0 +1,163 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// TestCollect tests the Await and Collect functions for collecting events.
+func TestCollect(t *testing.T) {
+	bot := &Client{
+		Config:   DefaultConfig(),
+		Handlers: new(Handlers),
+	}
+
+	// dispatch the events in order.
+	bot.Dispatcher = NewDispatcher(DispatchModeOrdered, 0, 0)
+	defer bot.Dispatcher.Close()
+
+	// ready is closed once a collector handles a "probe" event,
+	// which indicates that its event handler is added to the bot.
+	var (
+		mu    sync.Mutex
+		ready chan struct{}
+	)
+
+	// dispatch events with the given IDs to the bot's TypingStart event handlers (once a collector is ready).
+	dispatch := func(ids ...string) {
+		mu.Lock()
+		ready = make(chan struct{})
+		probed := ready
+		mu.Unlock()
+
+		for {
+			if err := bot.Dispatch(FlagGatewayEventNameTypingStart, json.RawMessage(`{"channel_id":"probe"}`)); err != nil {
+				t.Errorf("(%v): got %v, wanted %v", "Dispatch", err, nil)
+			}
+
+			select {
+			case <-probed:
+				for _, id := range ids {
+					if err := bot.Dispatch(FlagGatewayEventNameTypingStart, json.RawMessage(`{"channel_id":"`+id+`"}`)); err != nil {
+						t.Errorf("(%v): got %v, wanted %v", "Dispatch", err, nil)
+					}
+				}
+
+				return
+			case <-time.After(time.Millisecond):
+			}
+		}
+	}
+
+	var (
+		once  sync.Once
+		calls int32
+	)
+
+	matches := func(event *TypingStart) bool {
+		atomic.AddInt32(&calls, 1)
+
+		if event.ChannelID == "probe" {
+			mu.Lock()
+			once.Do(func() { close(ready) })
+			mu.Unlock()
+
+			return false
+		}
+
+		return event.ChannelID != "ignored"
+	}
+
+	// reset waits until every dispatched event is handled, then prepares the predicate for the next collector.
+	reset := func(dispatched <-chan struct{}) {
+		<-dispatched
+
+		for bot.Dispatcher.Metrics().Queued != 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		once = sync.Once{}
+	}
+
+	// removed checks that the event handler of a collector is removed,
+	// since the listeners of an event are called before Dispatch returns.
+	removed := func(name string) {
+		before := atomic.LoadInt32(&calls)
+
+		if err := bot.Dispatch(FlagGatewayEventNameTypingStart, json.RawMessage(`{"channel_id":"1"}`)); err != nil {
+			t.Fatalf("(%v): got %v, wanted %v", name, err, nil)
+		}
+
+		if after := atomic.LoadInt32(&calls); after != before {
+			t.Fatalf("(%v): got %d predicate calls, wanted %d predicate calls", name, after-before, 0)
+		}
+	}
+
+	// start dispatches events on a separate goroutine, then returns a channel that is closed once they are dispatched.
+	start := func(ids ...string) <-chan struct{} {
+		dispatched := make(chan struct{})
+
+		go func() {
+			dispatch(ids...)
+			close(dispatched)
+		}()
+
+		return dispatched
+	}
+
+	// await an event that matches the predicate.
+	dispatched := start("ignored", "1", "2")
+
+	event, err := Await(context.Background(), bot, matches)
+	if err != nil || event.ChannelID != "1" {
+		t.Fatalf("(%v): got %v, %v, wanted %v, %v", "Await", event, err, "1", nil)
+	}
+
+	reset(dispatched)
+	removed("Await")
+
+	// collect N events that match the predicate.
+	dispatched = start("1", "ignored", "2", "3")
+
+	events, err := Collect(context.Background(), bot, 2, matches)
+	if err != nil || len(events) != 2 || events[0].ChannelID != "1" || events[1].ChannelID != "2" {
+		t.Fatalf("(%v): got %d events with error %v, wanted %d events with error %v", "Collect", len(events), err, 2, nil)
+	}
+
+	reset(dispatched)
+
+	// collect events until a timeout.
+	dispatched = start("1", "2", "3")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	events, err = Collect(ctx, bot, 0, matches)
+	if err != nil || len(events) != 3 {
+		t.Fatalf("(%v): got %d events with error %v, wanted %d events with error %v", "CollectTimeout", len(events), err, 3, nil)
+	}
+
+	reset(dispatched)
+
+	// collect events until a cancellation.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Collect(ctx, bot, 0, matches); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "CollectCancel", err, nil)
+	}
+
+	// cancel an await.
+	if _, err := Await(ctx, bot, matches); !errors.Is(err, context.Canceled) {
+		t.Fatalf("(%v): got %v, wanted %v", "AwaitCancel", err, context.Canceled)
+	}
+
+	removed("AwaitCancel")
+}
@@ -0,0 +1,267 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// dispatchMessages dispatches n MessageCreate events (with the guild of each event determined by guild).
+func dispatchMessages(bot *Client, n int, guild func(i int) string) error {
+	for i := 0; i < n; i++ {
+		data := `{"id":"` + strconv.Itoa(i) + `","channel_id":"1","guild_id":"` + guild(i) + `","content":"` + strconv.Itoa(i) + `"}`
+		if err := bot.Dispatch(FlagGatewayEventNameMessageCreate, json.RawMessage(data)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TestDispatcherOrdered tests that DispatchModeOrdered handles events in the order they are received.
+func TestDispatcherOrdered(t *testing.T) {
+	const events = 100
+
+	bot := &Client{Config: DefaultConfig(), Handlers: new(Handlers)} //nolint:exhaustruct
+	bot.Dispatcher = NewDispatcher(DispatchModeOrdered, 0, 0)
+
+	var handled []string
+	if err := bot.Handle(FlagGatewayEventNameMessageCreate, func(m *MessageCreate) {
+		handled = append(handled, m.Content)
+	}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := dispatchMessages(bot, events, func(int) string { return "1" }); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	bot.Dispatcher.Close()
+
+	if len(handled) != events {
+		t.Fatalf("expected %d handled events but got %d", events, len(handled))
+	}
+
+	for i, content := range handled {
+		if content != strconv.Itoa(i) {
+			t.Fatalf("expected event %d at index %d but got %s", i, i, content)
+		}
+	}
+
+	if metrics := bot.Dispatcher.Metrics(); metrics.Dispatched != events || metrics.Queued != 0 {
+		t.Errorf("unexpected metrics: %+v", metrics)
+	}
+}
+
+// TestDispatcherKeyed tests that DispatchModeKeyed handles events with the same key in order.
+func TestDispatcherKeyed(t *testing.T) {
+	const events, guilds = 300, 3
+
+	bot := &Client{Config: DefaultConfig(), Handlers: new(Handlers)} //nolint:exhaustruct
+	bot.Dispatcher = NewDispatcher(DispatchModeKeyed, 4, 16)
+
+	var mu sync.Mutex
+
+	handled := make(map[string][]int)
+	if err := bot.Handle(FlagGatewayEventNameMessageCreate, func(m *MessageCreate) {
+		i, _ := strconv.Atoi(m.Content)
+
+		mu.Lock()
+		handled[*m.GuildID] = append(handled[*m.GuildID], i)
+		mu.Unlock()
+	}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := dispatchMessages(bot, events, func(i int) string { return strconv.Itoa(i % guilds) }); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	bot.Dispatcher.Close()
+
+	for guild, order := range handled {
+		if len(order) != events/guilds {
+			t.Errorf("guild %s: expected %d handled events but got %d", guild, events/guilds, len(order))
+		}
+
+		for i := 1; i < len(order); i++ {
+			if order[i] < order[i-1] {
+				t.Fatalf("guild %s: event %d was handled before event %d", guild, order[i-1], order[i])
+			}
+		}
+	}
+
+	if metrics := bot.Dispatcher.Metrics(); metrics.Dispatched != events || metrics.Queued != 0 {
+		t.Errorf("unexpected metrics: %+v", metrics)
+	}
+}
+
+// TestDispatcherBackpressure tests that a full queue blocks the dispatch of an event until it has space.
+func TestDispatcherBackpressure(t *testing.T) {
+	bot := &Client{Config: DefaultConfig(), Handlers: new(Handlers)} //nolint:exhaustruct
+	bot.Dispatcher = NewDispatcher(DispatchModeOrdered, 0, 1)
+
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+
+	if err := bot.Handle(FlagGatewayEventNameMessageCreate, func(m *MessageCreate) {
+		started <- struct{}{}
+		<-release
+	}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// the first event is handled (and blocks the worker), while the second event fills the queue.
+	if err := dispatchMessages(bot, 1, func(int) string { return "1" }); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	<-started
+
+	if err := dispatchMessages(bot, 1, func(int) string { return "1" }); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// the third event blocks until the queue has space.
+	dispatched := make(chan struct{})
+	go func() {
+		if err := dispatchMessages(bot, 1, func(int) string { return "1" }); err != nil {
+			t.Errorf("%v", err)
+		}
+
+		close(dispatched)
+	}()
+
+	for deadline := time.Now().Add(time.Second); bot.Dispatcher.Metrics().Blocked == 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected a blocked dispatch")
+		}
+	}
+
+	select {
+	case <-dispatched:
+		t.Fatalf("expected the dispatch to block while the queue is full")
+	default:
+	}
+
+	close(release)
+	<-dispatched
+	bot.Dispatcher.Close()
+
+	metrics := bot.Dispatcher.Metrics()
+	if metrics.Blocked != 1 || metrics.BlockedTime <= 0 || metrics.Dispatched != 3 || metrics.Queued != 0 {
+		t.Errorf("unexpected metrics: %+v", metrics)
+	}
+
+	// events that are dispatched after the Dispatcher is closed are dropped.
+	var eventErr ErrorEvent
+	if err := dispatchMessages(bot, 1, func(int) string { return "1" }); !errors.As(err, &eventErr) || eventErr.Action != ErrorEventActionDispatch {
+		t.Errorf("expected ErrorEvent after Close but got %v", err)
+	}
+
+	bot.Dispatcher.Close()
+
+	if metrics := bot.Dispatcher.Metrics(); metrics.Dropped != 1 || metrics.Dispatched != 3 {
+		t.Errorf("unexpected metrics after Close: %+v", metrics)
+	}
+}
+
+// TestDispatcherAwait tests that an event handler can wait for another event in every Dispatch Mode.
+func TestDispatcherAwait(t *testing.T) {
+	tests := []struct {
+		dispatcher *Dispatcher
+		name       string
+	}{
+		{name: "Default", dispatcher: nil},
+		{name: "Concurrent", dispatcher: NewDispatcher(DispatchModeConcurrent, 0, 0)},
+		{name: "Ordered", dispatcher: NewDispatcher(DispatchModeOrdered, 0, 0)},
+		{name: "Keyed", dispatcher: NewDispatcher(DispatchModeKeyed, 1, 0)},
+	}
+
+	for _, test := range tests {
+		bot := &Client{Config: DefaultConfig(), Handlers: new(Handlers), Dispatcher: test.dispatcher} //nolint:exhaustruct
+
+		answered := make(chan string, 2)
+
+		// the event handler of the question waits for the answer.
+		Once(bot, func(m *MessageCreate) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			answer, err := Await(ctx, bot, func(m *MessageCreate) bool { return m.Content == "answer" })
+			if err != nil {
+				answered <- err.Error()
+
+				return
+			}
+
+			answered <- answer.Content
+		})
+
+		if err := bot.Handle(FlagGatewayEventNameMessageCreate, func(m *MessageCreate) {
+			if m.Content != "question" {
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			answer, err := Await(ctx, bot, func(m *MessageCreate) bool { return m.Content == "answer" })
+			if err != nil {
+				answered <- err.Error()
+
+				return
+			}
+
+			answered <- answer.Content
+		}); err != nil {
+			t.Fatalf("(%v): %v", test.name, err)
+		}
+
+		if err := bot.Dispatch(FlagGatewayEventNameMessageCreate, json.RawMessage(`{"id":"1","channel_id":"1","guild_id":"1","content":"question"}`)); err != nil {
+			t.Fatalf("(%v): got %v, wanted %v", test.name, err, nil)
+		}
+
+		// answer until the event handler receives the answer, since it waits for the answer on another goroutine.
+		var answers []string
+
+	answer:
+		for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+			if err := bot.Dispatch(FlagGatewayEventNameMessageCreate, json.RawMessage(`{"id":"2","channel_id":"1","guild_id":"1","content":"answer"}`)); err != nil {
+				t.Fatalf("(%v): got %v, wanted %v", test.name, err, nil)
+			}
+
+			for len(answered) != 0 {
+				answers = append(answers, <-answered)
+			}
+
+			if len(answers) == 2 || time.Now().After(deadline) {
+				break answer
+			}
+		}
+
+		if test.dispatcher != nil {
+			test.dispatcher.Close()
+		}
+
+		if len(answers) != 2 || answers[0] != "answer" || answers[1] != "answer" {
+			t.Fatalf("(%v): got %v, wanted %v", test.name, answers, []string{"answer", "answer"})
+		}
+	}
+}
+
+// TestDispatchUnknown tests that an event which is unknown to Disgo is NOT dispatched.
+func TestDispatchUnknown(t *testing.T) {
+	bot := &Client{Config: DefaultConfig(), Handlers: new(Handlers)} //nolint:exhaustruct
+
+	var eventErr ErrorEvent
+	if err := bot.Dispatch("UNKNOWN_EVENT", json.RawMessage(`{}`)); !errors.As(err, &eventErr) || eventErr.Action != ErrorEventActionDispatch {
+		t.Fatalf("(%v): got %v, wanted %v", "Dispatch", err, "ErrorEvent")
+	}
+}
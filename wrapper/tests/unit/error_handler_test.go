@@ -0,0 +1,84 @@
+package unit_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// TestErrorHandler tests that panics and event errors are reported to the bot's ErrorHandler.
+func TestErrorHandler(t *testing.T) {
+	type report struct {
+		err error
+		ctx *ErrorContext
+	}
+
+	reports := make(chan report, 2)
+
+	bot := &Client{ApplicationID: "1", Config: DefaultConfig(), Handlers: new(Handlers)} //nolint:exhaustruct
+	bot.ErrorHandler = func(err error, ctx *ErrorContext) {
+		reports <- report{err: err, ctx: ctx}
+	}
+
+	handled := make(chan struct{}, 1)
+	if err := bot.Handle(FlagGatewayEventNameTypingStart, func(*TypingStart) {
+		panic("handler")
+	}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := bot.Handle(FlagGatewayEventNameTypingStart, func(*TypingStart) {
+		handled <- struct{}{}
+	}); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// a panic is recovered, such that the other event handlers are called.
+	payload := json.RawMessage(`{"channel_id":"1","user_id":"2"}`)
+	if err := bot.Dispatch(FlagGatewayEventNameTypingStart, payload); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Fatalf("expected the event handlers to be called after a panic")
+	}
+
+	r := <-reports
+
+	var panicErr ErrorPanic
+	if !errors.As(r.err, &panicErr) || panicErr.Value != "handler" || panicErr.Event != FlagGatewayEventNameTypingStart || len(panicErr.Stack) == 0 {
+		t.Fatalf("expected ErrorPanic but got %v", r.err)
+	}
+
+	if r.ctx.Event != FlagGatewayEventNameTypingStart || string(r.ctx.Payload) != string(payload) {
+		t.Errorf("unexpected ErrorContext: %+v", r.ctx)
+	}
+
+	// an event that can NOT be unmarshalled is reported (without calling the event handlers).
+	payload = json.RawMessage(`{"channel_id":1}`)
+	if err := bot.Dispatch(FlagGatewayEventNameTypingStart, payload); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	r = <-reports
+
+	var eventErr ErrorEvent
+	if !errors.As(r.err, &eventErr) || eventErr.Action != ErrorEventActionUnmarshal || eventErr.Event != FlagGatewayEventNameTypingStart {
+		t.Fatalf("expected ErrorEvent but got %v", r.err)
+	}
+
+	if r.ctx.Event != FlagGatewayEventNameTypingStart || string(r.ctx.Payload) != string(payload) {
+		t.Errorf("unexpected ErrorContext: %+v", r.ctx)
+	}
+
+	select {
+	case <-handled:
+		t.Errorf("expected the event handlers to NOT be called")
+	default:
+	}
+}
@@ -0,0 +1,131 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+	"github.com/switchupcb/websocket"
+)
+
+const (
+	// defaultGatewayInterval represents the default heartbeat interval of a test Discord Gateway.
+	defaultGatewayInterval = 45 * time.Second
+)
+
+// gatewayConn represents the connection between a test Discord Gateway and a session.
+type gatewayConn struct {
+	ctx  context.Context
+	conn *websocket.Conn
+}
+
+// write writes the given payloads to the session in order, then returns whether they were written.
+func (c *gatewayConn) write(payloads ...string) bool {
+	for _, payload := range payloads {
+		if err := c.conn.Write(c.ctx, websocket.MessageText, []byte(payload)); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// read reads the opcode and data of the next payload that the session sends.
+func (c *gatewayConn) read() (int, json.RawMessage, bool) {
+	_, data, err := c.conn.Read(c.ctx)
+	if err != nil {
+		return 0, nil, false
+	}
+
+	var payload struct {
+		Data json.RawMessage `json:"d"`
+		Op   int             `json:"op"`
+	}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return 0, nil, false
+	}
+
+	return payload.Op, payload.Data, true
+}
+
+// close closes the connection with the given close code.
+func (c *gatewayConn) close(code int) {
+	c.conn.Close(websocket.StatusCode(code), "test") //nolint:errcheck
+}
+
+// gatewayHandler handles a payload (with the given data) that a session sends to a test Discord Gateway,
+// then returns whether the connection remains open.
+type gatewayHandler func(c *gatewayConn, data json.RawMessage) bool
+
+// gatewayHandlers returns the default handlers of a test Discord Gateway,
+// which resume every session and acknowledge every Heartbeat.
+func gatewayHandlers() map[int]gatewayHandler {
+	return map[int]gatewayHandler{
+		FlagGatewayOpcodeResume: func(c *gatewayConn, _ json.RawMessage) bool {
+			return c.write(`{"op":0,"t":"RESUMED","s":6,"d":{}}`)
+		},
+		FlagGatewayOpcodeHeartbeat: func(c *gatewayConn, _ json.RawMessage) bool {
+			return c.write(`{"op":11}`)
+		},
+	}
+}
+
+// gateway returns the endpoint of a test Discord Gateway which sends a Hello with the given
+// heartbeat interval (or defaultGatewayInterval when it's 0), then handles each payload a session sends
+// using the handler of its opcode.
+//
+// The given handlers replace the default handlers (see gatewayHandlers),
+// while a payload without a handler (or with a nil handler) is ignored.
+func gateway(t *testing.T, interval time.Duration, handlers map[int]gatewayHandler) string {
+	t.Helper()
+
+	if interval == 0 {
+		interval = defaultGatewayInterval
+	}
+
+	ops := gatewayHandlers()
+	for op, handler := range handlers {
+		ops[op] = handler
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		defer conn.Close(websocket.StatusNormalClosure, "")
+
+		c := &gatewayConn{ctx: context.Background(), conn: conn}
+		if !c.write(`{"op":10,"d":{"heartbeat_interval":` + strconv.FormatInt(interval.Milliseconds(), 10) + `}}`) {
+			return
+		}
+
+		for {
+			op, data, ok := c.read()
+			if !ok {
+				return
+			}
+
+			handler := ops[op]
+			if handler == nil {
+				continue
+			}
+
+			if !handler(c, data) {
+				return
+			}
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
@@ -1,8 +1,10 @@
 package unit_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/switchupcb/disgo"
 )
@@ -78,3 +80,151 @@ func TestHandle(t *testing.T) {
 		)
 	}
 }
+
+// TestOn tests the On and Once functions for adding and removing event handlers using subscriptions.
+func TestOn(t *testing.T) {
+	bot := &Client{
+		Config:   DefaultConfig(),
+		Handlers: new(Handlers),
+	}
+
+	var called []string
+
+	if err := bot.Handle(FlagGatewayEventNameMessageCreate, func(*MessageCreate) { called = append(called, "first") }); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "AddMessageCreate", err, nil)
+	}
+
+	sub := On(bot, func(*MessageCreate) { called = append(called, "on") })
+	if sub.Event() != FlagGatewayEventNameMessageCreate || len(bot.Handlers.MessageCreate) != 2 {
+		t.Fatalf("(%v): got %v handlers for %q, wanted %v handlers for %q", "On",
+			len(bot.Handlers.MessageCreate), sub.Event(), 2, FlagGatewayEventNameMessageCreate,
+		)
+	}
+
+	// test for automatic intent calculation.
+	if bot.Config.Gateway.Intents != FlagIntentDIRECT_MESSAGES|FlagIntentGUILD_MESSAGES {
+		t.Fatalf("(automatic intent calculation): got %v, wanted %v", bot.Config.Gateway.Intents, FlagIntentDIRECT_MESSAGES|FlagIntentGUILD_MESSAGES)
+	}
+
+	if err := bot.Handle(FlagGatewayEventNameMessageCreate, func(*MessageCreate) { called = append(called, "last") }); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "AddMessageCreate", err, nil)
+	}
+
+	// remove an event handler that was added before the subscription.
+	if err := bot.Remove(FlagGatewayEventNameMessageCreate, 0); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "RemoveMessageCreate", err, nil)
+	}
+
+	// cancel the subscription, which must remove the subscription's event handler (at index 0).
+	handler := bot.Handlers.MessageCreate[0]
+	sub.Cancel()
+	sub.Cancel()
+
+	handler(nil)
+	for _, handler := range bot.Handlers.MessageCreate {
+		handler(nil)
+	}
+
+	if fmt.Sprint(called) != "[last]" {
+		t.Fatalf("(%v): got %v, wanted %v", "Cancel", called, "[last]")
+	}
+
+	// add an event handler that is removed once it's called (to a bot without other event handlers).
+	bot = &Client{
+		Config:   DefaultConfig(),
+		Handlers: new(Handlers),
+	}
+
+	once := make(chan string, 2)
+	Once(bot, func(m *MessageCreate) { once <- m.Content })
+
+	for _, content := range []string{"once", "twice"} {
+		if err := bot.Dispatch(FlagGatewayEventNameMessageCreate, json.RawMessage(`{"id":"1","channel_id":"1","content":"`+content+`"}`)); err != nil {
+			t.Fatalf("(%v): got %v, wanted %v", "Dispatch", err, nil)
+		}
+	}
+
+	if content := <-once; content != "once" {
+		t.Fatalf("(%v): got %v, wanted %v", "Once", content, "once")
+	}
+
+	select {
+	case content := <-once:
+		t.Fatalf("(%v): got %v, wanted %v", "Once", content, "no event")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if bot.Config.Gateway.Intents != FlagIntentDIRECT_MESSAGES|FlagIntentGUILD_MESSAGES {
+		t.Fatalf("(%v): got %v, wanted %v", "Once intents", bot.Config.Gateway.Intents, FlagIntentDIRECT_MESSAGES|FlagIntentGUILD_MESSAGES)
+	}
+}
+
+// TestOnCustom tests the OnCustom function for handling custom events using raw events.
+func TestOnCustom(t *testing.T) {
+	bot := &Client{
+		Config:   DefaultConfig(),
+		Handlers: new(Handlers),
+	}
+
+	type CustomEvent struct {
+		Value string `json:"value"`
+	}
+
+	var got []string
+
+	sub := OnCustom(bot, "CUSTOM_EVENT", nil, func(e *CustomEvent) { got = append(got, e.Value) })
+	if len(bot.Handlers.Raw) != 1 {
+		t.Fatalf("(%v): got %d raw handlers, wanted %d raw handlers", "OnCustom", len(bot.Handlers.Raw), 1)
+	}
+
+	// add a custom event handler with a decoder.
+	OnCustom(bot, "CUSTOM_EVENT", func(data json.RawMessage, e *CustomEvent) error {
+		e.Value = "decoded"
+		return nil
+	}, func(e *CustomEvent) { got = append(got, e.Value) })
+
+	for _, handler := range bot.Handlers.Raw {
+		handler(&RawEvent{Name: "UNKNOWN_EVENT", Data: json.RawMessage(`{"value":"unknown"}`)})
+		handler(&RawEvent{Name: "CUSTOM_EVENT", Data: json.RawMessage(`{"value":"custom"}`)})
+		handler(&RawEvent{Name: "CUSTOM_EVENT", Data: json.RawMessage(`{"value":}`)})
+	}
+
+	if fmt.Sprint(got) != "[custom decoded decoded]" {
+		t.Fatalf("(%v): got %v, wanted %v", "OnCustom", got, "[custom decoded decoded]")
+	}
+
+	sub.Cancel()
+
+	if len(bot.Handlers.Raw) != 1 {
+		t.Fatalf("(%v): got %d raw handlers, wanted %d raw handlers", "Cancel", len(bot.Handlers.Raw), 1)
+	}
+}
+
+// TestOnDispatch tests the OnDispatch function for handling events with their DispatchInfo.
+func TestOnDispatch(t *testing.T) {
+	bot := &Client{
+		Config:   DefaultConfig(),
+		Handlers: new(Handlers),
+	}
+
+	infos := make(chan DispatchInfo, 1)
+	OnDispatch(bot, func(m *MessageCreate, info *DispatchInfo) { infos <- *info })
+
+	// the intents of the event are added, although the event handler is a raw event handler.
+	if bot.Config.Gateway.Intents != FlagIntentDIRECT_MESSAGES|FlagIntentGUILD_MESSAGES {
+		t.Fatalf("(%v): got %v, wanted %v", "OnDispatch intents", bot.Config.Gateway.Intents, FlagIntentDIRECT_MESSAGES|FlagIntentGUILD_MESSAGES)
+	}
+
+	if err := bot.Dispatch(FlagGatewayEventNameMessageCreate, json.RawMessage(`{"id":"1","channel_id":"1"}`)); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Dispatch", err, nil)
+	}
+
+	select {
+	case info := <-infos:
+		if info.Replayed {
+			t.Fatalf("(%v): got %+v, wanted %v", "OnDispatch", info, "a live event")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("(%v): the event handler was NOT called", "OnDispatch")
+	}
+}
@@ -0,0 +1,104 @@
+package unit_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// TestInteractionServer tests the InteractionServer for verifying, acknowledging, and replying to interactions.
+func TestInteractionServer(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	bot := &Client{ //nolint:exhaustruct
+		Config:   DefaultConfig(),
+		Handlers: new(Handlers),
+	}
+
+	server, err := NewInteractionServer(bot, hex.EncodeToString(public))
+	if err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "NewInteractionServer", err, nil)
+	}
+
+	On(bot, func(i *InteractionCreate) {
+		if err := server.Reply(i.Interaction, &InteractionResponse{
+			Type: FlagInteractionCallbackTypeCHANNEL_MESSAGE_WITH_SOURCE,
+			Data: &Messages{Content: Pointer("pong")}, //nolint:exhaustruct
+		}); err != nil {
+			t.Errorf("(%v): got %v, wanted %v", "Reply", err, nil)
+		}
+	})
+
+	send := func(server *InteractionServer, body string, sign bool, timestamp time.Time) *httptest.ResponseRecorder {
+		signature := ed25519.Sign(private, []byte(strconv.FormatInt(timestamp.Unix(), 10)+body))
+		if !sign {
+			signature[0] ^= 1
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/interactions", bytes.NewBufferString(body))
+		r.Header.Set("X-Signature-Ed25519", hex.EncodeToString(signature))
+		r.Header.Set("X-Signature-Timestamp", strconv.FormatInt(timestamp.Unix(), 10))
+
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, r)
+
+		return w
+	}
+
+	// reject an invalid signature.
+	if w := send(server, `{"id":"1","type":1}`, false, time.Now()); w.Code != http.StatusUnauthorized {
+		t.Fatalf("(%v): got %v, wanted %v", "InvalidSignature", w.Code, http.StatusUnauthorized)
+	}
+
+	// reject a signature timestamp outside of the server's tolerance.
+	if w := send(server, `{"id":"1","type":1}`, true, time.Now().Add(-time.Minute)); w.Code != http.StatusUnauthorized {
+		t.Fatalf("(%v): got %v, wanted %v", "StaleTimestamp", w.Code, http.StatusUnauthorized)
+	}
+
+	if w := send(server, `{"id":"1","type":1}`, true, time.Now().Add(time.Minute)); w.Code != http.StatusUnauthorized {
+		t.Fatalf("(%v): got %v, wanted %v", "FutureTimestamp", w.Code, http.StatusUnauthorized)
+	}
+
+	// respond to a PING.
+	if w := send(server, `{"id":"1","type":1}`, true, time.Now()); w.Code != http.StatusOK || strings.TrimSpace(w.Body.String()) != `{"type":1}` {
+		t.Fatalf("(%v): got %v %v, wanted %v", "Ping", w.Code, w.Body.String(), `{"type":1}`)
+	}
+
+	// reply to an application command inline.
+	command := `{"id":"2","application_id":"app","type":2,"token":"token","data":{"id":"3","name":"ping","type":1}}`
+
+	w := send(server, command, true, time.Now())
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"content":"pong"`) {
+		t.Fatalf("(%v): got %v %v, wanted %v", "Reply", w.Code, w.Body.String(), `"content":"pong"`)
+	}
+
+	// defer an application command immediately when the bot has no interaction handler.
+	unhandled, err := NewInteractionServer(&Client{Config: DefaultConfig(), Handlers: new(Handlers)}, hex.EncodeToString(public)) //nolint:exhaustruct
+	if err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "NewInteractionServer", err, nil)
+	}
+
+	start := time.Now()
+	w = send(unhandled, command, true, start)
+
+	deferred := `{"type":` + strconv.Itoa(int(FlagInteractionCallbackTypeDEFERRED_CHANNEL_MESSAGE_WITH_SOURCE)) + `}`
+	if w.Code != http.StatusOK || strings.TrimSpace(w.Body.String()) != deferred {
+		t.Fatalf("(%v): got %v %v, wanted %v", "Unhandled", w.Code, w.Body.String(), deferred)
+	}
+
+	if elapsed := time.Since(start); elapsed >= unhandled.Timeout {
+		t.Fatalf("(%v): got %v, wanted %v", "Unhandled", elapsed, "an immediate response")
+	}
+}
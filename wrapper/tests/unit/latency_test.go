@@ -0,0 +1,167 @@
+package unit_test
+
+import (
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+const (
+	// latencyInterval represents the heartbeat interval of the latency test gateway.
+	latencyInterval = 120 * time.Millisecond
+
+	// slow represents the latency of a slow HeartbeatACK.
+	slow = 60 * time.Millisecond
+
+	// fast represents the latency of a fast HeartbeatACK.
+	fast = 0
+)
+
+// latencyGateway returns the endpoint of a test Discord Gateway which resumes every session,
+// then acknowledges each Heartbeat after the given delay (in order), along with the amount of sent HeartbeatACKs.
+func latencyGateway(t *testing.T, delays []time.Duration) (string, *int32) {
+	t.Helper()
+
+	acks := new(int32)
+	endpoint := gateway(t, latencyInterval, map[int]gatewayHandler{
+		FlagGatewayOpcodeHeartbeat: func(c *gatewayConn, _ json.RawMessage) bool {
+			n := int(atomic.LoadInt32(acks))
+			if n >= len(delays) {
+				return true
+			}
+
+			time.Sleep(delays[n])
+
+			if !c.write(`{"op":11}`) {
+				return false
+			}
+
+			atomic.AddInt32(acks, 1)
+
+			return true
+		},
+	})
+
+	return endpoint, acks
+}
+
+// repeat returns the given latency n times.
+func repeat(latency time.Duration, n int) []time.Duration {
+	latencies := make([]time.Duration, n)
+	for i := range latencies {
+		latencies[i] = latency
+	}
+
+	return latencies
+}
+
+// TestLatency tests the rolling window of a Session's heartbeat latency and its degraded threshold.
+func TestLatency(t *testing.T) {
+	tests := []struct {
+		name      string
+		delays    []time.Duration
+		threshold time.Duration
+		min       time.Duration
+		max       time.Duration
+		degraded  bool
+	}{
+		{
+			name:      "Below Samples",
+			delays:    repeat(slow, 2),
+			threshold: slow / 2,
+			min:       slow,
+			max:       slow + slow/2,
+			degraded:  false,
+		},
+		{
+			name:      "Degraded",
+			delays:    repeat(slow, 3),
+			threshold: slow / 2,
+			min:       slow,
+			max:       slow + slow/2,
+			degraded:  true,
+		},
+		{
+			name:      "No Threshold",
+			delays:    repeat(slow, 3),
+			threshold: 0,
+			min:       slow,
+			max:       slow + slow/2,
+			degraded:  false,
+		},
+		{
+			name:      "Rolling Window",
+			delays:    append(repeat(slow, 5), repeat(fast, 5)...),
+			threshold: 0,
+			min:       0,
+			max:       slow / 2,
+			degraded:  false,
+		},
+		{
+			name:      "Rolling Window Average",
+			delays:    append(repeat(slow, 5), repeat(fast, 2)...),
+			threshold: 0,
+			min:       slow * 3 / 5,
+			max:       slow,
+			degraded:  false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint, acks := latencyGateway(t, test.delays)
+
+			bot, transitions := statusClient()
+			bot.Config.Gateway.HeartbeatLatencyThreshold = test.threshold
+
+			s := NewSession()
+			s.Restore(&SessionState{Shard: nil, ID: "a", Endpoint: endpoint, Seq: 5})
+
+			if err := s.Connect(bot); err != nil {
+				t.Fatalf("connect: %v", err)
+			}
+
+			defer s.Disconnect() //nolint:errcheck
+
+			// wait for the last HeartbeatACK to be handled (prior to the next Heartbeat).
+			deadline := time.Now().Add(latencyInterval * time.Duration(len(test.delays)+5))
+			for atomic.LoadInt32(acks) != int32(len(test.delays)) {
+				if time.Now().After(deadline) {
+					t.Fatalf("timed out after %d of %d HeartbeatACKs", atomic.LoadInt32(acks), len(test.delays))
+				}
+
+				time.Sleep(time.Millisecond)
+			}
+
+			time.Sleep(latencyInterval / 6)
+
+			degraded := false
+			for _, transition := range transitions() {
+				if transition.To == SessionStatusReconnecting && strings.Contains(transition.Reason, "latency") {
+					degraded = true
+				}
+			}
+
+			if degraded != test.degraded {
+				t.Errorf("expected degraded %v but got %v", test.degraded, degraded)
+			}
+
+			// a degraded session is reconnected, so its latency is NOT measured.
+			if test.degraded {
+				return
+			}
+
+			if latency := s.Latency(); latency < test.min || latency > test.max {
+				t.Errorf("expected a latency between %v and %v but got %v", test.min, test.max, latency)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package unit_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// TestChannelPermissions tests the computation of a member's effective permissions.
+func TestChannelPermissions(t *testing.T) {
+	perms := func(flags BitFlag) string { return strconv.FormatUint(uint64(flags), 10) }
+
+	guild := &Guild{ //nolint:exhaustruct
+		ID:      "1",
+		OwnerID: "100",
+		Roles: []*Role{
+			{ID: "1", Permissions: perms(FlagBitwisePermissionVIEW_CHANNEL | FlagBitwisePermissionSEND_MESSAGES)}, //nolint:exhaustruct
+			{ID: "2", Permissions: perms(FlagBitwisePermissionKICK_MEMBERS | FlagBitwisePermissionEMBED_LINKS)},   //nolint:exhaustruct
+			{ID: "3", Permissions: perms(FlagBitwisePermissionADMINISTRATOR)},                                     //nolint:exhaustruct
+			nil, // a nil role is skipped.
+		},
+	}
+
+	member := &GuildMember{User: &User{ID: "200"}, Roles: []*string{Pointer("2")}} //nolint:exhaustruct
+
+	base, err := BasePermissions(guild, member)
+	if err != nil || base != FlagBitwisePermissionVIEW_CHANNEL|FlagBitwisePermissionSEND_MESSAGES|FlagBitwisePermissionKICK_MEMBERS|FlagBitwisePermissionEMBED_LINKS {
+		t.Fatalf("(%v): got (%v, %v)", "BasePermissions", PermissionNames(base), err)
+	}
+
+	channel := &Channel{ //nolint:exhaustruct
+		ID: "10",
+		PermissionOverwrites: []*PermissionOverwrite{
+			{ID: "1", Type: FlagPermissionOverwriteTypeRole, Deny: perms(FlagBitwisePermissionSEND_MESSAGES), Allow: "0"},
+			{ID: "2", Type: FlagPermissionOverwriteTypeRole, Deny: "0", Allow: perms(FlagBitwisePermissionATTACH_FILES)},
+			{ID: "200", Type: FlagPermissionOverwriteTypeMember, Deny: perms(FlagBitwisePermissionKICK_MEMBERS), Allow: "0"},
+			nil, // a nil overwrite is skipped.
+		},
+	}
+
+	// SEND_MESSAGES is denied, which implicitly denies EMBED_LINKS and ATTACH_FILES.
+	permissions, err := ChannelPermissions(guild, member, channel)
+	if err != nil || permissions != FlagBitwisePermissionVIEW_CHANNEL {
+		t.Fatalf("(%v): got (%v, %v)", "ChannelPermissions", PermissionNames(permissions), err)
+	}
+
+	if missing := MissingPermissions(permissions, FlagBitwisePermissionSEND_MESSAGES|FlagBitwisePermissionVIEW_CHANNEL); missing != FlagBitwisePermissionSEND_MESSAGES ||
+		PermissionNames(missing)[0] != "SEND_MESSAGES" {
+		t.Fatalf("(%v): got %v", "MissingPermissions", PermissionNames(missing))
+	}
+
+	// a timed out member can only view channels and read message history.
+	member.CommunicationDisabledUntil = Pointer(Pointer(time.Now().Add(time.Hour)))
+	if base, _ := BasePermissions(guild, member); base != FlagBitwisePermissionVIEW_CHANNEL {
+		t.Fatalf("(%v): got %v", "timeout", PermissionNames(base))
+	}
+
+	// an administrator and the owner have every permission.
+	admin := &GuildMember{User: &User{ID: "300"}, Roles: []*string{Pointer("3")}} //nolint:exhaustruct
+	owner := &GuildMember{User: &User{ID: "100"}}                                 //nolint:exhaustruct
+
+	for _, m := range []*GuildMember{admin, owner} {
+		if permissions, _ := ChannelPermissions(guild, m, channel); permissions != PermissionsAll {
+			t.Fatalf("(%v): got %v for member %v", "ADMINISTRATOR", permissions, m.User.ID)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package unit_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// permissionState represents a PermissionState for testing.
+type permissionState struct {
+	guild    *Guild
+	members  map[string]*GuildMember
+	channels map[string]*Channel
+}
+
+func (s *permissionState) Guild(guildID string) (*Guild, bool) {
+	return s.guild, s.guild.ID == guildID
+}
+
+func (s *permissionState) Member(_, userID string) (*GuildMember, bool) {
+	member, ok := s.members[userID]
+
+	return member, ok
+}
+
+func (s *permissionState) Channel(channelID string) (*Channel, bool) {
+	channel, ok := s.channels[channelID]
+
+	return channel, ok
+}
+
+// TestPreflight tests the pre-flight permission checks of requests.
+func TestPreflight(t *testing.T) {
+	for route := range RoutePermissions {
+		if _, ok := RouteIDs[route]; !ok {
+			t.Fatalf("(%v): route %q is NOT in RouteIDs", "RoutePermissions", route)
+		}
+	}
+
+	perms := func(flags BitFlag) string { return strconv.FormatUint(uint64(flags), 10) }
+
+	state := &permissionState{
+		guild: &Guild{ //nolint:exhaustruct
+			ID:      "1",
+			OwnerID: "100",
+			Roles: []*Role{
+				{ID: "1", Position: 0, Permissions: perms(FlagBitwisePermissionVIEW_CHANNEL)},             //nolint:exhaustruct
+				{ID: "2", Position: 1, Permissions: perms(FlagBitwisePermissionBAN_MEMBERS)},              //nolint:exhaustruct
+				{ID: "3", Position: 2, Permissions: perms(FlagBitwisePermissionKICK_MEMBERS)},             //nolint:exhaustruct
+				{ID: "4", Position: 3, Permissions: perms(FlagBitwisePermissionSEND_MESSAGES_IN_THREADS)}, //nolint:exhaustruct
+				nil, // a nil role is skipped.
+			},
+		},
+		members: map[string]*GuildMember{
+			"200": {User: &User{ID: "200"}, Roles: []*string{Pointer("2"), Pointer("4")}}, //nolint:exhaustruct
+			"300": {User: &User{ID: "300"}, Roles: []*string{Pointer("3")}},               //nolint:exhaustruct
+			"400": {User: &User{ID: "400"}, Roles: []*string{Pointer("4")}},               //nolint:exhaustruct
+		},
+		channels: map[string]*Channel{
+			"10": {ID: "10", GuildID: Pointer("1"), Type: Pointer(FlagChannelTypeGUILD_TEXT)},                              //nolint:exhaustruct
+			"11": {ID: "11", GuildID: Pointer("1"), Type: Pointer(FlagChannelTypePUBLIC_THREAD), ParentID: Pointer2("10")}, //nolint:exhaustruct
+		},
+	}
+
+	// the bot's member is found using the ID of the bot's user (instead of the application's ID).
+	bot := &Client{ApplicationID: "1000", Config: DefaultConfig()} //nolint:exhaustruct
+	bot.Config.Request.Preflight = state
+
+	// a request is NOT checked without the ID of the bot's user.
+	if err := Preflight(bot, &CreateMessage{ChannelID: "10"}); err != nil { //nolint:exhaustruct
+		t.Fatalf("(%v): got %v, wanted %v", "PreflightUserID", err, nil)
+	}
+
+	bot.Config.Request.PreflightUserID = "200"
+
+	tests := []struct {
+		request   any
+		missing   BitFlag
+		hierarchy bool
+	}{
+		{request: &CreateMessage{ChannelID: "10"}, missing: FlagBitwisePermissionSEND_MESSAGES},                                                                           //nolint:exhaustruct
+		{request: &CreateMessage{ChannelID: "11"}},                                                                                                                        //nolint:exhaustruct
+		{request: &CreateGuildBan{GuildID: "1", UserID: "300"}},                                                                                                           //nolint:exhaustruct
+		{request: &CreateGuildBan{GuildID: "1", UserID: "400"}, hierarchy: true},                                                                                          //nolint:exhaustruct
+		{request: &RemoveGuildMember{GuildID: "1", UserID: "300"}, missing: FlagBitwisePermissionKICK_MEMBERS},                                                            //nolint:exhaustruct
+		{request: &ModifyGuildMember{GuildID: "1", UserID: "300", Mute: Pointer2(true)}, missing: FlagBitwisePermissionMUTE_MEMBERS},                                      //nolint:exhaustruct
+		{request: &CreateReaction{ChannelID: "10", MessageID: "20", Emoji: "x"}, missing: FlagBitwisePermissionREAD_MESSAGE_HISTORY | FlagBitwisePermissionADD_REACTIONS}, //nolint:exhaustruct
+		{request: &GetChannel{ChannelID: "10"}},                                                                                                                           //nolint:exhaustruct
+	}
+
+	for i, test := range tests {
+		err := Preflight(bot, test.request)
+
+		var permErr ErrorPermissions
+		if test.missing == 0 && !test.hierarchy {
+			if err != nil {
+				t.Fatalf("test %d: (%T): unexpected error %v", i, test.request, err)
+			}
+
+			continue
+		}
+
+		if !errors.As(err, &permErr) || permErr.Missing != test.missing || permErr.Hierarchy != test.hierarchy {
+			t.Fatalf("test %d: (%T): got %v, wanted missing %v (hierarchy %v)", i, test.request, err, PermissionNames(test.missing), test.hierarchy)
+		}
+	}
+
+	// a request that fails its pre-flight check is NOT sent.
+	var permErr ErrorPermissions
+	if _, err := (&CreateMessage{ChannelID: "10"}).Send(bot); !errors.As(err, &permErr) { //nolint:exhaustruct
+		t.Fatalf("(%v): got %v, wanted %v", "Send", err, "ErrorPermissions")
+	}
+}
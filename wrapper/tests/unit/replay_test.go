@@ -0,0 +1,170 @@
+package unit_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// replayGateway returns the endpoint of a test Discord Gateway which replays the given payloads
+// when a session resumes, then sends the given live payloads.
+//
+// An empty payload indicates that the gateway waits for a Heartbeat from the session.
+func replayGateway(t *testing.T, replays []string, live []string) string {
+	t.Helper()
+
+	write := func(c *gatewayConn, payloads []string) bool {
+		for _, payload := range payloads {
+			if payload != "" {
+				if !c.write(payload) {
+					return false
+				}
+
+				continue
+			}
+
+			for {
+				op, _, ok := c.read()
+				if !ok {
+					return false
+				}
+
+				if op == FlagGatewayOpcodeHeartbeat {
+					break
+				}
+			}
+		}
+
+		return true
+	}
+
+	return gateway(t, 0, map[int]gatewayHandler{
+		FlagGatewayOpcodeResume: func(c *gatewayConn, _ json.RawMessage) bool {
+			return write(c, replays) && write(c, live)
+		},
+		FlagGatewayOpcodeHeartbeat: nil,
+	})
+}
+
+// TestReplay tests the events that a Session replays while resuming
+// along with the detection of events with a gap or duplicate sequence number.
+func TestReplay(t *testing.T) {
+	typing := func(seq string) string {
+		return `{"op":0,"t":"TYPING_START","s":` + seq + `,"d":{"channel_id":"` + seq + `"}}`
+	}
+
+	endpoint := replayGateway(t,
+		[]string{
+			typing("6"),
+			`{"op":1,"d":null}`,
+			"", // the session responds to the Heartbeat while replaying events.
+			typing("6"),
+			typing("8"),
+			`{"op":0,"t":"RESUMED","s":9,"d":{}}`,
+		},
+		[]string{
+			typing("10"),
+			typing("10"),
+		},
+	)
+
+	bot := &Client{ //nolint:exhaustruct
+		Authentication: BotToken("token"),
+		Config:         DefaultConfig(),
+		Handlers:       new(Handlers),
+		Sessions:       NewSessionManager(),
+	}
+
+	// dispatch the events in order (such that replayed events are handled before live events).
+	bot.Dispatcher = NewDispatcher(DispatchModeOrdered, 0, 0)
+	defer bot.Dispatcher.Close()
+
+	var (
+		mu     sync.Mutex
+		errs   []ErrorSequence
+		events []string
+	)
+
+	bot.ErrorHandler = func(err error, ctx *ErrorContext) {
+		sequenceErr := new(ErrorSequence)
+		if !errors.As(err, sequenceErr) {
+			return
+		}
+
+		mu.Lock()
+		errs = append(errs, *sequenceErr)
+		mu.Unlock()
+	}
+
+	handled := make(chan struct{})
+	OnDispatch(bot, func(event *TypingStart, info *DispatchInfo) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// a replayed event is indicated to typed event handlers.
+		if info.Replayed {
+			events = append(events, event.ChannelID+" (replayed)")
+		} else {
+			events = append(events, event.ChannelID)
+		}
+
+		if event.ChannelID == "10" {
+			close(handled)
+		}
+	})
+
+	s := NewSession()
+	s.Restore(&SessionState{Shard: nil, ID: "a", Endpoint: endpoint, Seq: 5})
+
+	if err := s.Connect(bot); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	defer s.Disconnect() //nolint:errcheck
+
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the live event")
+	}
+
+	// wait for the duplicate live event (which is NOT dispatched).
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		mu.Lock()
+		n := len(errs)
+		mu.Unlock()
+
+		if n == 3 {
+			break
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	expectedEvents := []string{"6 (replayed)", "8 (replayed)", "10"}
+	if strings.Join(events, ", ") != strings.Join(expectedEvents, ", ") {
+		t.Errorf("expected events %v but got %v", expectedEvents, events)
+	}
+
+	expectedErrs := []ErrorSequence{
+		{SessionID: "a", Event: FlagGatewayEventNameTypingStart, Expected: 7, Received: 6},   // duplicate
+		{SessionID: "a", Event: FlagGatewayEventNameTypingStart, Expected: 7, Received: 8},   // gap
+		{SessionID: "a", Event: FlagGatewayEventNameTypingStart, Expected: 11, Received: 10}, // duplicate
+	}
+
+	if len(errs) != len(expectedErrs) {
+		t.Fatalf("expected %d sequence errors but got %d: %v", len(expectedErrs), len(errs), errs)
+	}
+
+	for i := range expectedErrs {
+		if errs[i] != expectedErrs[i] {
+			t.Errorf("sequence error %d: expected %v but got %v", i, expectedErrs[i], errs[i])
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// membersGateway returns the endpoint of a test Discord Gateway which resumes every session,
+// then responds to each RequestGuildMembers with the chunks (payload data) returned by the given function.
+func membersGateway(t *testing.T, chunks func(nonce string) []string) string {
+	t.Helper()
+
+	var seq int64 = 6
+
+	return gateway(t, 0, map[int]gatewayHandler{
+		FlagGatewayOpcodeRequestGuildMembers: func(c *gatewayConn, data json.RawMessage) bool {
+			var request struct {
+				Nonce string `json:"nonce"`
+			}
+
+			if err := json.Unmarshal(data, &request); err != nil {
+				return false
+			}
+
+			for _, chunk := range chunks(request.Nonce) {
+				seq++
+				if !c.write(`{"op":0,"t":"GUILD_MEMBERS_CHUNK","s":` + strconv.FormatInt(seq, 10) + `,"d":` + chunk + `}`) {
+					return false
+				}
+			}
+
+			return true
+		},
+	})
+}
+
+// chunk returns the payload data of a GuildMembersChunk with the given nonce, index, count, and member IDs.
+func chunk(nonce string, index, count int, ids ...string) string {
+	members := make([]string, len(ids))
+	for i, id := range ids {
+		members[i] = `{"user":{"id":"` + id + `"}}`
+	}
+
+	return `{"guild_id":"1","nonce":"` + nonce + `","chunk_index":` + strconv.Itoa(index) +
+		`,"chunk_count":` + strconv.Itoa(count) + `,"members":[` + strings.Join(members, ",") + `]}`
+}
+
+// TestRequestGuildMembers tests the guild members that are returned from the GuildMembersChunk events of a request.
+func TestRequestGuildMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		nonce   *string
+		chunks  func(nonce string) []string
+		members []string
+	}{
+		{
+			name:  "Chunks",
+			nonce: nil,
+			chunks: func(nonce string) []string {
+				return []string{
+					chunk("other", 0, 1, "4"),
+					chunk(nonce, 1, 2, "3"),
+					chunk(nonce, 0, 2, "1", "2"),
+				}
+			},
+			members: []string{"1", "2", "3"},
+		},
+		{
+			name:  "No Chunk Count",
+			nonce: nil,
+			chunks: func(nonce string) []string {
+				return []string{chunk(nonce, 0, 0, "1")}
+			},
+			members: []string{"1"},
+		},
+		{
+			name:  "Nonce",
+			nonce: Pointer("nonce"),
+			chunks: func(nonce string) []string {
+				if nonce != "nonce" {
+					return nil
+				}
+
+				return []string{chunk(nonce, 0, 1, "1")}
+			},
+			members: []string{"1"},
+		},
+	}
+
+	for _, test := range tests {
+		bot := &Client{ //nolint:exhaustruct
+			Authentication: BotToken("token"),
+			Config:         DefaultConfig(),
+			Handlers:       new(Handlers),
+			Sessions:       NewSessionManager(),
+		}
+
+		s := NewSession()
+		s.Restore(&SessionState{Shard: nil, ID: "a", Endpoint: membersGateway(t, test.chunks), Seq: 5})
+
+		if err := s.Connect(bot); err != nil {
+			t.Fatalf("(%v): connect: %v", test.name, err)
+		}
+
+		request := &RequestGuildMembers{GuildID: "1", Nonce: test.nonce} //nolint:exhaustruct
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		members, err := request.Request(ctx, bot)
+
+		cancel()
+
+		_ = s.Disconnect()
+
+		if err != nil {
+			t.Fatalf("(%v): unexpected error: %v", test.name, err)
+		}
+
+		// the request is NOT modified.
+		if request.Nonce != test.nonce {
+			t.Errorf("(%v): the request's nonce was modified", test.name)
+		}
+
+		if test.nonce != nil && members.Nonce != *test.nonce {
+			t.Errorf("(%v): expected nonce %q but got %q", test.name, *test.nonce, members.Nonce)
+		}
+
+		ids := make([]string, len(members.Members))
+		for i, member := range members.Members {
+			ids[i] = member.User.ID
+		}
+
+		if strings.Join(ids, ",") != strings.Join(test.members, ",") {
+			t.Errorf("(%v): expected members %v but got %v", test.name, test.members, ids)
+		}
+	}
+}
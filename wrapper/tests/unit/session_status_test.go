@@ -0,0 +1,148 @@
+package unit_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// statusClient returns a client which records the status transitions of its sessions.
+func statusClient() (*Client, func() []SessionTransition) {
+	bot := &Client{ //nolint:exhaustruct
+		Authentication: BotToken("token"),
+		Config:         DefaultConfig(),
+		Handlers:       new(Handlers),
+		Sessions:       NewSessionManager(),
+	}
+
+	var (
+		mu          sync.Mutex
+		transitions []SessionTransition
+	)
+
+	bot.Config.Gateway.SessionStatusHandler = func(s *Session, t *SessionTransition) {
+		// the status handler is called without holding the Session's lock.
+		s.Lock()
+		s.Unlock() //nolint:staticcheck
+
+		mu.Lock()
+		transitions = append(transitions, *t)
+		mu.Unlock()
+	}
+
+	return bot, func() []SessionTransition {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]SessionTransition(nil), transitions...)
+	}
+}
+
+// TestSessionStatus tests the status transitions of a Session that connects and disconnects.
+func TestSessionStatus(t *testing.T) {
+	bot, transitions := statusClient()
+
+	s := NewSession()
+	s.Restore(&SessionState{Shard: nil, ID: "a", Endpoint: gateway(t, 0, nil), Seq: 5})
+
+	if err := s.Connect(bot); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	if status := s.Status(); status != SessionStatusReady {
+		t.Errorf("expected status %q but got %q", SessionStatuses[SessionStatusReady], SessionStatuses[status])
+	}
+
+	if err := s.Disconnect(); err != nil {
+		t.Fatalf("disconnect: %v", err)
+	}
+
+	expected := [][2]int{
+		{SessionStatusDisconnected, SessionStatusConnecting},
+		{SessionStatusConnecting, SessionStatusResuming},
+		{SessionStatusResuming, SessionStatusReady},
+		{SessionStatusReady, SessionStatusDisconnected},
+	}
+
+	got := transitions()
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d transitions but got %d: %v", len(expected), len(got), got)
+	}
+
+	for i, transition := range got {
+		if transition.From != expected[i][0] || transition.To != expected[i][1] {
+			t.Errorf("transition %d: expected %q -> %q but got %q -> %q", i,
+				SessionStatuses[expected[i][0]], SessionStatuses[expected[i][1]],
+				SessionStatuses[transition.From], SessionStatuses[transition.To],
+			)
+		}
+	}
+
+	// the Ready transition reports the attempt that succeeded.
+	if got[0].Attempt != 1 || got[2].Attempt != 1 {
+		t.Errorf("expected attempt 1 (connecting and ready) but got %d and %d", got[0].Attempt, got[2].Attempt)
+	}
+}
+
+// TestSessionStatusClose tests the status of a Session that is closed with a Gateway Close Event Code.
+func TestSessionStatusClose(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   int
+		status int
+		fatal  bool
+	}{
+		{name: "Unknown Error", code: 4000, status: SessionStatusDisconnected, fatal: false},
+		{name: "Authentication Failed", code: 4004, status: SessionStatusFailed, fatal: true},
+		{name: "Invalid Intents", code: 4013, status: SessionStatusFailed, fatal: true},
+		{name: "Disallowed Intents", code: 4014, status: SessionStatusFailed, fatal: true},
+	}
+
+	for _, test := range tests {
+		bot, transitions := statusClient()
+
+		// the gateway closes the connection with the close code when the session resumes.
+		code := test.code
+		endpoint := gateway(t, 0, map[int]gatewayHandler{
+			FlagGatewayOpcodeResume: func(c *gatewayConn, _ json.RawMessage) bool {
+				c.close(code)
+
+				return false
+			},
+		})
+
+		s := NewSession()
+		s.Restore(&SessionState{Shard: nil, ID: "a", Endpoint: endpoint, Seq: 5})
+
+		err := s.Connect(bot)
+		if err == nil {
+			t.Fatalf("(%v): expected an error", test.name)
+		}
+
+		closeErr := new(ErrorGatewayClose)
+		if fatal := errors.As(err, closeErr); fatal != test.fatal {
+			t.Errorf("(%v): expected ErrorGatewayClose %v but got %v", test.name, test.fatal, err)
+		} else if fatal && closeErr.Code.Code != test.code {
+			t.Errorf("(%v): expected close code %d but got %d", test.name, test.code, closeErr.Code.Code)
+		}
+
+		if status := s.Status(); status != test.status {
+			t.Errorf("(%v): expected status %q but got %q", test.name, SessionStatuses[test.status], SessionStatuses[status])
+		}
+
+		got := transitions()
+		if len(got) == 0 {
+			t.Fatalf("(%v): expected transitions", test.name)
+		}
+
+		last := got[len(got)-1]
+		if last.To != test.status || last.Code != test.code || last.Err == nil {
+			t.Errorf("(%v): expected a transition to %q with code %d but got %+v",
+				test.name, SessionStatuses[test.status], test.code, last,
+			)
+		}
+	}
+}
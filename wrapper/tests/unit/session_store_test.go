@@ -0,0 +1,67 @@
+package unit_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// TestFileSessionStore tests the FileSessionStore for saving, loading, and deleting Session State.
+func TestFileSessionStore(t *testing.T) {
+	store := NewFileSessionStore(filepath.Join(t.TempDir(), "sessions.json"))
+
+	// load from a file that does not exist.
+	state, err := store.Load(nil)
+	if err != nil || state != nil {
+		t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", "LoadEmpty", state, err, nil, nil)
+	}
+
+	s := NewSession()
+	s.Shard = &[2]int{1, 2}
+	s.Restore(&SessionState{
+		ID:       "session",
+		Seq:      10,
+		Endpoint: "wss://gateway.discord.gg",
+	})
+
+	// save the state of a session.
+	if err := store.Save(s.State()); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Save", err, nil)
+	}
+
+	// load the state of a different shard.
+	if state, err = store.Load(&[2]int{0, 2}); err != nil || state != nil {
+		t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", "LoadOtherShard", state, err, nil, nil)
+	}
+
+	// load the state of the session.
+	state, err = store.Load(&[2]int{1, 2})
+	if err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Load", err, nil)
+	}
+
+	if state == nil ||
+		state.ID != "session" ||
+		state.Seq != 10 ||
+		state.Endpoint != "wss://gateway.discord.gg" ||
+		state.Shard == nil || *state.Shard != [2]int{1, 2} {
+		t.Fatalf("(%v): got %+v, wanted %+v", "Load", state, s.State())
+	}
+
+	// restore the state to another session.
+	restored := NewSession()
+	restored.Restore(state)
+	if restored.ID != s.ID || restored.Seq != s.Seq || restored.Endpoint != s.Endpoint || *restored.Shard != *s.Shard {
+		t.Fatalf("(%v): got %+v, wanted %+v", "Restore", restored.State(), s.State())
+	}
+
+	// delete the state of the session.
+	if err := store.Delete(&[2]int{1, 2}); err != nil {
+		t.Fatalf("(%v): got %v, wanted %v", "Delete", err, nil)
+	}
+
+	if state, err = store.Load(&[2]int{1, 2}); err != nil || state != nil {
+		t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", "LoadDeleted", state, err, nil, nil)
+	}
+}
@@ -0,0 +1,64 @@
+package unit_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/switchupcb/disgo"
+)
+
+// TestGuildShard tests GuildShard for the shard_id of a guild.
+func TestGuildShard(t *testing.T) {
+	tests := []struct {
+		name    string
+		guildID string
+		shards  int
+		output  int
+	}{
+		{name: "unsharded", guildID: "81384788765712384", shards: 1, output: 0},
+		{name: "sixteen", guildID: "81384788765712384", shards: 16, output: 2},
+		{name: "four", guildID: "197038439483310086", shards: 4, output: 2},
+	}
+
+	for _, test := range tests {
+		shard, err := GuildShard(test.guildID, test.shards)
+		if err != nil || shard != test.output {
+			t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", test.name, shard, err, test.output, nil)
+		}
+	}
+
+	if _, err := GuildShard("guild", 1); err == nil {
+		t.Fatalf("(%v): got %v, wanted an error", "invalid", err)
+	}
+}
+
+// TestGuildSession tests GuildSession for an ErrorShard when the shard of a guild is NOT connected
+// (or an ErrorNoSession when no session is connected).
+func TestGuildSession(t *testing.T) {
+	bot := &Client{ //nolint:exhaustruct
+		Config:   DefaultConfig(),
+		Sessions: NewSessionManager(),
+	}
+
+	// the bot has no sessions.
+	var sessionErr ErrorNoSession
+	if _, err := bot.GuildSession("81384788765712384"); !errors.As(err, &sessionErr) {
+		t.Fatalf("(%v): got %v, wanted %v", "none", err, ErrorNoSession{GuildID: "81384788765712384"})
+	}
+
+	s := NewSession()
+	s.ID = "session"
+	s.Shard = &[2]int{0, 16}
+	bot.Sessions.Gateway.Store(s.ID, s)
+
+	// the guild is routed to shard 2.
+	var shardErr ErrorShard
+	if _, err := bot.GuildSession("81384788765712384"); !errors.As(err, &shardErr) || shardErr.Shard != 2 || shardErr.Shards != 16 {
+		t.Fatalf("(%v): got %v, wanted %v", "remote", err, ErrorShard{GuildID: "81384788765712384", Shard: 2, Shards: 16})
+	}
+
+	s.Shard = &[2]int{2, 16}
+	if session, err := bot.GuildSession("81384788765712384"); err != nil || session != s {
+		t.Fatalf("(%v): got (%v, %v), wanted (%v, %v)", "local", session, err, s.ID, nil)
+	}
+}
@@ -0,0 +1,61 @@
+package unit_test
+
+import (
+	"testing"
+	"time"
+
+	json "github.com/goccy/go-json"
+	. "github.com/switchupcb/disgo"
+)
+
+// TestSnowflake tests the fields, conversion, and JSON representation of a Snowflake.
+func TestSnowflake(t *testing.T) {
+	// https://discord.com/developers/docs/reference#snowflakes-snowflake-id-format-structure-left-to-right
+	id, err := ParseSnowflake("175928847299117063")
+	if err != nil {
+		t.Fatalf("(%v): %v", "ParseSnowflake", err)
+	}
+
+	if id.Timestamp() != 1462015105796 || id.WorkerID() != 1 || id.ProcessID() != 0 || id.Increment() != 7 {
+		t.Fatalf("(%v): got (%d, %d, %d, %d)", "fields", id.Timestamp(), id.WorkerID(), id.ProcessID(), id.Increment())
+	}
+
+	if after := SnowflakeFromTime(id.Time()); after.Time() != id.Time() || after.Compare(id) != -1 || id.Compare(after) != 1 {
+		t.Fatalf("(%v): got %v", "SnowflakeFromTime", after)
+	}
+
+	if shard, err := GuildShard(id.String(), 4); err != nil || shard != id.Shard(4) {
+		t.Fatalf("(%v): got (%v, %v)", "Shard", shard, err)
+	}
+
+	var ids struct {
+		String  Snowflake `json:"string"`
+		Integer Snowflake `json:"integer"`
+		Nonce   Nonce     `json:"nonce"`
+	}
+
+	data := []byte(`{"string":"175928847299117063","integer":175928847299117063,"nonce":175928847299117063}`)
+	if err := json.Unmarshal(data, &ids); err != nil || ids.String != id || ids.Integer != id || ids.Nonce != "175928847299117063" {
+		t.Fatalf("(%v): got (%+v, %v)", "UnmarshalJSON", ids, err)
+	}
+
+	if b, err := json.Marshal(id); err != nil || string(b) != `"175928847299117063"` {
+		t.Fatalf("(%v): got (%s, %v)", "MarshalJSON", b, err)
+	}
+
+	var integer struct {
+		ID IntegerSnowflake `json:"id"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":"175928847299117063"}`), &integer); err != nil || Snowflake(integer.ID) != id {
+		t.Fatalf("(%v): got (%v, %v)", "IntegerSnowflake UnmarshalJSON", integer.ID, err)
+	}
+
+	if b, err := json.Marshal(integer); err != nil || string(b) != `{"id":175928847299117063}` {
+		t.Fatalf("(%v): got (%s, %v)", "IntegerSnowflake MarshalJSON", b, err)
+	}
+
+	if SnowflakeFromTime(time.Unix(0, 0)) != 0 {
+		t.Fatalf("(%v): expected 0 for a time before the Discord Epoch", "SnowflakeFromTime")
+	}
+}